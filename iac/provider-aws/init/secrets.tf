@@ -244,6 +244,37 @@ output "sandbox_access_token_hash_seed" {
   sensitive = true
 }
 
+// ---
+// Team Secrets Encryption Key
+// ---
+resource "random_bytes" "secrets_encryption_key" {
+  length = 32
+}
+
+resource "aws_secretsmanager_secret" "secrets_encryption_key" {
+  name = "${var.prefix}secrets-encryption-key"
+}
+
+resource "aws_secretsmanager_secret_version" "secrets_encryption_key" {
+  secret_id     = aws_secretsmanager_secret.secrets_encryption_key.id
+  secret_string = random_bytes.secrets_encryption_key.base64
+
+  lifecycle {
+    ignore_changes = [secret_string]
+  }
+}
+
+data "aws_secretsmanager_secret_version" "secrets_encryption_key" {
+  secret_id     = aws_secretsmanager_secret.secrets_encryption_key.id
+  version_stage = "AWSCURRENT"
+  depends_on    = [aws_secretsmanager_secret_version.secrets_encryption_key]
+}
+
+output "secrets_encryption_key" {
+  value     = data.aws_secretsmanager_secret_version.secrets_encryption_key.secret_string
+  sensitive = true
+}
+
 // ---
 // Redis
 // ---
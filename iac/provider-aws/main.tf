@@ -111,6 +111,7 @@ locals {
     API_INTERNAL_GRPC_PORT         = tostring(var.api_internal_grpc_port)
     ADMIN_TOKEN                    = module.init.admin_token
     SANDBOX_ACCESS_TOKEN_HASH_SEED = module.init.sandbox_access_token_hash_seed
+    SECRETS_ENCRYPTION_KEY         = module.init.secrets_encryption_key
     AUTH_PROVIDER_CONFIG           = replace(jsonencode(local.auth_provider_config), "\"", "\\\"")
 
     POSTGRES_CONNECTION_STRING   = module.init.postgres_connection_string
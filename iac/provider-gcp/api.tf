@@ -113,3 +113,19 @@ resource "google_secret_manager_secret_version" "sandbox_access_token_hash_seed"
   secret      = google_secret_manager_secret.sandbox_access_token_hash_seed.id
   secret_data = random_password.sandbox_access_token_hash_seed.result
 }
+
+resource "random_bytes" "secrets_encryption_key" {
+  length = 32
+}
+
+resource "google_secret_manager_secret" "secrets_encryption_key" {
+  secret_id = "${var.prefix}secrets-encryption-key"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "secrets_encryption_key" {
+  secret      = google_secret_manager_secret.secrets_encryption_key.id
+  secret_data = random_bytes.secrets_encryption_key.base64
+}
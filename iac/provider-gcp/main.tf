@@ -82,6 +82,7 @@ locals {
     API_INTERNAL_GRPC_PORT         = tostring(var.api_internal_grpc_port)
     ADMIN_TOKEN                    = trimspace(data.google_secret_manager_secret_version.api_admin_token.secret_data)
     SANDBOX_ACCESS_TOKEN_HASH_SEED = random_password.sandbox_access_token_hash_seed.result
+    SECRETS_ENCRYPTION_KEY         = random_bytes.secrets_encryption_key.base64
     AUTH_PROVIDER_CONFIG           = replace(jsonencode(local.auth_provider_config), "\"", "\\\"")
 
     POSTGRES_CONNECTION_STRING             = data.google_secret_manager_secret_version.postgres_connection_string.secret_data
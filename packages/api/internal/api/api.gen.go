@@ -31,6 +31,7 @@ const (
 	ApiKeyAuthScopes             apiKeyAuthContextKey             = "ApiKeyAuth.Scopes"
 	AuthProviderBearerAuthScopes authProviderBearerAuthContextKey = "AuthProviderBearerAuth.Scopes"
 	AuthProviderTeamAuthScopes   authProviderTeamAuthContextKey   = "AuthProviderTeamAuth.Scopes"
+	SandboxControlAuthScopes     sandboxControlAuthContextKey     = "SandboxControlAuth.Scopes"
 )
 
 // Defines values for AWSRegistryType.
@@ -165,6 +166,117 @@ func (e NodeStatus) Valid() bool {
 	}
 }
 
+// Defines values for SandboxEventType.
+const (
+	SandboxLifecycleCheckpointed SandboxEventType = "sandbox.lifecycle.checkpointed"
+	SandboxLifecycleCreated      SandboxEventType = "sandbox.lifecycle.created"
+	SandboxLifecycleKilled       SandboxEventType = "sandbox.lifecycle.killed"
+	SandboxLifecyclePaused       SandboxEventType = "sandbox.lifecycle.paused"
+	SandboxLifecycleResumed      SandboxEventType = "sandbox.lifecycle.resumed"
+	SandboxLifecycleUpdated      SandboxEventType = "sandbox.lifecycle.updated"
+)
+
+// Valid indicates whether the value is a known member of the SandboxEventType enum.
+func (e SandboxEventType) Valid() bool {
+	switch e {
+	case SandboxLifecycleCheckpointed:
+		return true
+	case SandboxLifecycleCreated:
+		return true
+	case SandboxLifecycleKilled:
+		return true
+	case SandboxLifecyclePaused:
+		return true
+	case SandboxLifecycleResumed:
+		return true
+	case SandboxLifecycleUpdated:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SandboxNetworkDecision.
+const (
+	SandboxNetworkDecisionAllowed SandboxNetworkDecision = "allowed"
+	SandboxNetworkDecisionBlocked SandboxNetworkDecision = "blocked"
+)
+
+// Valid indicates whether the value is a known member of the SandboxNetworkDecision enum.
+func (e SandboxNetworkDecision) Valid() bool {
+	switch e {
+	case SandboxNetworkDecisionAllowed:
+		return true
+	case SandboxNetworkDecisionBlocked:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SandboxNetworkLogEntryMatchType.
+const (
+	SandboxNetworkMatchTypeCIDR   SandboxNetworkLogEntryMatchType = "cidr"
+	SandboxNetworkMatchTypeDomain SandboxNetworkLogEntryMatchType = "domain"
+	SandboxNetworkMatchTypeNone   SandboxNetworkLogEntryMatchType = "none"
+	SandboxNetworkMatchTypeRule   SandboxNetworkLogEntryMatchType = "rule"
+)
+
+// Valid indicates whether the value is a known member of the SandboxNetworkLogEntryMatchType enum.
+func (e SandboxNetworkLogEntryMatchType) Valid() bool {
+	switch e {
+	case SandboxNetworkMatchTypeCIDR:
+		return true
+	case SandboxNetworkMatchTypeDomain:
+		return true
+	case SandboxNetworkMatchTypeNone:
+		return true
+	case SandboxNetworkMatchTypeRule:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SandboxNetworkLogEntryProtocol.
+const (
+	SandboxNetworkProtocolHTTP  SandboxNetworkLogEntryProtocol = "http"
+	SandboxNetworkProtocolOther SandboxNetworkLogEntryProtocol = "other"
+	SandboxNetworkProtocolTLS   SandboxNetworkLogEntryProtocol = "tls"
+)
+
+// Valid indicates whether the value is a known member of the SandboxNetworkLogEntryProtocol enum.
+func (e SandboxNetworkLogEntryProtocol) Valid() bool {
+	switch e {
+	case SandboxNetworkProtocolHTTP:
+		return true
+	case SandboxNetworkProtocolOther:
+		return true
+	case SandboxNetworkProtocolTLS:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SandboxNetworkRuleAction.
+const (
+	SandboxNetworkRuleActionAllow SandboxNetworkRuleAction = "allow"
+	SandboxNetworkRuleActionDeny  SandboxNetworkRuleAction = "deny"
+)
+
+// Valid indicates whether the value is a known member of the SandboxNetworkRuleAction enum.
+func (e SandboxNetworkRuleAction) Valid() bool {
+	switch e {
+	case SandboxNetworkRuleActionAllow:
+		return true
+	case SandboxNetworkRuleActionDeny:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SandboxOnTimeout.
 const (
 	Kill  SandboxOnTimeout = "kill"
@@ -243,6 +355,27 @@ func (e GetTeamsTeamIDMetricsMaxParamsMetric) Valid() bool {
 	}
 }
 
+// Defines values for GetV2SandboxesSandboxIDLogsParamsStream.
+const (
+	Pty    GetV2SandboxesSandboxIDLogsParamsStream = "pty"
+	Stderr GetV2SandboxesSandboxIDLogsParamsStream = "stderr"
+	Stdout GetV2SandboxesSandboxIDLogsParamsStream = "stdout"
+)
+
+// Valid indicates whether the value is a known member of the GetV2SandboxesSandboxIDLogsParamsStream enum.
+func (e GetV2SandboxesSandboxIDLogsParamsStream) Valid() bool {
+	switch e {
+	case Pty:
+		return true
+	case Stderr:
+		return true
+	case Stdout:
+		return true
+	default:
+		return false
+	}
+}
+
 // AWSRegistry defines model for AWSRegistry.
 type AWSRegistry struct {
 	// AwsAccessKeyId AWS Access Key ID for ECR authentication
@@ -369,6 +502,27 @@ type CreatedTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Enabled Whether the webhook receives events
+	Enabled bool `json:"enabled"`
+
+	// Events Event types delivered to the webhook, empty means all events
+	Events []SandboxEventType `json:"events"`
+
+	// SigningSecret Secret used to sign the payloads, it is shown only once
+	SigningSecret string `json:"signingSecret"`
+
+	// Url URL the events are delivered to
+	Url string `json:"url"`
+
+	// WebhookID ID of the webhook
+	WebhookID openapi_types.UUID `json:"webhookID"`
+}
+
 // DeleteTemplateTagsRequest defines model for DeleteTemplateTagsRequest.
 type DeleteTemplateTagsRequest struct {
 	// Name Name of the template
@@ -562,7 +716,16 @@ type NewSandbox struct {
 
 	// AutoResume Auto-resume configuration for paused sandboxes.
 	AutoResume *SandboxAutoResumeConfig `json:"autoResume,omitempty"`
-	EnvVars    *EnvVars                 `json:"envVars,omitempty"`
+
+	// BandwidthLimits Bandwidth and IOPS limits of the sandbox. Limits that aren't set default to the limits of the team's tier, if any. Each limit is capped at the default limit of the node running the sandbox.
+	BandwidthLimits *SandboxBandwidthLimits `json:"bandwidthLimits,omitempty"`
+
+	// ControlPolicy Actions the sandbox can take on itself from inside the VM through the hyperloop server, without an API key. Nothing is allowed by default.
+	ControlPolicy *SandboxControlPolicy `json:"controlPolicy,omitempty"`
+	EnvVars       *EnvVars              `json:"envVars,omitempty"`
+
+	// LogProcessOutput Ship the stdout and stderr of processes started in the sandbox to the sandbox logs, tagged with the process pid, tag and stream. Processes can override it when started.
+	LogProcessOutput *bool `json:"logProcessOutput,omitempty"`
 
 	// Mcp MCP configuration for the sandbox
 	Mcp      *Mcp                  `json:"mcp,omitempty"`
@@ -580,6 +743,12 @@ type NewSandbox struct {
 	VolumeMounts *[]SandboxVolumeMount `json:"volumeMounts,omitempty"`
 }
 
+// NewSandboxCheckpoint defines model for NewSandboxCheckpoint.
+type NewSandboxCheckpoint struct {
+	// Name Optional name of the checkpoint, unique within the sandbox. Unnamed checkpoints are only addressable by their ID.
+	Name *string `json:"name,omitempty"`
+}
+
 // NewTeamAPIKey defines model for NewTeamAPIKey.
 type NewTeamAPIKey struct {
 	// Name Name of the API key
@@ -592,6 +761,15 @@ type NewVolume struct {
 	Name string `json:"name"`
 }
 
+// NewWebhook defines model for NewWebhook.
+type NewWebhook struct {
+	// Events Event types delivered to the webhook, empty means all events
+	Events *[]SandboxEventType `json:"events,omitempty"`
+
+	// Url URL the events are delivered to, must use HTTPS
+	Url string `json:"url"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// ClusterID Identifier of the cluster
@@ -722,6 +900,9 @@ type NodeStatusChange struct {
 	// ClusterID Identifier of the cluster
 	ClusterID *openapi_types.UUID `json:"clusterID,omitempty"`
 
+	// MigrateSandboxes Live-migrate the running sandboxes to other nodes when the node is set to draining
+	MigrateSandboxes *bool `json:"migrateSandboxes,omitempty"`
+
 	// Status Status of the node.
 	// - draining: the node is bound to be shut down. It will not accept new sandboxes and will stop once all existing sandboxes are done.
 	// - standby: the node is not actively used, but it can return to ready and continue serving traffic.
@@ -775,6 +956,51 @@ type SandboxAutoResumeConfig struct {
 // SandboxAutoResumeEnabled Auto-resume enabled flag for paused sandboxes. Default false.
 type SandboxAutoResumeEnabled = bool
 
+// SandboxBandwidthLimits Bandwidth and IOPS limits of the sandbox. Limits that aren't set default to the limits of the team's tier, if any. Each limit is capped at the default limit of the node running the sandbox.
+type SandboxBandwidthLimits struct {
+	// DiskIops Disk operations per second
+	DiskIops *int32 `json:"diskIops,omitempty"`
+
+	// DiskMBPerSecond Disk throughput in megabytes per second
+	DiskMBPerSecond *int32 `json:"diskMBPerSecond,omitempty"`
+
+	// NetworkMbps Network egress (outbound) bandwidth in megabits per second, inbound traffic isn't limited
+	NetworkMbps *int32 `json:"networkMbps,omitempty"`
+}
+
+// SandboxCheckpoint defines model for SandboxCheckpoint.
+type SandboxCheckpoint struct {
+	// CheckpointID Identifier of the checkpoint
+	CheckpointID openapi_types.UUID `json:"checkpointID"`
+
+	// CreatedAt Time when the checkpoint was taken
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the checkpoint, unique within the sandbox
+	Name *string `json:"name,omitempty"`
+}
+
+// SandboxCheckpointRestoreRequest defines model for SandboxCheckpointRestoreRequest.
+type SandboxCheckpointRestoreRequest struct {
+	// Timeout Time to live for the restored sandbox in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// SandboxControlPolicy Actions the sandbox can take on itself from inside the VM through the hyperloop server, without an API key. Nothing is allowed by default.
+type SandboxControlPolicy struct {
+	// ExtendTimeout Allow the sandbox to set its own timeout
+	ExtendTimeout *bool `json:"extendTimeout,omitempty"`
+
+	// MaxSpawnedSandboxes Maximum number of sandboxes the sandbox can create over its lifetime, unlimited if not set
+	MaxSpawnedSandboxes *int32 `json:"maxSpawnedSandboxes,omitempty"`
+
+	// Pause Allow the sandbox to pause itself
+	Pause *bool `json:"pause,omitempty"`
+
+	// SpawnSandboxes Allow the sandbox to create sandboxes in the same team
+	SpawnSandboxes *bool `json:"spawnSandboxes,omitempty"`
+}
+
 // SandboxDetail defines model for SandboxDetail.
 type SandboxDetail struct {
 	// Alias Alias of the template
@@ -783,6 +1009,9 @@ type SandboxDetail struct {
 	// AllowInternetAccess Whether internet access was explicitly enabled or disabled for the sandbox. Null means it was not explicitly set.
 	AllowInternetAccess *bool `json:"allowInternetAccess,omitempty"`
 
+	// BandwidthLimits Bandwidth and IOPS limits of the sandbox. Limits that aren't set default to the limits of the team's tier, if any. Each limit is capped at the default limit of the node running the sandbox.
+	BandwidthLimits *SandboxBandwidthLimits `json:"bandwidthLimits,omitempty"`
+
 	// ClientID Identifier of the client
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	ClientID string `json:"clientID"`
@@ -823,8 +1052,11 @@ type SandboxDetail struct {
 	State SandboxState `json:"state"`
 
 	// TemplateID Identifier of the template from which is the sandbox created
-	TemplateID   string                `json:"templateID"`
-	VolumeMounts *[]SandboxVolumeMount `json:"volumeMounts,omitempty"`
+	TemplateID string `json:"templateID"`
+
+	// UpdatedEnvVars Environment variables set on the sandbox after it was created, secret values are redacted. The environment variables passed on create are not returned.
+	UpdatedEnvVars *map[string]string    `json:"updatedEnvVars,omitempty"`
+	VolumeMounts   *[]SandboxVolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // SandboxEgressProxyConfig SOCKS5 proxy for sandbox egress. Outbound TCP is tunneled through the proxy after allow/deny filtering; the sandbox is unaware. Domain-matched flows use remote DNS (ATYP=domain).
@@ -839,6 +1071,27 @@ type SandboxEgressProxyConfig struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// SandboxEnvVar defines model for SandboxEnvVar.
+type SandboxEnvVar struct {
+	// Secret Whether the value is a secret, secret values are redacted from the sandbox detail and logs
+	Secret *bool `json:"secret,omitempty"`
+
+	// Value Value of the environment variable
+	Value string `json:"value"`
+}
+
+// SandboxEnvVarsUpdate Update of the default environment variables of a running sandbox, used by newly started processes.
+type SandboxEnvVarsUpdate struct {
+	// EnvVars Environment variables to set, existing variables with the same name are replaced
+	EnvVars *map[string]SandboxEnvVar `json:"envVars,omitempty"`
+
+	// Unset Names of the environment variables to remove
+	Unset *[]string `json:"unset,omitempty"`
+}
+
+// SandboxEventType Type of the sandbox lifecycle event
+type SandboxEventType string
+
 // SandboxForkRequest defines model for SandboxForkRequest.
 type SandboxForkRequest struct {
 	// Count Number of forked sandboxes to create. All forks boot from the same snapshot, so the snapshot is captured once regardless of count. Each fork succeeds or fails independently; the outcome of each is reported in its entry of the response list.
@@ -854,6 +1107,22 @@ type SandboxForkResult struct {
 	Sandbox *Sandbox `json:"sandbox,omitempty"`
 }
 
+// SandboxIdentityDiscovery defines model for SandboxIdentityDiscovery.
+type SandboxIdentityDiscovery struct {
+	ClaimsSupported                  *[]string `json:"claims_supported,omitempty"`
+	IdTokenSigningAlgValuesSupported []string  `json:"id_token_signing_alg_values_supported"`
+	Issuer                           string    `json:"issuer"`
+	JwksUri                          string    `json:"jwks_uri"`
+	ResponseTypesSupported           []string  `json:"response_types_supported"`
+	SubjectTypesSupported            []string  `json:"subject_types_supported"`
+}
+
+// SandboxIdentityJWKS defines model for SandboxIdentityJWKS.
+type SandboxIdentityJWKS struct {
+	// Keys JSON Web Keys as defined in RFC 7517
+	Keys []map[string]interface{} `json:"keys"`
+}
+
 // SandboxLifecycle Sandbox lifecycle policy returned by sandbox info.
 type SandboxLifecycle struct {
 	// AutoResume Whether the sandbox can auto-resume.
@@ -952,19 +1221,88 @@ type SandboxNetworkConfig struct {
 	// MaskRequestHost Specify host mask which will be used for all sandbox requests
 	MaskRequestHost *string `json:"maskRequestHost,omitempty"`
 
-	// Rules Per-domain transform rules applied to matching egress HTTP/HTTPS requests. Keys are domains (e.g. "api.example.com", "example.com"). A domain listed here is not automatically allowed - use allowOut to permit the traffic.
+	// Rules Per-domain rules applied to matching egress HTTP/HTTPS requests. Keys are domains (e.g. "api.example.com", "example.com"). A domain listed here is not automatically allowed - use allowOut to permit the traffic. Access rules (with an action) are evaluated for plain HTTP requests only; TLS connections to a domain with access rules are blocked because the request method and path cannot be inspected.
 	Rules *map[string][]SandboxNetworkRule `json:"rules,omitempty"`
 }
 
-// SandboxNetworkRule Transform rule applied to egress requests matching a domain pattern.
+// SandboxNetworkDecision Egress firewall decision for an outbound connection
+type SandboxNetworkDecision string
+
+// SandboxNetworkLogEntry Audit log entry for a single outbound connection made by the sandbox
+type SandboxNetworkLogEntry struct {
+	// BytesReceived Bytes received by the sandbox from the upstream
+	BytesReceived int64 `json:"bytesReceived"`
+
+	// BytesSent Bytes sent from the sandbox to the upstream
+	BytesSent int64 `json:"bytesSent"`
+
+	// Decision Egress firewall decision for an outbound connection
+	Decision SandboxNetworkDecision `json:"decision"`
+
+	// DestinationIP Destination IP address the sandbox connected to
+	DestinationIP string `json:"destinationIP"`
+
+	// DestinationPort Destination port
+	DestinationPort int32 `json:"destinationPort"`
+
+	// DurationMs Duration of the connection in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Error Error that prevented the connection from being established
+	Error *string `json:"error,omitempty"`
+
+	// Host HTTP Host header or TLS SNI of the connection, empty when not available
+	Host string `json:"host"`
+
+	// MatchType Which egress rule type decided the connection
+	MatchType SandboxNetworkLogEntryMatchType `json:"matchType"`
+
+	// Method HTTP request method, only set for plain HTTP connections
+	Method *string `json:"method,omitempty"`
+
+	// Protocol Protocol detected by the egress firewall
+	Protocol SandboxNetworkLogEntryProtocol `json:"protocol"`
+
+	// ResolvedIP Upstream IP address the egress proxy connected to
+	ResolvedIP *string `json:"resolvedIP,omitempty"`
+
+	// Timestamp Time the connection was opened
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SandboxNetworkLogEntryMatchType Which egress rule type decided the connection
+type SandboxNetworkLogEntryMatchType string
+
+// SandboxNetworkLogEntryProtocol Protocol detected by the egress firewall
+type SandboxNetworkLogEntryProtocol string
+
+// SandboxNetworkLogsResponse defines model for SandboxNetworkLogsResponse.
+type SandboxNetworkLogsResponse struct {
+	// Logs Egress audit log of the sandbox
+	Logs []SandboxNetworkLogEntry `json:"logs"`
+}
+
+// SandboxNetworkRule Rule applied to egress requests matching a domain pattern. A rule either transforms matching requests or, when action is set, allows or denies them by method and path. Deny rules take precedence; when a domain has any allow rules, requests matching none of them are denied.
 type SandboxNetworkRule struct {
+	// Action Whether requests matching an access rule are allowed or denied
+	Action *SandboxNetworkRuleAction `json:"action,omitempty"`
+
+	// Methods HTTP methods the access rule applies to (e.g. "GET"). Empty matches any method.
+	Methods *[]string `json:"methods,omitempty"`
+
+	// Path URL path the access rule applies to. A trailing "*" matches any path with the given prefix (e.g. "/repos/*"), otherwise the path must match exactly. Empty matches any path.
+	Path *string `json:"path,omitempty"`
+
 	// Transform Transformations applied to matching egress requests before forwarding.
 	Transform *SandboxNetworkTransform `json:"transform,omitempty"`
 }
 
+// SandboxNetworkRuleAction Whether requests matching an access rule are allowed or denied
+type SandboxNetworkRuleAction string
+
 // SandboxNetworkTransform Transformations applied to matching egress requests before forwarding.
 type SandboxNetworkTransform struct {
-	// Headers HTTP headers to inject or override in matching requests. An existing header with the same name is replaced. Values are plain strings; secret resolution happens client-side before sending to the API.
+	// Headers HTTP headers to inject or override in matching requests. An existing header with the same name is replaced. Values may reference team secrets as {{secret:NAME}}; they are resolved by the egress proxy. The sandbox sends matching requests as plain HTTP (http:// URLs) and the egress proxy forwards them over HTTPS it verifies itself, port 80 going to 443. TLS connections from the sandbox to the domain are blocked. When denyOut contains 0.0.0.0/0, the domain must be allowed by allowOut.
 	Headers *map[string]string `json:"headers,omitempty"`
 }
 
@@ -982,7 +1320,7 @@ type SandboxNetworkUpdateConfig struct {
 	// EgressProxy SOCKS5 proxy for sandbox egress. Outbound TCP is tunneled through the proxy after allow/deny filtering; the sandbox is unaware. Domain-matched flows use remote DNS (ATYP=domain).
 	EgressProxy *SandboxEgressProxyConfig `json:"egressProxy,omitempty"`
 
-	// Rules Per-domain transform rules. Replaces all existing rules when provided.
+	// Rules Per-domain transform and access rules. Replaces all existing rules when provided.
 	Rules *map[string][]SandboxNetworkRule `json:"rules,omitempty"`
 }
 
@@ -1001,6 +1339,15 @@ type SandboxRefreshRequest struct {
 	Duration *int `json:"duration,omitempty"`
 }
 
+// SandboxResourcesUpdate defines model for SandboxResourcesUpdate.
+type SandboxResourcesUpdate struct {
+	// CpuCount CPU cores of the sandbox. The CPU count can't change while the sandbox is running, a different value is rejected.
+	CpuCount *CPUCount `json:"cpuCount,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MiB
+	MemoryMB MemoryMB `json:"memoryMB"`
+}
+
 // SandboxSnapshotRequest defines model for SandboxSnapshotRequest.
 type SandboxSnapshotRequest struct {
 	// Name Optional name for the snapshot template. If a snapshot template with this name already exists, a new build will be assigned to the existing template instead of creating a new one.
@@ -1087,6 +1434,24 @@ type TeamMetric struct {
 	TimestampUnix int64 `json:"timestampUnix"`
 }
 
+// TeamSecret Team secret usable in network rule headers, the value is never returned
+type TeamSecret struct {
+	// CreatedAt Time when the secret was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the secret, referenced as {{secret:NAME}}
+	Name string `json:"name"`
+
+	// UpdatedAt Time when the secret value was last set
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TeamSecretValue defines model for TeamSecretValue.
+type TeamSecretValue struct {
+	// Value Value of the secret
+	Value string `json:"value"`
+}
+
 // TeamUser defines model for TeamUser.
 type TeamUser struct {
 	// Email Email of the user
@@ -1186,6 +1551,18 @@ type TemplateBuild struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// TemplateBuildDockerfile Dockerfile the template is built from. Cannot be combined with fromImage, fromTemplate or steps.
+type TemplateBuildDockerfile struct {
+	// BuildArgs Values for the ARG instructions of the Dockerfile
+	BuildArgs *map[string]string `json:"buildArgs,omitempty"`
+
+	// Content Content of the Dockerfile
+	Content string `json:"content"`
+
+	// FilesHash Hash of the uploaded build context the COPY and ADD instructions copy from
+	FilesHash *string `json:"filesHash,omitempty"`
+}
+
 // TemplateBuildFileUpload defines model for TemplateBuildFileUpload.
 type TemplateBuildFileUpload struct {
 	// Present Whether the file is already present in the cache
@@ -1285,6 +1662,9 @@ type TemplateBuildRequestV3 struct {
 
 // TemplateBuildStartV2 defines model for TemplateBuildStartV2.
 type TemplateBuildStartV2 struct {
+	// Dockerfile Dockerfile the template is built from. Cannot be combined with fromImage, fromTemplate or steps.
+	Dockerfile *TemplateBuildDockerfile `json:"dockerfile,omitempty"`
+
 	// Force Whether the whole build should be forced to run regardless of the cache
 	Force *bool `json:"force,omitempty"`
 
@@ -1470,6 +1850,39 @@ type VolumeAndToken struct {
 	VolumeID string `json:"volumeID"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Enabled Whether the webhook receives events
+	Enabled bool `json:"enabled"`
+
+	// Events Event types delivered to the webhook, empty means all events
+	Events []SandboxEventType `json:"events"`
+
+	// Url URL the events are delivered to
+	Url string `json:"url"`
+
+	// WebhookID ID of the webhook
+	WebhookID openapi_types.UUID `json:"webhookID"`
+}
+
+// WebhookTestResult defines model for WebhookTestResult.
+type WebhookTestResult struct {
+	// DurationMs Duration of the request in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Error Error of the failed delivery
+	Error *string `json:"error,omitempty"`
+
+	// StatusCode HTTP status code returned by the endpoint
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Success Whether the endpoint accepted the test event
+	Success bool `json:"success"`
+}
+
 // AccessTokenID defines model for accessTokenID.
 type AccessTokenID = string
 
@@ -1479,6 +1892,9 @@ type ApiKeyID = string
 // BuildID defines model for buildID.
 type BuildID = string
 
+// CheckpointID defines model for checkpointID.
+type CheckpointID = openapi_types.UUID
+
 // NodeID defines model for nodeID.
 type NodeID = string
 
@@ -1491,6 +1907,9 @@ type PaginationNextToken = string
 // SandboxID defines model for sandboxID.
 type SandboxID = string
 
+// SecretName defines model for secretName.
+type SecretName = string
+
 // TeamID defines model for teamID.
 type TeamID = string
 
@@ -1500,6 +1919,9 @@ type TemplateID = string
 // VolumeID defines model for volumeID.
 type VolumeID = string
 
+// WebhookID defines model for webhookID.
+type WebhookID = openapi_types.UUID
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -1539,6 +1961,9 @@ type authProviderBearerAuthContextKey string
 // authProviderTeamAuthContextKey is the context key for AuthProviderTeamAuth security scheme
 type authProviderTeamAuthContextKey string
 
+// sandboxControlAuthContextKey is the context key for SandboxControlAuth security scheme
+type sandboxControlAuthContextKey string
+
 // GetNodesParams defines parameters for GetNodes.
 type GetNodesParams struct {
 	// ClusterID Identifier of the cluster
@@ -1579,6 +2004,24 @@ type GetSandboxesSandboxIDMetricsParams struct {
 	End   *int64 `form:"end,omitempty" json:"end,omitempty"`
 }
 
+// GetSandboxesSandboxIDNetworkLogsParams defines parameters for GetSandboxesSandboxIDNetworkLogs.
+type GetSandboxesSandboxIDNetworkLogsParams struct {
+	// Cursor Starting timestamp of the logs that should be returned in milliseconds
+	Cursor *int64 `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of logs that should be returned
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Direction Direction of the logs that should be returned
+	Direction *LogsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Decision Only return connections with this firewall decision
+	Decision *SandboxNetworkDecision `form:"decision,omitempty" json:"decision,omitempty"`
+
+	// Host Only return connections to this exact HTTP Host or TLS SNI
+	Host *string `form:"host,omitempty" json:"host,omitempty"`
+}
+
 // GetSnapshotsParams defines parameters for GetSnapshots.
 type GetSnapshotsParams struct {
 	SandboxID *string `form:"sandboxID,omitempty" json:"sandboxID,omitempty"`
@@ -1682,8 +2125,17 @@ type GetV2SandboxesSandboxIDLogsParams struct {
 
 	// Search Case-sensitive substring match on log message content
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// ProcessTag Only return the output of processes started with this tag
+	ProcessTag *string `form:"processTag,omitempty" json:"processTag,omitempty"`
+
+	// Stream Only return the process output from this stream
+	Stream *GetV2SandboxesSandboxIDLogsParamsStream `form:"stream,omitempty" json:"stream,omitempty"`
 }
 
+// GetV2SandboxesSandboxIDLogsParamsStream defines parameters for GetV2SandboxesSandboxIDLogs.
+type GetV2SandboxesSandboxIDLogsParamsStream string
+
 // GetV2TemplatesParams defines parameters for GetV2Templates.
 type GetV2TemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
@@ -1713,9 +2165,27 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PutSandboxesSandboxIDBandwidthJSONRequestBody defines body for PutSandboxesSandboxIDBandwidth for application/json ContentType.
+type PutSandboxesSandboxIDBandwidthJSONRequestBody = SandboxBandwidthLimits
+
+// PostSandboxesSandboxIDCheckpointsJSONRequestBody defines body for PostSandboxesSandboxIDCheckpoints for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsJSONRequestBody = NewSandboxCheckpoint
+
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody defines body for PostSandboxesSandboxIDCheckpointsCheckpointIDRestore for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody = SandboxCheckpointRestoreRequest
+
 // PostSandboxesSandboxIDConnectJSONRequestBody defines body for PostSandboxesSandboxIDConnect for application/json ContentType.
 type PostSandboxesSandboxIDConnectJSONRequestBody = ConnectSandbox
 
+// PostSandboxesSandboxIDControlSandboxesJSONRequestBody defines body for PostSandboxesSandboxIDControlSandboxes for application/json ContentType.
+type PostSandboxesSandboxIDControlSandboxesJSONRequestBody = NewSandbox
+
+// PostSandboxesSandboxIDControlTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDControlTimeout for application/json ContentType.
+type PostSandboxesSandboxIDControlTimeoutJSONRequestBody = SandboxTimeoutRequest
+
+// PatchSandboxesSandboxIDEnvsJSONRequestBody defines body for PatchSandboxesSandboxIDEnvs for application/json ContentType.
+type PatchSandboxesSandboxIDEnvsJSONRequestBody = SandboxEnvVarsUpdate
+
 // PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
 type PostSandboxesSandboxIDForkJSONRequestBody = SandboxForkRequest
 
@@ -1728,6 +2198,9 @@ type PostSandboxesSandboxIDPauseJSONRequestBody = SandboxPauseRequest
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody = SandboxRefreshRequest
 
+// PatchSandboxesSandboxIDResourcesJSONRequestBody defines body for PatchSandboxesSandboxIDResources for application/json ContentType.
+type PatchSandboxesSandboxIDResourcesJSONRequestBody = SandboxResourcesUpdate
+
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
@@ -1737,6 +2210,9 @@ type PostSandboxesSandboxIDSnapshotsJSONRequestBody = SandboxSnapshotRequest
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody = SandboxTimeoutRequest
 
+// PutSecretsSecretNameJSONRequestBody defines body for PutSecretsSecretName for application/json ContentType.
+type PutSecretsSecretNameJSONRequestBody = TeamSecretValue
+
 // PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
 type PostTemplatesJSONRequestBody = TemplateBuildRequest
 
@@ -1767,6 +2243,9 @@ type PostV3TemplatesJSONRequestBody = TemplateBuildRequestV3
 // PostVolumesJSONRequestBody defines body for PostVolumes for application/json ContentType.
 type PostVolumesJSONRequestBody = NewVolume
 
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = NewWebhook
+
 // AsAWSRegistry returns the union data inside the FromImageRegistry as a AWSRegistry
 func (t FromImageRegistry) AsAWSRegistry() (AWSRegistry, error) {
 	var body AWSRegistry
@@ -1959,6 +2438,12 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWellKnownOpenidConfiguration request
+	GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAccessTokensWithBody request with any body
 	PostAccessTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2028,11 +2513,50 @@ type ClientInterface interface {
 	// GetSandboxesSandboxID request
 	GetSandboxesSandboxID(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutSandboxesSandboxIDBandwidthWithBody request with any body
+	PutSandboxesSandboxIDBandwidthWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSandboxesSandboxIDBandwidth(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDBandwidthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSandboxesSandboxIDCheckpoints request
+	GetSandboxesSandboxIDCheckpoints(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSandboxesSandboxIDCheckpointsWithBody request with any body
+	PostSandboxesSandboxIDCheckpointsWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSandboxesSandboxIDCheckpoints(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDCheckpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSandboxesSandboxIDCheckpointsCheckpointID request
+	DeleteSandboxesSandboxIDCheckpointsCheckpointID(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBody request with any body
+	PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBody(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, body PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSandboxesSandboxIDConnectWithBody request with any body
 	PostSandboxesSandboxIDConnectWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostSandboxesSandboxIDConnect(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostSandboxesSandboxIDControlPause request
+	PostSandboxesSandboxIDControlPause(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSandboxesSandboxIDControlSandboxesWithBody request with any body
+	PostSandboxesSandboxIDControlSandboxesWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSandboxesSandboxIDControlSandboxes(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSandboxesSandboxIDControlTimeoutWithBody request with any body
+	PostSandboxesSandboxIDControlTimeoutWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSandboxesSandboxIDControlTimeout(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchSandboxesSandboxIDEnvsWithBody request with any body
+	PatchSandboxesSandboxIDEnvsWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSandboxesSandboxIDEnvs(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSandboxesSandboxIDForkWithBody request with any body
 	PostSandboxesSandboxIDForkWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2049,6 +2573,9 @@ type ClientInterface interface {
 
 	PutSandboxesSandboxIDNetwork(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSandboxesSandboxIDNetworkLogs request
+	GetSandboxesSandboxIDNetworkLogs(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDNetworkLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSandboxesSandboxIDPauseWithBody request with any body
 	PostSandboxesSandboxIDPauseWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2059,6 +2586,11 @@ type ClientInterface interface {
 
 	PostSandboxesSandboxIDRefreshes(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchSandboxesSandboxIDResourcesWithBody request with any body
+	PatchSandboxesSandboxIDResourcesWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSandboxesSandboxIDResources(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSandboxesSandboxIDResumeWithBody request with any body
 	PostSandboxesSandboxIDResumeWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2074,6 +2606,17 @@ type ClientInterface interface {
 
 	PostSandboxesSandboxIDTimeout(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSecrets request
+	GetSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSecretsSecretName request
+	DeleteSecretsSecretName(ctx context.Context, secretName SecretName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSecretsSecretNameWithBody request with any body
+	PutSecretsSecretNameWithBody(ctx context.Context, secretName SecretName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSecretsSecretName(ctx context.Context, secretName SecretName, body PutSecretsSecretNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSnapshots request
 	GetSnapshots(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2180,10 +2723,24 @@ type ClientInterface interface {
 
 	// GetVolumesVolumeID request
 	GetVolumesVolumeID(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWithBody request with any body
+	PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksWebhookID request
+	DeleteWebhooksWebhookID(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWebhookIDTest request
+	PostWebhooksWebhookIDTest(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostAccessTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAccessTokensRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2194,8 +2751,8 @@ func (c *Client) PostAccessTokensWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAccessTokens(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAccessTokensRequest(c.Server, body)
+func (c *Client) GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownOpenidConfigurationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2206,8 +2763,8 @@ func (c *Client) PostAccessTokens(ctx context.Context, body PostAccessTokensJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAccessTokensAccessTokenID(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAccessTokensAccessTokenIDRequest(c.Server, accessTokenID)
+func (c *Client) PostAccessTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccessTokensRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2218,8 +2775,8 @@ func (c *Client) DeleteAccessTokensAccessTokenID(ctx context.Context, accessToke
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAdminTeamsTeamIDApiKeysWithBody(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminTeamsTeamIDApiKeysRequestWithBody(c.Server, teamID, contentType, body)
+func (c *Client) PostAccessTokens(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccessTokensRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2230,8 +2787,32 @@ func (c *Client) PostAdminTeamsTeamIDApiKeysWithBody(ctx context.Context, teamID
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAdminTeamsTeamIDApiKeys(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAdminTeamsTeamIDApiKeysRequest(c.Server, teamID, body)
+func (c *Client) DeleteAccessTokensAccessTokenID(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccessTokensAccessTokenIDRequest(c.Server, accessTokenID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminTeamsTeamIDApiKeysWithBody(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminTeamsTeamIDApiKeysRequestWithBody(c.Server, teamID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminTeamsTeamIDApiKeys(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminTeamsTeamIDApiKeysRequest(c.Server, teamID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2482,6 +3063,102 @@ func (c *Client) GetSandboxesSandboxID(ctx context.Context, sandboxID SandboxID,
 	return c.Client.Do(req)
 }
 
+func (c *Client) PutSandboxesSandboxIDBandwidthWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSandboxesSandboxIDBandwidthRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSandboxesSandboxIDBandwidth(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDBandwidthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSandboxesSandboxIDBandwidthRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSandboxesSandboxIDCheckpoints(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSandboxesSandboxIDCheckpointsRequest(c.Server, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDCheckpointsWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDCheckpointsRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDCheckpoints(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDCheckpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDCheckpointsRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSandboxesSandboxIDCheckpointsCheckpointID(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSandboxesSandboxIDCheckpointsCheckpointIDRequest(c.Server, sandboxID, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBody(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequestWithBody(c.Server, sandboxID, checkpointID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, body PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequest(c.Server, sandboxID, checkpointID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostSandboxesSandboxIDConnectWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostSandboxesSandboxIDConnectRequestWithBody(c.Server, sandboxID, contentType, body)
 	if err != nil {
@@ -2506,6 +3183,90 @@ func (c *Client) PostSandboxesSandboxIDConnect(ctx context.Context, sandboxID Sa
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostSandboxesSandboxIDControlPause(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDControlPauseRequest(c.Server, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDControlSandboxesWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDControlSandboxesRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDControlSandboxes(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDControlSandboxesRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDControlTimeoutWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDControlTimeoutRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSandboxesSandboxIDControlTimeout(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSandboxesSandboxIDControlTimeoutRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSandboxesSandboxIDEnvsWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSandboxesSandboxIDEnvsRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSandboxesSandboxIDEnvs(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSandboxesSandboxIDEnvsRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostSandboxesSandboxIDForkWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostSandboxesSandboxIDForkRequestWithBody(c.Server, sandboxID, contentType, body)
 	if err != nil {
@@ -2578,6 +3339,18 @@ func (c *Client) PutSandboxesSandboxIDNetwork(ctx context.Context, sandboxID San
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetSandboxesSandboxIDNetworkLogs(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDNetworkLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSandboxesSandboxIDNetworkLogsRequest(c.Server, sandboxID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostSandboxesSandboxIDPauseWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostSandboxesSandboxIDPauseRequestWithBody(c.Server, sandboxID, contentType, body)
 	if err != nil {
@@ -2626,6 +3399,30 @@ func (c *Client) PostSandboxesSandboxIDRefreshes(ctx context.Context, sandboxID
 	return c.Client.Do(req)
 }
 
+func (c *Client) PatchSandboxesSandboxIDResourcesWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSandboxesSandboxIDResourcesRequestWithBody(c.Server, sandboxID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSandboxesSandboxIDResources(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSandboxesSandboxIDResourcesRequest(c.Server, sandboxID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostSandboxesSandboxIDResumeWithBody(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostSandboxesSandboxIDResumeRequestWithBody(c.Server, sandboxID, contentType, body)
 	if err != nil {
@@ -2698,6 +3495,54 @@ func (c *Client) PostSandboxesSandboxIDTimeout(ctx context.Context, sandboxID Sa
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSecretsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSecretsSecretName(ctx context.Context, secretName SecretName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSecretsSecretNameRequest(c.Server, secretName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSecretsSecretNameWithBody(ctx context.Context, secretName SecretName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSecretsSecretNameRequestWithBody(c.Server, secretName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSecretsSecretName(ctx context.Context, secretName SecretName, body PutSecretsSecretNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSecretsSecretNameRequest(c.Server, secretName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSnapshots(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSnapshotsRequest(c.Server, params)
 	if err != nil {
@@ -3166,18 +4011,132 @@ func (c *Client) GetVolumesVolumeID(ctx context.Context, volumeID VolumeID, reqE
 	return c.Client.Do(req)
 }
 
-// NewPostAccessTokensRequest calls the generic PostAccessTokens builder with application/json body
-func NewPostAccessTokensRequest(server string, body PostAccessTokensJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAccessTokensRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAccessTokensRequestWithBody generates requests for PostAccessTokens with any type of body
+func (c *Client) PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhooksWebhookID(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksWebhookIDRequest(c.Server, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksWebhookIDTest(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksWebhookIDTestRequest(c.Server, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWellKnownOpenidConfigurationRequest generates requests for GetWellKnownOpenidConfiguration
+func NewGetWellKnownOpenidConfigurationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccessTokensRequest calls the generic PostAccessTokens builder with application/json body
+func NewPostAccessTokensRequest(server string, body PostAccessTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccessTokensRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAccessTokensRequestWithBody generates requests for PostAccessTokens with any type of body
 func NewPostAccessTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
@@ -3949,19 +4908,19 @@ func NewGetSandboxesSandboxIDRequest(server string, sandboxID SandboxID) (*http.
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDConnectRequest calls the generic PostSandboxesSandboxIDConnect builder with application/json body
-func NewPostSandboxesSandboxIDConnectRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody) (*http.Request, error) {
+// NewPutSandboxesSandboxIDBandwidthRequest calls the generic PutSandboxesSandboxIDBandwidth builder with application/json body
+func NewPutSandboxesSandboxIDBandwidthRequest(server string, sandboxID SandboxID, body PutSandboxesSandboxIDBandwidthJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDConnectRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPutSandboxesSandboxIDBandwidthRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostSandboxesSandboxIDConnectRequestWithBody generates requests for PostSandboxesSandboxIDConnect with any type of body
-func NewPostSandboxesSandboxIDConnectRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutSandboxesSandboxIDBandwidthRequestWithBody generates requests for PutSandboxesSandboxIDBandwidth with any type of body
+func NewPutSandboxesSandboxIDBandwidthRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3976,7 +4935,7 @@ func NewPostSandboxesSandboxIDConnectRequestWithBody(server string, sandboxID Sa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/connect", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/bandwidth", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3986,7 +4945,7 @@ func NewPostSandboxesSandboxIDConnectRequestWithBody(server string, sandboxID Sa
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3996,19 +4955,8 @@ func NewPostSandboxesSandboxIDConnectRequestWithBody(server string, sandboxID Sa
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDForkRequest calls the generic PostSandboxesSandboxIDFork builder with application/json body
-func NewPostSandboxesSandboxIDForkRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDForkRequestWithBody(server, sandboxID, "application/json", bodyReader)
-}
-
-// NewPostSandboxesSandboxIDForkRequestWithBody generates requests for PostSandboxesSandboxIDFork with any type of body
-func NewPostSandboxesSandboxIDForkRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetSandboxesSandboxIDCheckpointsRequest generates requests for GetSandboxesSandboxIDCheckpoints
+func NewGetSandboxesSandboxIDCheckpointsRequest(server string, sandboxID SandboxID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4023,7 +4971,7 @@ func NewPostSandboxesSandboxIDForkRequestWithBody(server string, sandboxID Sandb
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/fork", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/checkpoints", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4033,18 +4981,27 @@ func NewPostSandboxesSandboxIDForkRequestWithBody(server string, sandboxID Sandb
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetSandboxesSandboxIDLogsRequest generates requests for GetSandboxesSandboxIDLogs
-func NewGetSandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams) (*http.Request, error) {
+// NewPostSandboxesSandboxIDCheckpointsRequest calls the generic PostSandboxesSandboxIDCheckpoints builder with application/json body
+func NewPostSandboxesSandboxIDCheckpointsRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDCheckpointsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSandboxesSandboxIDCheckpointsRequestWithBody(server, sandboxID, "application/json", bodyReader)
+}
+
+// NewPostSandboxesSandboxIDCheckpointsRequestWithBody generates requests for PostSandboxesSandboxIDCheckpoints with any type of body
+func NewPostSandboxesSandboxIDCheckpointsRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4059,7 +5016,7 @@ func NewGetSandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, par
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/checkpoints", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4069,55 +5026,18 @@ func NewGetSandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, par
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Start != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", *params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetSandboxesSandboxIDMetricsRequest generates requests for GetSandboxesSandboxIDMetrics
-func NewGetSandboxesSandboxIDMetricsRequest(server string, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams) (*http.Request, error) {
+// NewDeleteSandboxesSandboxIDCheckpointsCheckpointIDRequest generates requests for DeleteSandboxesSandboxIDCheckpointsCheckpointID
+func NewDeleteSandboxesSandboxIDCheckpointsCheckpointIDRequest(server string, sandboxID SandboxID, checkpointID CheckpointID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4127,12 +5047,19 @@ func NewGetSandboxesSandboxIDMetricsRequest(server string, sandboxID SandboxID,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "checkpointID", checkpointID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/checkpoints/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4142,46 +5069,7 @@ func NewGetSandboxesSandboxIDMetricsRequest(server string, sandboxID SandboxID,
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Start != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", *params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.End != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", *params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4189,19 +5077,19 @@ func NewGetSandboxesSandboxIDMetricsRequest(server string, sandboxID SandboxID,
 	return req, nil
 }
 
-// NewPutSandboxesSandboxIDNetworkRequest calls the generic PutSandboxesSandboxIDNetwork builder with application/json body
-func NewPutSandboxesSandboxIDNetworkRequest(server string, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequest calls the generic PostSandboxesSandboxIDCheckpointsCheckpointIDRestore builder with application/json body
+func NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequest(server string, sandboxID SandboxID, checkpointID CheckpointID, body PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutSandboxesSandboxIDNetworkRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequestWithBody(server, sandboxID, checkpointID, "application/json", bodyReader)
 }
 
-// NewPutSandboxesSandboxIDNetworkRequestWithBody generates requests for PutSandboxesSandboxIDNetwork with any type of body
-func NewPutSandboxesSandboxIDNetworkRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequestWithBody generates requests for PostSandboxesSandboxIDCheckpointsCheckpointIDRestore with any type of body
+func NewPostSandboxesSandboxIDCheckpointsCheckpointIDRestoreRequestWithBody(server string, sandboxID SandboxID, checkpointID CheckpointID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4211,12 +5099,19 @@ func NewPutSandboxesSandboxIDNetworkRequestWithBody(server string, sandboxID San
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "checkpointID", checkpointID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/network", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/checkpoints/%s/restore", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4226,7 +5121,7 @@ func NewPutSandboxesSandboxIDNetworkRequestWithBody(server string, sandboxID San
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4236,19 +5131,19 @@ func NewPutSandboxesSandboxIDNetworkRequestWithBody(server string, sandboxID San
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDPauseRequest calls the generic PostSandboxesSandboxIDPause builder with application/json body
-func NewPostSandboxesSandboxIDPauseRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDConnectRequest calls the generic PostSandboxesSandboxIDConnect builder with application/json body
+func NewPostSandboxesSandboxIDConnectRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDPauseRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDConnectRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostSandboxesSandboxIDPauseRequestWithBody generates requests for PostSandboxesSandboxIDPause with any type of body
-func NewPostSandboxesSandboxIDPauseRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDConnectRequestWithBody generates requests for PostSandboxesSandboxIDConnect with any type of body
+func NewPostSandboxesSandboxIDConnectRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4263,7 +5158,7 @@ func NewPostSandboxesSandboxIDPauseRequestWithBody(server string, sandboxID Sand
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/pause", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/connect", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4283,19 +5178,8 @@ func NewPostSandboxesSandboxIDPauseRequestWithBody(server string, sandboxID Sand
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDRefreshesRequest calls the generic PostSandboxesSandboxIDRefreshes builder with application/json body
-func NewPostSandboxesSandboxIDRefreshesRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDRefreshesRequestWithBody(server, sandboxID, "application/json", bodyReader)
-}
-
-// NewPostSandboxesSandboxIDRefreshesRequestWithBody generates requests for PostSandboxesSandboxIDRefreshes with any type of body
-func NewPostSandboxesSandboxIDRefreshesRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDControlPauseRequest generates requests for PostSandboxesSandboxIDControlPause
+func NewPostSandboxesSandboxIDControlPauseRequest(server string, sandboxID SandboxID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4310,7 +5194,7 @@ func NewPostSandboxesSandboxIDRefreshesRequestWithBody(server string, sandboxID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/refreshes", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/control/pause", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4320,29 +5204,27 @@ func NewPostSandboxesSandboxIDRefreshesRequestWithBody(server string, sandboxID
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDResumeRequest calls the generic PostSandboxesSandboxIDResume builder with application/json body
-func NewPostSandboxesSandboxIDResumeRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDControlSandboxesRequest calls the generic PostSandboxesSandboxIDControlSandboxes builder with application/json body
+func NewPostSandboxesSandboxIDControlSandboxesRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDControlSandboxesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDResumeRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDControlSandboxesRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostSandboxesSandboxIDResumeRequestWithBody generates requests for PostSandboxesSandboxIDResume with any type of body
-func NewPostSandboxesSandboxIDResumeRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDControlSandboxesRequestWithBody generates requests for PostSandboxesSandboxIDControlSandboxes with any type of body
+func NewPostSandboxesSandboxIDControlSandboxesRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4357,7 +5239,7 @@ func NewPostSandboxesSandboxIDResumeRequestWithBody(server string, sandboxID San
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/resume", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/control/sandboxes", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4377,19 +5259,19 @@ func NewPostSandboxesSandboxIDResumeRequestWithBody(server string, sandboxID San
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDSnapshotsRequest calls the generic PostSandboxesSandboxIDSnapshots builder with application/json body
-func NewPostSandboxesSandboxIDSnapshotsRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDControlTimeoutRequest calls the generic PostSandboxesSandboxIDControlTimeout builder with application/json body
+func NewPostSandboxesSandboxIDControlTimeoutRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDControlTimeoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDControlTimeoutRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostSandboxesSandboxIDSnapshotsRequestWithBody generates requests for PostSandboxesSandboxIDSnapshots with any type of body
-func NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDControlTimeoutRequestWithBody generates requests for PostSandboxesSandboxIDControlTimeout with any type of body
+func NewPostSandboxesSandboxIDControlTimeoutRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4404,7 +5286,7 @@ func NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server string, sandboxID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/snapshots", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/control/timeout", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4424,19 +5306,19 @@ func NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server string, sandboxID
 	return req, nil
 }
 
-// NewPostSandboxesSandboxIDTimeoutRequest calls the generic PostSandboxesSandboxIDTimeout builder with application/json body
-func NewPostSandboxesSandboxIDTimeoutRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody) (*http.Request, error) {
+// NewPatchSandboxesSandboxIDEnvsRequest calls the generic PatchSandboxesSandboxIDEnvs builder with application/json body
+func NewPatchSandboxesSandboxIDEnvsRequest(server string, sandboxID SandboxID, body PatchSandboxesSandboxIDEnvsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSandboxesSandboxIDTimeoutRequestWithBody(server, sandboxID, "application/json", bodyReader)
+	return NewPatchSandboxesSandboxIDEnvsRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostSandboxesSandboxIDTimeoutRequestWithBody generates requests for PostSandboxesSandboxIDTimeout with any type of body
-func NewPostSandboxesSandboxIDTimeoutRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchSandboxesSandboxIDEnvsRequestWithBody generates requests for PatchSandboxesSandboxIDEnvs with any type of body
+func NewPatchSandboxesSandboxIDEnvsRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4451,7 +5333,7 @@ func NewPostSandboxesSandboxIDTimeoutRequestWithBody(server string, sandboxID Sa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sandboxes/%s/timeout", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/envs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4461,7 +5343,7 @@ func NewPostSandboxesSandboxIDTimeoutRequestWithBody(server string, sandboxID Sa
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4471,106 +5353,34 @@ func NewPostSandboxesSandboxIDTimeoutRequestWithBody(server string, sandboxID Sa
 	return req, nil
 }
 
-// NewGetSnapshotsRequest generates requests for GetSnapshots
-func NewGetSnapshotsRequest(server string, params *GetSnapshotsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/snapshots")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+// NewPostSandboxesSandboxIDForkRequest calls the generic PostSandboxesSandboxIDFork builder with application/json body
+func NewPostSandboxesSandboxIDForkRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSandboxesSandboxIDForkRequestWithBody(server, sandboxID, "application/json", bodyReader)
+}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.SandboxID != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sandboxID", *params.SandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Name != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.NextToken != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
+// NewPostSandboxesSandboxIDForkRequestWithBody generates requests for PostSandboxesSandboxIDFork with any type of body
+func NewPostSandboxesSandboxIDForkRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetTeamsRequest generates requests for GetTeams
-func NewGetTeamsRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/teams")
+	operationPath := fmt.Sprintf("/sandboxes/%s/fork", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4580,21 +5390,23 @@ func NewGetTeamsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetTeamsTeamIDMetricsRequest generates requests for GetTeamsTeamIDMetrics
-func NewGetTeamsTeamIDMetricsRequest(server string, teamID TeamID, params *GetTeamsTeamIDMetricsParams) (*http.Request, error) {
+// NewGetSandboxesSandboxIDLogsRequest generates requests for GetSandboxesSandboxIDLogs
+func NewGetSandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "teamID", teamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4604,7 +5416,7 @@ func NewGetTeamsTeamIDMetricsRequest(server string, teamID TeamID, params *GetTe
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/teams/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4635,9 +5447,9 @@ func NewGetTeamsTeamIDMetricsRequest(server string, teamID TeamID, params *GetTe
 
 		}
 
-		if params.End != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", *params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4661,13 +5473,13 @@ func NewGetTeamsTeamIDMetricsRequest(server string, teamID TeamID, params *GetTe
 	return req, nil
 }
 
-// NewGetTeamsTeamIDMetricsMaxRequest generates requests for GetTeamsTeamIDMetricsMax
-func NewGetTeamsTeamIDMetricsMaxRequest(server string, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams) (*http.Request, error) {
+// NewGetSandboxesSandboxIDMetricsRequest generates requests for GetSandboxesSandboxIDMetrics
+func NewGetSandboxesSandboxIDMetricsRequest(server string, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "teamID", teamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4677,7 +5489,7 @@ func NewGetTeamsTeamIDMetricsMaxRequest(server string, teamID TeamID, params *Ge
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/teams/%s/metrics/max", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4720,68 +5532,6 @@ func NewGetTeamsTeamIDMetricsMaxRequest(server string, teamID TeamID, params *Ge
 
 		}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "metric", params.Metric, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetTemplatesRequest generates requests for GetTemplates
-func NewGetTemplatesRequest(server string, params *GetTemplatesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/templates")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.TeamID != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "teamID", *params.TeamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -4796,27 +5546,34 @@ func NewGetTemplatesRequest(server string, params *GetTemplatesParams) (*http.Re
 	return req, nil
 }
 
-// NewPostTemplatesRequest calls the generic PostTemplates builder with application/json body
-func NewPostTemplatesRequest(server string, body PostTemplatesJSONRequestBody) (*http.Request, error) {
+// NewPutSandboxesSandboxIDNetworkRequest calls the generic PutSandboxesSandboxIDNetwork builder with application/json body
+func NewPutSandboxesSandboxIDNetworkRequest(server string, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostTemplatesRequestWithBody(server, "application/json", bodyReader)
+	return NewPutSandboxesSandboxIDNetworkRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostTemplatesRequestWithBody generates requests for PostTemplates with any type of body
-func NewPostTemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutSandboxesSandboxIDNetworkRequestWithBody generates requests for PutSandboxesSandboxIDNetwork with any type of body
+func NewPutSandboxesSandboxIDNetworkRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates")
+	operationPath := fmt.Sprintf("/sandboxes/%s/network", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4826,7 +5583,7 @@ func NewPostTemplatesRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4836,13 +5593,13 @@ func NewPostTemplatesRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewGetTemplatesAliasesAliasRequest generates requests for GetTemplatesAliasesAlias
-func NewGetTemplatesAliasesAliasRequest(server string, alias string) (*http.Request, error) {
+// NewGetSandboxesSandboxIDNetworkLogsRequest generates requests for GetSandboxesSandboxIDNetworkLogs
+func NewGetSandboxesSandboxIDNetworkLogsRequest(server string, sandboxID SandboxID, params *GetSandboxesSandboxIDNetworkLogsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "alias", alias, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4852,7 +5609,7 @@ func NewGetTemplatesAliasesAliasRequest(server string, alias string) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/aliases/%s", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/network/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4862,35 +5619,117 @@ func NewGetTemplatesAliasesAliasRequest(server string, alias string) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewDeleteTemplatesTagsRequest calls the generic DeleteTemplatesTags builder with application/json body
-func NewDeleteTemplatesTagsRequest(server string, body DeleteTemplatesTagsJSONRequestBody) (*http.Request, error) {
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Direction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "direction", *params.Direction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Decision != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "decision", *params.Decision, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Host != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "host", *params.Host, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSandboxesSandboxIDPauseRequest calls the generic PostSandboxesSandboxIDPause builder with application/json body
+func NewPostSandboxesSandboxIDPauseRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeleteTemplatesTagsRequestWithBody(server, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDPauseRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewDeleteTemplatesTagsRequestWithBody generates requests for DeleteTemplatesTags with any type of body
-func NewDeleteTemplatesTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDPauseRequestWithBody generates requests for PostSandboxesSandboxIDPause with any type of body
+func NewPostSandboxesSandboxIDPauseRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/tags")
+	operationPath := fmt.Sprintf("/sandboxes/%s/pause", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4900,7 +5739,7 @@ func NewDeleteTemplatesTagsRequestWithBody(server string, contentType string, bo
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4910,27 +5749,34 @@ func NewDeleteTemplatesTagsRequestWithBody(server string, contentType string, bo
 	return req, nil
 }
 
-// NewPostTemplatesTagsRequest calls the generic PostTemplatesTags builder with application/json body
-func NewPostTemplatesTagsRequest(server string, body PostTemplatesTagsJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDRefreshesRequest calls the generic PostSandboxesSandboxIDRefreshes builder with application/json body
+func NewPostSandboxesSandboxIDRefreshesRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostTemplatesTagsRequestWithBody(server, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDRefreshesRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostTemplatesTagsRequestWithBody generates requests for PostTemplatesTags with any type of body
-func NewPostTemplatesTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDRefreshesRequestWithBody generates requests for PostSandboxesSandboxIDRefreshes with any type of body
+func NewPostSandboxesSandboxIDRefreshesRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/tags")
+	operationPath := fmt.Sprintf("/sandboxes/%s/refreshes", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4950,13 +5796,24 @@ func NewPostTemplatesTagsRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewDeleteTemplatesTemplateIDRequest generates requests for DeleteTemplatesTemplateID
-func NewDeleteTemplatesTemplateIDRequest(server string, templateID TemplateID) (*http.Request, error) {
+// NewPatchSandboxesSandboxIDResourcesRequest calls the generic PatchSandboxesSandboxIDResources builder with application/json body
+func NewPatchSandboxesSandboxIDResourcesRequest(server string, sandboxID SandboxID, body PatchSandboxesSandboxIDResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchSandboxesSandboxIDResourcesRequestWithBody(server, sandboxID, "application/json", bodyReader)
+}
+
+// NewPatchSandboxesSandboxIDResourcesRequestWithBody generates requests for PatchSandboxesSandboxIDResources with any type of body
+func NewPatchSandboxesSandboxIDResourcesRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4966,7 +5823,7 @@ func NewDeleteTemplatesTemplateIDRequest(server string, templateID TemplateID) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/resources", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4976,21 +5833,34 @@ func NewDeleteTemplatesTemplateIDRequest(server string, templateID TemplateID) (
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetTemplatesTemplateIDRequest generates requests for GetTemplatesTemplateID
-func NewGetTemplatesTemplateIDRequest(server string, templateID TemplateID, params *GetTemplatesTemplateIDParams) (*http.Request, error) {
+// NewPostSandboxesSandboxIDResumeRequest calls the generic PostSandboxesSandboxIDResume builder with application/json body
+func NewPostSandboxesSandboxIDResumeRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSandboxesSandboxIDResumeRequestWithBody(server, sandboxID, "application/json", bodyReader)
+}
+
+// NewPostSandboxesSandboxIDResumeRequestWithBody generates requests for PostSandboxesSandboxIDResume with any type of body
+func NewPostSandboxesSandboxIDResumeRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -5000,7 +5870,7 @@ func NewGetTemplatesTemplateIDRequest(server string, templateID TemplateID, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/resume", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5010,71 +5880,34 @@ func NewGetTemplatesTemplateIDRequest(server string, templateID TemplateID, para
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.NextToken != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPatchTemplatesTemplateIDRequest calls the generic PatchTemplatesTemplateID builder with application/json body
-func NewPatchTemplatesTemplateIDRequest(server string, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDSnapshotsRequest calls the generic PostSandboxesSandboxIDSnapshots builder with application/json body
+func NewPostSandboxesSandboxIDSnapshotsRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchTemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPatchTemplatesTemplateIDRequestWithBody generates requests for PatchTemplatesTemplateID with any type of body
-func NewPatchTemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDSnapshotsRequestWithBody generates requests for PostSandboxesSandboxIDSnapshots with any type of body
+func NewPostSandboxesSandboxIDSnapshotsRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -5084,7 +5917,7 @@ func NewPatchTemplatesTemplateIDRequestWithBody(server string, templateID Templa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/snapshots", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5094,7 +5927,7 @@ func NewPatchTemplatesTemplateIDRequestWithBody(server string, templateID Templa
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5104,24 +5937,24 @@ func NewPatchTemplatesTemplateIDRequestWithBody(server string, templateID Templa
 	return req, nil
 }
 
-// NewPostTemplatesTemplateIDRequest calls the generic PostTemplatesTemplateID builder with application/json body
-func NewPostTemplatesTemplateIDRequest(server string, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
+// NewPostSandboxesSandboxIDTimeoutRequest calls the generic PostSandboxesSandboxIDTimeout builder with application/json body
+func NewPostSandboxesSandboxIDTimeoutRequest(server string, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostTemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
+	return NewPostSandboxesSandboxIDTimeoutRequestWithBody(server, sandboxID, "application/json", bodyReader)
 }
 
-// NewPostTemplatesTemplateIDRequestWithBody generates requests for PostTemplatesTemplateID with any type of body
-func NewPostTemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSandboxesSandboxIDTimeoutRequestWithBody generates requests for PostSandboxesSandboxIDTimeout with any type of body
+func NewPostSandboxesSandboxIDTimeoutRequestWithBody(server string, sandboxID SandboxID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -5131,7 +5964,7 @@ func NewPostTemplatesTemplateIDRequestWithBody(server string, templateID Templat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/sandboxes/%s/timeout", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5151,30 +5984,16 @@ func NewPostTemplatesTemplateIDRequestWithBody(server string, templateID Templat
 	return req, nil
 }
 
-// NewPostTemplatesTemplateIDBuildsBuildIDRequest generates requests for PostTemplatesTemplateIDBuildsBuildID
-func NewPostTemplatesTemplateIDBuildsBuildIDRequest(server string, templateID TemplateID, buildID BuildID) (*http.Request, error) {
+// NewGetSecretsRequest generates requests for GetSecrets
+func NewGetSecretsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s/builds/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5184,7 +6003,7 @@ func NewPostTemplatesTemplateIDBuildsBuildIDRequest(server string, templateID Te
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5192,30 +6011,23 @@ func NewPostTemplatesTemplateIDBuildsBuildIDRequest(server string, templateID Te
 	return req, nil
 }
 
-// NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest generates requests for GetTemplatesTemplateIDBuildsBuildIDLogs
-func NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest(server string, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams) (*http.Request, error) {
+// NewDeleteSecretsSecretNameRequest generates requests for DeleteSecretsSecretName
+func NewDeleteSecretsSecretNameRequest(server string, secretName SecretName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "secretName", secretName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/templates/%s/builds/%s/logs", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/secrets/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5225,82 +6037,7 @@ func NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest(server string, templateID
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Direction != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "direction", *params.Direction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Level != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Source != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "source", *params.Source, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5308,30 +6045,63 @@ func NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest(server string, templateID
 	return req, nil
 }
 
-// NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest generates requests for GetTemplatesTemplateIDBuildsBuildIDStatus
-func NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest(server string, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams) (*http.Request, error) {
+// NewPutSecretsSecretNameRequest calls the generic PutSecretsSecretName builder with application/json body
+func NewPutSecretsSecretNameRequest(server string, secretName SecretName, body PutSecretsSecretNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSecretsSecretNameRequestWithBody(server, secretName, "application/json", bodyReader)
+}
+
+// NewPutSecretsSecretNameRequestWithBody generates requests for PutSecretsSecretName with any type of body
+func NewPutSecretsSecretNameRequestWithBody(server string, secretName SecretName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "secretName", secretName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/secrets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSnapshotsRequest generates requests for GetSnapshots
+func NewGetSnapshotsRequest(server string, params *GetSnapshotsParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s/builds/%s/status", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/snapshots")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5350,9 +6120,21 @@ func NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest(server string, template
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.LogsOffset != nil {
+		if params.SandboxID != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "logsOffset", *params.LogsOffset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sandboxID", *params.SandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5374,9 +6156,9 @@ func NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest(server string, template
 
 		}
 
-		if params.Level != nil {
+		if params.NextToken != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5400,30 +6182,16 @@ func NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest(server string, template
 	return req, nil
 }
 
-// NewGetTemplatesTemplateIDFilesHashRequest generates requests for GetTemplatesTemplateIDFilesHash
-func NewGetTemplatesTemplateIDFilesHashRequest(server string, templateID TemplateID, hash string) (*http.Request, error) {
+// NewGetTeamsRequest generates requests for GetTeams
+func NewGetTeamsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "hash", hash, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/templates/%s/files/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/teams")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5441,50 +6209,23 @@ func NewGetTemplatesTemplateIDFilesHashRequest(server string, templateID Templat
 	return req, nil
 }
 
-// NewGetTemplatesTemplateIDTagsRequest generates requests for GetTemplatesTemplateIDTags
-func NewGetTemplatesTemplateIDTagsRequest(server string, templateID TemplateID) (*http.Request, error) {
+// NewGetTeamsTeamIDMetricsRequest generates requests for GetTeamsTeamIDMetrics
+func NewGetTeamsTeamIDMetricsRequest(server string, teamID TeamID, params *GetTeamsTeamIDMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/templates/%s/tags", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "teamID", teamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetV2SandboxesRequest generates requests for GetV2Sandboxes
-func NewGetV2SandboxesRequest(server string, params *GetV2SandboxesParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/sandboxes")
+	operationPath := fmt.Sprintf("/teams/%s/metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5503,33 +6244,9 @@ func NewGetV2SandboxesRequest(server string, params *GetV2SandboxesParams) (*htt
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Metadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "metadata", *params.Metadata, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.State != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "state", *params.State, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.NextToken != nil {
+		if params.Start != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", *params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5539,9 +6256,9 @@ func NewGetV2SandboxesRequest(server string, params *GetV2SandboxesParams) (*htt
 
 		}
 
-		if params.Limit != nil {
+		if params.End != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", *params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5565,13 +6282,13 @@ func NewGetV2SandboxesRequest(server string, params *GetV2SandboxesParams) (*htt
 	return req, nil
 }
 
-// NewGetV2SandboxesSandboxIDLogsRequest generates requests for GetV2SandboxesSandboxIDLogs
-func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams) (*http.Request, error) {
+// NewGetTeamsTeamIDMetricsMaxRequest generates requests for GetTeamsTeamIDMetricsMax
+func NewGetTeamsTeamIDMetricsMaxRequest(server string, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "teamID", teamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -5581,7 +6298,7 @@ func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/sandboxes/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/teams/%s/metrics/max", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5600,9 +6317,9 @@ func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, p
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Cursor != nil {
+		if params.Start != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", *params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5612,9 +6329,9 @@ func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, p
 
 		}
 
-		if params.Limit != nil {
+		if params.End != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", *params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5624,40 +6341,12 @@ func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, p
 
 		}
 
-		if params.Direction != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "direction", *params.Direction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "metric", params.Metric, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
-		}
-
-		if params.Level != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -5674,8 +6363,8 @@ func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, p
 	return req, nil
 }
 
-// NewGetV2TemplatesRequest generates requests for GetV2Templates
-func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*http.Request, error) {
+// NewGetTemplatesRequest generates requests for GetTemplates
+func NewGetTemplatesRequest(server string, params *GetTemplatesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5683,7 +6372,7 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates")
+	operationPath := fmt.Sprintf("/templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5714,30 +6403,6 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 
 		}
 
-		if params.NextToken != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -5752,19 +6417,19 @@ func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*htt
 	return req, nil
 }
 
-// NewPostV2TemplatesRequest calls the generic PostV2Templates builder with application/json body
-func NewPostV2TemplatesRequest(server string, body PostV2TemplatesJSONRequestBody) (*http.Request, error) {
+// NewPostTemplatesRequest calls the generic PostTemplates builder with application/json body
+func NewPostTemplatesRequest(server string, body PostTemplatesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostV2TemplatesRequestWithBody(server, "application/json", bodyReader)
+	return NewPostTemplatesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostV2TemplatesRequestWithBody generates requests for PostV2Templates with any type of body
-func NewPostV2TemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostTemplatesRequestWithBody generates requests for PostTemplates with any type of body
+func NewPostTemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5772,7 +6437,7 @@ func NewPostV2TemplatesRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates")
+	operationPath := fmt.Sprintf("/templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5792,24 +6457,13 @@ func NewPostV2TemplatesRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewPatchV2TemplatesTemplateIDRequest calls the generic PatchV2TemplatesTemplateID builder with application/json body
-func NewPatchV2TemplatesTemplateIDRequest(server string, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchV2TemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
-}
-
-// NewPatchV2TemplatesTemplateIDRequestWithBody generates requests for PatchV2TemplatesTemplateID with any type of body
-func NewPatchV2TemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetTemplatesAliasesAliasRequest generates requests for GetTemplatesAliasesAlias
+func NewGetTemplatesAliasesAliasRequest(server string, alias string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "alias", alias, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -5819,7 +6473,7 @@ func NewPatchV2TemplatesTemplateIDRequestWithBody(server string, templateID Temp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s", pathParam0)
+	operationPath := fmt.Sprintf("/templates/aliases/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5829,51 +6483,35 @@ func NewPatchV2TemplatesTemplateIDRequestWithBody(server string, templateID Temp
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostV2TemplatesTemplateIDBuildsBuildIDRequest calls the generic PostV2TemplatesTemplateIDBuildsBuildID builder with application/json body
-func NewPostV2TemplatesTemplateIDBuildsBuildIDRequest(server string, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody) (*http.Request, error) {
+// NewDeleteTemplatesTagsRequest calls the generic DeleteTemplatesTags builder with application/json body
+func NewDeleteTemplatesTagsRequest(server string, body DeleteTemplatesTagsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server, templateID, buildID, "application/json", bodyReader)
+	return NewDeleteTemplatesTagsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody generates requests for PostV2TemplatesTemplateIDBuildsBuildID with any type of body
-func NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server string, templateID TemplateID, buildID BuildID, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteTemplatesTagsRequestWithBody generates requests for DeleteTemplatesTags with any type of body
+func NewDeleteTemplatesTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/templates/%s/builds/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/templates/tags")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5883,7 +6521,7 @@ func NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server string, tem
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5893,19 +6531,19 @@ func NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server string, tem
 	return req, nil
 }
 
-// NewPostV3TemplatesRequest calls the generic PostV3Templates builder with application/json body
-func NewPostV3TemplatesRequest(server string, body PostV3TemplatesJSONRequestBody) (*http.Request, error) {
+// NewPostTemplatesTagsRequest calls the generic PostTemplatesTags builder with application/json body
+func NewPostTemplatesTagsRequest(server string, body PostTemplatesTagsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostV3TemplatesRequestWithBody(server, "application/json", bodyReader)
+	return NewPostTemplatesTagsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostV3TemplatesRequestWithBody generates requests for PostV3Templates with any type of body
-func NewPostV3TemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostTemplatesTagsRequestWithBody generates requests for PostTemplatesTags with any type of body
+func NewPostTemplatesTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5913,7 +6551,7 @@ func NewPostV3TemplatesRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v3/templates")
+	operationPath := fmt.Sprintf("/templates/tags")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5933,16 +6571,23 @@ func NewPostV3TemplatesRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewGetVolumesRequest generates requests for GetVolumes
-func NewGetVolumesRequest(server string) (*http.Request, error) {
+// NewDeleteTemplatesTemplateIDRequest generates requests for DeleteTemplatesTemplateID
+func NewDeleteTemplatesTemplateIDRequest(server string, templateID TemplateID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes")
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5952,7 +6597,7 @@ func NewGetVolumesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5960,27 +6605,23 @@ func NewGetVolumesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostVolumesRequest calls the generic PostVolumes builder with application/json body
-func NewPostVolumesRequest(server string, body PostVolumesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetTemplatesTemplateIDRequest generates requests for GetTemplatesTemplateID
+func NewGetTemplatesTemplateIDRequest(server string, templateID TemplateID, params *GetTemplatesTemplateIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostVolumesRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostVolumesRequestWithBody generates requests for PostVolumes with any type of body
-func NewPostVolumesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes")
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5990,23 +6631,71 @@ func NewPostVolumesRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.NextToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteVolumesVolumeIDRequest generates requests for DeleteVolumesVolumeID
-func NewDeleteVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Request, error) {
+// NewPatchTemplatesTemplateIDRequest calls the generic PatchTemplatesTemplateID builder with application/json body
+func NewPatchTemplatesTemplateIDRequest(server string, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
+}
+
+// NewPatchTemplatesTemplateIDRequestWithBody generates requests for PatchTemplatesTemplateID with any type of body
+func NewPatchTemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "volumeID", volumeID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -6016,7 +6705,7 @@ func NewDeleteVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6026,21 +6715,34 @@ func NewDeleteVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetVolumesVolumeIDRequest generates requests for GetVolumesVolumeID
-func NewGetVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Request, error) {
+// NewPostTemplatesTemplateIDRequest calls the generic PostTemplatesTemplateID builder with application/json body
+func NewPostTemplatesTemplateIDRequest(server string, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
+}
+
+// NewPostTemplatesTemplateIDRequestWithBody generates requests for PostTemplatesTemplateID with any type of body
+func NewPostTemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "volumeID", volumeID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -6050,7 +6752,7 @@ func NewGetVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6060,1570 +6762,1445 @@ func NewGetVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewPostTemplatesTemplateIDBuildsBuildIDRequest generates requests for PostTemplatesTemplateIDBuildsBuildID
+func NewPostTemplatesTemplateIDBuildsBuildIDRequest(server string, templateID TemplateID, buildID BuildID) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	var pathParam1 string
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostAccessTokensWithBodyWithResponse request with any body
-	PostAccessTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	PostAccessTokensWithResponse(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAccessTokensAccessTokenIDWithResponse request
-	DeleteAccessTokensAccessTokenIDWithResponse(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensAccessTokenIDResponse, error)
+	operationPath := fmt.Sprintf("/templates/%s/builds/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostAdminTeamsTeamIDApiKeysWithBodyWithResponse request with any body
-	PostAdminTeamsTeamIDApiKeysWithBodyWithResponse(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostAdminTeamsTeamIDApiKeysWithResponse(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse request
-	DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse(ctx context.Context, teamID openapi_types.UUID, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error)
+	return req, nil
+}
 
-	// PostAdminTeamsTeamIDBuildsCancelWithResponse request
-	PostAdminTeamsTeamIDBuildsCancelWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDBuildsCancelResponse, error)
+// NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest generates requests for GetTemplatesTemplateIDBuildsBuildIDLogs
+func NewGetTemplatesTemplateIDBuildsBuildIDLogsRequest(server string, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams) (*http.Request, error) {
+	var err error
 
-	// PostAdminTeamsTeamIDSandboxesKillWithResponse request
-	PostAdminTeamsTeamIDSandboxesKillWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDSandboxesKillResponse, error)
+	var pathParam0 string
 
-	// GetApiKeysWithResponse request
-	GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiKeysWithBodyWithResponse request with any body
-	PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+	var pathParam1 string
 
-	PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteApiKeysApiKeyIDWithResponse request
-	DeleteApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteApiKeysApiKeyIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchApiKeysApiKeyIDWithBodyWithResponse request with any body
-	PatchApiKeysApiKeyIDWithBodyWithResponse(ctx context.Context, apiKeyID ApiKeyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error)
+	operationPath := fmt.Sprintf("/templates/%s/builds/%s/logs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PatchApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, body PatchApiKeysApiKeyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// GetNodesWithResponse request
-	GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error)
+		if params.Cursor != nil {
 
-	// GetNodesNodeIDWithResponse request
-	GetNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, params *GetNodesNodeIDParams, reqEditors ...RequestEditorFn) (*GetNodesNodeIDResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// PostNodesNodeIDWithBodyWithResponse request with any body
-	PostNodesNodeIDWithBodyWithResponse(ctx context.Context, nodeID NodeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error)
+		}
 
-	PostNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, body PostNodesNodeIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error)
+		if params.Limit != nil {
 
-	// GetSandboxesWithResponse request
-	GetSandboxesWithResponse(ctx context.Context, params *GetSandboxesParams, reqEditors ...RequestEditorFn) (*GetSandboxesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// PostSandboxesWithBodyWithResponse request with any body
-	PostSandboxesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error)
+		}
 
-	PostSandboxesWithResponse(ctx context.Context, body PostSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error)
+		if params.Direction != nil {
 
-	// GetSandboxesMetricsWithResponse request
-	GetSandboxesMetricsWithResponse(ctx context.Context, params *GetSandboxesMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesMetricsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "direction", *params.Direction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// DeleteSandboxesSandboxIDWithResponse request
-	DeleteSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDResponse, error)
+		}
 
-	// GetSandboxesSandboxIDWithResponse request
-	GetSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDResponse, error)
+		if params.Level != nil {
 
-	// PostSandboxesSandboxIDConnectWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDConnectWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	PostSandboxesSandboxIDConnectWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error)
+		}
 
-	// PostSandboxesSandboxIDForkWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDForkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error)
+		if params.Source != nil {
 
-	PostSandboxesSandboxIDForkWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "source", *params.Source, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetSandboxesSandboxIDLogsWithResponse request
-	GetSandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDLogsResponse, error)
+		}
 
-	// GetSandboxesSandboxIDMetricsWithResponse request
-	GetSandboxesSandboxIDMetricsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDMetricsResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// PutSandboxesSandboxIDNetworkWithBodyWithResponse request with any body
-	PutSandboxesSandboxIDNetworkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PutSandboxesSandboxIDNetworkWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error)
+	return req, nil
+}
 
-	// PostSandboxesSandboxIDPauseWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDPauseWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error)
+// NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest generates requests for GetTemplatesTemplateIDBuildsBuildIDStatus
+func NewGetTemplatesTemplateIDBuildsBuildIDStatusRequest(server string, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams) (*http.Request, error) {
+	var err error
 
-	PostSandboxesSandboxIDPauseWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error)
+	var pathParam0 string
 
-	// PostSandboxesSandboxIDRefreshesWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDRefreshesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	PostSandboxesSandboxIDRefreshesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error)
+	var pathParam1 string
 
-	// PostSandboxesSandboxIDResumeWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDResumeWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	PostSandboxesSandboxIDResumeWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSandboxesSandboxIDSnapshotsWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDSnapshotsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error)
+	operationPath := fmt.Sprintf("/templates/%s/builds/%s/status", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PostSandboxesSandboxIDSnapshotsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSandboxesSandboxIDTimeoutWithBodyWithResponse request with any body
-	PostSandboxesSandboxIDTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	PostSandboxesSandboxIDTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error)
+		if params.LogsOffset != nil {
 
-	// GetSnapshotsWithResponse request
-	GetSnapshotsWithResponse(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*GetSnapshotsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "logsOffset", *params.LogsOffset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetTeamsWithResponse request
-	GetTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTeamsResponse, error)
+		}
 
-	// GetTeamsTeamIDMetricsWithResponse request
-	GetTeamsTeamIDMetricsWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsResponse, error)
+		if params.Limit != nil {
 
-	// GetTeamsTeamIDMetricsMaxWithResponse request
-	GetTeamsTeamIDMetricsMaxWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsMaxResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetTemplatesWithResponse request
-	GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error)
+		}
 
-	// PostTemplatesWithBodyWithResponse request with any body
-	PostTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error)
+		if params.Level != nil {
 
-	PostTemplatesWithResponse(ctx context.Context, body PostTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// GetTemplatesAliasesAliasWithResponse request
-	GetTemplatesAliasesAliasWithResponse(ctx context.Context, alias string, reqEditors ...RequestEditorFn) (*GetTemplatesAliasesAliasResponse, error)
+		}
 
-	// DeleteTemplatesTagsWithBodyWithResponse request with any body
-	DeleteTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	DeleteTemplatesTagsWithResponse(ctx context.Context, body DeleteTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostTemplatesTagsWithBodyWithResponse request with any body
-	PostTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error)
+	return req, nil
+}
 
-	PostTemplatesTagsWithResponse(ctx context.Context, body PostTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error)
+// NewGetTemplatesTemplateIDFilesHashRequest generates requests for GetTemplatesTemplateIDFilesHash
+func NewGetTemplatesTemplateIDFilesHashRequest(server string, templateID TemplateID, hash string) (*http.Request, error) {
+	var err error
 
-	// DeleteTemplatesTemplateIDWithResponse request
-	DeleteTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteTemplatesTemplateIDResponse, error)
+	var pathParam0 string
 
-	// GetTemplatesTemplateIDWithResponse request
-	GetTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, params *GetTemplatesTemplateIDParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchTemplatesTemplateIDWithBodyWithResponse request with any body
-	PatchTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error)
+	var pathParam1 string
 
-	PatchTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "hash", hash, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PostTemplatesTemplateIDWithBodyWithResponse request with any body
-	PostTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PostTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error)
+	operationPath := fmt.Sprintf("/templates/%s/files/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostTemplatesTemplateIDBuildsBuildIDWithResponse request
-	PostTemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse request
-	GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse request
-	GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error)
+	return req, nil
+}
 
-	// GetTemplatesTemplateIDFilesHashWithResponse request
-	GetTemplatesTemplateIDFilesHashWithResponse(ctx context.Context, templateID TemplateID, hash string, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDFilesHashResponse, error)
+// NewGetTemplatesTemplateIDTagsRequest generates requests for GetTemplatesTemplateIDTags
+func NewGetTemplatesTemplateIDTagsRequest(server string, templateID TemplateID) (*http.Request, error) {
+	var err error
 
-	// GetTemplatesTemplateIDTagsWithResponse request
-	GetTemplatesTemplateIDTagsWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDTagsResponse, error)
+	var pathParam0 string
 
-	// GetV2SandboxesWithResponse request
-	GetV2SandboxesWithResponse(ctx context.Context, params *GetV2SandboxesParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV2SandboxesSandboxIDLogsWithResponse request
-	GetV2SandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesSandboxIDLogsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetV2TemplatesWithResponse request
-	GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error)
+	operationPath := fmt.Sprintf("/templates/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostV2TemplatesWithBodyWithResponse request with any body
-	PostV2TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostV2TemplatesWithResponse(ctx context.Context, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchV2TemplatesTemplateIDWithBodyWithResponse request with any body
-	PatchV2TemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error)
+	return req, nil
+}
 
-	PatchV2TemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error)
+// NewGetV2SandboxesRequest generates requests for GetV2Sandboxes
+func NewGetV2SandboxesRequest(server string, params *GetV2SandboxesParams) (*http.Request, error) {
+	var err error
 
-	// PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse request with any body
-	PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error)
+	operationPath := fmt.Sprintf("/v2/sandboxes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostV3TemplatesWithBodyWithResponse request with any body
-	PostV3TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PostV3TemplatesWithResponse(ctx context.Context, body PostV3TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// GetVolumesWithResponse request
-	GetVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVolumesResponse, error)
+		if params.Metadata != nil {
 
-	// PostVolumesWithBodyWithResponse request with any body
-	PostVolumesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "metadata", *params.Metadata, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	PostVolumesWithResponse(ctx context.Context, body PostVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error)
+		}
 
-	// DeleteVolumesVolumeIDWithResponse request
-	DeleteVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*DeleteVolumesVolumeIDResponse, error)
+		if params.State != nil {
 
-	// GetVolumesVolumeIDWithResponse request
-	GetVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*GetVolumesVolumeIDResponse, error)
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "state", *params.State, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-type PostAccessTokensResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatedAccessToken
-	JSON401      *N401
-	JSON410      *N410
-	JSON500      *N500
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r PostAccessTokensResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.NextToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAccessTokensResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAccessTokensResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+// NewGetV2SandboxesSandboxIDLogsRequest generates requests for GetV2SandboxesSandboxIDLogs
+func NewGetV2SandboxesSandboxIDLogsRequest(server string, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams) (*http.Request, error) {
+	var err error
 
-type DeleteAccessTokensAccessTokenIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteAccessTokensAccessTokenIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sandboxID", sandboxID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAccessTokensAccessTokenIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteAccessTokensAccessTokenIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/v2/sandboxes/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
-
-type PostAdminTeamsTeamIDApiKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatedTeamAPIKey
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r PostAdminTeamsTeamIDApiKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAdminTeamsTeamIDApiKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAdminTeamsTeamIDApiKeysResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+		if params.Cursor != nil {
 
-type DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.Limit != nil {
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-type PostAdminTeamsTeamIDBuildsCancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AdminBuildCancelResult
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r PostAdminTeamsTeamIDBuildsCancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Direction != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAdminTeamsTeamIDBuildsCancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "direction", *params.Direction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAdminTeamsTeamIDBuildsCancelResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+		}
 
-type PostAdminTeamsTeamIDSandboxesKillResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AdminSandboxKillResult
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+		if params.Level != nil {
 
-// Status returns HTTPResponse.Status
-func (r PostAdminTeamsTeamIDSandboxesKillResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAdminTeamsTeamIDSandboxesKillResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostAdminTeamsTeamIDSandboxesKillResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+		if params.Search != nil {
 
-type GetApiKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TeamAPIKey
-	JSON401      *N401
-	JSON500      *N500
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r GetApiKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.ProcessTag != nil {
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetApiKeysResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "processTag", *params.ProcessTag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-type PostApiKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatedTeamAPIKey
-	JSON401      *N401
-	JSON500      *N500
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r PostApiKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Stream != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "stream", *params.Stream, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostApiKeysResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type DeleteApiKeysApiKeyIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiKeysApiKeyIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetV2TemplatesRequest generates requests for GetV2Templates
+func NewGetV2TemplatesRequest(server string, params *GetV2TemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiKeysApiKeyIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteApiKeysApiKeyIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type PatchApiKeysApiKeyIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-// Status returns HTTPResponse.Status
-func (r PatchApiKeysApiKeyIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.TeamID != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchApiKeysApiKeyIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "teamID", *params.TeamID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchApiKeysApiKeyIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+		}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-}
+		if params.NextToken != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nextToken", *params.NextToken, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int32"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetHealthResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetNodesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Node
-	JSON401      *N401
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetNodesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostV2TemplatesRequest calls the generic PostV2Templates builder with application/json body
+func NewPostV2TemplatesRequest(server string, body PostV2TemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplatesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetNodesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostV2TemplatesRequestWithBody generates requests for PostV2Templates with any type of body
+func NewPostV2TemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type GetNodesNodeIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NodeDetail
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetNodesNodeIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetNodesNodeIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchV2TemplatesTemplateIDRequest calls the generic PatchV2TemplatesTemplateID builder with application/json body
+func NewPatchV2TemplatesTemplateIDRequest(server string, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchV2TemplatesTemplateIDRequestWithBody(server, templateID, "application/json", bodyReader)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetNodesNodeIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+// NewPatchV2TemplatesTemplateIDRequestWithBody generates requests for PatchV2TemplatesTemplateID with any type of body
+func NewPatchV2TemplatesTemplateIDRequestWithBody(server string, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type PostNodesNodeIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r PostNodesNodeIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostNodesNodeIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostNodesNodeIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/v2/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
-
-type GetSandboxesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ListedSandbox
-	JSON400      *N400
-	JSON401      *N401
-	JSON500      *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r GetSandboxesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSandboxesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSandboxesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	req.Header.Add("Content-Type", contentType)
 
-type PostSandboxesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Sandbox
-	JSON400      *N400
-	JSON401      *N401
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostV2TemplatesTemplateIDBuildsBuildIDRequest calls the generic PostV2TemplatesTemplateIDBuildsBuildID builder with application/json body
+func NewPostV2TemplatesTemplateIDBuildsBuildIDRequest(server string, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server, templateID, buildID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody generates requests for PostV2TemplatesTemplateIDBuildsBuildID with any type of body
+func NewPostV2TemplatesTemplateIDBuildsBuildIDRequestWithBody(server string, templateID TemplateID, buildID BuildID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "templateID", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "buildID", buildID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetSandboxesMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SandboxesWithMetrics
-	JSON400      *N400
-	JSON401      *N401
-	JSON500      *N500
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetSandboxesMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v2/templates/%s/builds/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSandboxesMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSandboxesMetricsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type DeleteSandboxesSandboxIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSandboxesSandboxIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostV3TemplatesRequest calls the generic PostV3Templates builder with application/json body
+func NewPostV3TemplatesRequest(server string, body PostV3TemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV3TemplatesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSandboxesSandboxIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostV3TemplatesRequestWithBody generates requests for PostV3Templates with any type of body
+func NewPostV3TemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteSandboxesSandboxIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/v3/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type GetSandboxesSandboxIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SandboxDetail
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetSandboxesSandboxIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSandboxesSandboxIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetVolumesRequest generates requests for GetVolumes
+func NewGetVolumesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSandboxesSandboxIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type PostSandboxesSandboxIDConnectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Sandbox
-	JSON201      *Sandbox
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDConnectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDConnectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostVolumesRequest calls the generic PostVolumes builder with application/json body
+func NewPostVolumesRequest(server string, body PostVolumesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostVolumesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDConnectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewPostVolumesRequestWithBody generates requests for PostVolumes with any type of body
+func NewPostVolumesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type PostSandboxesSandboxIDForkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *[]SandboxForkResult
-	JSON401      *N401
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDForkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDForkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDForkResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewDeleteVolumesVolumeIDRequest generates requests for DeleteVolumesVolumeID
+func NewDeleteVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "volumeID", volumeID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetSandboxesSandboxIDLogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SandboxLogs
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetSandboxesSandboxIDLogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSandboxesSandboxIDLogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSandboxesSandboxIDLogsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetSandboxesSandboxIDMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SandboxMetric
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSandboxesSandboxIDMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetVolumesVolumeIDRequest generates requests for GetVolumesVolumeID
+func NewGetVolumesVolumeIDRequest(server string, volumeID VolumeID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "volumeID", volumeID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSandboxesSandboxIDMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSandboxesSandboxIDMetricsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type PutSandboxesSandboxIDNetworkResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutSandboxesSandboxIDNetworkResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutSandboxesSandboxIDNetworkResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PutSandboxesSandboxIDNetworkResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type PostSandboxesSandboxIDPauseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDPauseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDPauseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostWebhooksRequest calls the generic PostWebhooks builder with application/json body
+func NewPostWebhooksRequest(server string, body PostWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDPauseResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
-
-type PostSandboxesSandboxIDRefreshesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-}
+// NewPostWebhooksRequestWithBody generates requests for PostWebhooks with any type of body
+func NewPostWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDRefreshesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDRefreshesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDRefreshesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
-
-type PostSandboxesSandboxIDResumeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Sandbox
-	JSON401      *N401
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDResumeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDResumeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	req.Header.Add("Content-Type", contentType)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDResumeResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
+	return req, nil
 }
 
-type PostSandboxesSandboxIDSnapshotsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SnapshotInfo
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+// NewDeleteWebhooksWebhookIDRequest generates requests for DeleteWebhooksWebhookID
+func NewDeleteWebhooksWebhookIDRequest(server string, webhookID WebhookID) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDSnapshotsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDSnapshotsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "webhookID", webhookID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDSnapshotsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
-
-type PostSandboxesSandboxIDTimeoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r PostSandboxesSandboxIDTimeoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSandboxesSandboxIDTimeoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostSandboxesSandboxIDTimeoutResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetSnapshotsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SnapshotInfo
-	JSON401      *N401
-	JSON500      *N500
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSnapshotsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewPostWebhooksWebhookIDTestRequest generates requests for PostWebhooksWebhookIDTest
+func NewPostWebhooksWebhookIDTestRequest(server string, webhookID WebhookID) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSnapshotsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "webhookID", webhookID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetSnapshotsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetTeamsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Team
-	JSON401      *N401
-	JSON500      *N500
-}
+	operationPath := fmt.Sprintf("/webhooks/%s/test", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetTeamsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTeamsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return ""
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-type GetTeamsTeamIDMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TeamMetric
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTeamsTeamIDMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return &ClientWithResponses{client}, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamsTeamIDMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	return 0
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTeamsTeamIDMetricsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
 
-type GetTeamsTeamIDMetricsMaxResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MaxTeamMetric
-	JSON400      *N400
-	JSON401      *N401
-	JSON403      *N403
-	JSON500      *N500
-}
+	// GetWellKnownOpenidConfigurationWithResponse request
+	GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r GetTeamsTeamIDMetricsMaxResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// PostAccessTokensWithBodyWithResponse request with any body
+	PostAccessTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamsTeamIDMetricsMaxResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	PostAccessTokensWithResponse(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTeamsTeamIDMetricsMaxResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	// DeleteAccessTokensAccessTokenIDWithResponse request
+	DeleteAccessTokensAccessTokenIDWithResponse(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensAccessTokenIDResponse, error)
 
-type GetTemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Template
-	JSON401      *N401
-	JSON500      *N500
-}
+	// PostAdminTeamsTeamIDApiKeysWithBodyWithResponse request with any body
+	PostAdminTeamsTeamIDApiKeysWithBodyWithResponse(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r GetTemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	PostAdminTeamsTeamIDApiKeysWithResponse(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse request
+	DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse(ctx context.Context, teamID openapi_types.UUID, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	// PostAdminTeamsTeamIDBuildsCancelWithResponse request
+	PostAdminTeamsTeamIDBuildsCancelWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDBuildsCancelResponse, error)
 
-type PostTemplatesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *TemplateLegacy
-	JSON400      *N400
-	JSON401      *N401
-	JSON500      *N500
-}
+	// PostAdminTeamsTeamIDSandboxesKillWithResponse request
+	PostAdminTeamsTeamIDSandboxesKillWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDSandboxesKillResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r PostTemplatesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// GetApiKeysWithResponse request
+	GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTemplatesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// PostApiKeysWithBodyWithResponse request with any body
+	PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostTemplatesResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
 
-type GetTemplatesAliasesAliasResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TemplateAliasResponse
-	JSON400      *N400
-	JSON403      *N403
-	JSON404      *N404
-	JSON500      *N500
-}
+	// DeleteApiKeysApiKeyIDWithResponse request
+	DeleteApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteApiKeysApiKeyIDResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r GetTemplatesAliasesAliasResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// PatchApiKeysApiKeyIDWithBodyWithResponse request with any body
+	PatchApiKeysApiKeyIDWithBodyWithResponse(ctx context.Context, apiKeyID ApiKeyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesAliasesAliasResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	PatchApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, body PatchApiKeysApiKeyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesAliasesAliasResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
 
-type DeleteTemplatesTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	// GetNodesWithResponse request
+	GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r DeleteTemplatesTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// GetNodesNodeIDWithResponse request
+	GetNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, params *GetNodesNodeIDParams, reqEditors ...RequestEditorFn) (*GetNodesNodeIDResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTemplatesTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// PostNodesNodeIDWithBodyWithResponse request with any body
+	PostNodesNodeIDWithBodyWithResponse(ctx context.Context, nodeID NodeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteTemplatesTagsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	PostNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, body PostNodesNodeIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error)
 
-type PostTemplatesTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AssignedTemplateTags
-	JSON400      *N400
-	JSON401      *N401
-	JSON404      *N404
-	JSON500      *N500
-}
+	// GetSandboxesWithResponse request
+	GetSandboxesWithResponse(ctx context.Context, params *GetSandboxesParams, reqEditors ...RequestEditorFn) (*GetSandboxesResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r PostTemplatesTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// PostSandboxesWithBodyWithResponse request with any body
+	PostSandboxesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTemplatesTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	PostSandboxesWithResponse(ctx context.Context, body PostSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostTemplatesTagsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	// GetSandboxesMetricsWithResponse request
+	GetSandboxesMetricsWithResponse(ctx context.Context, params *GetSandboxesMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesMetricsResponse, error)
 
-type DeleteTemplatesTemplateIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *N401
-	JSON500      *N500
-}
+	// DeleteSandboxesSandboxIDWithResponse request
+	DeleteSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r DeleteTemplatesTemplateIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// GetSandboxesSandboxIDWithResponse request
+	GetSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTemplatesTemplateIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// PutSandboxesSandboxIDBandwidthWithBodyWithResponse request with any body
+	PutSandboxesSandboxIDBandwidthWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDBandwidthResponse, error)
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteTemplatesTemplateIDResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
+	PutSandboxesSandboxIDBandwidthWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDBandwidthJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDBandwidthResponse, error)
 
-type GetTemplatesTemplateIDResponse struct {
+	// GetSandboxesSandboxIDCheckpointsWithResponse request
+	GetSandboxesSandboxIDCheckpointsWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDCheckpointsResponse, error)
+
+	// PostSandboxesSandboxIDCheckpointsWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDCheckpointsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsResponse, error)
+
+	PostSandboxesSandboxIDCheckpointsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDCheckpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsResponse, error)
+
+	// DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse request
+	DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse, error)
+
+	// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse, error)
+
+	PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, body PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse, error)
+
+	// PostSandboxesSandboxIDConnectWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDConnectWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error)
+
+	PostSandboxesSandboxIDConnectWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error)
+
+	// PostSandboxesSandboxIDControlPauseWithResponse request
+	PostSandboxesSandboxIDControlPauseWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlPauseResponse, error)
+
+	// PostSandboxesSandboxIDControlSandboxesWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDControlSandboxesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlSandboxesResponse, error)
+
+	PostSandboxesSandboxIDControlSandboxesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlSandboxesResponse, error)
+
+	// PostSandboxesSandboxIDControlTimeoutWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDControlTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlTimeoutResponse, error)
+
+	PostSandboxesSandboxIDControlTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlTimeoutResponse, error)
+
+	// PatchSandboxesSandboxIDEnvsWithBodyWithResponse request with any body
+	PatchSandboxesSandboxIDEnvsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDEnvsResponse, error)
+
+	PatchSandboxesSandboxIDEnvsWithResponse(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDEnvsResponse, error)
+
+	// PostSandboxesSandboxIDForkWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDForkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error)
+
+	PostSandboxesSandboxIDForkWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error)
+
+	// GetSandboxesSandboxIDLogsWithResponse request
+	GetSandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDLogsResponse, error)
+
+	// GetSandboxesSandboxIDMetricsWithResponse request
+	GetSandboxesSandboxIDMetricsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDMetricsResponse, error)
+
+	// PutSandboxesSandboxIDNetworkWithBodyWithResponse request with any body
+	PutSandboxesSandboxIDNetworkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error)
+
+	PutSandboxesSandboxIDNetworkWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error)
+
+	// GetSandboxesSandboxIDNetworkLogsWithResponse request
+	GetSandboxesSandboxIDNetworkLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDNetworkLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDNetworkLogsResponse, error)
+
+	// PostSandboxesSandboxIDPauseWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDPauseWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error)
+
+	PostSandboxesSandboxIDPauseWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error)
+
+	// PostSandboxesSandboxIDRefreshesWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDRefreshesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error)
+
+	PostSandboxesSandboxIDRefreshesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error)
+
+	// PatchSandboxesSandboxIDResourcesWithBodyWithResponse request with any body
+	PatchSandboxesSandboxIDResourcesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDResourcesResponse, error)
+
+	PatchSandboxesSandboxIDResourcesWithResponse(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDResourcesResponse, error)
+
+	// PostSandboxesSandboxIDResumeWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDResumeWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error)
+
+	PostSandboxesSandboxIDResumeWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error)
+
+	// PostSandboxesSandboxIDSnapshotsWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDSnapshotsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error)
+
+	PostSandboxesSandboxIDSnapshotsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error)
+
+	// PostSandboxesSandboxIDTimeoutWithBodyWithResponse request with any body
+	PostSandboxesSandboxIDTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error)
+
+	PostSandboxesSandboxIDTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error)
+
+	// GetSecretsWithResponse request
+	GetSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error)
+
+	// DeleteSecretsSecretNameWithResponse request
+	DeleteSecretsSecretNameWithResponse(ctx context.Context, secretName SecretName, reqEditors ...RequestEditorFn) (*DeleteSecretsSecretNameResponse, error)
+
+	// PutSecretsSecretNameWithBodyWithResponse request with any body
+	PutSecretsSecretNameWithBodyWithResponse(ctx context.Context, secretName SecretName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSecretsSecretNameResponse, error)
+
+	PutSecretsSecretNameWithResponse(ctx context.Context, secretName SecretName, body PutSecretsSecretNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSecretsSecretNameResponse, error)
+
+	// GetSnapshotsWithResponse request
+	GetSnapshotsWithResponse(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*GetSnapshotsResponse, error)
+
+	// GetTeamsWithResponse request
+	GetTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTeamsResponse, error)
+
+	// GetTeamsTeamIDMetricsWithResponse request
+	GetTeamsTeamIDMetricsWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsResponse, error)
+
+	// GetTeamsTeamIDMetricsMaxWithResponse request
+	GetTeamsTeamIDMetricsMaxWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsMaxResponse, error)
+
+	// GetTemplatesWithResponse request
+	GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error)
+
+	// PostTemplatesWithBodyWithResponse request with any body
+	PostTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error)
+
+	PostTemplatesWithResponse(ctx context.Context, body PostTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error)
+
+	// GetTemplatesAliasesAliasWithResponse request
+	GetTemplatesAliasesAliasWithResponse(ctx context.Context, alias string, reqEditors ...RequestEditorFn) (*GetTemplatesAliasesAliasResponse, error)
+
+	// DeleteTemplatesTagsWithBodyWithResponse request with any body
+	DeleteTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error)
+
+	DeleteTemplatesTagsWithResponse(ctx context.Context, body DeleteTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error)
+
+	// PostTemplatesTagsWithBodyWithResponse request with any body
+	PostTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error)
+
+	PostTemplatesTagsWithResponse(ctx context.Context, body PostTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error)
+
+	// DeleteTemplatesTemplateIDWithResponse request
+	DeleteTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteTemplatesTemplateIDResponse, error)
+
+	// GetTemplatesTemplateIDWithResponse request
+	GetTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, params *GetTemplatesTemplateIDParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDResponse, error)
+
+	// PatchTemplatesTemplateIDWithBodyWithResponse request with any body
+	PatchTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error)
+
+	PatchTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error)
+
+	// PostTemplatesTemplateIDWithBodyWithResponse request with any body
+	PostTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error)
+
+	PostTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error)
+
+	// PostTemplatesTemplateIDBuildsBuildIDWithResponse request
+	PostTemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error)
+
+	// GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse request
+	GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error)
+
+	// GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse request
+	GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error)
+
+	// GetTemplatesTemplateIDFilesHashWithResponse request
+	GetTemplatesTemplateIDFilesHashWithResponse(ctx context.Context, templateID TemplateID, hash string, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDFilesHashResponse, error)
+
+	// GetTemplatesTemplateIDTagsWithResponse request
+	GetTemplatesTemplateIDTagsWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDTagsResponse, error)
+
+	// GetV2SandboxesWithResponse request
+	GetV2SandboxesWithResponse(ctx context.Context, params *GetV2SandboxesParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesResponse, error)
+
+	// GetV2SandboxesSandboxIDLogsWithResponse request
+	GetV2SandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesSandboxIDLogsResponse, error)
+
+	// GetV2TemplatesWithResponse request
+	GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error)
+
+	// PostV2TemplatesWithBodyWithResponse request with any body
+	PostV2TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+
+	PostV2TemplatesWithResponse(ctx context.Context, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error)
+
+	// PatchV2TemplatesTemplateIDWithBodyWithResponse request with any body
+	PatchV2TemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error)
+
+	PatchV2TemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error)
+
+	// PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse request with any body
+	PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error)
+
+	PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error)
+
+	// PostV3TemplatesWithBodyWithResponse request with any body
+	PostV3TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error)
+
+	PostV3TemplatesWithResponse(ctx context.Context, body PostV3TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error)
+
+	// GetVolumesWithResponse request
+	GetVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVolumesResponse, error)
+
+	// PostVolumesWithBodyWithResponse request with any body
+	PostVolumesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error)
+
+	PostVolumesWithResponse(ctx context.Context, body PostVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error)
+
+	// DeleteVolumesVolumeIDWithResponse request
+	DeleteVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*DeleteVolumesVolumeIDResponse, error)
+
+	// GetVolumesVolumeIDWithResponse request
+	GetVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*GetVolumesVolumeIDResponse, error)
+
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// PostWebhooksWithBodyWithResponse request with any body
+	PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	// DeleteWebhooksWebhookIDWithResponse request
+	DeleteWebhooksWebhookIDWithResponse(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIDResponse, error)
+
+	// PostWebhooksWebhookIDTestWithResponse request
+	PostWebhooksWebhookIDTestWithResponse(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIDTestResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TemplateWithBuilds
-	JSON401      *N401
-	JSON500      *N500
+	JSON200      *SandboxIdentityJWKS
+	JSON404      *N404
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTemplatesTemplateIDResponse) Status() string {
+func (r GetWellKnownJwksJsonResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7631,7 +8208,7 @@ func (r GetTemplatesTemplateIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesTemplateIDResponse) StatusCode() int {
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7639,23 +8216,22 @@ func (r GetTemplatesTemplateIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesTemplateIDResponse) ContentType() string {
+func (r GetWellKnownJwksJsonResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchTemplatesTemplateIDResponse struct {
+type GetWellKnownOpenidConfigurationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON401      *N401
-	JSON500      *N500
+	JSON200      *SandboxIdentityDiscovery
+	JSON404      *N404
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchTemplatesTemplateIDResponse) Status() string {
+func (r GetWellKnownOpenidConfigurationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7663,7 +8239,7 @@ func (r PatchTemplatesTemplateIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchTemplatesTemplateIDResponse) StatusCode() int {
+func (r GetWellKnownOpenidConfigurationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7671,23 +8247,24 @@ func (r PatchTemplatesTemplateIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchTemplatesTemplateIDResponse) ContentType() string {
+func (r GetWellKnownOpenidConfigurationResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostTemplatesTemplateIDResponse struct {
+type PostAccessTokensResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON202      *TemplateLegacy
+	JSON201      *CreatedAccessToken
 	JSON401      *N401
+	JSON410      *N410
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostTemplatesTemplateIDResponse) Status() string {
+func (r PostAccessTokensResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7695,7 +8272,7 @@ func (r PostTemplatesTemplateIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostTemplatesTemplateIDResponse) StatusCode() int {
+func (r PostAccessTokensResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7703,22 +8280,23 @@ func (r PostTemplatesTemplateIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostTemplatesTemplateIDResponse) ContentType() string {
+func (r PostAccessTokensResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostTemplatesTemplateIDBuildsBuildIDResponse struct {
+type DeleteAccessTokensAccessTokenIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON401      *N401
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostTemplatesTemplateIDBuildsBuildIDResponse) Status() string {
+func (r DeleteAccessTokensAccessTokenIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7726,7 +8304,7 @@ func (r PostTemplatesTemplateIDBuildsBuildIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostTemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
+func (r DeleteAccessTokensAccessTokenIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7734,24 +8312,26 @@ func (r PostTemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostTemplatesTemplateIDBuildsBuildIDResponse) ContentType() string {
+func (r DeleteAccessTokensAccessTokenIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetTemplatesTemplateIDBuildsBuildIDLogsResponse struct {
+type PostAdminTeamsTeamIDApiKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TemplateBuildLogsResponse
+	JSON201      *CreatedTeamAPIKey
+	JSON400      *N400
 	JSON401      *N401
+	JSON403      *N403
 	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) Status() string {
+func (r PostAdminTeamsTeamIDApiKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7759,7 +8339,7 @@ func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) StatusCode() int {
+func (r PostAdminTeamsTeamIDApiKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7767,24 +8347,24 @@ func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) ContentType() string {
+func (r PostAdminTeamsTeamIDApiKeysResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetTemplatesTemplateIDBuildsBuildIDStatusResponse struct {
+type DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TemplateBuildInfo
+	JSON400      *N400
 	JSON401      *N401
 	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) Status() string {
+func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7792,7 +8372,7 @@ func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) StatusCode() int {
+func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7800,25 +8380,24 @@ func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) ContentType() string {
+func (r DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetTemplatesTemplateIDFilesHashResponse struct {
+type PostAdminTeamsTeamIDBuildsCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TemplateBuildFileUpload
-	JSON400      *N400
+	JSON200      *AdminBuildCancelResult
 	JSON401      *N401
 	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTemplatesTemplateIDFilesHashResponse) Status() string {
+func (r PostAdminTeamsTeamIDBuildsCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7826,7 +8405,7 @@ func (r GetTemplatesTemplateIDFilesHashResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesTemplateIDFilesHashResponse) StatusCode() int {
+func (r PostAdminTeamsTeamIDBuildsCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7834,25 +8413,24 @@ func (r GetTemplatesTemplateIDFilesHashResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesTemplateIDFilesHashResponse) ContentType() string {
+func (r PostAdminTeamsTeamIDBuildsCancelResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetTemplatesTemplateIDTagsResponse struct {
+type PostAdminTeamsTeamIDSandboxesKillResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]TemplateTag
+	JSON200      *AdminSandboxKillResult
 	JSON401      *N401
-	JSON403      *N403
 	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTemplatesTemplateIDTagsResponse) Status() string {
+func (r PostAdminTeamsTeamIDSandboxesKillResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7860,7 +8438,7 @@ func (r GetTemplatesTemplateIDTagsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTemplatesTemplateIDTagsResponse) StatusCode() int {
+func (r PostAdminTeamsTeamIDSandboxesKillResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7868,24 +8446,23 @@ func (r GetTemplatesTemplateIDTagsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetTemplatesTemplateIDTagsResponse) ContentType() string {
+func (r PostAdminTeamsTeamIDSandboxesKillResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetV2SandboxesResponse struct {
+type GetApiKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ListedSandbox
-	JSON400      *N400
+	JSON200      *[]TeamAPIKey
 	JSON401      *N401
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetV2SandboxesResponse) Status() string {
+func (r GetApiKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7893,7 +8470,7 @@ func (r GetV2SandboxesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetV2SandboxesResponse) StatusCode() int {
+func (r GetApiKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7901,24 +8478,23 @@ func (r GetV2SandboxesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetV2SandboxesResponse) ContentType() string {
+func (r GetApiKeysResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetV2SandboxesSandboxIDLogsResponse struct {
+type PostApiKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SandboxLogsV2Response
+	JSON201      *CreatedTeamAPIKey
 	JSON401      *N401
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetV2SandboxesSandboxIDLogsResponse) Status() string {
+func (r PostApiKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7926,7 +8502,7 @@ func (r GetV2SandboxesSandboxIDLogsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetV2SandboxesSandboxIDLogsResponse) StatusCode() int {
+func (r PostApiKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7934,25 +8510,23 @@ func (r GetV2SandboxesSandboxIDLogsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetV2SandboxesSandboxIDLogsResponse) ContentType() string {
+func (r PostApiKeysResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetV2TemplatesResponse struct {
+type DeleteApiKeysApiKeyIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Template
-	JSON400      *N400
 	JSON401      *N401
-	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetV2TemplatesResponse) Status() string {
+func (r DeleteApiKeysApiKeyIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7960,7 +8534,7 @@ func (r GetV2TemplatesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetV2TemplatesResponse) StatusCode() int {
+func (r DeleteApiKeysApiKeyIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -7968,24 +8542,23 @@ func (r GetV2TemplatesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetV2TemplatesResponse) ContentType() string {
+func (r DeleteApiKeysApiKeyIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostV2TemplatesResponse struct {
+type PatchApiKeysApiKeyIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON202      *TemplateLegacy
-	JSON400      *N400
 	JSON401      *N401
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostV2TemplatesResponse) Status() string {
+func (r PatchApiKeysApiKeyIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7993,7 +8566,7 @@ func (r PostV2TemplatesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostV2TemplatesResponse) StatusCode() int {
+func (r PatchApiKeysApiKeyIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8001,24 +8574,21 @@ func (r PostV2TemplatesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostV2TemplatesResponse) ContentType() string {
+func (r PatchApiKeysApiKeyIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PatchV2TemplatesTemplateIDResponse struct {
+type GetHealthResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TemplateUpdateResponse
-	JSON400      *N400
 	JSON401      *N401
-	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchV2TemplatesTemplateIDResponse) Status() string {
+func (r GetHealthResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8026,7 +8596,7 @@ func (r PatchV2TemplatesTemplateIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchV2TemplatesTemplateIDResponse) StatusCode() int {
+func (r GetHealthResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8034,22 +8604,23 @@ func (r PatchV2TemplatesTemplateIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PatchV2TemplatesTemplateIDResponse) ContentType() string {
+func (r GetHealthResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostV2TemplatesTemplateIDBuildsBuildIDResponse struct {
+type GetNodesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]Node
 	JSON401      *N401
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) Status() string {
+func (r GetNodesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8057,7 +8628,7 @@ func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
+func (r GetNodesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8065,25 +8636,24 @@ func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) ContentType() string {
+func (r GetNodesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostV3TemplatesResponse struct {
+type GetNodesNodeIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON202      *TemplateRequestResponseV3
-	JSON400      *N400
+	JSON200      *NodeDetail
 	JSON401      *N401
-	JSON403      *N403
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostV3TemplatesResponse) Status() string {
+func (r GetNodesNodeIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8091,7 +8661,7 @@ func (r PostV3TemplatesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostV3TemplatesResponse) StatusCode() int {
+func (r GetNodesNodeIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8099,23 +8669,23 @@ func (r PostV3TemplatesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostV3TemplatesResponse) ContentType() string {
+func (r GetNodesNodeIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetVolumesResponse struct {
+type PostNodesNodeIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Volume
 	JSON401      *N401
+	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetVolumesResponse) Status() string {
+func (r PostNodesNodeIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8123,7 +8693,7 @@ func (r GetVolumesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetVolumesResponse) StatusCode() int {
+func (r PostNodesNodeIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8131,24 +8701,24 @@ func (r GetVolumesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetVolumesResponse) ContentType() string {
+func (r PostNodesNodeIDResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PostVolumesResponse struct {
+type GetSandboxesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *VolumeAndToken
+	JSON200      *[]ListedSandbox
 	JSON400      *N400
 	JSON401      *N401
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r PostVolumesResponse) Status() string {
+func (r GetSandboxesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8156,7 +8726,7 @@ func (r PostVolumesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostVolumesResponse) StatusCode() int {
+func (r GetSandboxesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8164,23 +8734,24 @@ func (r PostVolumesResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PostVolumesResponse) ContentType() string {
+func (r GetSandboxesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteVolumesVolumeIDResponse struct {
+type PostSandboxesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *Sandbox
+	JSON400      *N400
 	JSON401      *N401
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteVolumesVolumeIDResponse) Status() string {
+func (r PostSandboxesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8188,7 +8759,7 @@ func (r DeleteVolumesVolumeIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteVolumesVolumeIDResponse) StatusCode() int {
+func (r PostSandboxesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8196,24 +8767,24 @@ func (r DeleteVolumesVolumeIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteVolumesVolumeIDResponse) ContentType() string {
+func (r PostSandboxesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetVolumesVolumeIDResponse struct {
+type GetSandboxesMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *VolumeAndToken
+	JSON200      *SandboxesWithMetrics
+	JSON400      *N400
 	JSON401      *N401
-	JSON404      *N404
 	JSON500      *N500
 }
 
 // Status returns HTTPResponse.Status
-func (r GetVolumesVolumeIDResponse) Status() string {
+func (r GetSandboxesMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8221,7 +8792,7 @@ func (r GetVolumesVolumeIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetVolumesVolumeIDResponse) StatusCode() int {
+func (r GetSandboxesMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8229,2792 +8800,2947 @@ func (r GetVolumesVolumeIDResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetVolumesVolumeIDResponse) ContentType() string {
+func (r GetSandboxesMetricsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-// PostAccessTokensWithBodyWithResponse request with arbitrary body returning *PostAccessTokensResponse
-func (c *ClientWithResponses) PostAccessTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error) {
-	rsp, err := c.PostAccessTokensWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAccessTokensResponse(rsp)
+type DeleteSandboxesSandboxIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostAccessTokensWithResponse(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error) {
-	rsp, err := c.PostAccessTokens(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteSandboxesSandboxIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostAccessTokensResponse(rsp)
+	return http.StatusText(0)
 }
 
-// DeleteAccessTokensAccessTokenIDWithResponse request returning *DeleteAccessTokensAccessTokenIDResponse
-func (c *ClientWithResponses) DeleteAccessTokensAccessTokenIDWithResponse(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensAccessTokenIDResponse, error) {
-	rsp, err := c.DeleteAccessTokensAccessTokenID(ctx, accessTokenID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSandboxesSandboxIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseDeleteAccessTokensAccessTokenIDResponse(rsp)
+	return 0
 }
 
-// PostAdminTeamsTeamIDApiKeysWithBodyWithResponse request with arbitrary body returning *PostAdminTeamsTeamIDApiKeysResponse
-func (c *ClientWithResponses) PostAdminTeamsTeamIDApiKeysWithBodyWithResponse(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
-	rsp, err := c.PostAdminTeamsTeamIDApiKeysWithBody(ctx, teamID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteSandboxesSandboxIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostAdminTeamsTeamIDApiKeysResponse(rsp)
+	return ""
 }
 
-func (c *ClientWithResponses) PostAdminTeamsTeamIDApiKeysWithResponse(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
-	rsp, err := c.PostAdminTeamsTeamIDApiKeys(ctx, teamID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAdminTeamsTeamIDApiKeysResponse(rsp)
+type GetSandboxesSandboxIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SandboxDetail
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse request returning *DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse
-func (c *ClientWithResponses) DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse(ctx context.Context, teamID openapi_types.UUID, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error) {
-	rsp, err := c.DeleteAdminTeamsTeamIDApiKeysApiKeyID(ctx, teamID, apiKeyID, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSandboxesSandboxIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostAdminTeamsTeamIDBuildsCancelWithResponse request returning *PostAdminTeamsTeamIDBuildsCancelResponse
-func (c *ClientWithResponses) PostAdminTeamsTeamIDBuildsCancelWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDBuildsCancelResponse, error) {
-	rsp, err := c.PostAdminTeamsTeamIDBuildsCancel(ctx, teamID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxesSandboxIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostAdminTeamsTeamIDBuildsCancelResponse(rsp)
+	return 0
 }
 
-// PostAdminTeamsTeamIDSandboxesKillWithResponse request returning *PostAdminTeamsTeamIDSandboxesKillResponse
-func (c *ClientWithResponses) PostAdminTeamsTeamIDSandboxesKillWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDSandboxesKillResponse, error) {
-	rsp, err := c.PostAdminTeamsTeamIDSandboxesKill(ctx, teamID, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSandboxesSandboxIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostAdminTeamsTeamIDSandboxesKillResponse(rsp)
+	return ""
 }
 
-// GetApiKeysWithResponse request returning *GetApiKeysResponse
-func (c *ClientWithResponses) GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error) {
-	rsp, err := c.GetApiKeys(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiKeysResponse(rsp)
+type PutSandboxesSandboxIDBandwidthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// PostApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiKeysResponse
-func (c *ClientWithResponses) PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
-	rsp, err := c.PostApiKeysWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PutSandboxesSandboxIDBandwidthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostApiKeysResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
-	rsp, err := c.PostApiKeys(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSandboxesSandboxIDBandwidthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostApiKeysResponse(rsp)
+	return 0
 }
 
-// DeleteApiKeysApiKeyIDWithResponse request returning *DeleteApiKeysApiKeyIDResponse
-func (c *ClientWithResponses) DeleteApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteApiKeysApiKeyIDResponse, error) {
-	rsp, err := c.DeleteApiKeysApiKeyID(ctx, apiKeyID, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutSandboxesSandboxIDBandwidthResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseDeleteApiKeysApiKeyIDResponse(rsp)
+	return ""
 }
 
-// PatchApiKeysApiKeyIDWithBodyWithResponse request with arbitrary body returning *PatchApiKeysApiKeyIDResponse
-func (c *ClientWithResponses) PatchApiKeysApiKeyIDWithBodyWithResponse(ctx context.Context, apiKeyID ApiKeyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error) {
-	rsp, err := c.PatchApiKeysApiKeyIDWithBody(ctx, apiKeyID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchApiKeysApiKeyIDResponse(rsp)
+type GetSandboxesSandboxIDCheckpointsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SandboxCheckpoint
+	JSON401      *N401
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PatchApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, body PatchApiKeysApiKeyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error) {
-	rsp, err := c.PatchApiKeysApiKeyID(ctx, apiKeyID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSandboxesSandboxIDCheckpointsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePatchApiKeysApiKeyIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxesSandboxIDCheckpointsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetHealthResponse(rsp)
+	return 0
 }
 
-// GetNodesWithResponse request returning *GetNodesResponse
-func (c *ClientWithResponses) GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error) {
-	rsp, err := c.GetNodes(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSandboxesSandboxIDCheckpointsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetNodesResponse(rsp)
+	return ""
 }
 
-// GetNodesNodeIDWithResponse request returning *GetNodesNodeIDResponse
-func (c *ClientWithResponses) GetNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, params *GetNodesNodeIDParams, reqEditors ...RequestEditorFn) (*GetNodesNodeIDResponse, error) {
-	rsp, err := c.GetNodesNodeID(ctx, nodeID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodesNodeIDResponse(rsp)
+type PostSandboxesSandboxIDCheckpointsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SandboxCheckpoint
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// PostNodesNodeIDWithBodyWithResponse request with arbitrary body returning *PostNodesNodeIDResponse
-func (c *ClientWithResponses) PostNodesNodeIDWithBodyWithResponse(ctx context.Context, nodeID NodeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error) {
-	rsp, err := c.PostNodesNodeIDWithBody(ctx, nodeID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDCheckpointsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostNodesNodeIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) PostNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, body PostNodesNodeIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error) {
-	rsp, err := c.PostNodesNodeID(ctx, nodeID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDCheckpointsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostNodesNodeIDResponse(rsp)
+	return 0
 }
 
-// GetSandboxesWithResponse request returning *GetSandboxesResponse
-func (c *ClientWithResponses) GetSandboxesWithResponse(ctx context.Context, params *GetSandboxesParams, reqEditors ...RequestEditorFn) (*GetSandboxesResponse, error) {
-	rsp, err := c.GetSandboxes(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDCheckpointsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetSandboxesResponse(rsp)
+	return ""
 }
 
-// PostSandboxesWithBodyWithResponse request with arbitrary body returning *PostSandboxesResponse
-func (c *ClientWithResponses) PostSandboxesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error) {
-	rsp, err := c.PostSandboxesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostSandboxesResponse(rsp)
+type DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostSandboxesWithResponse(ctx context.Context, body PostSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error) {
-	rsp, err := c.PostSandboxes(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostSandboxesResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetSandboxesMetricsWithResponse request returning *GetSandboxesMetricsResponse
-func (c *ClientWithResponses) GetSandboxesMetricsWithResponse(ctx context.Context, params *GetSandboxesMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesMetricsResponse, error) {
-	rsp, err := c.GetSandboxesMetrics(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetSandboxesMetricsResponse(rsp)
+	return 0
 }
 
-// DeleteSandboxesSandboxIDWithResponse request returning *DeleteSandboxesSandboxIDResponse
-func (c *ClientWithResponses) DeleteSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDResponse, error) {
-	rsp, err := c.DeleteSandboxesSandboxID(ctx, sandboxID, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseDeleteSandboxesSandboxIDResponse(rsp)
+	return ""
 }
 
-// GetSandboxesSandboxIDWithResponse request returning *GetSandboxesSandboxIDResponse
-func (c *ClientWithResponses) GetSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDResponse, error) {
-	rsp, err := c.GetSandboxesSandboxID(ctx, sandboxID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetSandboxesSandboxIDResponse(rsp)
+type PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Sandbox
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// PostSandboxesSandboxIDConnectWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDConnectResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDConnectWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDConnectWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostSandboxesSandboxIDConnectResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDConnectWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDConnect(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostSandboxesSandboxIDConnectResponse(rsp)
+	return 0
 }
 
-// PostSandboxesSandboxIDForkWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDForkResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDForkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDForkWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostSandboxesSandboxIDForkResponse(rsp)
+	return ""
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDForkWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDFork(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostSandboxesSandboxIDForkResponse(rsp)
+type PostSandboxesSandboxIDConnectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+	JSON201      *Sandbox
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// GetSandboxesSandboxIDLogsWithResponse request returning *GetSandboxesSandboxIDLogsResponse
-func (c *ClientWithResponses) GetSandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDLogsResponse, error) {
-	rsp, err := c.GetSandboxesSandboxIDLogs(ctx, sandboxID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDConnectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetSandboxesSandboxIDLogsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetSandboxesSandboxIDMetricsWithResponse request returning *GetSandboxesSandboxIDMetricsResponse
-func (c *ClientWithResponses) GetSandboxesSandboxIDMetricsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDMetricsResponse, error) {
-	rsp, err := c.GetSandboxesSandboxIDMetrics(ctx, sandboxID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDConnectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetSandboxesSandboxIDMetricsResponse(rsp)
+	return 0
 }
 
-// PutSandboxesSandboxIDNetworkWithBodyWithResponse request with arbitrary body returning *PutSandboxesSandboxIDNetworkResponse
-func (c *ClientWithResponses) PutSandboxesSandboxIDNetworkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error) {
-	rsp, err := c.PutSandboxesSandboxIDNetworkWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDConnectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePutSandboxesSandboxIDNetworkResponse(rsp)
+	return ""
 }
 
-func (c *ClientWithResponses) PutSandboxesSandboxIDNetworkWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error) {
-	rsp, err := c.PutSandboxesSandboxIDNetwork(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+type PostSandboxesSandboxIDControlPauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDControlPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePutSandboxesSandboxIDNetworkResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostSandboxesSandboxIDPauseWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDPauseResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDPauseWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDPauseWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDControlPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostSandboxesSandboxIDPauseResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDPauseWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDPause(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDControlPauseResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostSandboxesSandboxIDPauseResponse(rsp)
+	return ""
 }
 
-// PostSandboxesSandboxIDRefreshesWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDRefreshesResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDRefreshesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDRefreshesWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostSandboxesSandboxIDRefreshesResponse(rsp)
+type PostSandboxesSandboxIDControlSandboxesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Sandbox
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDRefreshesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDRefreshes(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDControlSandboxesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostSandboxesSandboxIDRefreshesResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostSandboxesSandboxIDResumeWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDResumeResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDResumeWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDResumeWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDControlSandboxesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostSandboxesSandboxIDResumeResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDResumeWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDResume(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDControlSandboxesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostSandboxesSandboxIDResumeResponse(rsp)
+	return ""
 }
 
-// PostSandboxesSandboxIDSnapshotsWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDSnapshotsResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDSnapshotsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDSnapshotsWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostSandboxesSandboxIDSnapshotsResponse(rsp)
+type PostSandboxesSandboxIDControlTimeoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDSnapshotsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDSnapshots(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDControlTimeoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostSandboxesSandboxIDSnapshotsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostSandboxesSandboxIDTimeoutWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDTimeoutResponse
-func (c *ClientWithResponses) PostSandboxesSandboxIDTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDTimeoutWithBody(ctx, sandboxID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDControlTimeoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostSandboxesSandboxIDTimeoutResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostSandboxesSandboxIDTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error) {
-	rsp, err := c.PostSandboxesSandboxIDTimeout(ctx, sandboxID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDControlTimeoutResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostSandboxesSandboxIDTimeoutResponse(rsp)
+	return ""
 }
 
-// GetSnapshotsWithResponse request returning *GetSnapshotsResponse
-func (c *ClientWithResponses) GetSnapshotsWithResponse(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*GetSnapshotsResponse, error) {
-	rsp, err := c.GetSnapshots(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetSnapshotsResponse(rsp)
+type PatchSandboxesSandboxIDEnvsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// GetTeamsWithResponse request returning *GetTeamsResponse
-func (c *ClientWithResponses) GetTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTeamsResponse, error) {
-	rsp, err := c.GetTeams(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchSandboxesSandboxIDEnvsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetTeamsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetTeamsTeamIDMetricsWithResponse request returning *GetTeamsTeamIDMetricsResponse
-func (c *ClientWithResponses) GetTeamsTeamIDMetricsWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsResponse, error) {
-	rsp, err := c.GetTeamsTeamIDMetrics(ctx, teamID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSandboxesSandboxIDEnvsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetTeamsTeamIDMetricsResponse(rsp)
+	return 0
 }
 
-// GetTeamsTeamIDMetricsMaxWithResponse request returning *GetTeamsTeamIDMetricsMaxResponse
-func (c *ClientWithResponses) GetTeamsTeamIDMetricsMaxWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsMaxResponse, error) {
-	rsp, err := c.GetTeamsTeamIDMetricsMax(ctx, teamID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchSandboxesSandboxIDEnvsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetTeamsTeamIDMetricsMaxResponse(rsp)
+	return ""
 }
 
-// GetTemplatesWithResponse request returning *GetTemplatesResponse
-func (c *ClientWithResponses) GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error) {
-	rsp, err := c.GetTemplates(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTemplatesResponse(rsp)
+type PostSandboxesSandboxIDForkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *[]SandboxForkResult
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// PostTemplatesWithBodyWithResponse request with arbitrary body returning *PostTemplatesResponse
-func (c *ClientWithResponses) PostTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error) {
-	rsp, err := c.PostTemplatesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDForkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostTemplatesResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) PostTemplatesWithResponse(ctx context.Context, body PostTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error) {
-	rsp, err := c.PostTemplates(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDForkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostTemplatesResponse(rsp)
+	return 0
 }
 
-// GetTemplatesAliasesAliasWithResponse request returning *GetTemplatesAliasesAliasResponse
-func (c *ClientWithResponses) GetTemplatesAliasesAliasWithResponse(ctx context.Context, alias string, reqEditors ...RequestEditorFn) (*GetTemplatesAliasesAliasResponse, error) {
-	rsp, err := c.GetTemplatesAliasesAlias(ctx, alias, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDForkResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetTemplatesAliasesAliasResponse(rsp)
+	return ""
 }
 
-// DeleteTemplatesTagsWithBodyWithResponse request with arbitrary body returning *DeleteTemplatesTagsResponse
-func (c *ClientWithResponses) DeleteTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error) {
-	rsp, err := c.DeleteTemplatesTagsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteTemplatesTagsResponse(rsp)
+type GetSandboxesSandboxIDLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SandboxLogs
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) DeleteTemplatesTagsWithResponse(ctx context.Context, body DeleteTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error) {
-	rsp, err := c.DeleteTemplatesTags(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSandboxesSandboxIDLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseDeleteTemplatesTagsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostTemplatesTagsWithBodyWithResponse request with arbitrary body returning *PostTemplatesTagsResponse
-func (c *ClientWithResponses) PostTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error) {
-	rsp, err := c.PostTemplatesTagsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxesSandboxIDLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostTemplatesTagsResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostTemplatesTagsWithResponse(ctx context.Context, body PostTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error) {
-	rsp, err := c.PostTemplatesTags(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSandboxesSandboxIDLogsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostTemplatesTagsResponse(rsp)
+	return ""
 }
 
-// DeleteTemplatesTemplateIDWithResponse request returning *DeleteTemplatesTemplateIDResponse
-func (c *ClientWithResponses) DeleteTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteTemplatesTemplateIDResponse, error) {
-	rsp, err := c.DeleteTemplatesTemplateID(ctx, templateID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteTemplatesTemplateIDResponse(rsp)
+type GetSandboxesSandboxIDMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SandboxMetric
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// GetTemplatesTemplateIDWithResponse request returning *GetTemplatesTemplateIDResponse
-func (c *ClientWithResponses) GetTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, params *GetTemplatesTemplateIDParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDResponse, error) {
-	rsp, err := c.GetTemplatesTemplateID(ctx, templateID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSandboxesSandboxIDMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetTemplatesTemplateIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PatchTemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PatchTemplatesTemplateIDResponse
-func (c *ClientWithResponses) PatchTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error) {
-	rsp, err := c.PatchTemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxesSandboxIDMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePatchTemplatesTemplateIDResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PatchTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error) {
-	rsp, err := c.PatchTemplatesTemplateID(ctx, templateID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSandboxesSandboxIDMetricsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePatchTemplatesTemplateIDResponse(rsp)
+	return ""
 }
 
-// PostTemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PostTemplatesTemplateIDResponse
-func (c *ClientWithResponses) PostTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error) {
-	rsp, err := c.PostTemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostTemplatesTemplateIDResponse(rsp)
+type PutSandboxesSandboxIDNetworkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error) {
-	rsp, err := c.PostTemplatesTemplateID(ctx, templateID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PutSandboxesSandboxIDNetworkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostTemplatesTemplateIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostTemplatesTemplateIDBuildsBuildIDWithResponse request returning *PostTemplatesTemplateIDBuildsBuildIDResponse
-func (c *ClientWithResponses) PostTemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error) {
-	rsp, err := c.PostTemplatesTemplateIDBuildsBuildID(ctx, templateID, buildID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSandboxesSandboxIDNetworkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostTemplatesTemplateIDBuildsBuildIDResponse(rsp)
+	return 0
 }
 
-// GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse request returning *GetTemplatesTemplateIDBuildsBuildIDLogsResponse
-func (c *ClientWithResponses) GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error) {
-	rsp, err := c.GetTemplatesTemplateIDBuildsBuildIDLogs(ctx, templateID, buildID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutSandboxesSandboxIDNetworkResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse(rsp)
+	return ""
 }
 
-// GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse request returning *GetTemplatesTemplateIDBuildsBuildIDStatusResponse
-func (c *ClientWithResponses) GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error) {
-	rsp, err := c.GetTemplatesTemplateIDBuildsBuildIDStatus(ctx, templateID, buildID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse(rsp)
+type GetSandboxesSandboxIDNetworkLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SandboxNetworkLogsResponse
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// GetTemplatesTemplateIDFilesHashWithResponse request returning *GetTemplatesTemplateIDFilesHashResponse
-func (c *ClientWithResponses) GetTemplatesTemplateIDFilesHashWithResponse(ctx context.Context, templateID TemplateID, hash string, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDFilesHashResponse, error) {
-	rsp, err := c.GetTemplatesTemplateIDFilesHash(ctx, templateID, hash, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSandboxesSandboxIDNetworkLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetTemplatesTemplateIDFilesHashResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetTemplatesTemplateIDTagsWithResponse request returning *GetTemplatesTemplateIDTagsResponse
-func (c *ClientWithResponses) GetTemplatesTemplateIDTagsWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDTagsResponse, error) {
-	rsp, err := c.GetTemplatesTemplateIDTags(ctx, templateID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxesSandboxIDNetworkLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetTemplatesTemplateIDTagsResponse(rsp)
+	return 0
 }
 
-// GetV2SandboxesWithResponse request returning *GetV2SandboxesResponse
-func (c *ClientWithResponses) GetV2SandboxesWithResponse(ctx context.Context, params *GetV2SandboxesParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesResponse, error) {
-	rsp, err := c.GetV2Sandboxes(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSandboxesSandboxIDNetworkLogsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseGetV2SandboxesResponse(rsp)
+	return ""
 }
 
-// GetV2SandboxesSandboxIDLogsWithResponse request returning *GetV2SandboxesSandboxIDLogsResponse
-func (c *ClientWithResponses) GetV2SandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesSandboxIDLogsResponse, error) {
-	rsp, err := c.GetV2SandboxesSandboxIDLogs(ctx, sandboxID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetV2SandboxesSandboxIDLogsResponse(rsp)
+type PostSandboxesSandboxIDPauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// GetV2TemplatesWithResponse request returning *GetV2TemplatesResponse
-func (c *ClientWithResponses) GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error) {
-	rsp, err := c.GetV2Templates(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetV2TemplatesResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostV2TemplatesWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesResponse
-func (c *ClientWithResponses) PostV2TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
-	rsp, err := c.PostV2TemplatesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostV2TemplatesResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostV2TemplatesWithResponse(ctx context.Context, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
-	rsp, err := c.PostV2Templates(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDPauseResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostV2TemplatesResponse(rsp)
+	return ""
 }
 
-// PatchV2TemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PatchV2TemplatesTemplateIDResponse
-func (c *ClientWithResponses) PatchV2TemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error) {
-	rsp, err := c.PatchV2TemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchV2TemplatesTemplateIDResponse(rsp)
+type PostSandboxesSandboxIDRefreshesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
 }
 
-func (c *ClientWithResponses) PatchV2TemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error) {
-	rsp, err := c.PatchV2TemplatesTemplateID(ctx, templateID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDRefreshesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePatchV2TemplatesTemplateIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesTemplateIDBuildsBuildIDResponse
-func (c *ClientWithResponses) PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
-	rsp, err := c.PostV2TemplatesTemplateIDBuildsBuildIDWithBody(ctx, templateID, buildID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDRefreshesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
-	rsp, err := c.PostV2TemplatesTemplateIDBuildsBuildID(ctx, templateID, buildID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDRefreshesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp)
+	return ""
 }
 
-// PostV3TemplatesWithBodyWithResponse request with arbitrary body returning *PostV3TemplatesResponse
-func (c *ClientWithResponses) PostV3TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error) {
-	rsp, err := c.PostV3TemplatesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostV3TemplatesResponse(rsp)
+type PatchSandboxesSandboxIDResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-func (c *ClientWithResponses) PostV3TemplatesWithResponse(ctx context.Context, body PostV3TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error) {
-	rsp, err := c.PostV3Templates(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchSandboxesSandboxIDResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePostV3TemplatesResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetVolumesWithResponse request returning *GetVolumesResponse
-func (c *ClientWithResponses) GetVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVolumesResponse, error) {
-	rsp, err := c.GetVolumes(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSandboxesSandboxIDResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetVolumesResponse(rsp)
+	return 0
 }
 
-// PostVolumesWithBodyWithResponse request with arbitrary body returning *PostVolumesResponse
-func (c *ClientWithResponses) PostVolumesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error) {
-	rsp, err := c.PostVolumesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchSandboxesSandboxIDResourcesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePostVolumesResponse(rsp)
+	return ""
 }
 
-func (c *ClientWithResponses) PostVolumesWithResponse(ctx context.Context, body PostVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error) {
-	rsp, err := c.PostVolumes(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostVolumesResponse(rsp)
+type PostSandboxesSandboxIDResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Sandbox
+	JSON401      *N401
+	JSON404      *N404
+	JSON409      *N409
+	JSON500      *N500
 }
 
-// DeleteVolumesVolumeIDWithResponse request returning *DeleteVolumesVolumeIDResponse
-func (c *ClientWithResponses) DeleteVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*DeleteVolumesVolumeIDResponse, error) {
-	rsp, err := c.DeleteVolumesVolumeID(ctx, volumeID, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseDeleteVolumesVolumeIDResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetVolumesVolumeIDWithResponse request returning *GetVolumesVolumeIDResponse
-func (c *ClientWithResponses) GetVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*GetVolumesVolumeIDResponse, error) {
-	rsp, err := c.GetVolumesVolumeID(ctx, volumeID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetVolumesVolumeIDResponse(rsp)
+	return 0
 }
 
-// ParsePostAccessTokensResponse parses an HTTP response from a PostAccessTokensWithResponse call
-func ParsePostAccessTokensResponse(rsp *http.Response) (*PostAccessTokensResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &PostAccessTokensResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDResumeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatedAccessToken
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
-		var dest N410
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON410 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostSandboxesSandboxIDSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SnapshotInfo
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-
-	return response, nil
+	return http.StatusText(0)
 }
 
-// ParseDeleteAccessTokensAccessTokenIDResponse parses an HTTP response from a DeleteAccessTokensAccessTokenIDWithResponse call
-func ParseDeleteAccessTokensAccessTokenIDResponse(rsp *http.Response) (*DeleteAccessTokensAccessTokenIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	response := &DeleteAccessTokensAccessTokenIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDSnapshotsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostSandboxesSandboxIDTimeoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r PostSandboxesSandboxIDTimeoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSandboxesSandboxIDTimeoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostSandboxesSandboxIDTimeoutResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TeamSecret
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParsePostAdminTeamsTeamIDApiKeysResponse parses an HTTP response from a PostAdminTeamsTeamIDApiKeysWithResponse call
-func ParsePostAdminTeamsTeamIDApiKeysResponse(rsp *http.Response) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostAdminTeamsTeamIDApiKeysResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatedTeamAPIKey
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSecretsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+type DeleteSecretsSecretNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r DeleteSecretsSecretNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSecretsSecretNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteSecretsSecretNameResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PutSecretsSecretNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamSecret
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse parses an HTTP response from a DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse call
-func ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse(rsp *http.Response) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PutSecretsSecretNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSecretsSecretNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutSecretsSecretNameResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SnapshotInfo
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r GetSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSnapshotsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Team
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParsePostAdminTeamsTeamIDBuildsCancelResponse parses an HTTP response from a PostAdminTeamsTeamIDBuildsCancelWithResponse call
-func ParsePostAdminTeamsTeamIDBuildsCancelResponse(rsp *http.Response) (*PostAdminTeamsTeamIDBuildsCancelResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostAdminTeamsTeamIDBuildsCancelResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AdminBuildCancelResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTeamsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetTeamsTeamIDMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TeamMetric
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r GetTeamsTeamIDMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamsTeamIDMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTeamsTeamIDMetricsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetTeamsTeamIDMetricsMaxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaxTeamMetric
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
 }
 
-// ParsePostAdminTeamsTeamIDSandboxesKillResponse parses an HTTP response from a PostAdminTeamsTeamIDSandboxesKillWithResponse call
-func ParsePostAdminTeamsTeamIDSandboxesKillResponse(rsp *http.Response) (*PostAdminTeamsTeamIDSandboxesKillResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetTeamsTeamIDMetricsMaxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostAdminTeamsTeamIDSandboxesKillResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamsTeamIDMetricsMaxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AdminSandboxKillResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTeamsTeamIDMetricsMaxResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Template
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r GetTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *TemplateLegacy
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseGetApiKeysResponse parses an HTTP response from a GetApiKeysWithResponse call
-func ParseGetApiKeysResponse(rsp *http.Response) (*GetApiKeysResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetApiKeysResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TeamAPIKey
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostTemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetTemplatesAliasesAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateAliasResponse
+	JSON400      *N400
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParsePostApiKeysResponse parses an HTTP response from a PostApiKeysWithResponse call
-func ParsePostApiKeysResponse(rsp *http.Response) (*PostApiKeysResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetTemplatesAliasesAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostApiKeysResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesAliasesAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatedTeamAPIKey
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesAliasesAliasResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type DeleteTemplatesTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParseDeleteApiKeysApiKeyIDResponse parses an HTTP response from a DeleteApiKeysApiKeyIDWithResponse call
-func ParseDeleteApiKeysApiKeyIDResponse(rsp *http.Response) (*DeleteApiKeysApiKeyIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteTemplatesTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteApiKeysApiKeyIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTemplatesTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteTemplatesTagsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostTemplatesTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AssignedTemplateTags
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParsePatchApiKeysApiKeyIDResponse parses an HTTP response from a PatchApiKeysApiKeyIDWithResponse call
-func ParsePatchApiKeysApiKeyIDResponse(rsp *http.Response) (*PatchApiKeysApiKeyIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostTemplatesTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PatchApiKeysApiKeyIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTemplatesTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostTemplatesTagsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type DeleteTemplatesTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteTemplatesTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTemplatesTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteTemplatesTemplateIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-
-	return response, nil
+	return ""
 }
 
-// ParseGetNodesResponse parses an HTTP response from a GetNodesWithResponse call
-func ParseGetNodesResponse(rsp *http.Response) (*GetNodesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetTemplatesTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateWithBuilds
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	response := &GetNodesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// Status returns HTTPResponse.Status
+func (r GetTemplatesTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Node
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesTemplateIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PatchTemplatesTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseGetNodesNodeIDResponse parses an HTTP response from a GetNodesNodeIDWithResponse call
-func ParseGetNodesNodeIDResponse(rsp *http.Response) (*GetNodesNodeIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchTemplatesTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetNodesNodeIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchTemplatesTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeDetail
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchTemplatesTemplateIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostTemplatesTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *TemplateLegacy
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r PostTemplatesTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTemplatesTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostTemplatesTemplateIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostTemplatesTemplateIDBuildsBuildIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParsePostNodesNodeIDResponse parses an HTTP response from a PostNodesNodeIDWithResponse call
-func ParsePostNodesNodeIDResponse(rsp *http.Response) (*PostNodesNodeIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostTemplatesTemplateIDBuildsBuildIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostNodesNodeIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostTemplatesTemplateIDBuildsBuildIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type GetTemplatesTemplateIDBuildsBuildIDLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateBuildLogsResponse
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesTemplateIDBuildsBuildIDLogsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
 }
 
-// ParseGetSandboxesResponse parses an HTTP response from a GetSandboxesWithResponse call
-func ParseGetSandboxesResponse(rsp *http.Response) (*GetSandboxesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+type GetTemplatesTemplateIDBuildsBuildIDStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateBuildInfo
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetSandboxesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ListedSandbox
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesTemplateIDBuildsBuildIDStatusResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetTemplatesTemplateIDFilesHashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TemplateBuildFileUpload
+	JSON400      *N400
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// Status returns HTTPResponse.Status
+func (r GetTemplatesTemplateIDFilesHashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesTemplateIDFilesHashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesTemplateIDFilesHashResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetTemplatesTemplateIDTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TemplateTag
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParsePostSandboxesResponse parses an HTTP response from a PostSandboxesWithResponse call
-func ParsePostSandboxesResponse(rsp *http.Response) (*PostSandboxesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetTemplatesTemplateIDTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostSandboxesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesTemplateIDTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Sandbox
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTemplatesTemplateIDTagsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetV2SandboxesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ListedSandbox
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// Status returns HTTPResponse.Status
+func (r GetV2SandboxesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2SandboxesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV2SandboxesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetV2SandboxesSandboxIDLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SandboxLogsV2Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParseGetSandboxesMetricsResponse parses an HTTP response from a GetSandboxesMetricsWithResponse call
-func ParseGetSandboxesMetricsResponse(rsp *http.Response) (*GetSandboxesMetricsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetV2SandboxesSandboxIDLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetSandboxesMetricsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2SandboxesSandboxIDLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SandboxesWithMetrics
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV2SandboxesSandboxIDLogsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetV2TemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Template
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// Status returns HTTPResponse.Status
+func (r GetV2TemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV2TemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV2TemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostV2TemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *TemplateLegacy
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseDeleteSandboxesSandboxIDResponse parses an HTTP response from a DeleteSandboxesSandboxIDWithResponse call
-func ParseDeleteSandboxesSandboxIDResponse(rsp *http.Response) (*DeleteSandboxesSandboxIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteSandboxesSandboxIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV2TemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PatchV2TemplatesTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplateUpdateResponse
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseGetSandboxesSandboxIDResponse parses an HTTP response from a GetSandboxesSandboxIDWithResponse call
-func ParseGetSandboxesSandboxIDResponse(rsp *http.Response) (*GetSandboxesSandboxIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchV2TemplatesTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetSandboxesSandboxIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV2TemplatesTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SandboxDetail
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchV2TemplatesTemplateIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type PostV2TemplatesTemplateIDBuildsBuildIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV2TemplatesTemplateIDBuildsBuildIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostV3TemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *TemplateRequestResponseV3
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
 }
 
-// ParsePostSandboxesSandboxIDConnectResponse parses an HTTP response from a PostSandboxesSandboxIDConnectWithResponse call
-func ParsePostSandboxesSandboxIDConnectResponse(rsp *http.Response) (*PostSandboxesSandboxIDConnectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostV3TemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostSandboxesSandboxIDConnectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV3TemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Sandbox
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Sandbox
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV3TemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetVolumesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Volume
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r GetVolumesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVolumesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetVolumesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostVolumesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VolumeAndToken
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParsePostSandboxesSandboxIDForkResponse parses an HTTP response from a PostSandboxesSandboxIDForkWithResponse call
-func ParsePostSandboxesSandboxIDForkResponse(rsp *http.Response) (*PostSandboxesSandboxIDForkResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostVolumesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PostSandboxesSandboxIDForkResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostVolumesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest []SandboxForkResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostVolumesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type DeleteVolumesVolumeIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+// Status returns HTTPResponse.Status
+func (r DeleteVolumesVolumeIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVolumesVolumeIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteVolumesVolumeIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type GetVolumesVolumeIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VolumeAndToken
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParseGetSandboxesSandboxIDLogsResponse parses an HTTP response from a GetSandboxesSandboxIDLogsWithResponse call
-func ParseGetSandboxesSandboxIDLogsResponse(rsp *http.Response) (*GetSandboxesSandboxIDLogsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetVolumesVolumeIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetSandboxesSandboxIDLogsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVolumesVolumeIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SandboxLogs
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetVolumesVolumeIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type GetWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+	JSON401      *N401
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetWebhooksResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedWebhook
+	JSON400      *N400
+	JSON401      *N401
+	JSON500      *N500
 }
 
-// ParseGetSandboxesSandboxIDMetricsResponse parses an HTTP response from a GetSandboxesSandboxIDMetricsWithResponse call
-func ParseGetSandboxesSandboxIDMetricsResponse(rsp *http.Response) (*GetSandboxesSandboxIDMetricsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetSandboxesSandboxIDMetricsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SandboxMetric
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostWebhooksResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type DeleteWebhooksWebhookIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksWebhookIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksWebhookIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteWebhooksWebhookIDResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
+	return ""
+}
 
-	return response, nil
+type PostWebhooksWebhookIDTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookTestResult
+	JSON401      *N401
+	JSON404      *N404
+	JSON500      *N500
 }
 
-// ParsePutSandboxesSandboxIDNetworkResponse parses an HTTP response from a PutSandboxesSandboxIDNetworkWithResponse call
-func ParsePutSandboxesSandboxIDNetworkResponse(rsp *http.Response) (*PutSandboxesSandboxIDNetworkResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostWebhooksWebhookIDTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PutSandboxesSandboxIDNetworkResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksWebhookIDTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostWebhooksWebhookIDTestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
 }
 
-// ParsePostSandboxesSandboxIDPauseResponse parses an HTTP response from a PostSandboxesSandboxIDPauseWithResponse call
-func ParsePostSandboxesSandboxIDPauseResponse(rsp *http.Response) (*PostSandboxesSandboxIDPauseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
 
-	response := &PostSandboxesSandboxIDPauseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetWellKnownOpenidConfigurationWithResponse request returning *GetWellKnownOpenidConfigurationResponse
+func (c *ClientWithResponses) GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error) {
+	rsp, err := c.GetWellKnownOpenidConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetWellKnownOpenidConfigurationResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// PostAccessTokensWithBodyWithResponse request with arbitrary body returning *PostAccessTokensResponse
+func (c *ClientWithResponses) PostAccessTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error) {
+	rsp, err := c.PostAccessTokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePostAccessTokensResponse(rsp)
 }
 
-// ParsePostSandboxesSandboxIDRefreshesResponse parses an HTTP response from a PostSandboxesSandboxIDRefreshesWithResponse call
-func ParsePostSandboxesSandboxIDRefreshesResponse(rsp *http.Response) (*PostSandboxesSandboxIDRefreshesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PostAccessTokensWithResponse(ctx context.Context, body PostAccessTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccessTokensResponse, error) {
+	rsp, err := c.PostAccessTokens(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostAccessTokensResponse(rsp)
+}
 
-	response := &PostSandboxesSandboxIDRefreshesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DeleteAccessTokensAccessTokenIDWithResponse request returning *DeleteAccessTokensAccessTokenIDResponse
+func (c *ClientWithResponses) DeleteAccessTokensAccessTokenIDWithResponse(ctx context.Context, accessTokenID AccessTokenID, reqEditors ...RequestEditorFn) (*DeleteAccessTokensAccessTokenIDResponse, error) {
+	rsp, err := c.DeleteAccessTokensAccessTokenID(ctx, accessTokenID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteAccessTokensAccessTokenIDResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
+// PostAdminTeamsTeamIDApiKeysWithBodyWithResponse request with arbitrary body returning *PostAdminTeamsTeamIDApiKeysResponse
+func (c *ClientWithResponses) PostAdminTeamsTeamIDApiKeysWithBodyWithResponse(ctx context.Context, teamID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
+	rsp, err := c.PostAdminTeamsTeamIDApiKeysWithBody(ctx, teamID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePostAdminTeamsTeamIDApiKeysResponse(rsp)
 }
 
-// ParsePostSandboxesSandboxIDResumeResponse parses an HTTP response from a PostSandboxesSandboxIDResumeWithResponse call
-func ParsePostSandboxesSandboxIDResumeResponse(rsp *http.Response) (*PostSandboxesSandboxIDResumeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PostAdminTeamsTeamIDApiKeysWithResponse(ctx context.Context, teamID openapi_types.UUID, body PostAdminTeamsTeamIDApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
+	rsp, err := c.PostAdminTeamsTeamIDApiKeys(ctx, teamID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostAdminTeamsTeamIDApiKeysResponse(rsp)
+}
 
-	response := &PostSandboxesSandboxIDResumeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse request returning *DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse
+func (c *ClientWithResponses) DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse(ctx context.Context, teamID openapi_types.UUID, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error) {
+	rsp, err := c.DeleteAdminTeamsTeamIDApiKeysApiKeyID(ctx, teamID, apiKeyID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Sandbox
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+// PostAdminTeamsTeamIDBuildsCancelWithResponse request returning *PostAdminTeamsTeamIDBuildsCancelResponse
+func (c *ClientWithResponses) PostAdminTeamsTeamIDBuildsCancelWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDBuildsCancelResponse, error) {
+	rsp, err := c.PostAdminTeamsTeamIDBuildsCancel(ctx, teamID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminTeamsTeamIDBuildsCancelResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// PostAdminTeamsTeamIDSandboxesKillWithResponse request returning *PostAdminTeamsTeamIDSandboxesKillResponse
+func (c *ClientWithResponses) PostAdminTeamsTeamIDSandboxesKillWithResponse(ctx context.Context, teamID openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAdminTeamsTeamIDSandboxesKillResponse, error) {
+	rsp, err := c.PostAdminTeamsTeamIDSandboxesKill(ctx, teamID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminTeamsTeamIDSandboxesKillResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetApiKeysWithResponse request returning *GetApiKeysResponse
+func (c *ClientWithResponses) GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error) {
+	rsp, err := c.GetApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeysResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+// PostApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiKeysResponse
+func (c *ClientWithResponses) PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (c *ClientWithResponses) PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
 
+// DeleteApiKeysApiKeyIDWithResponse request returning *DeleteApiKeysApiKeyIDResponse
+func (c *ClientWithResponses) DeleteApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteApiKeysApiKeyIDResponse, error) {
+	rsp, err := c.DeleteApiKeysApiKeyID(ctx, apiKeyID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteApiKeysApiKeyIDResponse(rsp)
+}
 
-	return response, nil
+// PatchApiKeysApiKeyIDWithBodyWithResponse request with arbitrary body returning *PatchApiKeysApiKeyIDResponse
+func (c *ClientWithResponses) PatchApiKeysApiKeyIDWithBodyWithResponse(ctx context.Context, apiKeyID ApiKeyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error) {
+	rsp, err := c.PatchApiKeysApiKeyIDWithBody(ctx, apiKeyID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiKeysApiKeyIDResponse(rsp)
 }
 
-// ParsePostSandboxesSandboxIDSnapshotsResponse parses an HTTP response from a PostSandboxesSandboxIDSnapshotsWithResponse call
-func ParsePostSandboxesSandboxIDSnapshotsResponse(rsp *http.Response) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PatchApiKeysApiKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, body PatchApiKeysApiKeyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiKeysApiKeyIDResponse, error) {
+	rsp, err := c.PatchApiKeysApiKeyID(ctx, apiKeyID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchApiKeysApiKeyIDResponse(rsp)
+}
 
-	response := &PostSandboxesSandboxIDSnapshotsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetHealthResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SnapshotInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetNodesWithResponse request returning *GetNodesResponse
+func (c *ClientWithResponses) GetNodesWithResponse(ctx context.Context, params *GetNodesParams, reqEditors ...RequestEditorFn) (*GetNodesResponse, error) {
+	rsp, err := c.GetNodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodesResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetNodesNodeIDWithResponse request returning *GetNodesNodeIDResponse
+func (c *ClientWithResponses) GetNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, params *GetNodesNodeIDParams, reqEditors ...RequestEditorFn) (*GetNodesNodeIDResponse, error) {
+	rsp, err := c.GetNodesNodeID(ctx, nodeID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodesNodeIDResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostNodesNodeIDWithBodyWithResponse request with arbitrary body returning *PostNodesNodeIDResponse
+func (c *ClientWithResponses) PostNodesNodeIDWithBodyWithResponse(ctx context.Context, nodeID NodeID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error) {
+	rsp, err := c.PostNodesNodeIDWithBody(ctx, nodeID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNodesNodeIDResponse(rsp)
+}
 
+func (c *ClientWithResponses) PostNodesNodeIDWithResponse(ctx context.Context, nodeID NodeID, body PostNodesNodeIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNodesNodeIDResponse, error) {
+	rsp, err := c.PostNodesNodeID(ctx, nodeID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostNodesNodeIDResponse(rsp)
+}
 
-	return response, nil
+// GetSandboxesWithResponse request returning *GetSandboxesResponse
+func (c *ClientWithResponses) GetSandboxesWithResponse(ctx context.Context, params *GetSandboxesParams, reqEditors ...RequestEditorFn) (*GetSandboxesResponse, error) {
+	rsp, err := c.GetSandboxes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSandboxesResponse(rsp)
 }
 
-// ParsePostSandboxesSandboxIDTimeoutResponse parses an HTTP response from a PostSandboxesSandboxIDTimeoutWithResponse call
-func ParsePostSandboxesSandboxIDTimeoutResponse(rsp *http.Response) (*PostSandboxesSandboxIDTimeoutResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostSandboxesWithBodyWithResponse request with arbitrary body returning *PostSandboxesResponse
+func (c *ClientWithResponses) PostSandboxesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error) {
+	rsp, err := c.PostSandboxesWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostSandboxesResponse(rsp)
+}
 
-	response := &PostSandboxesSandboxIDTimeoutResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) PostSandboxesWithResponse(ctx context.Context, body PostSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesResponse, error) {
+	rsp, err := c.PostSandboxes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetSandboxesMetricsWithResponse request returning *GetSandboxesMetricsResponse
+func (c *ClientWithResponses) GetSandboxesMetricsWithResponse(ctx context.Context, params *GetSandboxesMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesMetricsResponse, error) {
+	rsp, err := c.GetSandboxesMetrics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSandboxesMetricsResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// DeleteSandboxesSandboxIDWithResponse request returning *DeleteSandboxesSandboxIDResponse
+func (c *ClientWithResponses) DeleteSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDResponse, error) {
+	rsp, err := c.DeleteSandboxesSandboxID(ctx, sandboxID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSandboxesSandboxIDResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetSandboxesSandboxIDWithResponse request returning *GetSandboxesSandboxIDResponse
+func (c *ClientWithResponses) GetSandboxesSandboxIDWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDResponse, error) {
+	rsp, err := c.GetSandboxesSandboxID(ctx, sandboxID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSandboxesSandboxIDResponse(rsp)
+}
 
+// PutSandboxesSandboxIDBandwidthWithBodyWithResponse request with arbitrary body returning *PutSandboxesSandboxIDBandwidthResponse
+func (c *ClientWithResponses) PutSandboxesSandboxIDBandwidthWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDBandwidthResponse, error) {
+	rsp, err := c.PutSandboxesSandboxIDBandwidthWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePutSandboxesSandboxIDBandwidthResponse(rsp)
+}
 
-	return response, nil
+func (c *ClientWithResponses) PutSandboxesSandboxIDBandwidthWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDBandwidthJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDBandwidthResponse, error) {
+	rsp, err := c.PutSandboxesSandboxIDBandwidth(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSandboxesSandboxIDBandwidthResponse(rsp)
 }
 
-// ParseGetSnapshotsResponse parses an HTTP response from a GetSnapshotsWithResponse call
-func ParseGetSnapshotsResponse(rsp *http.Response) (*GetSnapshotsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSandboxesSandboxIDCheckpointsWithResponse request returning *GetSandboxesSandboxIDCheckpointsResponse
+func (c *ClientWithResponses) GetSandboxesSandboxIDCheckpointsWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDCheckpointsResponse, error) {
+	rsp, err := c.GetSandboxesSandboxIDCheckpoints(ctx, sandboxID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetSandboxesSandboxIDCheckpointsResponse(rsp)
+}
 
-	response := &GetSnapshotsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostSandboxesSandboxIDCheckpointsWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDCheckpointsResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDCheckpointsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDCheckpointsWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDCheckpointsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SnapshotInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+func (c *ClientWithResponses) PostSandboxesSandboxIDCheckpointsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDCheckpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDCheckpoints(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDCheckpointsResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse request returning *DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse
+func (c *ClientWithResponses) DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, reqEditors ...RequestEditorFn) (*DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse, error) {
+	rsp, err := c.DeleteSandboxesSandboxIDCheckpointsCheckpointID(ctx, sandboxID, checkpointID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithBody(ctx, sandboxID, checkpointID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse(rsp)
+}
 
+func (c *ClientWithResponses) PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithResponse(ctx context.Context, sandboxID SandboxID, checkpointID CheckpointID, body PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(ctx, sandboxID, checkpointID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse(rsp)
+}
 
-	return response, nil
+// PostSandboxesSandboxIDConnectWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDConnectResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDConnectWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDConnectWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDConnectResponse(rsp)
 }
 
-// ParseGetTeamsResponse parses an HTTP response from a GetTeamsWithResponse call
-func ParseGetTeamsResponse(rsp *http.Response) (*GetTeamsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PostSandboxesSandboxIDConnectWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDConnectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDConnectResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDConnect(ctx, sandboxID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDConnectResponse(rsp)
+}
 
-	response := &GetTeamsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostSandboxesSandboxIDControlPauseWithResponse request returning *PostSandboxesSandboxIDControlPauseResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDControlPauseWithResponse(ctx context.Context, sandboxID SandboxID, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlPauseResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDControlPause(ctx, sandboxID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDControlPauseResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Team
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// PostSandboxesSandboxIDControlSandboxesWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDControlSandboxesResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDControlSandboxesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlSandboxesResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDControlSandboxesWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDControlSandboxesResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+func (c *ClientWithResponses) PostSandboxesSandboxIDControlSandboxesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlSandboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlSandboxesResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDControlSandboxes(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDControlSandboxesResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostSandboxesSandboxIDControlTimeoutWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDControlTimeoutResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDControlTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlTimeoutResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDControlTimeoutWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDControlTimeoutResponse(rsp)
+}
 
+func (c *ClientWithResponses) PostSandboxesSandboxIDControlTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDControlTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDControlTimeoutResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDControlTimeout(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDControlTimeoutResponse(rsp)
+}
 
-	return response, nil
+// PatchSandboxesSandboxIDEnvsWithBodyWithResponse request with arbitrary body returning *PatchSandboxesSandboxIDEnvsResponse
+func (c *ClientWithResponses) PatchSandboxesSandboxIDEnvsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDEnvsResponse, error) {
+	rsp, err := c.PatchSandboxesSandboxIDEnvsWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSandboxesSandboxIDEnvsResponse(rsp)
 }
 
-// ParseGetTeamsTeamIDMetricsResponse parses an HTTP response from a GetTeamsTeamIDMetricsWithResponse call
-func ParseGetTeamsTeamIDMetricsResponse(rsp *http.Response) (*GetTeamsTeamIDMetricsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PatchSandboxesSandboxIDEnvsWithResponse(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDEnvsResponse, error) {
+	rsp, err := c.PatchSandboxesSandboxIDEnvs(ctx, sandboxID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchSandboxesSandboxIDEnvsResponse(rsp)
+}
 
-	response := &GetTeamsTeamIDMetricsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostSandboxesSandboxIDForkWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDForkResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDForkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDForkWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDForkResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TeamMetric
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) PostSandboxesSandboxIDForkWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDForkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDForkResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDFork(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePostSandboxesSandboxIDForkResponse(rsp)
 }
 
-// ParseGetTeamsTeamIDMetricsMaxResponse parses an HTTP response from a GetTeamsTeamIDMetricsMaxWithResponse call
-func ParseGetTeamsTeamIDMetricsMaxResponse(rsp *http.Response) (*GetTeamsTeamIDMetricsMaxResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSandboxesSandboxIDLogsWithResponse request returning *GetSandboxesSandboxIDLogsResponse
+func (c *ClientWithResponses) GetSandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDLogsResponse, error) {
+	rsp, err := c.GetSandboxesSandboxIDLogs(ctx, sandboxID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetSandboxesSandboxIDLogsResponse(rsp)
+}
 
-	response := &GetTeamsTeamIDMetricsMaxResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetSandboxesSandboxIDMetricsWithResponse request returning *GetSandboxesSandboxIDMetricsResponse
+func (c *ClientWithResponses) GetSandboxesSandboxIDMetricsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDMetricsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDMetricsResponse, error) {
+	rsp, err := c.GetSandboxesSandboxIDMetrics(ctx, sandboxID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetSandboxesSandboxIDMetricsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MaxTeamMetric
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// PutSandboxesSandboxIDNetworkWithBodyWithResponse request with arbitrary body returning *PutSandboxesSandboxIDNetworkResponse
+func (c *ClientWithResponses) PutSandboxesSandboxIDNetworkWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error) {
+	rsp, err := c.PutSandboxesSandboxIDNetworkWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePutSandboxesSandboxIDNetworkResponse(rsp)
 }
 
-// ParseGetTemplatesResponse parses an HTTP response from a GetTemplatesWithResponse call
-func ParseGetTemplatesResponse(rsp *http.Response) (*GetTemplatesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PutSandboxesSandboxIDNetworkWithResponse(ctx context.Context, sandboxID SandboxID, body PutSandboxesSandboxIDNetworkJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSandboxesSandboxIDNetworkResponse, error) {
+	rsp, err := c.PutSandboxesSandboxIDNetwork(ctx, sandboxID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePutSandboxesSandboxIDNetworkResponse(rsp)
+}
 
-	response := &GetTemplatesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetSandboxesSandboxIDNetworkLogsWithResponse request returning *GetSandboxesSandboxIDNetworkLogsResponse
+func (c *ClientWithResponses) GetSandboxesSandboxIDNetworkLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetSandboxesSandboxIDNetworkLogsParams, reqEditors ...RequestEditorFn) (*GetSandboxesSandboxIDNetworkLogsResponse, error) {
+	rsp, err := c.GetSandboxesSandboxIDNetworkLogs(ctx, sandboxID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetSandboxesSandboxIDNetworkLogsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Template
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostSandboxesSandboxIDPauseWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDPauseResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDPauseWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDPauseWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDPauseResponse(rsp)
+}
 
+func (c *ClientWithResponses) PostSandboxesSandboxIDPauseWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDPauseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDPauseResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDPause(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDPauseResponse(rsp)
+}
 
-	return response, nil
+// PostSandboxesSandboxIDRefreshesWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDRefreshesResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDRefreshesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDRefreshesWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDRefreshesResponse(rsp)
 }
 
-// ParsePostTemplatesResponse parses an HTTP response from a PostTemplatesWithResponse call
-func ParsePostTemplatesResponse(rsp *http.Response) (*PostTemplatesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PostSandboxesSandboxIDRefreshesWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDRefreshesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDRefreshesResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDRefreshes(ctx, sandboxID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDRefreshesResponse(rsp)
+}
 
-	response := &PostTemplatesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PatchSandboxesSandboxIDResourcesWithBodyWithResponse request with arbitrary body returning *PatchSandboxesSandboxIDResourcesResponse
+func (c *ClientWithResponses) PatchSandboxesSandboxIDResourcesWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDResourcesResponse, error) {
+	rsp, err := c.PatchSandboxesSandboxIDResourcesWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePatchSandboxesSandboxIDResourcesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TemplateLegacy
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
+func (c *ClientWithResponses) PatchSandboxesSandboxIDResourcesWithResponse(ctx context.Context, sandboxID SandboxID, body PatchSandboxesSandboxIDResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSandboxesSandboxIDResourcesResponse, error) {
+	rsp, err := c.PatchSandboxesSandboxIDResources(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSandboxesSandboxIDResourcesResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PostSandboxesSandboxIDResumeWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDResumeResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDResumeWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDResumeWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDResumeResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+func (c *ClientWithResponses) PostSandboxesSandboxIDResumeWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDResumeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDResumeResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDResume(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDResumeResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostSandboxesSandboxIDSnapshotsWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDSnapshotsResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDSnapshotsWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDSnapshotsWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDSnapshotsResponse(rsp)
+}
 
+func (c *ClientWithResponses) PostSandboxesSandboxIDSnapshotsWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDSnapshotsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDSnapshots(ctx, sandboxID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDSnapshotsResponse(rsp)
+}
 
-	return response, nil
+// PostSandboxesSandboxIDTimeoutWithBodyWithResponse request with arbitrary body returning *PostSandboxesSandboxIDTimeoutResponse
+func (c *ClientWithResponses) PostSandboxesSandboxIDTimeoutWithBodyWithResponse(ctx context.Context, sandboxID SandboxID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDTimeoutWithBody(ctx, sandboxID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSandboxesSandboxIDTimeoutResponse(rsp)
 }
 
-// ParseGetTemplatesAliasesAliasResponse parses an HTTP response from a GetTemplatesAliasesAliasWithResponse call
-func ParseGetTemplatesAliasesAliasResponse(rsp *http.Response) (*GetTemplatesAliasesAliasResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PostSandboxesSandboxIDTimeoutWithResponse(ctx context.Context, sandboxID SandboxID, body PostSandboxesSandboxIDTimeoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSandboxesSandboxIDTimeoutResponse, error) {
+	rsp, err := c.PostSandboxesSandboxIDTimeout(ctx, sandboxID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostSandboxesSandboxIDTimeoutResponse(rsp)
+}
 
-	response := &GetTemplatesAliasesAliasResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetSecretsWithResponse request returning *GetSecretsResponse
+func (c *ClientWithResponses) GetSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error) {
+	rsp, err := c.GetSecrets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetSecretsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateAliasResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// DeleteSecretsSecretNameWithResponse request returning *DeleteSecretsSecretNameResponse
+func (c *ClientWithResponses) DeleteSecretsSecretNameWithResponse(ctx context.Context, secretName SecretName, reqEditors ...RequestEditorFn) (*DeleteSecretsSecretNameResponse, error) {
+	rsp, err := c.DeleteSecretsSecretName(ctx, secretName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseDeleteSecretsSecretNameResponse(rsp)
 }
 
-// ParseDeleteTemplatesTagsResponse parses an HTTP response from a DeleteTemplatesTagsWithResponse call
-func ParseDeleteTemplatesTagsResponse(rsp *http.Response) (*DeleteTemplatesTagsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PutSecretsSecretNameWithBodyWithResponse request with arbitrary body returning *PutSecretsSecretNameResponse
+func (c *ClientWithResponses) PutSecretsSecretNameWithBodyWithResponse(ctx context.Context, secretName SecretName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSecretsSecretNameResponse, error) {
+	rsp, err := c.PutSecretsSecretNameWithBody(ctx, secretName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePutSecretsSecretNameResponse(rsp)
+}
 
-	response := &DeleteTemplatesTagsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) PutSecretsSecretNameWithResponse(ctx context.Context, secretName SecretName, body PutSecretsSecretNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSecretsSecretNameResponse, error) {
+	rsp, err := c.PutSecretsSecretName(ctx, secretName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePutSecretsSecretNameResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// GetSnapshotsWithResponse request returning *GetSnapshotsResponse
+func (c *ClientWithResponses) GetSnapshotsWithResponse(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*GetSnapshotsResponse, error) {
+	rsp, err := c.GetSnapshots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseGetSnapshotsResponse(rsp)
 }
 
-// ParsePostTemplatesTagsResponse parses an HTTP response from a PostTemplatesTagsWithResponse call
-func ParsePostTemplatesTagsResponse(rsp *http.Response) (*PostTemplatesTagsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTeamsWithResponse request returning *GetTeamsResponse
+func (c *ClientWithResponses) GetTeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTeamsResponse, error) {
+	rsp, err := c.GetTeams(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTeamsResponse(rsp)
+}
 
-	response := &PostTemplatesTagsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetTeamsTeamIDMetricsWithResponse request returning *GetTeamsTeamIDMetricsResponse
+func (c *ClientWithResponses) GetTeamsTeamIDMetricsWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsResponse, error) {
+	rsp, err := c.GetTeamsTeamIDMetrics(ctx, teamID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetTeamsTeamIDMetricsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AssignedTemplateTags
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// GetTeamsTeamIDMetricsMaxWithResponse request returning *GetTeamsTeamIDMetricsMaxResponse
+func (c *ClientWithResponses) GetTeamsTeamIDMetricsMaxWithResponse(ctx context.Context, teamID TeamID, params *GetTeamsTeamIDMetricsMaxParams, reqEditors ...RequestEditorFn) (*GetTeamsTeamIDMetricsMaxResponse, error) {
+	rsp, err := c.GetTeamsTeamIDMetricsMax(ctx, teamID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseGetTeamsTeamIDMetricsMaxResponse(rsp)
 }
 
-// ParseDeleteTemplatesTemplateIDResponse parses an HTTP response from a DeleteTemplatesTemplateIDWithResponse call
-func ParseDeleteTemplatesTemplateIDResponse(rsp *http.Response) (*DeleteTemplatesTemplateIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTemplatesWithResponse request returning *GetTemplatesResponse
+func (c *ClientWithResponses) GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error) {
+	rsp, err := c.GetTemplates(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTemplatesResponse(rsp)
+}
 
-	response := &DeleteTemplatesTemplateIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostTemplatesWithBodyWithResponse request with arbitrary body returning *PostTemplatesResponse
+func (c *ClientWithResponses) PostTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error) {
+	rsp, err := c.PostTemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostTemplatesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) PostTemplatesWithResponse(ctx context.Context, body PostTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesResponse, error) {
+	rsp, err := c.PostTemplates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePostTemplatesResponse(rsp)
 }
 
-// ParseGetTemplatesTemplateIDResponse parses an HTTP response from a GetTemplatesTemplateIDWithResponse call
-func ParseGetTemplatesTemplateIDResponse(rsp *http.Response) (*GetTemplatesTemplateIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTemplatesAliasesAliasWithResponse request returning *GetTemplatesAliasesAliasResponse
+func (c *ClientWithResponses) GetTemplatesAliasesAliasWithResponse(ctx context.Context, alias string, reqEditors ...RequestEditorFn) (*GetTemplatesAliasesAliasResponse, error) {
+	rsp, err := c.GetTemplatesAliasesAlias(ctx, alias, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTemplatesAliasesAliasResponse(rsp)
+}
 
-	response := &GetTemplatesTemplateIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DeleteTemplatesTagsWithBodyWithResponse request with arbitrary body returning *DeleteTemplatesTagsResponse
+func (c *ClientWithResponses) DeleteTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error) {
+	rsp, err := c.DeleteTemplatesTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteTemplatesTagsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateWithBuilds
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) DeleteTemplatesTagsWithResponse(ctx context.Context, body DeleteTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTemplatesTagsResponse, error) {
+	rsp, err := c.DeleteTemplatesTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseDeleteTemplatesTagsResponse(rsp)
 }
 
-// ParsePatchTemplatesTemplateIDResponse parses an HTTP response from a PatchTemplatesTemplateIDWithResponse call
-func ParsePatchTemplatesTemplateIDResponse(rsp *http.Response) (*PatchTemplatesTemplateIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostTemplatesTagsWithBodyWithResponse request with arbitrary body returning *PostTemplatesTagsResponse
+func (c *ClientWithResponses) PostTemplatesTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error) {
+	rsp, err := c.PostTemplatesTagsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostTemplatesTagsResponse(rsp)
+}
 
-	response := &PatchTemplatesTemplateIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) PostTemplatesTagsWithResponse(ctx context.Context, body PostTemplatesTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTagsResponse, error) {
+	rsp, err := c.PostTemplatesTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostTemplatesTagsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// DeleteTemplatesTemplateIDWithResponse request returning *DeleteTemplatesTemplateIDResponse
+func (c *ClientWithResponses) DeleteTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteTemplatesTemplateIDResponse, error) {
+	rsp, err := c.DeleteTemplatesTemplateID(ctx, templateID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseDeleteTemplatesTemplateIDResponse(rsp)
 }
 
-// ParsePostTemplatesTemplateIDResponse parses an HTTP response from a PostTemplatesTemplateIDWithResponse call
-func ParsePostTemplatesTemplateIDResponse(rsp *http.Response) (*PostTemplatesTemplateIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTemplatesTemplateIDWithResponse request returning *GetTemplatesTemplateIDResponse
+func (c *ClientWithResponses) GetTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, params *GetTemplatesTemplateIDParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDResponse, error) {
+	rsp, err := c.GetTemplatesTemplateID(ctx, templateID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTemplatesTemplateIDResponse(rsp)
+}
 
-	response := &PostTemplatesTemplateIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PatchTemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PatchTemplatesTemplateIDResponse
+func (c *ClientWithResponses) PatchTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error) {
+	rsp, err := c.PatchTemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePatchTemplatesTemplateIDResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TemplateLegacy
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) PatchTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTemplatesTemplateIDResponse, error) {
+	rsp, err := c.PatchTemplatesTemplateID(ctx, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePatchTemplatesTemplateIDResponse(rsp)
 }
 
-// ParsePostTemplatesTemplateIDBuildsBuildIDResponse parses an HTTP response from a PostTemplatesTemplateIDBuildsBuildIDWithResponse call
-func ParsePostTemplatesTemplateIDBuildsBuildIDResponse(rsp *http.Response) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostTemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PostTemplatesTemplateIDResponse
+func (c *ClientWithResponses) PostTemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error) {
+	rsp, err := c.PostTemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostTemplatesTemplateIDResponse(rsp)
+}
 
-	response := &PostTemplatesTemplateIDBuildsBuildIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) PostTemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PostTemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDResponse, error) {
+	rsp, err := c.PostTemplatesTemplateID(ctx, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostTemplatesTemplateIDResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// PostTemplatesTemplateIDBuildsBuildIDWithResponse request returning *PostTemplatesTemplateIDBuildsBuildIDResponse
+func (c *ClientWithResponses) PostTemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, reqEditors ...RequestEditorFn) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error) {
+	rsp, err := c.PostTemplatesTemplateIDBuildsBuildID(ctx, templateID, buildID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePostTemplatesTemplateIDBuildsBuildIDResponse(rsp)
 }
 
-// ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse parses an HTTP response from a GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse call
-func ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse(rsp *http.Response) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse request returning *GetTemplatesTemplateIDBuildsBuildIDLogsResponse
+func (c *ClientWithResponses) GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDLogsParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error) {
+	rsp, err := c.GetTemplatesTemplateIDBuildsBuildIDLogs(ctx, templateID, buildID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse(rsp)
+}
 
-	response := &GetTemplatesTemplateIDBuildsBuildIDLogsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse request returning *GetTemplatesTemplateIDBuildsBuildIDStatusResponse
+func (c *ClientWithResponses) GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, params *GetTemplatesTemplateIDBuildsBuildIDStatusParams, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error) {
+	rsp, err := c.GetTemplatesTemplateIDBuildsBuildIDStatus(ctx, templateID, buildID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateBuildLogsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// GetTemplatesTemplateIDFilesHashWithResponse request returning *GetTemplatesTemplateIDFilesHashResponse
+func (c *ClientWithResponses) GetTemplatesTemplateIDFilesHashWithResponse(ctx context.Context, templateID TemplateID, hash string, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDFilesHashResponse, error) {
+	rsp, err := c.GetTemplatesTemplateIDFilesHash(ctx, templateID, hash, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseGetTemplatesTemplateIDFilesHashResponse(rsp)
 }
 
-// ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse parses an HTTP response from a GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse call
-func ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse(rsp *http.Response) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTemplatesTemplateIDTagsWithResponse request returning *GetTemplatesTemplateIDTagsResponse
+func (c *ClientWithResponses) GetTemplatesTemplateIDTagsWithResponse(ctx context.Context, templateID TemplateID, reqEditors ...RequestEditorFn) (*GetTemplatesTemplateIDTagsResponse, error) {
+	rsp, err := c.GetTemplatesTemplateIDTags(ctx, templateID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetTemplatesTemplateIDTagsResponse(rsp)
+}
 
-	response := &GetTemplatesTemplateIDBuildsBuildIDStatusResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetV2SandboxesWithResponse request returning *GetV2SandboxesResponse
+func (c *ClientWithResponses) GetV2SandboxesWithResponse(ctx context.Context, params *GetV2SandboxesParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesResponse, error) {
+	rsp, err := c.GetV2Sandboxes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetV2SandboxesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateBuildInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// GetV2SandboxesSandboxIDLogsWithResponse request returning *GetV2SandboxesSandboxIDLogsResponse
+func (c *ClientWithResponses) GetV2SandboxesSandboxIDLogsWithResponse(ctx context.Context, sandboxID SandboxID, params *GetV2SandboxesSandboxIDLogsParams, reqEditors ...RequestEditorFn) (*GetV2SandboxesSandboxIDLogsResponse, error) {
+	rsp, err := c.GetV2SandboxesSandboxIDLogs(ctx, sandboxID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseGetV2SandboxesSandboxIDLogsResponse(rsp)
 }
 
-// ParseGetTemplatesTemplateIDFilesHashResponse parses an HTTP response from a GetTemplatesTemplateIDFilesHashWithResponse call
-func ParseGetTemplatesTemplateIDFilesHashResponse(rsp *http.Response) (*GetTemplatesTemplateIDFilesHashResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetV2TemplatesWithResponse request returning *GetV2TemplatesResponse
+func (c *ClientWithResponses) GetV2TemplatesWithResponse(ctx context.Context, params *GetV2TemplatesParams, reqEditors ...RequestEditorFn) (*GetV2TemplatesResponse, error) {
+	rsp, err := c.GetV2Templates(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetV2TemplatesResponse(rsp)
+}
 
-	response := &GetTemplatesTemplateIDFilesHashResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostV2TemplatesWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesResponse
+func (c *ClientWithResponses) PostV2TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
+	rsp, err := c.PostV2TemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostV2TemplatesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TemplateBuildFileUpload
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+func (c *ClientWithResponses) PostV2TemplatesWithResponse(ctx context.Context, body PostV2TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesResponse, error) {
+	rsp, err := c.PostV2Templates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PatchV2TemplatesTemplateIDWithBodyWithResponse request with arbitrary body returning *PatchV2TemplatesTemplateIDResponse
+func (c *ClientWithResponses) PatchV2TemplatesTemplateIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error) {
+	rsp, err := c.PatchV2TemplatesTemplateIDWithBody(ctx, templateID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2TemplatesTemplateIDResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+func (c *ClientWithResponses) PatchV2TemplatesTemplateIDWithResponse(ctx context.Context, templateID TemplateID, body PatchV2TemplatesTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchV2TemplatesTemplateIDResponse, error) {
+	rsp, err := c.PatchV2TemplatesTemplateID(ctx, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV2TemplatesTemplateIDResponse(rsp)
+}
+
+// PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse request with arbitrary body returning *PostV2TemplatesTemplateIDBuildsBuildIDResponse
+func (c *ClientWithResponses) PostV2TemplatesTemplateIDBuildsBuildIDWithBodyWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
+	rsp, err := c.PostV2TemplatesTemplateIDBuildsBuildIDWithBody(ctx, templateID, buildID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(ctx context.Context, templateID TemplateID, buildID BuildID, body PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
+	rsp, err := c.PostV2TemplatesTemplateIDBuildsBuildID(ctx, templateID, buildID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp)
+}
+
+// PostV3TemplatesWithBodyWithResponse request with arbitrary body returning *PostV3TemplatesResponse
+func (c *ClientWithResponses) PostV3TemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error) {
+	rsp, err := c.PostV3TemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV3TemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV3TemplatesWithResponse(ctx context.Context, body PostV3TemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV3TemplatesResponse, error) {
+	rsp, err := c.PostV3Templates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV3TemplatesResponse(rsp)
+}
+
+// GetVolumesWithResponse request returning *GetVolumesResponse
+func (c *ClientWithResponses) GetVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVolumesResponse, error) {
+	rsp, err := c.GetVolumes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVolumesResponse(rsp)
+}
+
+// PostVolumesWithBodyWithResponse request with arbitrary body returning *PostVolumesResponse
+func (c *ClientWithResponses) PostVolumesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error) {
+	rsp, err := c.PostVolumesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostVolumesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostVolumesWithResponse(ctx context.Context, body PostVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVolumesResponse, error) {
+	rsp, err := c.PostVolumes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostVolumesResponse(rsp)
+}
+
+// DeleteVolumesVolumeIDWithResponse request returning *DeleteVolumesVolumeIDResponse
+func (c *ClientWithResponses) DeleteVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*DeleteVolumesVolumeIDResponse, error) {
+	rsp, err := c.DeleteVolumesVolumeID(ctx, volumeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVolumesVolumeIDResponse(rsp)
+}
+
+// GetVolumesVolumeIDWithResponse request returning *GetVolumesVolumeIDResponse
+func (c *ClientWithResponses) GetVolumesVolumeIDWithResponse(ctx context.Context, volumeID VolumeID, reqEditors ...RequestEditorFn) (*GetVolumesVolumeIDResponse, error) {
+	rsp, err := c.GetVolumesVolumeID(ctx, volumeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVolumesVolumeIDResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// PostWebhooksWithBodyWithResponse request with arbitrary body returning *PostWebhooksResponse
+func (c *ClientWithResponses) PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+// DeleteWebhooksWebhookIDWithResponse request returning *DeleteWebhooksWebhookIDResponse
+func (c *ClientWithResponses) DeleteWebhooksWebhookIDWithResponse(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIDResponse, error) {
+	rsp, err := c.DeleteWebhooksWebhookID(ctx, webhookID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksWebhookIDResponse(rsp)
+}
+
+// PostWebhooksWebhookIDTestWithResponse request returning *PostWebhooksWebhookIDTestResponse
+func (c *ClientWithResponses) PostWebhooksWebhookIDTestWithResponse(ctx context.Context, webhookID WebhookID, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIDTestResponse, error) {
+	rsp, err := c.PostWebhooksWebhookIDTest(ctx, webhookID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksWebhookIDTestResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxIdentityJWKS
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest N404
@@ -11023,53 +11749,32 @@ func ParseGetTemplatesTemplateIDFilesHashResponse(rsp *http.Response) (*GetTempl
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetTemplatesTemplateIDTagsResponse parses an HTTP response from a GetTemplatesTemplateIDTagsWithResponse call
-func ParseGetTemplatesTemplateIDTagsResponse(rsp *http.Response) (*GetTemplatesTemplateIDTagsResponse, error) {
+// ParseGetWellKnownOpenidConfigurationResponse parses an HTTP response from a GetWellKnownOpenidConfigurationWithResponse call
+func ParseGetWellKnownOpenidConfigurationResponse(rsp *http.Response) (*GetWellKnownOpenidConfigurationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTemplatesTemplateIDTagsResponse{
+	response := &GetWellKnownOpenidConfigurationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TemplateTag
+		var dest SandboxIdentityDiscovery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11077,52 +11782,45 @@ func ParseGetTemplatesTemplateIDTagsResponse(rsp *http.Response) (*GetTemplatesT
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetV2SandboxesResponse parses an HTTP response from a GetV2SandboxesWithResponse call
-func ParseGetV2SandboxesResponse(rsp *http.Response) (*GetV2SandboxesResponse, error) {
+// ParsePostAccessTokensResponse parses an HTTP response from a PostAccessTokensWithResponse call
+func ParsePostAccessTokensResponse(rsp *http.Response) (*PostAccessTokensResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2SandboxesResponse{
+	response := &PostAccessTokensResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ListedSandbox
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedAccessToken
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest N410
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON410 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -11136,27 +11834,20 @@ func ParseGetV2SandboxesResponse(rsp *http.Response) (*GetV2SandboxesResponse, e
 	return response, nil
 }
 
-// ParseGetV2SandboxesSandboxIDLogsResponse parses an HTTP response from a GetV2SandboxesSandboxIDLogsWithResponse call
-func ParseGetV2SandboxesSandboxIDLogsResponse(rsp *http.Response) (*GetV2SandboxesSandboxIDLogsResponse, error) {
+// ParseDeleteAccessTokensAccessTokenIDResponse parses an HTTP response from a DeleteAccessTokensAccessTokenIDWithResponse call
+func ParseDeleteAccessTokensAccessTokenIDResponse(rsp *http.Response) (*DeleteAccessTokensAccessTokenIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2SandboxesSandboxIDLogsResponse{
+	response := &DeleteAccessTokensAccessTokenIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SandboxLogsV2Response
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11183,26 +11874,26 @@ func ParseGetV2SandboxesSandboxIDLogsResponse(rsp *http.Response) (*GetV2Sandbox
 	return response, nil
 }
 
-// ParseGetV2TemplatesResponse parses an HTTP response from a GetV2TemplatesWithResponse call
-func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, error) {
+// ParsePostAdminTeamsTeamIDApiKeysResponse parses an HTTP response from a PostAdminTeamsTeamIDApiKeysWithResponse call
+func ParsePostAdminTeamsTeamIDApiKeysResponse(rsp *http.Response) (*PostAdminTeamsTeamIDApiKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV2TemplatesResponse{
+	response := &PostAdminTeamsTeamIDApiKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Template
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedTeamAPIKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
@@ -11225,6 +11916,13 @@ func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11237,27 +11935,20 @@ func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, e
 	return response, nil
 }
 
-// ParsePostV2TemplatesResponse parses an HTTP response from a PostV2TemplatesWithResponse call
-func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse, error) {
+// ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse parses an HTTP response from a DeleteAdminTeamsTeamIDApiKeysApiKeyIDWithResponse call
+func ParseDeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse(rsp *http.Response) (*DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2TemplatesResponse{
+	response := &DeleteAdminTeamsTeamIDApiKeysApiKeyIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TemplateLegacy
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11272,45 +11963,92 @@ func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse,
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePatchV2TemplatesTemplateIDResponse parses an HTTP response from a PatchV2TemplatesTemplateIDWithResponse call
-func ParsePatchV2TemplatesTemplateIDResponse(rsp *http.Response) (*PatchV2TemplatesTemplateIDResponse, error) {
+// ParsePostAdminTeamsTeamIDBuildsCancelResponse parses an HTTP response from a PostAdminTeamsTeamIDBuildsCancelWithResponse call
+func ParsePostAdminTeamsTeamIDBuildsCancelResponse(rsp *http.Response) (*PostAdminTeamsTeamIDBuildsCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchV2TemplatesTemplateIDResponse{
+	response := &PostAdminTeamsTeamIDBuildsCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TemplateUpdateResponse
+		var dest AdminBuildCancelResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminTeamsTeamIDSandboxesKillResponse parses an HTTP response from a PostAdminTeamsTeamIDSandboxesKillWithResponse call
+func ParsePostAdminTeamsTeamIDSandboxesKillResponse(rsp *http.Response) (*PostAdminTeamsTeamIDSandboxesKillResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminTeamsTeamIDSandboxesKillResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminSandboxKillResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
@@ -11319,6 +12057,13 @@ func ParsePatchV2TemplatesTemplateIDResponse(rsp *http.Response) (*PatchV2Templa
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11331,20 +12076,27 @@ func ParsePatchV2TemplatesTemplateIDResponse(rsp *http.Response) (*PatchV2Templa
 	return response, nil
 }
 
-// ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse parses an HTTP response from a PostV2TemplatesTemplateIDBuildsBuildIDWithResponse call
-func ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp *http.Response) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
+// ParseGetApiKeysResponse parses an HTTP response from a GetApiKeysWithResponse call
+func ParseGetApiKeysResponse(rsp *http.Response) (*GetApiKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV2TemplatesTemplateIDBuildsBuildIDResponse{
+	response := &GetApiKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TeamAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11364,34 +12116,60 @@ func ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp *http.Response) (*P
 	return response, nil
 }
 
-// ParsePostV3TemplatesResponse parses an HTTP response from a PostV3TemplatesWithResponse call
-func ParsePostV3TemplatesResponse(rsp *http.Response) (*PostV3TemplatesResponse, error) {
+// ParsePostApiKeysResponse parses an HTTP response from a PostApiKeysWithResponse call
+func ParsePostApiKeysResponse(rsp *http.Response) (*PostApiKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV3TemplatesResponse{
+	response := &PostApiKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest TemplateRequestResponseV3
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedTeamAPIKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
 
+// ParseDeleteApiKeysApiKeyIDResponse parses an HTTP response from a DeleteApiKeysApiKeyIDWithResponse call
+func ParseDeleteApiKeysApiKeyIDResponse(rsp *http.Response) (*DeleteApiKeysApiKeyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiKeysApiKeyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11399,12 +12177,12 @@ func ParsePostV3TemplatesResponse(rsp *http.Response) (*PostV3TemplatesResponse,
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest N403
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -11418,22 +12196,88 @@ func ParsePostV3TemplatesResponse(rsp *http.Response) (*PostV3TemplatesResponse,
 	return response, nil
 }
 
-// ParseGetVolumesResponse parses an HTTP response from a GetVolumesWithResponse call
-func ParseGetVolumesResponse(rsp *http.Response) (*GetVolumesResponse, error) {
+// ParsePatchApiKeysApiKeyIDResponse parses an HTTP response from a PatchApiKeysApiKeyIDWithResponse call
+func ParsePatchApiKeysApiKeyIDResponse(rsp *http.Response) (*PatchApiKeysApiKeyIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVolumesResponse{
+	response := &PatchApiKeysApiKeyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNodesResponse parses an HTTP response from a GetNodesWithResponse call
+func ParseGetNodesResponse(rsp *http.Response) (*GetNodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNodesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Volume
+		var dest []Node
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11458,40 +12302,40 @@ func ParseGetVolumesResponse(rsp *http.Response) (*GetVolumesResponse, error) {
 	return response, nil
 }
 
-// ParsePostVolumesResponse parses an HTTP response from a PostVolumesWithResponse call
-func ParsePostVolumesResponse(rsp *http.Response) (*PostVolumesResponse, error) {
+// ParseGetNodesNodeIDResponse parses an HTTP response from a GetNodesNodeIDWithResponse call
+func ParseGetNodesNodeIDResponse(rsp *http.Response) (*GetNodesNodeIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostVolumesResponse{
+	response := &GetNodesNodeIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest VolumeAndToken
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeDetail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -11505,15 +12349,15 @@ func ParsePostVolumesResponse(rsp *http.Response) (*PostVolumesResponse, error)
 	return response, nil
 }
 
-// ParseDeleteVolumesVolumeIDResponse parses an HTTP response from a DeleteVolumesVolumeIDWithResponse call
-func ParseDeleteVolumesVolumeIDResponse(rsp *http.Response) (*DeleteVolumesVolumeIDResponse, error) {
+// ParsePostNodesNodeIDResponse parses an HTTP response from a PostNodesNodeIDWithResponse call
+func ParsePostNodesNodeIDResponse(rsp *http.Response) (*PostNodesNodeIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteVolumesVolumeIDResponse{
+	response := &PostNodesNodeIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11545,40 +12389,2914 @@ func ParseDeleteVolumesVolumeIDResponse(rsp *http.Response) (*DeleteVolumesVolum
 	return response, nil
 }
 
-// ParseGetVolumesVolumeIDResponse parses an HTTP response from a GetVolumesVolumeIDWithResponse call
-func ParseGetVolumesVolumeIDResponse(rsp *http.Response) (*GetVolumesVolumeIDResponse, error) {
+// ParseGetSandboxesResponse parses an HTTP response from a GetSandboxesWithResponse call
+func ParseGetSandboxesResponse(rsp *http.Response) (*GetSandboxesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVolumesVolumeIDResponse{
+	response := &GetSandboxesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VolumeAndToken
+		var dest []ListedSandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest N401
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesResponse parses an HTTP response from a PostSandboxesWithResponse call
+func ParsePostSandboxesResponse(rsp *http.Response) (*PostSandboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesMetricsResponse parses an HTTP response from a GetSandboxesMetricsWithResponse call
+func ParseGetSandboxesMetricsResponse(rsp *http.Response) (*GetSandboxesMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxesWithMetrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSandboxesSandboxIDResponse parses an HTTP response from a DeleteSandboxesSandboxIDWithResponse call
+func ParseDeleteSandboxesSandboxIDResponse(rsp *http.Response) (*DeleteSandboxesSandboxIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSandboxesSandboxIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesSandboxIDResponse parses an HTTP response from a GetSandboxesSandboxIDWithResponse call
+func ParseGetSandboxesSandboxIDResponse(rsp *http.Response) (*GetSandboxesSandboxIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesSandboxIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSandboxesSandboxIDBandwidthResponse parses an HTTP response from a PutSandboxesSandboxIDBandwidthWithResponse call
+func ParsePutSandboxesSandboxIDBandwidthResponse(rsp *http.Response) (*PutSandboxesSandboxIDBandwidthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSandboxesSandboxIDBandwidthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesSandboxIDCheckpointsResponse parses an HTTP response from a GetSandboxesSandboxIDCheckpointsWithResponse call
+func ParseGetSandboxesSandboxIDCheckpointsResponse(rsp *http.Response) (*GetSandboxesSandboxIDCheckpointsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesSandboxIDCheckpointsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SandboxCheckpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDCheckpointsResponse parses an HTTP response from a PostSandboxesSandboxIDCheckpointsWithResponse call
+func ParsePostSandboxesSandboxIDCheckpointsResponse(rsp *http.Response) (*PostSandboxesSandboxIDCheckpointsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDCheckpointsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SandboxCheckpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse parses an HTTP response from a DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse call
+func ParseDeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse(rsp *http.Response) (*DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSandboxesSandboxIDCheckpointsCheckpointIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse parses an HTTP response from a PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithResponse call
+func ParsePostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse(rsp *http.Response) (*PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDConnectResponse parses an HTTP response from a PostSandboxesSandboxIDConnectWithResponse call
+func ParsePostSandboxesSandboxIDConnectResponse(rsp *http.Response) (*PostSandboxesSandboxIDConnectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDConnectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDControlPauseResponse parses an HTTP response from a PostSandboxesSandboxIDControlPauseWithResponse call
+func ParsePostSandboxesSandboxIDControlPauseResponse(rsp *http.Response) (*PostSandboxesSandboxIDControlPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDControlPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDControlSandboxesResponse parses an HTTP response from a PostSandboxesSandboxIDControlSandboxesWithResponse call
+func ParsePostSandboxesSandboxIDControlSandboxesResponse(rsp *http.Response) (*PostSandboxesSandboxIDControlSandboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDControlSandboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDControlTimeoutResponse parses an HTTP response from a PostSandboxesSandboxIDControlTimeoutWithResponse call
+func ParsePostSandboxesSandboxIDControlTimeoutResponse(rsp *http.Response) (*PostSandboxesSandboxIDControlTimeoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDControlTimeoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchSandboxesSandboxIDEnvsResponse parses an HTTP response from a PatchSandboxesSandboxIDEnvsWithResponse call
+func ParsePatchSandboxesSandboxIDEnvsResponse(rsp *http.Response) (*PatchSandboxesSandboxIDEnvsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchSandboxesSandboxIDEnvsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDForkResponse parses an HTTP response from a PostSandboxesSandboxIDForkWithResponse call
+func ParsePostSandboxesSandboxIDForkResponse(rsp *http.Response) (*PostSandboxesSandboxIDForkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDForkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []SandboxForkResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesSandboxIDLogsResponse parses an HTTP response from a GetSandboxesSandboxIDLogsWithResponse call
+func ParseGetSandboxesSandboxIDLogsResponse(rsp *http.Response) (*GetSandboxesSandboxIDLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesSandboxIDLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesSandboxIDMetricsResponse parses an HTTP response from a GetSandboxesSandboxIDMetricsWithResponse call
+func ParseGetSandboxesSandboxIDMetricsResponse(rsp *http.Response) (*GetSandboxesSandboxIDMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesSandboxIDMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SandboxMetric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSandboxesSandboxIDNetworkResponse parses an HTTP response from a PutSandboxesSandboxIDNetworkWithResponse call
+func ParsePutSandboxesSandboxIDNetworkResponse(rsp *http.Response) (*PutSandboxesSandboxIDNetworkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSandboxesSandboxIDNetworkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSandboxesSandboxIDNetworkLogsResponse parses an HTTP response from a GetSandboxesSandboxIDNetworkLogsWithResponse call
+func ParseGetSandboxesSandboxIDNetworkLogsResponse(rsp *http.Response) (*GetSandboxesSandboxIDNetworkLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSandboxesSandboxIDNetworkLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxNetworkLogsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDPauseResponse parses an HTTP response from a PostSandboxesSandboxIDPauseWithResponse call
+func ParsePostSandboxesSandboxIDPauseResponse(rsp *http.Response) (*PostSandboxesSandboxIDPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDRefreshesResponse parses an HTTP response from a PostSandboxesSandboxIDRefreshesWithResponse call
+func ParsePostSandboxesSandboxIDRefreshesResponse(rsp *http.Response) (*PostSandboxesSandboxIDRefreshesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDRefreshesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchSandboxesSandboxIDResourcesResponse parses an HTTP response from a PatchSandboxesSandboxIDResourcesWithResponse call
+func ParsePatchSandboxesSandboxIDResourcesResponse(rsp *http.Response) (*PatchSandboxesSandboxIDResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchSandboxesSandboxIDResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDResumeResponse parses an HTTP response from a PostSandboxesSandboxIDResumeWithResponse call
+func ParsePostSandboxesSandboxIDResumeResponse(rsp *http.Response) (*PostSandboxesSandboxIDResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest N409
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDSnapshotsResponse parses an HTTP response from a PostSandboxesSandboxIDSnapshotsWithResponse call
+func ParsePostSandboxesSandboxIDSnapshotsResponse(rsp *http.Response) (*PostSandboxesSandboxIDSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SnapshotInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSandboxesSandboxIDTimeoutResponse parses an HTTP response from a PostSandboxesSandboxIDTimeoutWithResponse call
+func ParsePostSandboxesSandboxIDTimeoutResponse(rsp *http.Response) (*PostSandboxesSandboxIDTimeoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSandboxesSandboxIDTimeoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSecretsResponse parses an HTTP response from a GetSecretsWithResponse call
+func ParseGetSecretsResponse(rsp *http.Response) (*GetSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TeamSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSecretsSecretNameResponse parses an HTTP response from a DeleteSecretsSecretNameWithResponse call
+func ParseDeleteSecretsSecretNameResponse(rsp *http.Response) (*DeleteSecretsSecretNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSecretsSecretNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSecretsSecretNameResponse parses an HTTP response from a PutSecretsSecretNameWithResponse call
+func ParsePutSecretsSecretNameResponse(rsp *http.Response) (*PutSecretsSecretNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSecretsSecretNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSnapshotsResponse parses an HTTP response from a GetSnapshotsWithResponse call
+func ParseGetSnapshotsResponse(rsp *http.Response) (*GetSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SnapshotInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamsResponse parses an HTTP response from a GetTeamsWithResponse call
+func ParseGetTeamsResponse(rsp *http.Response) (*GetTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamsTeamIDMetricsResponse parses an HTTP response from a GetTeamsTeamIDMetricsWithResponse call
+func ParseGetTeamsTeamIDMetricsResponse(rsp *http.Response) (*GetTeamsTeamIDMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamsTeamIDMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TeamMetric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamsTeamIDMetricsMaxResponse parses an HTTP response from a GetTeamsTeamIDMetricsMaxWithResponse call
+func ParseGetTeamsTeamIDMetricsMaxResponse(rsp *http.Response) (*GetTeamsTeamIDMetricsMaxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamsTeamIDMetricsMaxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MaxTeamMetric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesResponse parses an HTTP response from a GetTemplatesWithResponse call
+func ParseGetTemplatesResponse(rsp *http.Response) (*GetTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Template
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTemplatesResponse parses an HTTP response from a PostTemplatesWithResponse call
+func ParsePostTemplatesResponse(rsp *http.Response) (*PostTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TemplateLegacy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesAliasesAliasResponse parses an HTTP response from a GetTemplatesAliasesAliasWithResponse call
+func ParseGetTemplatesAliasesAliasResponse(rsp *http.Response) (*GetTemplatesAliasesAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesAliasesAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateAliasResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTemplatesTagsResponse parses an HTTP response from a DeleteTemplatesTagsWithResponse call
+func ParseDeleteTemplatesTagsResponse(rsp *http.Response) (*DeleteTemplatesTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTemplatesTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTemplatesTagsResponse parses an HTTP response from a PostTemplatesTagsWithResponse call
+func ParsePostTemplatesTagsResponse(rsp *http.Response) (*PostTemplatesTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTemplatesTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AssignedTemplateTags
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTemplatesTemplateIDResponse parses an HTTP response from a DeleteTemplatesTemplateIDWithResponse call
+func ParseDeleteTemplatesTemplateIDResponse(rsp *http.Response) (*DeleteTemplatesTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTemplatesTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesTemplateIDResponse parses an HTTP response from a GetTemplatesTemplateIDWithResponse call
+func ParseGetTemplatesTemplateIDResponse(rsp *http.Response) (*GetTemplatesTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateWithBuilds
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchTemplatesTemplateIDResponse parses an HTTP response from a PatchTemplatesTemplateIDWithResponse call
+func ParsePatchTemplatesTemplateIDResponse(rsp *http.Response) (*PatchTemplatesTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchTemplatesTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTemplatesTemplateIDResponse parses an HTTP response from a PostTemplatesTemplateIDWithResponse call
+func ParsePostTemplatesTemplateIDResponse(rsp *http.Response) (*PostTemplatesTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTemplatesTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TemplateLegacy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTemplatesTemplateIDBuildsBuildIDResponse parses an HTTP response from a PostTemplatesTemplateIDBuildsBuildIDWithResponse call
+func ParsePostTemplatesTemplateIDBuildsBuildIDResponse(rsp *http.Response) (*PostTemplatesTemplateIDBuildsBuildIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTemplatesTemplateIDBuildsBuildIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse parses an HTTP response from a GetTemplatesTemplateIDBuildsBuildIDLogsWithResponse call
+func ParseGetTemplatesTemplateIDBuildsBuildIDLogsResponse(rsp *http.Response) (*GetTemplatesTemplateIDBuildsBuildIDLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesTemplateIDBuildsBuildIDLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateBuildLogsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse parses an HTTP response from a GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse call
+func ParseGetTemplatesTemplateIDBuildsBuildIDStatusResponse(rsp *http.Response) (*GetTemplatesTemplateIDBuildsBuildIDStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesTemplateIDBuildsBuildIDStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateBuildInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesTemplateIDFilesHashResponse parses an HTTP response from a GetTemplatesTemplateIDFilesHashWithResponse call
+func ParseGetTemplatesTemplateIDFilesHashResponse(rsp *http.Response) (*GetTemplatesTemplateIDFilesHashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesTemplateIDFilesHashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TemplateBuildFileUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesTemplateIDTagsResponse parses an HTTP response from a GetTemplatesTemplateIDTagsWithResponse call
+func ParseGetTemplatesTemplateIDTagsResponse(rsp *http.Response) (*GetTemplatesTemplateIDTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesTemplateIDTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TemplateTag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2SandboxesResponse parses an HTTP response from a GetV2SandboxesWithResponse call
+func ParseGetV2SandboxesResponse(rsp *http.Response) (*GetV2SandboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2SandboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ListedSandbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2SandboxesSandboxIDLogsResponse parses an HTTP response from a GetV2SandboxesSandboxIDLogsWithResponse call
+func ParseGetV2SandboxesSandboxIDLogsResponse(rsp *http.Response) (*GetV2SandboxesSandboxIDLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2SandboxesSandboxIDLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SandboxLogsV2Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV2TemplatesResponse parses an HTTP response from a GetV2TemplatesWithResponse call
+func ParseGetV2TemplatesResponse(rsp *http.Response) (*GetV2TemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV2TemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Template
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2TemplatesResponse parses an HTTP response from a PostV2TemplatesWithResponse call
+func ParsePostV2TemplatesResponse(rsp *http.Response) (*PostV2TemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2TemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TemplateLegacy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV2TemplatesTemplateIDResponse parses an HTTP response from a PatchV2TemplatesTemplateIDWithResponse call
+func ParsePatchV2TemplatesTemplateIDResponse(rsp *http.Response) (*PatchV2TemplatesTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV2TemplatesTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplateUpdateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse parses an HTTP response from a PostV2TemplatesTemplateIDBuildsBuildIDWithResponse call
+func ParsePostV2TemplatesTemplateIDBuildsBuildIDResponse(rsp *http.Response) (*PostV2TemplatesTemplateIDBuildsBuildIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV2TemplatesTemplateIDBuildsBuildIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV3TemplatesResponse parses an HTTP response from a PostV3TemplatesWithResponse call
+func ParsePostV3TemplatesResponse(rsp *http.Response) (*PostV3TemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV3TemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TemplateRequestResponseV3
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVolumesResponse parses an HTTP response from a GetVolumesWithResponse call
+func ParseGetVolumesResponse(rsp *http.Response) (*GetVolumesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVolumesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Volume
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostVolumesResponse parses an HTTP response from a PostVolumesWithResponse call
+func ParsePostVolumesResponse(rsp *http.Response) (*PostVolumesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostVolumesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VolumeAndToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVolumesVolumeIDResponse parses an HTTP response from a DeleteVolumesVolumeIDWithResponse call
+func ParseDeleteVolumesVolumeIDResponse(rsp *http.Response) (*DeleteVolumesVolumeIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVolumesVolumeIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVolumesVolumeIDResponse parses an HTTP response from a GetVolumesVolumeIDWithResponse call
+func ParseGetVolumesVolumeIDResponse(rsp *http.Response) (*GetVolumesVolumeIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVolumesVolumeIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VolumeAndToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksResponse parses an HTTP response from a PostWebhooksWithResponse call
+func ParsePostWebhooksResponse(rsp *http.Response) (*PostWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedWebhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksWebhookIDResponse parses an HTTP response from a DeleteWebhooksWebhookIDWithResponse call
+func ParseDeleteWebhooksWebhookIDResponse(rsp *http.Response) (*DeleteWebhooksWebhookIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksWebhookIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksWebhookIDTestResponse parses an HTTP response from a PostWebhooksWebhookIDTestWithResponse call
+func ParsePostWebhooksWebhookIDTestResponse(rsp *http.Response) (*PostWebhooksWebhookIDTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksWebhookIDTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
@@ -11594,6 +15312,12 @@ func ParseGetVolumesVolumeIDResponse(rsp *http.Response) (*GetVolumesVolumeIDRes
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Sandbox identity signing keys
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(c *gin.Context)
+	// Sandbox identity OpenID configuration
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(c *gin.Context)
 	// Create access token
 	// (POST /access-tokens)
 	PostAccessTokens(c *gin.Context)
@@ -11651,9 +15375,36 @@ type ServerInterface interface {
 	// Sandbox
 	// (GET /sandboxes/{sandboxID})
 	GetSandboxesSandboxID(c *gin.Context, sandboxID SandboxID)
+	// Update sandbox bandwidth limits
+	// (PUT /sandboxes/{sandboxID}/bandwidth)
+	PutSandboxesSandboxIDBandwidth(c *gin.Context, sandboxID SandboxID)
+	// List sandbox checkpoints
+	// (GET /sandboxes/{sandboxID}/checkpoints)
+	GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID SandboxID)
+	// Create sandbox checkpoint
+	// (POST /sandboxes/{sandboxID}/checkpoints)
+	PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID SandboxID)
+	// Delete sandbox checkpoint
+	// (DELETE /sandboxes/{sandboxID}/checkpoints/{checkpointID})
+	DeleteSandboxesSandboxIDCheckpointsCheckpointID(c *gin.Context, sandboxID SandboxID, checkpointID CheckpointID)
+	// Restore sandbox checkpoint
+	// (POST /sandboxes/{sandboxID}/checkpoints/{checkpointID}/restore)
+	PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c *gin.Context, sandboxID SandboxID, checkpointID CheckpointID)
 	// Connect sandbox
 	// (POST /sandboxes/{sandboxID}/connect)
 	PostSandboxesSandboxIDConnect(c *gin.Context, sandboxID SandboxID)
+	// Pause sandbox on behalf of the sandbox
+	// (POST /sandboxes/{sandboxID}/control/pause)
+	PostSandboxesSandboxIDControlPause(c *gin.Context, sandboxID SandboxID)
+	// Create sandbox on behalf of the sandbox
+	// (POST /sandboxes/{sandboxID}/control/sandboxes)
+	PostSandboxesSandboxIDControlSandboxes(c *gin.Context, sandboxID SandboxID)
+	// Set sandbox timeout on behalf of the sandbox
+	// (POST /sandboxes/{sandboxID}/control/timeout)
+	PostSandboxesSandboxIDControlTimeout(c *gin.Context, sandboxID SandboxID)
+	// Update sandbox environment variables
+	// (PATCH /sandboxes/{sandboxID}/envs)
+	PatchSandboxesSandboxIDEnvs(c *gin.Context, sandboxID SandboxID)
 	// Fork sandbox
 	// (POST /sandboxes/{sandboxID}/fork)
 	PostSandboxesSandboxIDFork(c *gin.Context, sandboxID SandboxID)
@@ -11666,12 +15417,18 @@ type ServerInterface interface {
 	// Update sandbox network
 	// (PUT /sandboxes/{sandboxID}/network)
 	PutSandboxesSandboxIDNetwork(c *gin.Context, sandboxID SandboxID)
+	// Sandbox network logs
+	// (GET /sandboxes/{sandboxID}/network/logs)
+	GetSandboxesSandboxIDNetworkLogs(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDNetworkLogsParams)
 	// Pause sandbox
 	// (POST /sandboxes/{sandboxID}/pause)
 	PostSandboxesSandboxIDPause(c *gin.Context, sandboxID SandboxID)
 	// Refresh sandbox
 	// (POST /sandboxes/{sandboxID}/refreshes)
 	PostSandboxesSandboxIDRefreshes(c *gin.Context, sandboxID SandboxID)
+	// Resize sandbox resources
+	// (PATCH /sandboxes/{sandboxID}/resources)
+	PatchSandboxesSandboxIDResources(c *gin.Context, sandboxID SandboxID)
 	// Resume sandbox
 	// (POST /sandboxes/{sandboxID}/resume)
 	PostSandboxesSandboxIDResume(c *gin.Context, sandboxID SandboxID)
@@ -11681,6 +15438,15 @@ type ServerInterface interface {
 	// Set sandbox timeout
 	// (POST /sandboxes/{sandboxID}/timeout)
 	PostSandboxesSandboxIDTimeout(c *gin.Context, sandboxID SandboxID)
+	// List team secrets
+	// (GET /secrets)
+	GetSecrets(c *gin.Context)
+	// Delete team secret
+	// (DELETE /secrets/{secretName})
+	DeleteSecretsSecretName(c *gin.Context, secretName SecretName)
+	// Set team secret
+	// (PUT /secrets/{secretName})
+	PutSecretsSecretName(c *gin.Context, secretName SecretName)
 	// List snapshots
 	// (GET /snapshots)
 	GetSnapshots(c *gin.Context, params GetSnapshotsParams)
@@ -11768,22 +15534,452 @@ type ServerInterface interface {
 	// Team volume
 	// (GET /volumes/{volumeID})
 	GetVolumesVolumeID(c *gin.Context, volumeID VolumeID)
+	// List team webhooks
+	// (GET /webhooks)
+	GetWebhooks(c *gin.Context)
+	// Create team webhook
+	// (POST /webhooks)
+	PostWebhooks(c *gin.Context)
+	// Delete team webhook
+	// (DELETE /webhooks/{webhookID})
+	DeleteWebhooksWebhookID(c *gin.Context, webhookID WebhookID)
+	// Test team webhook
+	// (POST /webhooks/{webhookID}/test)
+	PostWebhooksWebhookIDTest(c *gin.Context, webhookID WebhookID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWellKnownJwksJson(c)
+}
+
+// GetWellKnownOpenidConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownOpenidConfiguration(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWellKnownOpenidConfiguration(c)
+}
+
+// PostAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostAccessTokens(c *gin.Context) {
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAccessTokens(c)
+}
+
+// DeleteAccessTokensAccessTokenID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccessTokensAccessTokenID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "accessTokenID" -------------
+	var accessTokenID AccessTokenID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accessTokenID", c.Param("accessTokenID"), &accessTokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessTokenID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAccessTokensAccessTokenID(c, accessTokenID)
+}
+
+// PostAdminTeamsTeamIDApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDApiKeys(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminTeamsTeamIDApiKeys(c, teamID)
+}
+
+// DeleteAdminTeamsTeamIDApiKeysApiKeyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminTeamsTeamIDApiKeysApiKeyID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminTeamsTeamIDApiKeysApiKeyID(c, teamID, apiKeyID)
+}
+
+// PostAdminTeamsTeamIDBuildsCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDBuildsCancel(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminTeamsTeamIDBuildsCancel(c, teamID)
+}
+
+// PostAdminTeamsTeamIDSandboxesKill operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDSandboxesKill(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminTeamsTeamIDSandboxesKill(c, teamID)
+}
+
+// GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiKeys(c)
+}
+
+// PostApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiKeys(c *gin.Context) {
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiKeys(c)
+}
+
+// DeleteApiKeysApiKeyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKeysApiKeyID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiKeysApiKeyID(c, apiKeyID)
+}
+
+// PatchApiKeysApiKeyID operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiKeysApiKeyID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchApiKeysApiKeyID(c, apiKeyID)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealth(c)
+}
+
+// GetNodes operation middleware
+func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesParams
+
+	// ------------- Optional query parameter "clusterID" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "clusterID", c.Request.URL.Query(), &params.ClusterID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetNodes(c, params)
+}
+
+// GetNodesNodeID operation middleware
+func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "nodeID" -------------
+	var nodeID NodeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesNodeIDParams
+
+	// ------------- Optional query parameter "clusterID" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "clusterID", c.Request.URL.Query(), &params.ClusterID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetNodesNodeID(c, nodeID, params)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// PostNodesNodeID operation middleware
+func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "nodeID" -------------
+	var nodeID NodeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostNodesNodeID(c, nodeID)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// GetSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 
-// PostAccessTokens operation middleware
-func (siw *ServerInterfaceWrapper) PostAccessTokens(c *gin.Context) {
+	var err error
+	_ = err
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
 
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
 
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesParams
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -11791,26 +15987,94 @@ func (siw *ServerInterfaceWrapper) PostAccessTokens(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAccessTokens(c)
+	siw.Handler.GetSandboxes(c, params)
 }
 
-// DeleteAccessTokensAccessTokenID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAccessTokensAccessTokenID(c *gin.Context) {
+// PostSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxes(c)
+}
+
+// GetSandboxesMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "accessTokenID" -------------
-	var accessTokenID AccessTokenID
+	c.Set(string(ApiKeyAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "accessTokenID", c.Param("accessTokenID"), &accessTokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesMetricsParams
+
+	// ------------- Required query parameter "sandbox_ids" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, true, "sandbox_ids", c.Request.URL.Query(), &params.SandboxIds, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessTokenID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandbox_ids: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesMetrics(c, params)
+}
+
+// DeleteSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
 
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -11818,26 +16082,34 @@ func (siw *ServerInterfaceWrapper) DeleteAccessTokensAccessTokenID(c *gin.Contex
 		}
 	}
 
-	siw.Handler.DeleteAccessTokensAccessTokenID(c, accessTokenID)
+	siw.Handler.DeleteSandboxesSandboxID(c, sandboxID)
 }
 
-// PostAdminTeamsTeamIDApiKeys operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDApiKeys(c *gin.Context) {
+// GetSandboxesSandboxID operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID openapi_types.UUID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
 	c.Set(string(AdminApiKeyAuthScopes), []string{})
 
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -11845,35 +16117,69 @@ func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDApiKeys(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAdminTeamsTeamIDApiKeys(c, teamID)
+	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
 }
 
-// DeleteAdminTeamsTeamIDApiKeysApiKeyID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAdminTeamsTeamIDApiKeysApiKeyID(c *gin.Context) {
+// PutSandboxesSandboxIDBandwidth operation middleware
+func (siw *ServerInterfaceWrapper) PutSandboxesSandboxIDBandwidth(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID openapi_types.UUID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Path parameter "apiKeyID" -------------
-	var apiKeyID ApiKeyID
+	c.Set(string(ApiKeyAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSandboxesSandboxIDBandwidth(c, sandboxID)
+}
+
+// GetSandboxesSandboxIDCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDCheckpoints(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
 	c.Set(string(AdminApiKeyAuthScopes), []string{})
 
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -11881,26 +16187,34 @@ func (siw *ServerInterfaceWrapper) DeleteAdminTeamsTeamIDApiKeysApiKeyID(c *gin.
 		}
 	}
 
-	siw.Handler.DeleteAdminTeamsTeamIDApiKeysApiKeyID(c, teamID, apiKeyID)
+	siw.Handler.GetSandboxesSandboxIDCheckpoints(c, sandboxID)
 }
 
-// PostAdminTeamsTeamIDBuildsCancel operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDBuildsCancel(c *gin.Context) {
+// PostSandboxesSandboxIDCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDCheckpoints(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID openapi_types.UUID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
 	c.Set(string(AdminApiKeyAuthScopes), []string{})
 
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -11908,38 +16222,34 @@ func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDBuildsCancel(c *gin.Conte
 		}
 	}
 
-	siw.Handler.PostAdminTeamsTeamIDBuildsCancel(c, teamID)
+	siw.Handler.PostSandboxesSandboxIDCheckpoints(c, sandboxID)
 }
 
-// PostAdminTeamsTeamIDSandboxesKill operation middleware
-func (siw *ServerInterfaceWrapper) PostAdminTeamsTeamIDSandboxesKill(c *gin.Context) {
+// DeleteSandboxesSandboxIDCheckpointsCheckpointID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxIDCheckpointsCheckpointID(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID openapi_types.UUID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(string(AdminApiKeyAuthScopes), []string{})
+	// ------------- Path parameter "checkpointID" -------------
+	var checkpointID CheckpointID
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "checkpointID", c.Param("checkpointID"), &checkpointID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checkpointID: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostAdminTeamsTeamIDSandboxesKill(c, teamID)
-}
-
-// GetApiKeys operation middleware
-func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
+	c.Set(string(ApiKeyAuthScopes), []string{})
 
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
 
@@ -11956,41 +16266,35 @@ func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiKeys(c)
+	siw.Handler.DeleteSandboxesSandboxIDCheckpointsCheckpointID(c, sandboxID, checkpointID)
 }
 
-// PostApiKeys operation middleware
-func (siw *ServerInterfaceWrapper) PostApiKeys(c *gin.Context) {
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c *gin.Context) {
 
-	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+	var err error
+	_ = err
 
-	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostApiKeys(c)
-}
-
-// DeleteApiKeysApiKeyID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiKeysApiKeyID(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "apiKeyID" -------------
-	var apiKeyID ApiKeyID
+	// ------------- Path parameter "checkpointID" -------------
+	var checkpointID CheckpointID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "checkpointID", c.Param("checkpointID"), &checkpointID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checkpointID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
 
 	c.Set(string(AuthProviderTeamAuthScopes), []string{})
@@ -12006,24 +16310,26 @@ func (siw *ServerInterfaceWrapper) DeleteApiKeysApiKeyID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteApiKeysApiKeyID(c, apiKeyID)
+	siw.Handler.PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c, sandboxID, checkpointID)
 }
 
-// PatchApiKeysApiKeyID operation middleware
-func (siw *ServerInterfaceWrapper) PatchApiKeysApiKeyID(c *gin.Context) {
+// PostSandboxesSandboxIDConnect operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDConnect(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "apiKeyID" -------------
-	var apiKeyID ApiKeyID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
 
 	c.Set(string(AuthProviderTeamAuthScopes), []string{})
@@ -12039,11 +16345,25 @@ func (siw *ServerInterfaceWrapper) PatchApiKeysApiKeyID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchApiKeysApiKeyID(c, apiKeyID)
+	siw.Handler.PostSandboxesSandboxIDConnect(c, sandboxID)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+// PostSandboxesSandboxIDControlPause operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDControlPause(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(SandboxControlAuthScopes), []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -12052,28 +16372,26 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealth(c)
+	siw.Handler.PostSandboxesSandboxIDControlPause(c, sandboxID)
 }
 
-// GetNodes operation middleware
-func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
+// PostSandboxesSandboxIDControlSandboxes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDControlSandboxes(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	c.Set(string(AdminApiKeyAuthScopes), []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodesParams
-
-	// ------------- Optional query parameter "clusterID" -------------
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "clusterID", c.Request.URL.Query(), &params.ClusterID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(SandboxControlAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12081,36 +16399,25 @@ func (siw *ServerInterfaceWrapper) GetNodes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNodes(c, params)
+	siw.Handler.PostSandboxesSandboxIDControlSandboxes(c, sandboxID)
 }
 
-// GetNodesNodeID operation middleware
-func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
+// PostSandboxesSandboxIDControlTimeout operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDControlTimeout(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "nodeID" -------------
-	var nodeID NodeID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(string(AdminApiKeyAuthScopes), []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodesNodeIDParams
-
-	// ------------- Optional query parameter "clusterID" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "clusterID", c.Request.URL.Query(), &params.ClusterID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clusterID: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(string(SandboxControlAuthScopes), []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -12119,26 +16426,34 @@ func (siw *ServerInterfaceWrapper) GetNodesNodeID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNodesNodeID(c, nodeID, params)
+	siw.Handler.PostSandboxesSandboxIDControlTimeout(c, sandboxID)
 }
 
-// PostNodesNodeID operation middleware
-func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
+// PatchSandboxesSandboxIDEnvs operation middleware
+func (siw *ServerInterfaceWrapper) PatchSandboxesSandboxIDEnvs(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "nodeID" -------------
-	var nodeID NodeID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nodeID", c.Param("nodeID"), &nodeID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nodeID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
 	c.Set(string(AdminApiKeyAuthScopes), []string{})
 
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12146,15 +16461,24 @@ func (siw *ServerInterfaceWrapper) PostNodesNodeID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostNodesNodeID(c, nodeID)
+	siw.Handler.PatchSandboxesSandboxIDEnvs(c, sandboxID)
 }
 
-// GetSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
+// PostSandboxesSandboxIDFork operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDFork(c *gin.Context) {
 
 	var err error
 	_ = err
 
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(string(ApiKeyAuthScopes), []string{})
 
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
@@ -12165,17 +16489,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesParams
-
-	// ------------- Optional query parameter "metadata" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12183,11 +16496,23 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxes(c, params)
+	siw.Handler.PostSandboxesSandboxIDFork(c, sandboxID)
 }
 
-// PostSandboxes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
+// GetSandboxesSandboxIDLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(string(ApiKeyAuthScopes), []string{})
 
@@ -12199,6 +16524,25 @@ func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesSandboxIDLogsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12206,15 +16550,24 @@ func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxes(c)
+	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
 }
 
-// GetSandboxesMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
+// GetSandboxesSandboxIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
 
 	var err error
 	_ = err
 
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(string(ApiKeyAuthScopes), []string{})
 
 	c.Set(string(AuthProviderBearerAuthScopes), []string{})
@@ -12226,13 +16579,21 @@ func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesMetricsParams
+	var params GetSandboxesSandboxIDMetricsParams
 
-	// ------------- Required query parameter "sandbox_ids" -------------
+	// ------------- Optional query parameter "start" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", false, true, "sandbox_ids", c.Request.URL.Query(), &params.SandboxIds, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandbox_ids: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "end", c.Request.URL.Query(), &params.End, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -12243,11 +16604,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesMetrics(c, params)
+	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID, params)
 }
 
-// DeleteSandboxesSandboxID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
+// PutSandboxesSandboxIDNetwork operation middleware
+func (siw *ServerInterfaceWrapper) PutSandboxesSandboxIDNetwork(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12278,11 +16639,11 @@ func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSandboxesSandboxID(c, sandboxID)
+	siw.Handler.PutSandboxesSandboxIDNetwork(c, sandboxID)
 }
 
-// GetSandboxesSandboxID operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
+// GetSandboxesSandboxIDNetworkLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDNetworkLogs(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12306,40 +16667,48 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesSandboxIDNetworkLogsParams
 
-// PostSandboxesSandboxIDConnect operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDConnect(c *gin.Context) {
+	// ------------- Optional query parameter "cursor" -------------
 
-	var err error
-	_ = err
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(string(ApiKeyAuthScopes), []string{})
+	// ------------- Optional query parameter "direction" -------------
 
-	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "direction", c.Request.URL.Query(), &params.Direction, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter direction: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+	// ------------- Optional query parameter "decision" -------------
 
-	c.Set(string(AdminApiKeyAuthScopes), []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "decision", c.Request.URL.Query(), &params.Decision, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter decision: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	c.Set(string(AdminTeamAuthScopes), []string{})
+	// ------------- Optional query parameter "host" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "host", c.Request.URL.Query(), &params.Host, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter host: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -12348,11 +16717,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDConnect(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDConnect(c, sandboxID)
+	siw.Handler.GetSandboxesSandboxIDNetworkLogs(c, sandboxID, params)
 }
 
-// PostSandboxesSandboxIDFork operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDFork(c *gin.Context) {
+// PostSandboxesSandboxIDPause operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12383,11 +16752,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDFork(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDFork(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
 }
 
-// GetSandboxesSandboxIDLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
+// PostSandboxesSandboxIDRefreshes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12411,25 +16780,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesSandboxIDLogsParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12437,11 +16787,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
+	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
 }
 
-// GetSandboxesSandboxIDMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
+// PatchSandboxesSandboxIDResources operation middleware
+func (siw *ServerInterfaceWrapper) PatchSandboxesSandboxIDResources(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12465,25 +16815,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 
 	c.Set(string(AdminTeamAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesSandboxIDMetricsParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "end" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "end", c.Request.URL.Query(), &params.End, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12491,11 +16822,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID, params)
+	siw.Handler.PatchSandboxesSandboxIDResources(c, sandboxID)
 }
 
-// PutSandboxesSandboxIDNetwork operation middleware
-func (siw *ServerInterfaceWrapper) PutSandboxesSandboxIDNetwork(c *gin.Context) {
+// PostSandboxesSandboxIDResume operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12526,11 +16857,11 @@ func (siw *ServerInterfaceWrapper) PutSandboxesSandboxIDNetwork(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PutSandboxesSandboxIDNetwork(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
 }
 
-// PostSandboxesSandboxIDPause operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
+// PostSandboxesSandboxIDSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDSnapshots(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12561,11 +16892,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDSnapshots(c, sandboxID)
 }
 
-// PostSandboxesSandboxIDRefreshes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
+// PostSandboxesSandboxIDTimeout operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -12596,23 +16927,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Contex
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
 }
 
-// PostSandboxesSandboxIDResume operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetSecrets operation middleware
+func (siw *ServerInterfaceWrapper) GetSecrets(c *gin.Context) {
 
 	c.Set(string(ApiKeyAuthScopes), []string{})
 
@@ -12631,21 +16950,21 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
+	siw.Handler.GetSecrets(c)
 }
 
-// PostSandboxesSandboxIDSnapshots operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDSnapshots(c *gin.Context) {
+// DeleteSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSecretsSecretName(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -12666,21 +16985,21 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDSnapshots(c *gin.Contex
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDSnapshots(c, sandboxID)
+	siw.Handler.DeleteSecretsSecretName(c, secretName)
 }
 
-// PostSandboxesSandboxIDTimeout operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
+// PutSecretsSecretName operation middleware
+func (siw *ServerInterfaceWrapper) PutSecretsSecretName(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "sandboxID" -------------
-	var sandboxID SandboxID
+	// ------------- Path parameter "secretName" -------------
+	var secretName SecretName
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "secretName", c.Param("secretName"), &secretName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -12701,7 +17020,7 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
+	siw.Handler.PutSecretsSecretName(c, secretName)
 }
 
 // GetSnapshots operation middleware
@@ -13608,6 +17927,22 @@ func (siw *ServerInterfaceWrapper) GetV2SandboxesSandboxIDLogs(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "processTag" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "processTag", c.Request.URL.Query(), &params.ProcessTag, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter processTag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "stream" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stream", c.Request.URL.Query(), &params.Stream, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter stream: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -13916,6 +18251,122 @@ func (siw *ServerInterfaceWrapper) GetVolumesVolumeID(c *gin.Context) {
 	siw.Handler.GetVolumesVolumeID(c, volumeID)
 }
 
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(c *gin.Context) {
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooks(c)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(c *gin.Context) {
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooks(c)
+}
+
+// DeleteWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookID(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhooksWebhookID(c, webhookID)
+}
+
+// PostWebhooksWebhookIDTest operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksWebhookIDTest(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(ApiKeyAuthScopes), []string{})
+
+	c.Set(string(AuthProviderBearerAuthScopes), []string{})
+
+	c.Set(string(AuthProviderTeamAuthScopes), []string{})
+
+	c.Set(string(AdminApiKeyAuthScopes), []string{})
+
+	c.Set(string(AdminTeamAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooksWebhookIDTest(c, webhookID)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -13943,6 +18394,8 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(options.BaseURL+"/.well-known/openid-configuration", wrapper.GetWellKnownOpenidConfiguration)
 	router.POST(options.BaseURL+"/access-tokens", wrapper.PostAccessTokens)
 	router.DELETE(options.BaseURL+"/access-tokens/:accessTokenID", wrapper.DeleteAccessTokensAccessTokenID)
 	router.POST(options.BaseURL+"/admin/teams/:teamID/api-keys", wrapper.PostAdminTeamsTeamIDApiKeys)
@@ -13962,16 +18415,30 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/sandboxes/metrics", wrapper.GetSandboxesMetrics)
 	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID", wrapper.DeleteSandboxesSandboxID)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID", wrapper.GetSandboxesSandboxID)
+	router.PUT(options.BaseURL+"/sandboxes/:sandboxID/bandwidth", wrapper.PutSandboxesSandboxIDBandwidth)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/checkpoints", wrapper.GetSandboxesSandboxIDCheckpoints)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/checkpoints", wrapper.PostSandboxesSandboxIDCheckpoints)
+	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID/checkpoints/:checkpointID", wrapper.DeleteSandboxesSandboxIDCheckpointsCheckpointID)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/checkpoints/:checkpointID/restore", wrapper.PostSandboxesSandboxIDCheckpointsCheckpointIDRestore)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/connect", wrapper.PostSandboxesSandboxIDConnect)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/control/pause", wrapper.PostSandboxesSandboxIDControlPause)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/control/sandboxes", wrapper.PostSandboxesSandboxIDControlSandboxes)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/control/timeout", wrapper.PostSandboxesSandboxIDControlTimeout)
+	router.PATCH(options.BaseURL+"/sandboxes/:sandboxID/envs", wrapper.PatchSandboxesSandboxIDEnvs)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/fork", wrapper.PostSandboxesSandboxIDFork)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs", wrapper.GetSandboxesSandboxIDLogs)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/metrics", wrapper.GetSandboxesSandboxIDMetrics)
 	router.PUT(options.BaseURL+"/sandboxes/:sandboxID/network", wrapper.PutSandboxesSandboxIDNetwork)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/network/logs", wrapper.GetSandboxesSandboxIDNetworkLogs)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/pause", wrapper.PostSandboxesSandboxIDPause)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/refreshes", wrapper.PostSandboxesSandboxIDRefreshes)
+	router.PATCH(options.BaseURL+"/sandboxes/:sandboxID/resources", wrapper.PatchSandboxesSandboxIDResources)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/resume", wrapper.PostSandboxesSandboxIDResume)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/snapshots", wrapper.PostSandboxesSandboxIDSnapshots)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/timeout", wrapper.PostSandboxesSandboxIDTimeout)
+	router.GET(options.BaseURL+"/secrets", wrapper.GetSecrets)
+	router.DELETE(options.BaseURL+"/secrets/:secretName", wrapper.DeleteSecretsSecretName)
+	router.PUT(options.BaseURL+"/secrets/:secretName", wrapper.PutSecretsSecretName)
 	router.GET(options.BaseURL+"/snapshots", wrapper.GetSnapshots)
 	router.GET(options.BaseURL+"/teams", wrapper.GetTeams)
 	router.GET(options.BaseURL+"/teams/:teamID/metrics", wrapper.GetTeamsTeamIDMetrics)
@@ -14001,6 +18468,10 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/volumes", wrapper.PostVolumes)
 	router.DELETE(options.BaseURL+"/volumes/:volumeID", wrapper.DeleteVolumesVolumeID)
 	router.GET(options.BaseURL+"/volumes/:volumeID", wrapper.GetVolumesVolumeID)
+	router.GET(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	router.DELETE(options.BaseURL+"/webhooks/:webhookID", wrapper.DeleteWebhooksWebhookID)
+	router.POST(options.BaseURL+"/webhooks/:webhookID/test", wrapper.PostWebhooksWebhookIDTest)
 }
 
 // Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
@@ -14008,205 +18479,284 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L17Uxw5sij+VRT9OxHH3l/TYDyzcYaJ/QODPcsZbBOAPXvu2HeOqMru1lKvlVRAr4PvfkMpqUpVpXo1",
-	"bw+xETumS89UZiqVz2+TII2zNIFEisnOt0lGOY1BAse/aBCAEKfpOSQH++oHlkx2JhmVy8l0ktAYJju1",
-	"NtMJh3/ljEM42ZE8h+lEBEuIqeosV5nqICRnyWJyfT2d0Iz9Cqv2oe3ncaOe5SwKWwe1X8eNmaQhtA5p",
-	"Po4bMaMLllDJ0uSQxUyqRiGIgLNM/TbZmbynVyzOY5Lk8Rlwks4JkxALIlPCQeY8IRlwktEFTKZ6Vf/K",
-	"ga/KZUU4rruKEOY0j+Rk59XW1nQyT3lM5WRnwhL5ensyncR6RvM5Zon5a2qXzxIJC+C19X+AK4nn39zD",
-	"Xs5FytWShaRcErkEEjEhyZynccuyk2K4bgAKmoRn6VXrqZTfxx2MBBq3Dmo+jh0xziIqoWPUosG4kS/S",
-	"KI/bxy0+jxn1WjUWWZoIQCbww9aW+k+QJhISxFOaZREL8Ow3/ylSPPdyvP/gMJ/sTP6/zZKzbOqvYvMt",
-	"5ynXc1QR5Q0NiVoiCDm5nk5+2Hp193Pu5nIJiTSjEtDt1OSv737ydyk/Y2EIiZ7xh7uf8UMqyTzNk1DP",
-	"+NPdz7iXJvOIBfpEX90DFv2SJqAm+/E+UPYE+AVwizbXlqSQZnZ/OzmGBROSr/BW5WkGXDJNUPRS7OKl",
-	"qS63sMk0d387IboB+RVW5GCfzFNO3u4dE1rB2Mm0TrtTNbaaOE38w+pv5HIJHJAZq1G5WSlhgkRpQCWE",
-	"LUOfQMBBFov3z6EbuTsYvnz9Q33U01UG6v4rFtoYCBJ1Uf2u1jj5OvUwypL9/a6/TuvH4N2gC9By3PTs",
-	"n6CxejeMWfJGSRR7NAkgOgaB92v9yAP8GkG4l+aJ567/UNzxKJ4IInJcwzyPohUpek+aN/F0MqdsxMBy",
-	"SSXRXdS1rIeeeG94F2a1DVRn/WohcaKv3F9Z1AqJgas1lzc0FnzOosgLBvVh1MAVEOve/XBwZ/EAQQi2",
-	"SE7NbX5KF+LY3GkNOEi6EB5MpwsU8CgOpP6liNSKB0pgUiKg59YuFk45pyv8m/IFSN8U6vdiTMIS8gXl",
-	"hR1JF18mxEiFvUSkh5/qjZSbh9DdfnPfjnBeXddBqCh6zvQxqW1jUwWKNGCKKZFLJpfqiwCCszoibJ4z",
-	"L9Pyg9kuFYex060B5QZMcFF2iwooyBsO08XbxHsVRHABUd8NdJguDrHd9XQSgxBK4m9s6TBdEPOR2HvP",
-	"Aw8hIWt2PpGQKUQooZ7xFNk3hwhBbzAxShcEcCs+WLMYhKSxZ4JT+8kC2x2oOMSQSthQo/RjXzFVCZKp",
-	"gWYB9hNJZS6OgZr7vgZ6fSjmr+Jl9PvXqQeyoFvWwSFwBsL1FA7edB1nFSU8lNt6xu/N+Vo6qM4/JUHO",
-	"OSQyWhEOWcolSxYkTSJ9AaOcYnqMxAyHBfeejF28OoW9o08t/Hjv6BMJUg4Cl4Zb0Xx54nuWdjxEp0rI",
-	"TCCQ5urxMFoWQ5pLP06muVR4LyBIk1DgqxRXYyBJVGdC5xI4uVyyYOkulYhlmkchgauMcehc+FbvvWJX",
-	"6RMy9jgopNstFS0eAcO0kT20p7U1RKpRCHbSAtQQGpxOWDiEb7tzDOHRMRXnfURTzvKeinOWLPZBUhYJ",
-	"1V8/dhtXPo2hZUVNzuXXXpwugRgJTIO3Z6DameJucXF2BrPXqXNcX8sDPgUa7x4dGMF6vfPdPTog57Aa",
-	"f7Rmgjc4N42ij/PJzu/dZ6LW+0koZP46nSR5FNGzCLR+YTCumPUOQZNz34PjmF6SCxrl0BywMUBEhfwk",
-	"wLOuQyoMrcslEwUQL6kguUCm5wVidc8Pgtmt2/Xhom5oUNAgZhUT9yECCYME2P61OQLVQLnMir8hLmN9",
-	"QcwSnRVN95k4fw+Ss8AjkYZwwQLPVvbxd2LHqi9gziIQKyEhPvU+Wt8V34nqS17AbDGbEriSP0zJ1Vy8",
-	"9LJCdV0epcx3Z75X30imPloIhwyP0sPPJI3erCT4YKy+EZHRAGX/M2zlkh9L5F9/8D6xFC20jKroap1B",
-	"69JDuf+pPZgGqN2FVPZqj/qE/Rvev/GcKBPnRLB/Q13qUGt+z96MvcOnk7fJxWdqbCVhyNQ8NDqqoZe7",
-	"hLfJBeNpEivh4oJyptiHTwhqUvPb5CL8DFx4dTvmg8ULSC5CwvMkURKgketbx55OtIqreeekoQevsTHB",
-	"bx5wNUHUKs3qWfsYl5nIFSvf8TQ+iOkCXBVbyNTYMUuo1HuJaZapAbXCrY37uoq66WQRZG0Nf9k7chry",
-	"YuaW1pAAp1HR43pqYbv6YFT+atfX00mawICr1l3m9bS7rbvS3rb1dSr4ugM0kEIAV1S5GwSKVP9b+LDx",
-	"RLchphH575OPHxDHf9k7ugcloDrFoUpAz3Z8IngdTg2wZFSIy5R7ZIsj80Xda7koWQ8vsenWIVCM/dUz",
-	"eC6A+y/vT+bL8KX6gVrMMC3h4oNqq+jTAK+SWSD8rAS9Iw5zduWBM/6O8ppieboHuagyRv3uSXmbiOjM",
-	"c5LPvfPo3284T9a9CXxwMwsd0RiSGEA3xkVR+BCShVx6pFz8vXuJbRezWXB1hqnnXHwwVEzlkAkJYesr",
-	"nUaM+hR16uch8mQQMUik1StmHLQZwwjmfa8Q3ds7bpYXKowuRlqoOq6n6ipyRJCuXo6wcq2ot/V9Ry6X",
-	"ULnGySWLIo/qofONB1URotPq5TTFSzxO+ap/Q+9tO+wjaUhlr4HN4MR727xu2u87vA7BBp0OYAxUqSCm",
-	"02CoCqlwctgmT7BtwyWgb4uFsh4VVFoTxURl5eYd52UK6AWAzwcksUFaSrPgz2XffvW368Xgel8UxOme",
-	"iENbDn5VqMeShIVxFYORq1jVuEdxqeDVQBF7Q4Zwli/QAWWeTqaTS8rx/kSR1HdpHqYLsc84BNIrfxef",
-	"HP22MV0ZLeEZGK8dPCO7jHnKLylXv5zR4Bz/2Zh9OrnaUO03LijeqkJ1rKznXTFK5ec3xZBmAydpzn0v",
-	"Xf37yKWr0045RakgU0ci0OYwfPl61lNnmPLXI2fA6+nkPQ2WLIEDdVjNZ0qW7/JgySQEMufgVzZTp4Xd",
-	"aKKfFj6e/47GLFr5h5rjtwGDvE9DH2aqMWL1aegQH7zCWjlM4uhc/GPV31TFBp111uabNuCqD+LqFGis",
-	"dSkepgo0JjF+NEYKx07TVMs7xqLuG7thPjJzjLEgOfapT4lP9uqcRIl6qpvWEr6wBgPBkgAIZGmwfFl7",
-	"DrfoUFB+8quajftdVZ9pnKIgtMsxz/kFu4CEqIH5BXUs4tpbsNNgVoWDXRIeb5B1qDIaHjDv945IkCZz",
-	"tsi59qFqKjJadKTlI+C9I1rUzV3qyzq6mlfb/+WD/Qe47DSi3NSQ4NNCftXzdgi+UXr5B55jAvIPPYFP",
-	"EI7SywIEMi1WsgRiO8/Ib0qeESBVgzmNBEwJk+QMlvQCrLgQA1FCTgYBm69YsiAhJKuPOfbZmuH/Nrcs",
-	"liUgL1N+bk55Vm75LE0joCgb0lymRzQXULGj6umbHndpTNWDNYpWJFOdqlKMNrWhyGMMYp0zagypzOtj",
-	"H3tpInkamakSmollKsk5S0IiqXoFNeRANcOGWV+a2MWQF2hN5RDBBU2k7lYsBiUynsNLcw7mABBtiuFI",
-	"yNPMHtuGFnvQKAuEJiExF6nQZlvVqtR9khfU+WsDW9jNvPyZcBB5jPo+SYI0CjfO0lQK8oID/uNlZX8o",
-	"RypJa0ZO8mBJaAmWgCZJqpBGrxqHhZCcrYjkdD5nAS40zoXUgoH+DFdZxAImo9WUiBTXUIwTpPEZS6z/",
-	"hhr1GHvNyL4+NdT5K9CRF/M8iogFi91cO97pgQbKsrtFhz1EZ/MksgrcnucQNlPPGs0jO19BQXbDB5Ch",
-	"u4E9P+jW5a4EBF5J6AR/JzSKiMGpII3jPLEusHhAjfeUA/NxzxbLDLstQa593rqn/+iTABSWROzCq703",
-	"F/JsvAr/AV5H5k7osvfenuXPvYX0eteZTUMJFXpS3TaTncn//Z1u/Ht34/9sbfz0x8bX//8/Bq7EIwJ8",
-	"MIaGmlwf5UICH4ZqprFXjE5jb3zFHv5uB0h5sAQhOZoPWu3j76x6sse90DzH0WlmqHVNdznRXokwZhZR",
-	"9Bk20zDTfNuzJK4+xjoZodNUM0Rrgu3qpdDBWmtLZdAIz05r+UoTdyMVyLQYq0ShpkHvqf45TUNyYiev",
-	"MSD/LNrocJAISZPAy0ytCYWZNqU2uPd8jIvXACBrB7myz96SJosh6jI1tXU+u6SCRFRIEujeg59jFwON",
-	"md2U6fNzaMJ36nCTAkLNbdeQrcTYJnVWOUIL5pSbLNhQlX6+Gu6nDR8+v/FgCSF6DHoYwiETyL90K+vh",
-	"zcIa4g/3GX5muc8s995Z7jMzfCLMsMKM+jmij/UV7NTHBB0PrXrkWmi1caKhSKSRiVnaO/rUhatFO1I4",
-	"Hw/E0KKnVje0eEDtou9SdSbzkB3pZuXaHH2+W2VAcOlGPZ7ugiw/Ah6Al8IVwNXgOfqbZ7qddrIfMnbI",
-	"xLnwedRJHcdjzlL7pdNgiaqIzbh0cBvqS+869nnus2W+gCO6gBP2b2g5NvUJD40IliwiIKpPpnY98tTs",
-	"XOIYFMn53ExLZOSmTTGbIC80lUi8yCVJUklWIAm+iCF8OXYViCr9GJRxKNG1XEwHOvVN7PewLbdeTsIS",
-	"9DJ5gR58ZIPMOcDQfWrKOu31c0w061iHDHWvT+0+jx+csa07yNqejxU21sJzKkTbXKDHouoAyFJlA0fq",
-	"Z+dDZR8pWbZ9UlyxTQtsLtwLevYl2SAhp0yJCjvl5ckEOUvzBF2kzoCIZS5JmF4mM3IgtaODogYaBJBJ",
-	"ksClI3fQJNQthEwzkirhgKJjBBP4LnNaciBhmuhFqLswPFtV16AnkewCIn2gU6RErcu0ORcw+wINVzhz",
-	"kCaSJTkQvGSThVWRzr5UvbFouFLwNzvHGxGDVvQfebIEGsnlSl/CamEDzagl+I/NHOUv++Vs5Y977rzl",
-	"z5+cFZS/nti1VA5aCwi3pq7pdfsfL8HVKMsMoHahtb8dXkhVU0a3UfKWjBkPq/9UwHpyTllhGlPmkZXf",
-	"UKFoXH10QtwLo5kxXzBhjGfsLBoUxQHJRT34qgYQN6gKbwIUbJKLsKpfv12frNtykrpPVyRzBp3QxJ9L",
-	"24ECpTmvkp+TC0ZJxtOr1az/BNdwU6r7GbVZkJqoUNrMPKZxZBJheSnNGq8ZSNRGwtEWrbemX32zdjyf",
-	"2r11kEF2XLtLMwOZR3Th36Q182mLqN+YZ9bSpge7KSdCK/uBsZPvttjYf1uCXAIv7OnWxq4e9aV9s9hw",
-	"ytWzxWy+ypFn5IO2Y9JEKPlBjaCkC2cUAbIDdR3IfA+erffOsO/BkfYR3ggRm0OwCqKhBvHDov39u/je",
-	"1ML97CH87CE8xEPYrPLtgoMQR0piaLu6Tz7u/Xryo5YqkFwt4AD7zsjHXOpn6uneEQI3TxLAtDRLnuYL",
-	"nRxAd9eiP946myEkKzJnkQQF7p+rkrsgeUIvKYcZ2Uc+uBFTidaceZReYvgx4RCnEsj+hxPyYvf0f47+",
-	"pjnmS9/9Ubs2w5B7r7rKXk0r9YxYpkLuZCmXJg2MjlolXyZa1oIrGmcRzII03nm19V9bXyaub44T3tIa",
-	"DfUx046FxK7ARke9OH63R179tP3TyymJ6RXZ/vFHrUyZ6cSENqhl+8cfR8U01Se0LW80YV13Y8DcIWS9",
-	"S/l5ayh1UGp77QNw2qo/m6f83BWvMIsTkvaM7EYRfhfkLE1lmc4Cvf6sOxU6aFU84ZggAc1krsRtVKFw",
-	"WFAeRgop0jnB5c3IWxoscXRt/IJQKBEIzW2EJSFkkISYfUSjeJrLINUOJKjiZcJkJdGKMiaF8awtnIV0",
-	"zkHMD+l/xw5OT3mjV3UCl00g3+SJ3YcVNlFWLbMB/q6gkyauG7Ba2oy8vaKBEiPVN8c6WeR5YUJJmDvl",
-	"tWXvMX2AmsdWUmBNTU9sydyUW9ja81SxUcMDsucV3H7gpdIJtUNXzKnxNbPdQhIiWRqxYFUEMJCzlaM2",
-	"mafNbVXdCv0vhMq1ShPXU9L/vEmT0xIhB+z/Y9G+wWvK5bnDdrCew3ThTxelya/qp49qzYgl0IAL/ugd",
-	"R33pyjn1QHmhcMFfK3BoycI1Z2D8K9qC+Ns8J0pg33smr4eCKq7fzbploFeFtOhPuFW1FfA80BcQhh81",
-	"BPcxEmlXbq0o9eUdObyNOXulX5x76sKhBrPP28fmEvRCry9RWcH81G5EAdFbg55vO84O3juPwWHJMGyP",
-	"3ndaZRJv5NF7N1ZnKEtrN7R/aJrYh2W7CLL8k4DwKGhJetZlUJ9HqZt40Uby6AdPpz03xMQmrdlX2m2c",
-	"qqPfYIu5Ulqtmp1W0z0aLH0Ba9pLzRhMX6CNHV1KXo6fohMaHebezkH9gHjfY+BtH/LPGeI2IvDMed47",
-	"dFOehXPUDmI5WOuShsOJqtoif8jVR19KQOtQiS0gJCEIaZLcG2cVVAUUZl79JtLQU3LgGRBK9g72j8lZ",
-	"lAbnxev5v2b4v83X218mL6eEkjPKgRwcFU/vWkNslXJCrXJUv1hNI+cV/mUyJV8mf5lVfnqJD0HcgM1b",
-	"SaNLuhIY7UQUHkII6lTTC+AkhISVTWejHEYRUEf5WcSCUw2T3mCsEx15RliF55NPx4fCCTguFb46FMqG",
-	"Djn5TvyStolmaz9bs93ylPAZW54F+E96vzwI7UuQpJKIPDMvWtUFFT08j8YCEUrd1MA7uqnNMrlLjIrh",
-	"76nwQMBCfpkKiXlLjJ4QNeBnUOqpMT7InIt5eHpThuJeu677MXKHIdrjXKuj60Cq5UkBvmFIQ3KaCMWV",
-	"NOgJZnrXD1dUpbFkYQ/z76enR5vq/06Kbc3Ir7CyziFqvJIWacZmDVJrEJql0AjzmxA0YlhfkoqDgOUp",
-	"G6jTszxILTMDHjNdG6PiP1J7Y3S8iF3YNZl+BUAufAxYLCxKeBV8x0T7NF/JBdTHnW6xliHbOXXnaNmT",
-	"4c0dh17s7gzmKUcFyCXlIUsWzV0tgYbAx70EqwtT2EXMMGo1LFF7UxxG8VrOQtBJg8waSzTcTUqnJd3f",
-	"SfOruD9eAVqJFtEAwhnBFDsad7NInZZelPiZCJ2Cn4NIoxytz0uaZZAIYw/cEGohBiACkhB9l1IbV7Ym",
-	"+n3KlGzSpl7/UAkltlbxHPtorlM4kRdW1GO9V1HJyWvPFam9gFHG0wsWQlgdf0Y+xkxKjdP4UCVBBJQL",
-	"wjwarWfB4HYFgycd1v7nFSQe47Xu8IKKf6fhAgpBLAeYjeJdH13NbN2IjyyqJUUAPmZImiMfsa6epjoF",
-	"usB48wiZWdHJsNUeFA9La1Boo1NrvlFMzhNAX01I4EstwIRNPQAh6kuKbAIVVbcvrQCTZTaBKYlSoXOO",
-	"VnIbTAvWbuoKgJjiNGkGCbHOsGmCx4xeRUwakT8pnGbsZUlemA6K3LTS/eXPrgp+auRaw3glZ4sFcK37",
-	"p/yMSU55kc1gSjjM0XVTmEQI9vKpJyfwsYkOxDqGOQexbD3k0FxRHtWL6zLWmnb+DMg5ZJJQNF6V9inX",
-	"UPb6rxVL2Tjj1InBnpEZoAuDK15NhWOUtTVaF4AZOZi7aScKBwZzoysZGpl6pN2tkeIV1qB9ThepsA8X",
-	"WpTz0CJMwR2cIidCAg3RmomZ0VEgUCOlCcy8CsdWsFhnjlH5xgz+W94QdjEHw5DaS8c8+YoGHteRm+Qk",
-	"8Pgf+PJfHtEy+2VbX38icRyvYyMgfmNy2ZphvDAjd12qw/ynOAtsiT7Hw70YH5VghqT82dJM7EAjW7m6",
-	"NrRMYnG4QZksCaJcPxWAxro16pwpJtJZGDlUfdwQUb7YjFcbdpSdi+2Xo2QZ23Ggh1fXYpdYLmhGPiku",
-	"X6x6E31KTQ4fzWsuqSjfEV2bMdeyksXVDXzJBKjbNRLkjAbnlg1xelmu52DfjEjPglfbr4shZr046EBi",
-	"ao7Ph4qnQGOPrhNLqHpkG1PiwPJntU9vxQ+xb0WQLmM4IoTxNzM7qw3pSNZDKgf4V1OW5uz3h/ON0HBH",
-	"M8U8DZkbYLm7/mog+1yXozVA509fVsNgj7e0yy1lSwzSxFzgJ+5d0swhWEZ2ll0cN6YauQ8waLp5LY69",
-	"ko+3tp5xMMqAG1FkkKHz2WLWZzHz4IHnjCzmIRdo8CyITYxFH4TfqoZ247nAeL1e4hzGX8xoPczFR216",
-	"9XqHJuDDHy5iSaQnik83vUERQFPwr9eTQCsMKs7aihOqznIYLY6onYhJKEr3PvNgUuSvE4d2BcuclcXz",
-	"+risPQKn3t66sSU9N2mpgKlAr3Rqf6DrdP1k72tHeVAhTzJ6mYwGFiLFzW7eNYJEWl4cH9zHRrHMF3X5",
-	"3Pih44aKb+G4t0SGBuo+CdY+HATR7dFok0Qr1xZ9tvJIl45oK9S5rMsJ6ifT4VmyVmyIjx60/WU9RNJd",
-	"13QpdCNBLF8bFPBhDtNlGO42XBKv00rlfCpsu0qP0+ICsdhbZYru5YP3R7vb3v2h3m3hRNdBmd24+0fO",
-	"f/Myv0MeGnd6qxiN4hpXyv3fAHOWMLEctyvbZ/C21mH14iZCw2BWVG7q5nyoZD1FHqxWvuLhTQ1KeMci",
-	"+JRFKfXQRMZBeNMtucxgziJkBFbvbTpZy2VgXOCa9J9zj0PkJx45gbU4dmk+yHGdqDbvhZNde2PDfi3j",
-	"GuTf1CsMLdCM61jX67m3GvMAx+tyAaPEEl5Upu5dYKWU9U0J7T5uCg9d+d3fK2s8TBfiRi7wd4kKbe7v",
-	"lR20Gm1unEtlnXD9NDgHrqjeY2QsvjlKofbp17kNkIHtxaEvtk2xtmAJwTnGw1Od+wmuIMhRd1+Vi8pE",
-	"Oq3MAhVO3rlQK3JLs9yy/tk5nzZE+rz9OFBpnfN3oTU2b8Ug+GlAtILudSfoBqiF6sCckf2i2xTdOLVT",
-	"njYu1+KG3Rok9w/74dWZZ2SPJsZcBoSiIQ+10UEapQkRkFHMNlp4mcWrDdv3y0S9VCo/7Vy8QkezgzmO",
-	"xIQdOpxqpw5tspe2oLqwjuA4r2uAs/RJF4IgCx4E4O7C0tpTwBroCtK3BD/87r4D3K6jMTIuH/3P06KK",
-	"V1eSHleqvFymkRWcSwEQB0KeyPOkFvTdLWzObQleDy9UP9sKolSgT6NoXjLtTHbuK+/bhffNesBmFFdB",
-	"XDe9mFXcYJ3f3/UmJGR9Epb1fsS2XfM1aGqIpHoiIfNKXh5zd1O27UlB2Viadc7Bv7V3ziVlJjuizdrY",
-	"XhPQLuEQFjRY9Vghnm0Oty6TPFsMvlOLwbO+/llfv56+3n0LmGeA1Se0Pgfu2U5897x0jMHtkdrROqT4",
-	"urPvjYT4+1SEFYTQNC7hdl3cRTnII85A5pezrF99M6SJ9+rMdvkijxUvLp211exjAImRBH+nwuN0q361",
-	"EMRmRSS9M1PzDTD+iaOGupW3jf6lgXurrHTv9q66jgXqq3ump3Rxc0W5Qv80YPh2Lj3lJV0MMqANFpjM",
-	"W9zS2nBvKrrw+6WpEY2fXA/YcCvODVNzp9Ow1KGGrcrW+2JU154ltWmvH9oNwuPZXuU5vzG5LCtNPfxF",
-	"2VHwylS68iiuR702te3aVwfrXl4WDymWPzvlPAv5g3w9fOJKmyTfL71rjqNZ5RrlT+GyGlU2ugbqLRRA",
-	"bUme6z31/ZHhRsVQ0/ZiqXoLu0m4dhHz9q3IlmzWuRI0MJe10ZeqA8D4bIrFRLQoosftyll3h6Cyi//q",
-	"C/7FeshMrk7UBaDB5KTvVttDWQwoB/7O0o3mMH/YWu/V9f7lL6UpaPaXv5BKwu9qnhrFvMpLs6xYcwZf",
-	"Eg5xeqHFYUrmOcbwcoiACsB4pSJmojbki//9x8bu0cHGr7D635dfEmuBwrwNeMshC8T9lJBcSonK1d0w",
-	"ZskuxrrYrTO1J512wgJ0Z/KPDWy5cVqtdm+iZOxASMbdw6gmG3hUzSEGLUPv1Ns/l8sjbTHib3C/w06z",
-	"H0jOwDfZokI/ZnxkJJPqSp683X6jDtapkbcz2Zq9mm1hqtAMEpqxyc7k9WxrtmWiDxFnN/UmNnATWkor",
-	"Ev10C2V7umqlDnmlDq66Js2ftSkkKZDuxdvtN3/sHh388evb/3npmjkVv0FEPAgnO5OjVEiHnMREUyoI",
-	"+SYNVyZ8RRrPJ8zUorF485/G/UXLZb0lfuDSzblfi4I0ylWbRRihs7316tZm3zN3Xn0FHXn/zTVZybKr",
-	"TvgHvSzfbMXyN1Uj1fbV1oC2rxBzftwa0FY1clkiKrPbqOj3r9dfpxORxzHlKweNnF1a7cXO75Mqdn5V",
-	"s1QxdvMbLcF3sH+tMTcCn4VuH39X2FibrYp7upmLfbvuFEg9nMYgMa1Pi96+bLJZWSDq72sY9UNPsQe9",
-	"nxseup6lr+0P93Po9iRGHLq6FzaVPC42v2lL+fUmzdjGOaxqbKvJpAShOpzUDVHVuWBoREw1U3KZ8nPM",
-	"kN/CjuzNJE5xen3NiCY6eCLyEGuQzWPYd8Hki2jRKtOZOgykL5zp651xRkeYfhjGWF+AB7CVGFgviQzh",
-	"dVtb48np9ZC2r++R9OoSWAujrdABFQQJyyU+/HsA0W1+0/LIIKZ7Ywo0TNlPg7tmIQ9Ni9P+u8CudNg1",
-	"UMHx9mvgrnD8UeCtuStujLdaWbAZ0CTQWdVbbgz8rlNCsWQj46lOtqXeWJlJa1fTFeoMYZgrUOuL+i8P",
-	"rRTVcz2KG6SCiVu3xslx37hZvVdTmcLDzk8crCb6kCKsW4wqnqeJunrPiEoOytBCrTgUdYsg+E1MBtaK",
-	"u7+yyGBuMzx/DSQt4sF/tSnIvmcsNbtVex2Ipeow3IouTxRL1Y49SNONpo7kvYC2jIZqUJdviwba/QKy",
-	"lKNvdL4DrTSlRNkwZnUfdlHtpbmpkcd+F8+rNuXS71+VUOJBgYamrY4VeID1wytQwR7/1+tp58vLqIfc",
-	"cfysx0GC58fMLSh57hnJ+l4aftxxOcmo50TtNdH2Wuh7HTyIsP50dDa3z1Q8snwrV6Ey8Pj+aDtf3/kf",
-	"qc63fPy3z5YaNstBnGmrB/OM9fQZ8yqYZ/BmGE9aAo20acYr2/wdP+tgDJ9Eo79PBvEMJZkb7QcTRE88",
-	"8riu3X1W1oabSdIQBshpuplnNx/Mh07p31clOhcSDVr4HvhXDphByzwIzFcdzXtvb4BBMqLa782kQw3K",
-	"+7uw+wR8PGN7vj5pHr9tflP/MdevF1d+AT0MVjlsRZUPOMpobqsnnyj6foqo1YdRpgr9YDzClPIGE5/g",
-	"m/KDgyZ1jGt9MSxpsgAiigArqiHgey/cBqbdleUkDUEHiekNqTO/HnoXIHkZCKD/Hw7xJC7zXmWYPl5n",
-	"hy3cqJLauGBE3c4IxTVWq/cBQvu8/PL2lGxebG+61XdbXA9+gUoixM5rr6g2iDxIu6HL1FTmdtNgQ1l2",
-	"IxfA/0bPgi/51tb2X2mW/S3jaYgBzljhA/XbSUgudCmWOBeSnAH5dHxIIAlSU43Ax/mK0vku43uQO/QQ",
-	"s/4XhXdvcpk2DvQuTR43QP8q5k8fQFnThFRJX9V03j0aG5u6vUj07jiWNlmxSyx3pLwpKzjfq+amMq1H",
-	"brd1sEs/7XuzzT1VRDU45lRl9SBo5RLYjMsU+O0vGNPIiWZzqaCdwdv8+j18fi+NY7ph8lZAiJXZ3Brp",
-	"B/sYt76Aykom0wlcZZGSYWyskY9tm0H+YKHotJC0+8HH9OpAf8Qy9hVmO53kCftXDqYB0sydSrve+gU3",
-	"Y/naF9giwjNZ+fm/Rca4wOl+4vpm/tmjddUmIodsferW4uBP7JijRfNiNUNVrjU2bC1yj19aflB0wePs",
-	"5sHTdhVAKSCcrQg+ptv56x2hwq1zq3We56IUb58RrIpgJyPud4cFbZoqXO0eDsd4AKLAwFBXYNC5oRxm",
-	"wATRRZEqGaJ0La9wRk5PD1UTjPqCKwmJeVV1SLcFJu+ZNd4UoW9fUjYrGyUtbz2EtGwTkdoKVtfTh5Lb",
-	"DUZ8bz51Dyvjm1J6Yk0mME/5eTsHeJfyc5fUd7StI0tZousd16RHwhKCVR7JCyYV0Z9xBvNoVfAHWwup",
-	"CH9nUlRLHupyg0UJQwhJmmCrJA1hSs4BMixQKAU52Md2WO3MVKNNZJoHSwhf4hf9UCRBmicSXSMcpxv9",
-	"3KbSKbJo+V2aaO4VSax/YriHru15PiVAsTYh51imFFgR7Vq8Sw0wjDMYJqDSc2UcLiBRbWzVRUwMxpLF",
-	"jOySJE02trdeWZVkDDTRAa22cOKcMnTT03V/aYKeveeayqWEOJPDOas62kfIVs361OpsdgCvSvfV7evR",
-	"KlNrn7R+XVqdx7kIjgndlhhseS7IJagzs8f0s0YjXYaGQ5ZyqRFPNf5PrEsapPGdWiR+2PppSNufnjJ7",
-	"RAa2Lm+0+YoHqsWVuG5RQXXVyvCKIrwx/DDNeEG0hzoF89pEO/VmH8Qie/U6Sbg6zSCLnCyFRM4SErMo",
-	"YmXJUq+uRQ3uNwzaKPzuepQNC4Cui+oUyepaZcuqIhaz6qqKHDWvtra2xhbNvIf3Ep76Oq8lndL8WWpq",
-	"ezLZlO/j2EKfjtTlA6V6aAB1t+pHb0DgRXkzTdxlhijKpSV1DA26oNHUKTw7rRUvLjdyR5TuGxaw7JxL",
-	"qAO2Bkm43sbGLfleTHu1urHrmvZclnAPit0/EwdZU/+7meiy+jrZlWx1vUTPFN2UBFjg360tTuvvL6fK",
-	"vlszGnRUlam1r7OOQZmouzLwjHyMmUSZYM4gCkkQAeWCMOl5WeQeLvbB7OzRvi3MAjWE93Dvw9Q3Hp14",
-	"hd6sM6hLbt7De5bqb8W/tAbj0TSISol25ceR+lwrBz/kZY39Hi/64/I639YDbD9an1PR5GmNC00c3e8z",
-	"nt8MzzUGrvt85TDnIJYgujT82KTCsbSK3urYdPXnlETsAgbi/3Ex76OlAbPEsVRQk670Nu/JPvW4MNMi",
-	"zvq4KWwet4EZkI6xxxrsWHd8hLioFxY+fn+rdrvNM2tfn4AQndelH6tm7s3GQyjJgAsmJBaSN91Kb0Mz",
-	"5n+K4rGAFpgZObEz2Dvd+tsao0rVnKITNuA85AxWqdF8p5wtGCYdKaaJ2BzUpTLUTlGs4/HeJnaJznVy",
-	"r5RspsdKjj6dgD30+8/b8yewvxofSwPj0YSsSCHNO5wwTkBbWk3DUoNnX/wVbs2iSNtDgVxZjZfjWszK",
-	"JKKGHmZkj0YRWgCWTJAY5DINSZxHkmURmKS66QXwS86kUSucnh4aKygOmAvdvdQ3lEo5Kkp1o2plLMcp",
-	"iYGK3JQ1tVsLrQpiIGs4NbB7tIzBLPBGYqYw52+P2CLMMy02dXKO4l0WuNFKj+4V2h2uWTR1MpZ70rr8",
-	"ArLjturwRq6Fz9V8MHR8S7mGsxURac4DcDyi/fmx+gfCbMwpJwf7U5JiQ6qQTtLFxr9yGukcNk7hQNv5",
-	"y2RqywbSeLPyoagoaH8yFQVbTAcm+W97DE1/nq+MKiFDLf4QbXujunyAK2nSgd6PSr9yW6+r0S+R90/l",
-	"dO1QV0HWxW+arDGb07BMOV6z3Kn5cD/5cW6eGec+MaCecrsPDVqS3FRiEVVT5+TKPFxDzKxuJEoXW3by",
-	"bK1rZDVZtZ4trN+XhVUhxW2YVzHXxb3YVofnQn2qvP7UheUIVrEZ06tOdoHYaHyIfKzDVrXSQW0Wt4cx",
-	"lPf06pmnPHqeMvUElXMWYJkx9S+4gAqWYFy4CS9siQLnWGSlPZLQFqkN0sS8j/9wwyVtQCIexh+cSvDU",
-	"qr1TL7P39Mrlgs9c72G4nnVulAO4n44QXytnQ9nZy9bKjwOer0Wm0TZiH1w7/Z7uehNZf0Oh28LoIZ9e",
-	"Y0XxO8tZWSKMxdTyt2r6gxEVTjpzILhIehfKu0p1uVE6/e1bX4MpTd5ipNMVJTH8Iwggk9bj4tHFa98i",
-	"tvqTX5a12DxIWOGZm6Yc2uY3/Ed7Aq49LP/P5k6RNiaXRjbUZfTgignZzUhNNUX8TwtTrWZvpqZlu0BR",
-	"T0doVmc73qfoYCfH7RWlM/vYqbpFdG5sWwJ3ZVY/GnsfXWGIhzVGIcrKBkb00kRZrrkzEWxpFqILa1Zq",
-	"FSd0n4IQTunirhh2dSY10Siu/UNLoervtATEg2JokZPWoKiklQCMoo623xa6i+WMVacX4qV6s9Fm9fAO",
-	"eeEOUVCvbG0UfHXLC4HQXYpXfKCLshL7M4bfHoZbHO3G8Cr7/VYWbh2aj7tFPq4z3UpB2JG6qaLr8PQw",
-	"lXq2t5GV+zt7M9XYX+ujqdt2VStv0oIKrhR6S3iwpl11DQvufQitTr30kUov9z3ApFizbs6TNsVW792O",
-	"53+ZWb77/V/kDG97+auB7oSx3Z0GQe9plCSwNYCxtiedfwKJ2h6eCTcx7Waaq2PQehiaDNRbPQ3kfbrq",
-	"ryet0rLYNEqn5QqQtvbeN/yvkSiH4jLmg8Bbbsh7qphUX6Nv9IR3LGKYbflkhG0/89QAXVKhDajfBZqY",
-	"g6q/fm+IKs2UJw0TemVGm0BhiORZwZG1EpmsiSf3m/QkyLlAc/FTynriS3qiZzWf1/EDCBmHAPcwHcj2",
-	"FVbsF71aB47gAgt5Dh70EDt4QHui/WiHnP6cp3GbHwWOMmqXeuJ70s4jzalZB2vo/e8dh+Qfp+Knhdk+",
-	"sDOTj2HelE+bmg4jOHVbGZk+Tn1iq0c8DK8+SEK4sgRahJEUsGwl1yLLhSNHeXlJuhAf53MBLcxxdD6o",
-	"74Z9r81l742ltQa89bKyZ/61Lv9qFJMZyMHmLFI/LalYdte8ognJsyilIYlYcm6VnJQTNQJRSERZ4vAA",
-	"ugL9bagc+k61/TsVy5vyNI/xfqmHHWq7V6uwvM1uod98/+puqEnB5RNCvu297Z7L5RI4JoYwPyJ1mVP6",
-	"DkxIT4ASkR4M9D8dH44nSWv07wmUQVP/OqYGY269TbPTHToFntLFTSMAXIvfHTq4/hmsqFUbQ7sN1U2u",
-	"OiCOs6tMzeft77kSWWs0aLHQsxXmH045iVOuq8YhJAZV1ZGaLayX3vBEep1ypxMhV5H6QUm3T8n6+Fy2",
-	"7ZGX7VF0ebH9siM6vDNr86DUr22qUYfPPNG8zk9Sxdn3SN4au+ZCUTkEsi1LvhUVaQ2UehOoHMEnvIlt",
-	"ynkyI6o3OYMovdTpPnQDyoHAVRDlYTtsb03lukcFbAhIBJPsAojIz/QdRWIqgyVJE1x5DELQhX7vKZbb",
-	"cu0A5cGysqyYXh1CslAMYPvHv96vN7STrvvz9nq61ufE3cMTdw9g4f5IqfFxUZ+3Hywy6juTem47Bms6",
-	"WQIN8Si+Tf6xoSCwoUHQDOjAawsFdZAmjjSBK0kyxWnSuan5IqaEzbUMjPEdnWL19fcY9PjYYsvqhH7X",
-	"AWZVar8vH5vP24/dy6YshPRceXdQVrhCgdGBwPWbquEM7nhQjvaXdDD5+/aYvJNFtAtwzy6Zd+qSuTa9",
-	"9Pi+jfV085LPw/m63fE9hBAZdQs9Lle7h32YePzxetH4dfWB0pPHt19uef0gcsvrh5JbzAIso7YLeVwi",
-	"zJ8hdUlT3HndifdplMcwMEkfsa19z/Li090/XPVco5+tEWr5m7v5c8UKVY7RooX9pT3et8b6ilH83M9B",
-	"htvnfB/g0mLA/Qb06ll3k9Aodnrwzeb4bsLs+ZnWzrdczGqip8OzNr/pfwwP1G1HWt3IoO1nM+xogdKu",
-	"Z2CUbgVZbIQubSLKs9rZG7fbjSjTLm/comurK+5dosLWQzEkm1bvGcuG5b3s4kO4XH5h0SHn0WRnspQy",
-	"EzubmzRjM9g+m9EsQwQw/b+VJokyb9m3WgLy6o+Yas79G49zQ6rzrjbM2MY5rCq/Ge+n4u9C4Pl6/f8C",
-	"AAD//w==",
+	"7L17c9y4sSj+VVDzO1Wx8xuNZHs3J/FW/pBl70ZZP1SS7D3nxr4biMTMIOIADABKmrj03W91AyBBEuRw",
+	"RqOHd1WpylpDPBqNRqPRz6+jRC5yKZgwevTy6yinii6YYQr/oknCtD6V50wcvoYfuBi9HOXUzEfjkaAL",
+	"NnrZaDMeKfbvgiuWjl4aVbDxSCdztqDQ2Sxz6KCN4mI2ur4ej2jOf2bL7qH95/VGPSt4lnYO6r+uN2Yy",
+	"Z8l5LrkwnQPXmvSNPpVqQc3o5agoeDoaR2YTMmWd87iP68Gf0xkX1HAp3vIFN9AoZTpRPIffRi9H7+gV",
+	"XxQLIorFGVNETgk3bKGJkUQxUyhBcqZITmdsNLZQ/btgalmBleG4IRQpm9IiM6OXz/b2xtWquTAvno/G",
+	"o4Wd0X1ecOH+KhHChWEzphrwv2dXBqmtvYaDQmmpAGRtqDLEzBnJuDZkquSiA2xRDtePQE1FeiavOnel",
+	"+r7exmiWKGbe4yDxgasG641sGF10gus+rjviIs+oYT2jlg3WG/lCZsWie9zy83qjXrKzuZTnncNW329y",
+	"XK+hs86l0AyZ5nd7e/CfRArDBJ40mucZT5B6d/+lJVJuNf5/KTYdvRz9f7sVJ961X/XuG6WksnPUSf0V",
+	"TQmAzLQZXY9H3+09u/059wszZ8K4UQmz7WDyF7c/+Y9SnfE0ZcLO+N3tz/heGjKVhUjtjH+5/RkPpJhm",
+	"PLE7+uwOqOgnKRhM9v1dkOwJUxdMebK59kcMz8z+LyfHbMa1UUv4M1cyZ8pwe6Dopd5HIQOEgbTN9vd/",
+	"OSG2AfmZLcnhazKVirw5OCa0RrHtszuGsWFiKeLD2m/kcs4Uw+sERlUOUsI1yWRCDUs7hj5B3l0CH5/D",
+	"NgpXMBx8+0Nz1NNlzuAGLwFtDcQEXLX/ABhHX2ISSMUO/2G/jpvbEF1giNBqXHn2L2apej9dcPEKJLAD",
+	"KhKWHTONEkJzyxP8mrH0QBYiIq28L6UUFOc00QXCMC2ybEnK3qO2LDEeTSlfY2Azp4bYLiBY2KFHURkl",
+	"xFljAfVZv3hMnFih4WeedWJiILRO/GAtgM95lkXRAB/WGriGYtt7NR7OeS8StOYzceqkhlM608fuTmvh",
+	"wdCZjlA6naGISnEg+BccUi+GjMYjFGIj0kEJOFWKLvFvqmbMxKaA38sxCRfkMwoQLw2dfR4RJx6sPER2",
+	"+LFdSLV4lobLb687eMzU4TpM4URPud0mWDY2BVTIhANTIpfczOGLZgRnHa+SZcYdaPag4jB+ug2w3MIJ",
+	"AuWXCEhB3vBWzt6I6FWQsQuWrbqB3srZW2x3PR4tmNbwZmkt6a2cEfeR+Hsvgg9tWN7ufGJYDoRQYT1X",
+	"Etm3Yhmi3lFiJmeE4VJiuOYLpg1dRCY49Z88ssOByk1MqWE7MMpq6iunqlAydtgs0X5iqCn0MaPuvm+g",
+	"3m6K+6t82/3jyziCWWZbNtGhcQai7BQB3fRtZ50kIie3c4/fuf3156A+/5gkhVJMmGxJFMulMlzMiBSZ",
+	"vYBRTnE91qSMgAWv3BkPPOzCwdHHDn58cPSRJFIxjaDhUixfHsUe1j1P6TEImYIlxl09EUbLF0wWJk6T",
+	"sjBA95olUqQa39UIjcMkgc6ETg1T5HLOk3kIKtFzWWQpYVc5V6wX8L2V94qHMiZkHCgGRLdfKabaq0xc",
+	"G7Pi7FntFjEwCsFOVoAacgbHI54O4dvhHEN49ILq81WHpprlHdXnXMxeM0N5pqG/cMqGxpVPF6wDojbn",
+	"iutfTueMOAnMonfFQI09xdUKq+jwPXCt42C7vlQbfMroYv/o0AnWm+3v/tEhOWfL9bfWTfAK56ZZ9mE6",
+	"evmP/j0BeD9qIOYv45EosoyeZczqGwbTioN3CJmcxx4cx/SSXNCsYO0BWwNkVJuPmkXgeku1O+tmznWJ",
+	"xEuqSaFZGkIXIrG+5nuh7M7lxmjRNnQk6AgzSom/WEXScFLwHa7HTcIFQYuLmX1YRe4X/B2xjHpOlHrn",
+	"jOR0mUma6jHhBt6lei4vhb3MpEhWCwj1adtcFRb7mmXMsEHS+uqNCKTHgUKol/VTBGNzqdNzGC+Hv+b6",
+	"/B0ziicR8TtlFzyJLOU1/k78WE0ApjxjeqkNW5xGX+g/lt8J9CVP2GQ2GRN2Zb4bk6upfhrl+yAbHEke",
+	"ExDewTeC1geP4ZTr89gwRhqavVoaFsMxfCM6pwk+dM6wVchruDB/+i76ngSS7BgVmMgmgzZFpWr9Y78x",
+	"LVSHgNTW6rf6hP+HvXsV2VGuz4nm/2FNEQtgfsdfrSuwjEdvxMUn6gxpacphHpodNcgrBOGNuOBKigUT",
+	"hlxQxYFXxiS+9lF+Iy7ST0zpqCLLffB0wcRFSlQh4Lz7R0zn2OOR1ee1L1iZRugaGxP8FkFXG0Wdorud",
+	"dRXXchOFMvSPSi4OF3TGQn1iymHsBRfU2LUsaJ7DgFa72MWlQ63keDRL8q6GPx0cBQ1VOXNHayaYolnZ",
+	"43rscbt87+wosOrr8UgKNuAyCcG8Hve3DSFd2bYJJ+A3HKBFFJopOJX7SQJH9e86Ro0ntg1xjcjfTz68",
+	"Rxr/6eDoDjSesItDNZ6R5cTeG008tdCSU60vpYoIUkfuC9xrha5Yj6qoaesYKMf+Ehm80EzFL++P7stw",
+	"UONILWcYV3iJYbVTzmuhFwQ0ln4CqfZIsSm/iuAZf7diExfE9iAXdcZoH3lSdcnDwTwnxTQ6j/39hvPk",
+	"/YtA7QL32NGtIYlDdGtclPvfMjEz84hIj7/3g9h1MTuA6zOMI/sSwyEwlbdcG5Z2qiRoxmlMKwk/D5En",
+	"k4wz77iRslwxa7Nxr5BVTy7bOzpuXpT6mj5GWup1wCZWE0H6egXCyjWc3s7HLJinatc4ueRZFtGz9D5o",
+	"WV2E6DXxBU3xEl9ItVy9oHe+HfYxNKVmpTXR0cQ737zpibFq83oEG/QRYetglWriOg3GqjZAk8MWeYJt",
+	"W34Wq5boW1ttnFW7cV2D3D1ao0wBXSvw+YBHbJBK1gH8qeq7WtcfuoaEzjLl4Qx3JDhbAX3VTo8/Eh7H",
+	"dQpGruLtABEtLeCrRSL+hkzZWTEbjUdcTOVoPLqkCu9PFEljl+ZbOdOvuWKJicrf5adAme/sdE4lesac",
+	"kxVLAzCmUl1SBb+c0eQc/9mafTy62oH2OxcUb1UNHWvw/FiOUvv5VTmkW8CJLFTspWt/XxN02G2pKEoF",
+	"OWyJRgPLcPDtrKfBMNWvR8GA1+PRO5rMuWCHsFntZ0pe7Ktkzg1LTKFYXLNOgxZ+ocI+LWI8/0e64Nky",
+	"PtQUvw0Y5J1MWRYfYwGfhg7xPiqsVcOIQOcSH6v5pioXGMDZmG/cwqvdiCtQcVpdSoSpMrogC/zoLDKB",
+	"UaptgwgsY/03dstW5uZYx1wWGOM+ipjs1TsJiHrQDVdEnnjriOYiYYTlMpk/bTyHO3QoKD/F9erOW7Ku",
+	"vHUeYCz14Ljn/IxfMEFgYHVBA/O/de7stQ7W8eBBwu1N8h5VRsvd593BEUmkmPJZoazDWFuR0aEQrh4B",
+	"7wLRojE8ftlEV/Ps+Z9juH/PLnstRje1msS0kF/svD2CbyYvf8V9FMz8aieICcKZvCxRYGQJyZwR33lC",
+	"fgF5RjMDDaY00wx1xWdsTi+YFxfAeqeJzlnCp0vQD6VMLD8U2Gdvgv/b3fNUJpi5lOrc7fKkWvKZlBmj",
+	"KBvSwsgjWmhWMxrb6dvuhXJB4cEKFqQcOtWlGGtXhF+89a93RkshtXlj7ONACqNk5qYSNNdzacg5Fykx",
+	"FF5BLTkQZthx8EnhgSFPUNuuWMYuqDC2WwkMSmSqYE/dPrgNQLIphyOpkrnfth0r9qAFmhEqUuIuUm3V",
+	"+tCq0n2SJzT4awdb+MU8/YEoposF7Cc3JJFZunMmpdHkiWL4j6e19aEcCZLWhJwUyZzQCi0JFUIC0Vio",
+	"cViWkrMlMYpOpzxBQBeFNlYwsJ/ZFTgTcpMtx0RLwsNxErk448I7q8Cox9hrQl7bXUOdP6COPAHbIvFo",
+	"8Yvrpjs70EBZdr/scIDkDIOcUZFe8tTM0Wd+qFT8qtELLmtLY0cy48ly4DAHtT72gebVySseZ9gMDHhy",
+	"dmT9Uj4UJvc2/b4zeDLnuaUFkwJNw25qkzKFrw3n5MLKZ1BDfVy6ebg/QVgcg8/QLHBG8qOQnKf40U2i",
+	"GF1MyFE5RUIFkRdMKZ4ywt15cvPG93yR5KuQA3fYzV6fjukN7Pnetq5ISrMkKoae4O+EZhlxBzqRi0Uh",
+	"vLM1Yq/1mA0Wv96b0d9E/Wa40BPEh3J8HxO/YOMzfhE1nThpaLK+/eQenqb1C/mgjOgZKg58yK1oVBO7",
+	"q8CgMSkE/3dhPZLqZ2dCPgrolAbNNaHwIAFeTtNUMa1BWEJuO2dckcPXExtH45V6KNosuCj/jkkhLSHr",
+	"Pbvsc6XYnlE9lHnsBm0ymyULVB8bwxR8/r//oDv/2d/5P3s7f/l158v//18DIYnjIjDm10FjFz5KrmGr",
+	"ukDXp2XONBin+QVTlcubizEZE7bIzZIsGBUaj7kbbbwWWeNUp8461PSDK1TkQfnx+C3CYadDggphHNvL",
+	"GoSPv52eHp2sxBxMEkWcswc2nt9ZoQ1Tw5iSaxx97cpFNGrtAH/3A0iVzJk2Cq18nT47P3orwgqXZ6c1",
+	"Q0e+oUZw2+XEekqzdWbRZZ9hMw1zF+rSHizqOpPeKzNoaq9O7ynR1wvIwTtVVDrbNbzNvYFainAhNcx0",
+	"2JR1qU1Fj87Vc7qG5MRP3riq4rNY2+Ch0IaKJHrteksnd20qo83K/XFupwOQbJ12qz4HcypmQ7TaMLV3",
+	"iAXNdka1IYntPVhrcjHQ56D/ZMZ8r9r4HQfcpMRQe9kNYqsotn066xyhg3KqRZZsqH5+vjjuZ+2TsViW",
+	"ZM5S9GKOMAQwfQGWbCsfdcLTBuEPj2N4ZLmPLPfOWe4jM/xGmGGNGa3miDHWV7LTGBMMHCkbBAcYrvq2",
+	"9Zyo4T84+thHq2U7UgZEDKTQsqfVCnY4Ku6ji2F9JqdvWtMbMnQNiLlYVmkWypVscO6SvDhiKmHCdCAc",
+	"Bi8wBia37ehs6NigANQxx1djYwvdXtpYGZrMUWO4u6j8UIc+bUL/28h9Ni9m7IjOGJh9O7YNPuGmEc3F",
+	"LGME+uSw6jV3zc+ljxkcOZb2EaNybcrZNHliT4nBi9wQIQ1ZMggFKjLD0qfrQoGkspqCcsUqcq2A6SGn",
+	"VRN/1P1LrybhAt+OT9DRluyQqWJs6DrtyTpd6Y4sLOvY5BjaXh+7XZPfB2N7r62NHZRrbKyD59QObRvA",
+	"iONDgCB/Kls00ty7GCnHjpJn2yflFdt2lCh0eEFPPosdkirKQVR4Wf5MuCZnshCo9zhjRM8LQ1J5KSbk",
+	"0Fh/JDgNYJjKDRHsMpA7QP+LLbSROYYrWAXJFdf4LgtagvZCCgsE3IXp2bIOg53E8AuW2Q0d40m0Jgef",
+	"yQZz2tB0iTMnUhguCkbwkhUzb8mYfK47TdJ0Cfh3K8cbEQPp7B+FmDOamfnSXsIA2EBvhwr9x26O6pfX",
+	"1WzVjwfhvNXPHwMIql9PPCy1jbYCwtbUNasj1vhMgZzhN3K1IeItv2A7rhvO5oXTihiMJNLMmcK91w1Z",
+	"jmtv6gx2rK01X1+0bBx5NwCg11qPerwY66bQfqeGLRlD71eFD8j65pw6U7mgPCLEv6KaEfsxyAfiseTN",
+	"n1w74zsHt4YBIW/gtNbwO2ggJIxAxSsKJS4I4qiZiLbr07ktJ8u7dGV0e9CLTfy5Mn8BKt1+VbyFXHAK",
+	"Vsqr5WT1Dm7g5tj0U+yyQLdJobK5R1xrkEmkFYOctJ5ZTMBC0rUt4m9cv+Zi/Xgxe0DnIIP8QPwq3Qxk",
+	"mtFZfJHeTcB6VMQNwx2G+cgRdw1QMDj8cHRCMKudbhD6hNgRrCMkVUz8weB14xZWZn+odTaMLv6gieFM",
+	"jQmfEiqWE/IGXk7YDgg9oXnOUkJt6jo/mv0cyGDlZRjC1NptEBUPZa47Qt2gKRKPxsx+lsevn04AZnn3",
+	"6oipEztAfDIzV7KYzXObPmDBZhSF7BvN7Czx785iS3SGd8JmCrjnE1kYFE+fktKpo4SEmxCQMeHCSbIl",
+	"Txd/cLvA1obzuvts9JmXm/kmV95n1VgD5LEV0fGVLFUNi2oxdIgarA9bbcodZBlf7bFaT7wZRGgPwf0x",
+	"00Yq1p14aHMRStmh063IUj1raXoXNQUIe8prtygVuJlECsKNZtnUXrlcaJ5ayebTO39q8c/5MmcqkzLH",
+	"dxKwMNgp6yPkHQAm5L2E3ZuhGAROidY3zOEtciFdGSbS0wiG4zcDDNl0OAK+i1z2UvSKwgt6dZLTS1FK",
+	"6EwPyYIaJtaq489ZEcBHCQHI+JQBAEDKjlkAmxcS74b1+Vs+0G0yhpTc+hzizsYfPoCKNd5j0VkcBioU",
+	"lQd3Ya+7yNQ9dNxlQLvpSwEp8dD5we53+ND+Mmf4mPT+st6HFthe5b9YCiRSkZRr++/Gi2lC3ls/RSo0",
+	"Oq5Rq5YIRtHM9IiWwTZtzwPxNxACd+cvszuIuHuATz/gY8kyyYZ6zr4t2999LOBNvTEfQwm3+P4ucoA/",
+	"HZD2otV1SB4MuOiliCjBHI91kI2JzZhtI2WszlixlCbgN0xObQKMyPA51XDCZHmxQ0fg2z68bTKKXF2/",
+	"4fBJ73KIL6gjUId06SVOPhz8fPK9VZkgi/L7Y19fE/LBvb7I6cERElQhBIOrM5QwbXe7pXhl76ZMLCGq",
+	"wTCgkx9qW881KQS9pAoiBZD37yyoQR+aaSYvMREVUWwhDSOv35+QJ/un/3v0V3tLPI1dvg2Zw/rbrlir",
+	"awVyz1xq8zKXyriEoDalD/k8sookdkUXecYmiVy8fLb3573PozBwoToI3akiStdiD4FrSZ4c/3hAnv3l",
+	"+V+ejiFKjDz//ntrwmo4Bz///vu1Ej40J/QtbzRh02Lm0NzzUrPsJJZrpMqQ1Se5esEOXYcxeA4kAcck",
+	"+phFld3Q01yKAioqhyC0ISpYd4TwfQrD9mIMaOUr1wXirUKU/ohcOOIIjL97ELxuKc4L0ZbesLiMrQRy",
+	"tgTrXbYsQ0DKoJCYvnHlTTDE5xlHGXpL2BfhuLIeVp+qNKBAxUjKdr/zjCbhlVahthCambgOQ/ftpqub",
+	"sZAXayYq69zc0vG7M/NLLfLGy2TW7zswZPpHStliUt3o7W9lmuf2J6uJjX5ycV/Rb05IiH6rFDksjQba",
+	"O2T8KNV5p74mqdxuvLZm3OnIMJXqPFQnV6/aCdnPMvwOhm1pQm6wqAIFMaCtFjlo1bimUChMJJggh6o0",
+	"g3sC/DwBPKf3hdGtFyJLNTwp0e+RcJGynIkUU9PaW08WJpFWb4a+Nly7lLXWY4Eb7SKRy/geW5ACy5/E",
+	"dU2Dq6/cyIoIlv4Wkm9HDWapwmdRb6S9xN8BO1KEYdMA2oS8uaKJwUyJLHQTLZMAW2Pyy0p69+K83UDH",
+	"CMP86GPXE1vyMB87to6wSp9lbUBphfLoDGSgvVizjwCzfM11AqqsWC7VjPKF/lUXuaW4mpy70h+Zp7/i",
+	"A/ZXl2LyV5rNfrXX7cZDal0wFW36r8tz/WuhePSjPxW/wpeNZ9cF4vAmgzR9PO16Auh7YO0GYCiuv6ym",
+	"hr//8vNJmxDO2TIiEWMCt1/YGZSu0IRqkC0wopcLApLif3//7L/DSzAuCnQkAOjFG8LTs5q3oSajIca3",
+	"Lsoc1eflaw8EnUptP5XtI1sPMe4WOkOdcRA1HTdVShFowwec7Q9l+5ZoXYEXDtuHLjmL58m3V0s9Z4eV",
+	"grlgLbzgj9Fx4Etfsv17SoiPAH+p4aGj/MCUsyztIeLug18h+85LGNwXVhH+Cvyxx14d03p1pYHauTWq",
+	"SKxwhamIWrq5dRQwfUUFMhnLQfx2G3Ou5GvucRngoYGzT8+P3fUQxd6qCg0nQXw+0SVGt4a92HKCFbwL",
+	"9L3DEuP6HitVsbVJolmI3oV5e4aytG5v/vdtP/5hmW+TvABP3aOko9pDn9f+NJNhxRmf1cfq93qdxlNM",
+	"ctyZibnbkRo6xr3C0b+j03W61zX7AIJRIsu3oXDOK/sJOvJj3MrT9afoxUaPT3nvoHFEvFvhRd495O8z",
+	"3dUaSagCbXZwbqq9CLY6IKyAasOjEXCiukEonn7pQ2G6oza9k0PKtHH1SV1EjPU78r7k9r1vsQdyICTQ",
+	"IQeHr4/JWSaT81JZ/OcJ/m/3xfPPo6djQskZaKgOj0pNc6MhtpKKUG//tApa1yhQOn8ejcnn0R8ntZ+e",
+	"opIDF+AL9tDski6tow8BOmQpg11FJ4eUCV41nawVlYqIOirOMp6cWpysTMx0YrNQEW4p0IvRH4/f6iD5",
+	"YGXTtYkyfBqhIPdxXNJ2ma2699Ytt9olVNFUe8HiO/262ghd2pDKxxd2gamJKrJ1kcgqU8xQ3WnLeOPy",
+	"GDv12d+kjhWZcJifS20wh7EzBaKR+4xVpmhMV+P2xSlVorWScK191/06coc7tMdFFs2C0ciZzNSOOxoI",
+	"BMHCllYVg/YiUBG7LYQEGLvwfyflYibuUau8g0F1AmnOJ60D1jpe/lxmmOGYoHeCD1Opufh7TrKDhivP",
+	"eQDMnKkFtx6hJZE5zwC7pCeW5gUGvkjxFMFl8OynnuLyDICAtZVLw+PyAzl9e0J8JAt6icmKmdhxw6lg",
+	"ZDwL8EBmCXoZBYkI4ZqYy9RmK6NmHuT54kLnDG2wn0Vb096joHLb/ZolPB5da2mcTLlil0CQqWtpKVQQ",
+	"7/oZLDOsgGnxPhqP3LoGhu3EodsvR4t/f+XnaK0vfHQ2/aFTbqqXmV2Wj3SMLI4saOqTBAWScqOsIEgn",
+	"xyxhPBrliNFhRLnvjcEqVXiR27xdw8QcnPMkGqxq59NMmLbVzcgN5koDchnOU0oiwxHKK/3wKBYHW34O",
+	"7+cQcLclyGyikSbVEEdSmf454PoY9rJIXVDAu5jnt/tWOnZVVMMFWfAs406cG4bmUm0dKwOC3vG5QgsU",
+	"S5vz4T6fMeS/2tCzjOt53K9kHr2kkJnB/UXmjKbwBlPIzE7eH7ZX59MvoT4eme8F5Vnc7goXpEnmcWPb",
+	"L3gRugsDeKKt3wPklrbWGKaURo46Go8SnqqRvRFH45GQgm3EcN55EF/7gTu+g/jS/fXYgtHx9b10ijHL",
+	"1jv2oM77x1YM08w0L57glolXPZBGJjKL1T2wX0jKjD1Pjh+xOucP0D03Bt8RmMQAowQ3wrKfGeAfjTs+",
+	"nr496fz2wU5tbQ8yu2BpjJd8dKytyUjc+qyvySpuskLN1zx+4DElcyYGO6D1PNfKrXNntck826wuYNDh",
+	"casxr/DCGDcurOj9qW+kFHNiBC1v2xup+Zq3+ro6slDIbVszge8EgqxnR16yKwXbUpJzqfJAIEWmxbg1",
+	"TygqNGx+0KccRaqx5ZfUXRDaelagxKSJdA9CJNUFHMmG8AehWf6d03xQ/uBG9vDNqYZ4KDu27TKOrEc4",
+	"Cy3OaFPZCe5FysbbvUzGv96TwkZLjEqmpzu4nvsaJn5W1bagHO1fCj+9OcXHwBubARAWw+xy7SDrPQIB",
+	"tfE0f/ClBx7YfKMozwCTn0d//DyqwYK9SxcZm0HcFnYpF7KrWC717h+t1gEo6JK7BwD2xlSCOCZh1qQe",
+	"WzTSxmfREcFp6XG9fTstuw14SASb3Gm2i5wkUceqYuWLzR+EtPmqGFklw0Y3TwXlvhuq6/trnKK1zNMQ",
+	"lY3rwH9y+qqeJ3GJiDM2lQodHqBoBBeztj3UimE38fvFg+WGAWi4gC0EBFeJgEWbU03Ivqj8vWz/mLMX",
+	"16Wv14R8sg5/Cwom3ylTqOYycAlbj0C0ZH/9av94+X7/3Zvra/TFWTqnMXudN0QR6+xJTqt7g2gm0hh7",
+	"pToUjp6AxPJydxfVW0+RhzaH9ch3DBdVclZbwQ2UmLLVoGzMzxjfC+TPe2QmYVYjyXffvZi0Xvtdjy3H",
+	"loPnvstb7vPBJ1IYVIeUWeHHYUefATwIAvMqjQ3f/9Z9scsD+X0tFb1/5FhfM/dgbrgzTsixpQVdK2Ad",
+	"SPa6lrL6AuX72vgT8mHBjbEXLRo3SZIxqmATJtEcV4/K5O0pk7/psgi/X+Xzg1AFl3IGMtpQwxmwhVoO",
+	"IscQ5kyUzGCyFhv7IE67Cuvbe7yr2gQ+tEDPNwnkC/DLHfkQ0R5PWcw30+kquxhWIaN0ZpLesxX4XaQW",
+	"Q722RaxKBde+igWzD4WyMEVdcRapUMFNVZhiTDKJGTsaZTLGJZcvvdLHOI3MmQgvvgmxrlEYO+S05z4+",
+	"s1RoPHEd4OQhnOzpD6EH19iZRRwPNorPZky5206dcQOVq/zZhOfMFLP4aFdTw99DzToXk/WiZo/ZVDE9",
+	"79xk/6LuUQZOpXJLCXehsrGds9wQin69letu6EP84k97exv77R4zjWW9gsiFbu+LYeXVq2jTL+OIb4VL",
+	"t9jI6QFCm/1aCEwX9gefAhSQk7Fm+JEjNbhYUz5FEdJUESaK/cuaOzaLimyWvvYfetz3TtwpXLMoe71k",
+	"QRnL7AYrQwIn5HBKaPt3LyRxbQegmU2thpwTTh+6gGNa49J+SLXmM1HlyC+5bDkoF9owmsIeoTu+lbFg",
+	"JCnYZDSorEEtFHLdEoBucz2P7Q1HcIx9WAaJpl5OFiY4VJVA7gVS6OyC4jqPaDvueKArfVOht8JBNAxY",
+	"vEnhhkjUW0yncUTNfFXfeG1/HK9nIUz/ws28s+i/DvMi3CByyU4wum5CWY2P73Z3pOIFDJ1+oImbH+H6",
+	"FWEYUvtkcpFkRWrPFV3Y1uj6ha9LOnOiPXzc0Vkx210sd/woLy+eP11LPPQdB8ZS9wELhm46m5CPcFuW",
+	"UO9iGgivN4SfUYddPs36FuPEm5rWakqzTBOorOnZkKKXFTyHr92I9Cx59vxFOcRkJQ0GmBi77YuRIpRc",
+	"aW83zbkrwtKQEW2GlZI/17NrVNvC9WsvyvX5pCNBuMjuMmlVPGHHkDw+XdDA70Mjz+liJWbdcGN/zB2y",
+	"wlV/cZjtKmazIvNR6fPm8Y3trZlimGOdm+DVcri0AvBCdXWUVuphyYOzzlcleFYmf8qoNnEPxrdUuwsH",
+	"73OPAzhmLtYvioOVmTTAhWgVy+ws9z6IAIdWIEKMOOpBqGrpohztbKGAaSKFu8B7kg2BwFklGqq6BJFy",
+	"jeM+wPof1rA4jko+sdT/ZTBvNB9bp7/xo+PqKsfVCB1E9shT3kkQ096iPBepXtgiYFyUiia0SDi1+bge",
+	"5y7YBVNlTFObTIfmgXNzB2k2tpgFzofil3r4NKJ770k4Mhh8i5ayvkMjK9dw27djIBX2QlC67nq7t598",
+	"eoD6RgzJGmAXcYM8AeU905qeLVzirVVn+A009AAVmqkh7H/YDeZGW3F9xfi5hd6eISu9deQQY3rIGvdt",
+	"00hmseHSML53V4aMWNVeOAkSJ3Qe6OuF8wyTsCzJlzHK7kkOF4y19fZlUMO2J4NyZfsteBV02TBP2GDu",
+	"VMPeuvxp6wLbpunNbpCxi2rjchuuiSwkipvJdhsk/Op409ayapRgPmm+AC2cdkHlt3S912qOkQir3kgO",
+	"Aq6JbY+WaKzGWQUdnC1DAa0j0eKmnKC5Mz0hRBvl+brRpRohpDK3xyYOZdUKKr42KJGV28yuCzk84s2z",
+	"UtufGtuun8dxeYF46q0zxfDywfuj2xXt7khvWzTRt1FuNeH6kfO3173GVYVNB+Uxvs1bxemsN7hS7v4G",
+	"mHKB7tPrrMr3GbysTVi9vonQMJgVVYu6OR+qWE9ZVa2Tr0R4U+skvAb3GQU20IgFrvzWOvYAhrV4TshB",
+	"R7V8+Hq4oDM2xn/6aYFXaMPySEYuXNy+mt3ES8t5TnnVxP7xT2iuUYU1rfpDHKw78hbx+Qoi5SXxQ98o",
+	"FWDwu/4b1RHjAfxaPi3yTNLUV9e0uRKubETVwYej/0V9+P7r1/VlJDJfIlpXUoxfS2vrf+QZ+4hzR64B",
+	"xXQUAeE9gJTBdWlUc528p0niwlzbrD9eClplQX5cHLuy8ZY4MnLlgj3srQXHTRgbcP620rKRE6LTjRvh",
+	"2DSzAXYelhViAABrSaSKUb2a7QcM8th2uCmPvQshIcJS4ykuajDe2KP/Nkmhy32/toJOi/CNax9tknV7",
+	"2D1UaZy7p99EEEAGdrBIY7nZgLVh7j9Ma01tETl2xZICDYP1u7EqfNXJLFCbHZ0LVa5bmmXLxq1gf7oI",
+	"6dPzh0FKm+x/iK11088Pwp9FRCfqXvSiboBGsIlMcNzy3TA3qnMyt54rjVS4z57/+R5xP8SA6xd1QIWz",
+	"xTNC0UsARc1EZlIQzXKKZYtLr+DFcsf3/TwCwbP208uLZ+gYfDjFkbj2Q6dj63ln/YGMla9B6++SPeC8",
+	"oXXfn0860wRZ8CAEQ/PI2wEGMdK5IXnrf3n0/YEffnffAm03yRgZV+z819n6YAEgkKuv8ZmUsPVSKV/O",
+	"ZeYfXpUUiQMhY1WFaGQ+7ZdYy7dMhKHCzzAmHDKq0ZFdt2+qbk5djn3MZly7IPs+VP3Y6uBGCQ0MTROd",
+	"g+IGcP727kh8hq4Q07zLO7btm691MIdQ+4lh+bBMyzEBeUVB3BZo3n0Q/7b+g5eUu1qtvoasDZqPORR6",
+	"EN6yGU2WK6xYjzarrQs2jxan36jF6dHe82jv2czeEz4o3FvCKyU+vVjBoe/Iz+D2eek6BtsHaofteQo0",
+	"wxFu9BK4S21aeRDaxklcbki7KAdFxBmWx+UsH0HVjmNVKxVv+2pWLJgwlZFAw+zrIHKgZh+blSk3g5na",
+	"b4D1nzgw1FbeNmZ13Y4o1E0qgK/hnp7S2c217UD+MuH4AK9ieQydbbWQrH/Q+7M23N+TzuKeszCioLFO",
+	"TbThUoIbpuHwa3Fpg886NbZ3xaiuIyB1qcDv240m4hVZ5zkQZIOvKf0wLkrdHW9tv8e032u9NnG1sfnv",
+	"5mVxn2L5o1PXo5A/yFcoJq50SfKrpXfLcSyr7Av86dCBs8t63OvQQBIcDma2MZHbDYe0X6K7/nrNgMhy",
+	"qLEDOaL4skvYF2lZhnZ7SzEdlW0LEDTgm9eXwgYA6SuaYLQviiJ23L7iFreIKg98DGW/sLO5lOdrx5dV",
+	"B+3SjrARm3dFqPs5nZ/AJXHVthJcvGih+9TO43mBIcjLnGmSMkgAoKpnkpvAp9a09a4xaYafaJ1UHlWB",
+	"uwhnj7uzHL9FOOx0Lh1cBWMMcQ7kfnJxjdaORagGtwCXaK02rCZ8dpPVqVUyuCjOeDqHIdldfSKL7ad2",
+	"9c8vW9bMoX3ZVbW40AcyZR2J9GwDksiU1Yov4daKFMsBRgF0uoz+U+BHwNs992loDWDFV0aM5NoIt9VP",
+	"U8tN+SWW+kWzpFDcLE+Asu1uBeW9geXBT2eMKqZ+9BtgpQ5btmvUlHz/+MfKxjz54x9JrSB4Pck9CDSV",
+	"II0ubc68+lnYQpT4RIbELZjBRbGMUc0wyrqM9GwM+eSf/7Ozf3S48zNb/vPpZ+FN25jAC48v4g3XU+ER",
+	"U69ej0f76YKLfYzQ9UvnsCYbqOaZ7MvR/+xgy51ThwF/9rFnORBe7f3DQJOdw9fRIQaBYVca7V+Y+ZE1",
+	"RatXuN5hu7kaScHAN1uiY6PgPqlktmIc13jHte7CPRA1dy59hhsQ/kdvnr8CchmNRxfecjDamzyb7AEQ",
+	"MmeC5nz0cvQCcmK5TAx4EnYnlyzLds6FvBS7UOdu8i/n7zaLRTzaUhFAlPWc2twVqLNnwHJ9l/vOqi8m",
+	"IwTDntTDdPRy9BMzv7As+xlm/vvluf67liIosIfQPd/bc2G73isVkyLac7DrIbVX1sALrVZKD1HZUL0F",
+	"5SIrxodrtcX7cPGA1e/2vuuas1zELjS6Rq64WFCwcI9Omiirjes1ovVsFNfj+kbBhvJ0p5b2rnPPPuRM",
+	"HL4mnp7JolG1yZY5bJaqbexoVWZYM3XBE6bJlKW4n14qLAF2iRZtOUQ/riMMrsk/4f328nOxt/cisS4a",
+	"+G/20g3gPpXV1O3Xf45dClNuBgzxx3/6JKk2e5M2Vd6rEk5fdxRG6KfPD4jvgxq6745Uqxqga9Gr2/c6",
+	"kWyRbqPjd9Ov5cE7lg5QgCrzx/frmQ5c3X/MM0SDqzZ09frBeneI8s588ub5q1/3jw5//fnN/z4N3b/q",
+	"u3wktQmkAT2yUgbT5pVMl1vb0ffsMpilmXrG2Ysb9PRsa7MfOOm2CUEz310gxTiBuFY91xLPsyHE8wzb",
+	"Ptsb0PYZXlHf7w1oC41CiQ7t811CwD++XH8J6deTUbDKgFrr1Bmh2N2vtELf4etrS7kZizkdvcbfg+TE",
+	"frY67dlmIfXth1PgNa3oghlM39vhilA12a0BiC4JDYr6LpbkMNh0u54bbvpg7nIXm+53Yo1NB7F2F64E",
+	"vfvV3i3XuzTnO77Kbh4te2GpSxNqc/iEeYFsTlOa+ZuTQHaIKSRr72BHXrDWpzi9lZJ1mxwiySiQalC6",
+	"BAmvki3LFD11pjMOGMiqV/WXW+OMgX7wfhhjE4AIYmuJh6JHZAiv29tb/zi9GNL2xR0eveYDsoPR1s4B",
+	"mBihX3j48O8Bh273q334DGK6Nz6BjinHz+C+A+S+z+J49V3gIR12DdRovPsauC0afxB06+6KG9OttX/s",
+	"JlQkLOu5MfC7Vc1ysZMr6SqdiJTkTGDOvYb502a6xpoA1gS2+vKwdl4714O4QW7pyYTrxsXatTo97aoH",
+	"k92kzMeg6m+UdO2akZQCkqGlpXQo6ZZvtl3MZN1Juz/zzFFuOyfaBkRaJuH6mWe/fSp1q4W1DqRS2Azg",
+	"xx5N3yiVwoojRNNPpoHkHdVuoacIDBrybR3T5lRy9I32d6DjSSVRtvxzBupw2otac9tv43nVpRv/x5fr",
+	"cZQEWoaCJlXgBjY3ryQFv/1g0+97eTn1UDhOnPUERPD4mNmCkueOiWzVSyNOOyEnWes5QWg/SbnXwqrX",
+	"wb0I69+Ozmb7TCUiy3dyFbAqxIo/pnT1/h9B5y1v//bZUssNaxBn2ltBec4h7JHyapTn6GYYT5ozmpl5",
+	"p2zzN/xs40tjEo39PhrEM+as1H5wTezEa25XzRxUgw0XI2TKBshptllkNe/dh17pv+1fmWSFNkz598C/",
+	"C+vx4h4E7iueyrt7AwySEWG9N5MOLSrv7sJeJeDjHvv9jUnz+G33K/zHXb9RWvmJ2WEIejl0kcp7HGVt",
+	"bmsnH12Pm7N+G6S1iqJsfvb1LMXCUeI3+KZ8H5BJk+I6Xwy2epAuY8apxUDsvbANSrsty4lMmY17twuC",
+	"Pb8eehfg8XIYQF9XHOKbuMxXKsPs9gYr7OBGtXoyJSPqd0Yor7FG3UqmrcveT29Oye7F82rsbteDn1gt",
+	"+3zvtffO++wgD7K+OEZCnJ3P9VBN6HPAFJqpv9KzBFxjnv+J5vlfcyVTW/IYKlWiflukNv26LmuDgv8u",
+	"E4l0pfRinM97ENUY373coW+xZJ1D480u09aG3qbJ4wbkX6f88T0oa9qYinv9rNTYuMZVda0gVqbNisPD",
+	"ckvKm5KQ7lZzU5s2Irc7NAUxCXdmm/tWCdXRWFVBrsstrfx7d1HVHet+wbhGQYB+eAq6GbwvaraCzx/I",
+	"xYLuuHxeLCWZT8LjSODwNabimbEaJKPxCKpkojO/C5+OsW03yK881b0Wku7QvgW9OrQfn+3tNZjteFQI",
+	"/u+CuQZ4Zm5V2o0WjbsZy7eOrJ4QHo9VnP97YlyUNL36cH0t3Xl7ta7WRBQc25i6tdz4Ez/m2qJ5Cc1Q",
+	"lWuDDXuL3MOXlu+VXHA7+3nwuFsFUAkIZ0vC0xY1hPz1lkhh69xqk+e5rsTbRwKrE9jJGvd7wIJ2z6hI",
+	"L3lqda95rAisqzduE3L41vheOvxwdEIyvuDGKQ8a14gNf3ANqGIEiYalhC8WLOXUsMw+vGz95kRJrQmW",
+	"s61Kb7OIa2gRofZX5TpuSvbbl6cdkCWIbxEjw2TrCAuunQ9vewgjVqpdytxMD8Nl7bu9vwxp+5dv+Rw6",
+	"q0fnVqx7PtGsgBGiK+RxVNNWjRtRTGNwCmDakClX2gy7QA6Cqe/xLlknSLsCeXMVSHiSQuz/HgXbpEYC",
+	"a6k2yp6I0MbNQLgg9lZ5wg1WE1GcTbOl5f7puKy/XCZ9gisENorYpHuoz2Tj4J5IiRTYSsiUjck5YzlM",
+	"CL8cvsZ2WIXcBg4XwsgimbP0qRshkSqtF37G/LiKaSMVI7iQCfngI+cMo4s/6AA99njDoXPnLDyKXNsb",
+	"DouXWgT8QGSWMkWkYC4zgJJ5zjrism73bN6mAik8kDGl/NZVR/UJY0qkYNPC3BaPd+Q9qaSCDbnJ7bj7",
+	"tfqj9bYe9ngODtVBMNZNDtjq+IQQ6jXe4A0q/oacn+6V9OzW3y7p7bpbo9tr/Fhmmb1tSjGxqvJfjTUh",
+	"++2LUxNt8KqwotyYpFwnVKX+ssOL0ZWMrg/3Q21KW1xflLdnaXqoOpBCpKVRbcEC3esG11R4oo4dgu76",
+	"YN3a065anFuaz9N4N5feEHuJI8roRj9eaDfjKm7Tt8JWpBAsMT28A98pupwrRT2atjVM6ufbC9NBJRN3",
+	"2ifk9PQtNMFcEOzKMJGuIXw6GB+e4OkgW8t6uXcfp9EXzHPsHYj//vhCsYhKDt90jOP9CriWDNcyujaY",
+	"gFEy28UD3M0KjuBz7dDjfuKx8oyeC83TWiOrlcWhCRZZ0UGhFI9WlDOYMGOinVRCs4wpwrX4A1b9/A8T",
+	"ZMHTHTfdhBzbY2azIdnR3TJILjOeLNfgLtALF7ddvdPzfnMWqCEYiFGWbz6UAPW7uUBj6bmaZG3pzaNL",
+	"CnLG5jSbNlSNG1N7zQttoMNMmRqeLhpgDDwLNbLF7K0lRTbod2zPgXc78Vc9hQMxpxcAVYPgyaEhXMyZ",
+	"ArkcOjMb2KyZgWRRTR0t3NOpZHjCNDOomUqosHe04bO5sak5Fy+hpZ2YZpm8dGH9zPj0HlIRmqYwF6tn",
+	"J/PDY7cxKp3w0ZAyAXaZstPYuUHiR8EMpAkgqsgYjm0NNBJzGLol5UperXvCe3z+HpAG69EF6gGn4BjC",
+	"tRrapq2zLcMXTBY9EvsJszpw13ALfMoK7KduvBtds26Qh2s1dQAGD+qbG0212xG/BX4LH89O06uAmSaS",
+	"tneAmLiwV31v3CHM4EqiECYuuJJiwYQhF1RxSFrc53rgLjGkQYb+goJdZksr9rKyhAyzuUa4XtcRAQBv",
+	"n6w3sK4He57eiItPVGmL3dvzQYhu1aOR5d4cEeL7se6RnUp13n3R/QhSYkAEL0Nd8sO3AltxiiSyEAZz",
+	"KATZOazOlJoSpAnxijgprFotswbe4C6XCpLPQ4RJQpVaAiCMl5m/my8JlzUG05rbuXKFKcBZCvI+goCM",
+	"i4sZWAaEFDvP95752CWb3j7Mqu6Sn5+xqVTAyDAFmE3oT41hi9wMV/nB1j5cngbQbVHjvo7HiZ3aJq9Z",
+	"7XHSlOBDAgcSxJz1Up1rcskUq7bpB0tGTBgFniq5VMYSHjT+gyayMIlcsEeeeTOeiQxsU3Emk7N14ud+",
+	"CkQr6Gqj5moRc63hh4XQlYf2LUB0MxtbpPIysDGsHGToIi+LNwJ0lkGW9ehKj6p2eYdoUAYMHo8g9kUg",
+	"FlzwRbEYvdxr11toQ/uOXkFrIsqCR31QdkCFfj01qMr6fM/2IPSjVVC4F8g7cKzGXd/I2w0p+FGd3+Vb",
+	"bfGzLltYFUwV8gHfdtDp7gykusEB/yj4VXC4q+qYVJV6C1Q2XtBsDOfaHekxNr2c82SObaqF3NJJjw3L",
+	"RNo4qAOWxkS62cLWA/kunWAtYWzHAfYOIsB+Txxkw0CxXaeC74zRCHQlrmm9GoFLL9hSkrjYDvtuSAql",
+	"mDBepW/V/a7iKiO5RUdaH3hCPiw4WjPIlLMsJUnGqNKEm4FxG+/dyh7s28IBaDFsC1/cntYkunmPUv1W",
+	"NSGipLiNzmBbzm/e5oGljxYpNyA3tMIxpGDuOQdKA1mYM1kIPFyCJTjYICHAUee3JeknhdIYq/3Ni/p2",
+	"Wv99PZhfc2V3eghmO0BO/Rg1sHvzhMiZLmeOgYUxDnbagBp1UHp7yhW7hKjxlCVc28mjwFWfN+G4r333",
+	"NYBEF1quCbuiiSFYvu9vUhvQrZ2+PSEn7w87YJ1LXd/6Bb16y8QM+Mnz71/cbfaqOh5gx8oa2xsJcf5W",
+	"qd53j5LcTSW5Gk7XvUrW9esaqKTdjsvUrUlSCF6vmnZAvgFrGqi5PJSOMpV/66PIdDMqr3l6rU3eik0V",
+	"0/M+R65j26RuMkSvBm+uAcED+DlUbh1I/8flvA/2DDgQ1z0FDR5vl3lHOREemqu9JZzNaVPLQiWsz/Pg",
+	"JyUvQWTQc8XFudP7oL0x5mqAkpEsDEZYOKmZG+uAMENDXOl/ELgk6KVI5koKWWjHwezj/ZJo/h+20heB",
+	"AIwwUwBcTl0YeFkj331Q3neIVp+gOoaxQh317ebS5FkxI4rNuBTjQO3lMnySOUUZy5aLtnZXJmQxm5Op",
+	"YhWWRJlMs0RWywH54OijM7VaT8Yzj5t0sKPFcbmXD/i8OxC36W+hGNBI5Pg/elbcUbQP/0+JeaICKlyb",
+	"FxULtk4F0GPssYFoaDs+wHNiAUsfvrNtd5zM40m6wUkqFpvLmd57YkjAQM6U5hoopkrxUEZCujH/oEsd",
+	"ODoWTciJn8G/L3y+WecrVPcSsgXLcB5yxpbSXepS8RnHonvlNBmfMhBwh7rflHA83JvOg7iWo+4WT7Kb",
+	"/lBMZVRL4jf97p3mfwfxbvY0+IO19kFe24W+NEyHEmUlj2eZdfNj5MobcqvDDmN4lWsZvXZAs8yKqVyT",
+	"BTNzmZJFkRmeZ7aHJlB4/FJx4wT509O3zrkPByy0l3I9C6k051RXVnRo5RwiJVkwqgvFaktLvWVtIGv4",
+	"Bh34t+yw/3gWV7js951Hlig2JLWYa+gPDgbc+YevmTOuXPb0qNnKTXJX9ebsfGs7QGSYQr2qNudx83tK",
+	"/FVbeEA27pca0ex+tf94TxdsjQpittOEHLdyDoO3NlIXkhL8a2n9YH28BhDchFQBml4mB42GstGMoQsE",
+	"eDCQOaMpU86wptiUKSYSFnVQcHmB7OpOyrWtz1qrrsMS+tTI0KfyKY+ZLsn5kdd1FzdzWIrR7DjuNFO+",
+	"DYL+oPZTLuEpN9pS4oQ4msBMbUwkapkjp7A6v7r9wRFYCrf+V3dCXr7ff/fm+hrM4zXaNIoKDVZlT6Wg",
+	"c2OKhS4EGFlLdHGmDTeFkz4WZVy+PSqCXTBFmDCW0ivB6LOIuuFsn8S3Lz5UnPwTrPGuk3mEF8mKi8NL",
+	"J63T+pgyvSmZrDqqeL2Ez/r+Empl0/JFEC21DDJI9wu6p0JAo6RVI9zJ1pypYDhbEqsKDDJlxWvWrx4I",
+	"4ABmdPh6TCQ2pEBqhs52/l3QzNaV9gVuFssd3/nzaGx/AETs1j7AcLW2Ly+eQRGcDpcI/E9fXZvVKbhy",
+	"CooPAB5THo/W6vKeXZlTec7EXaWQrWkQNnWerYj3d5UvNjhd5aEuf7PHGghyYPXq6Cvi1H24mzfEzatV",
+	"3yUFIBx4WIaRQYfkH24fhabBzlW18YdENITVYfrYclD7ftN4BgvWYzDDbyyYAYhiG5EMKHHcSRjD8AQP",
+	"3yqvPw1xuQar2F3Qq5Uu086ZNso6fJ4nW2jK0/YwhvKOXj3ylAfPU8aRQo+KJ8RIOM6KswtWoxL77rUl",
+	"vzoqMwLr6KvuxQQA849RIoXT2f8aljDzRcJwM35V1LDRl7v1A35Hr0Iu+Mj17ofr+eACM4D7We+qjeqo",
+	"Vp2jbK36OOD56vhX92Fvl3h2Etq9FC/1q7up0O1xdJ9Pr3VF8VtT5FcE4ym1+q1et6OfQEs1KTg79NYl",
+	"DYn0djSCdvxXBc/StfwMnm8dhrdsRpPOHB/gXZnaTCtJwvJbri9xhw/HHmqNeQQE5BIjwhrP3KUZpwDp",
+	"V/xHd1F8TLZO+LQc3bqxWtkQ+xJ2xbXpZ6T7djb8TwdTzamZVzyVupbdAkWDDjx0vuNdig5+clze4Ogh",
+	"uEVAr1h5CS8d9GtT70PLdnfPDjJIsqZFESvPhG2x0rRauarQmXd16RQnbJ/yIJzS2W0x7PpMMNFN0zjC",
+	"GD3FTh5duG5sSnUkamgtrg3/213ta19rPhPQ6Yl+Cm+2RqRB2i8v3CIJWsg2JsFnWwaEpSEoUfGBzgh1",
+	"bR8pfIsU7mm0n8Lr7Per/+eKas2Bh0uHfNxkuuW4G+imyq7Dy0VVotKWikX9xt5MDfbX+Wjqt10hq9Mu",
+	"2UonKYRS6JboYEO76gYW3LsQWqGMPb7x9LpKr/A9wMGQ7kb5XXnwhfduz/O/in3sf//73EKdL38Y6FYY",
+	"2+1pEOya1pIE9gYwVp/S566u7d8YE25T2s00V8fM6mGoGKi3+jaI99tVf33TKi1PTWvptEIBchf7692v",
+	"+F8nUQ6lZUzIZGvwD3hPlZPaa/SVnfCWRQy3rDUqL1mEQlC58/D+DZCJ26jm6/eGpDIg61htRp+XZojk",
+	"WaORjTKJbUgnj1nHVmYdW5F0bDM/gK1kDYuuhV2wbJ1B32KHCGpPrB/tkN0HbWcHbq037lqrtBPfkXYe",
+	"z9zm+b1iR/5hKn46mO09OzPFGOZN+bStxrAOp+bgb7wBpz6xM90Xrz4UKbvyB7QMbS1x2Xlcy4SygRwV",
+	"5SVypj9Mp5p1MMe1U6//Ztj3xlz2zlhaZxD+Slb2yL825V/as4M1OdiUZ/DTnOr5dS/booIUeSZpSjLI",
+	"iuWUnFQRGAGL0FEuAh5Al8x+GyqH/ght/0b1/KY8LWK8n9thh9ruAQrP2/wSVpvvn93OaQK8fETMd723",
+	"w31xAYTS/4iny+3Sb8CE9A2cRDwPDvsfj9+ufyS90X9FoAya+jcxNThz6zbNTrfoFHhKZzeNAAgtft98",
+	"3caHZGPotqGGdYxW03LVNELBn573VApuOY3TlBqKnkzLMlvT1AZYBoHRTJfBk4Vm6q/0LPlc7O09/xPN",
+	"87/mSqYQGEneQHqXc2bzM9r8FmRRYHYnONmEiUSmNmFhhw86QrMqijIeDVoCerbEbPFSkYW0yRCsVw+7",
+	"yjOZstHLKc0064wkMPUH8DqVRE5M1Cl3PNJmmcEPIN1+S9bHQYt/i3lATqrEipu7JKtmjovHuPCuCNLq",
+	"XF48f9qTsaa3QNqgKktdqtGAz3yjJdQeCys84MIK7+wiUDmCT3gX21QoMSHQm5wxKOaPKchsA0w3cpVk",
+	"RdqN262pXA+oZjuaCc0Nv2A24wjcUWQBBn5I6guQL5jWdMaIZ7kd1w6jKpl3F1T404CsCGGNB9hFWZjc",
+	"Vk+vyjaHiYEs4gyddcDkep3S2XbhcuN6+FyeOQ7AKRtTE7+Y3cd2PJo2qU3apU3KFBzn3CzvOvYsKCb4",
+	"6fnNyk88lhVcXVZwwK0XDy5bP5Ts0/N7Cyb7jQmK2w5bG49cGiiY+H92AAM7FgXtGBi86fFtw4wLvRXs",
+	"ypAcmLOcuorUegxxMvhswJCY3pfI9W8xTvShheM1D/ptx+TVT/tduSV9ev7QHZOqMu3fpLPe3Sf3LXU+",
+	"PQTcvKla/vMdBTcGuZgGlPzbdjK9FSC6BbhHL9Zb9WLd+LyscBdc1zkwenzuzz3wlu8hxMhat9DD8k68",
+	"34dJxIVxJRm/qD9QVpRjWC23vLgXueXFfcktDgDPqD0gD0uE+T1ke2mLOy966V5mxYINzGtIfOvYs7z8",
+	"dPsPVzvXjROk+9X87hKkV9voycL/0h0i3WB95Shx7hcQw/Y533t26SngbmOg7az7InWKnRX05ku1tHH2",
+	"+Ezr5lshZbXJM+BZu1/tP4bHNncTrW3kyPaTG3ZtgdLDc5O0+bRNKI9q566k+T2EMu5zYC67dnov3yYp",
+	"7N0XQ/KZCB+pbFiq0BV86JKdzaU8HyA8+ZZYmlQbplgKbjF9mYV/8YPfhUDlJruxRFVi5HcnUl1W2+WJ",
+	"pfypW6g6duTgRATXgyiWMH4R1uiFgnvJMskYYReAlqjUVSOZWxG7Sjq5W7nLygZpbfb15K7LisAfBa9u",
+	"wcujKUrEIcvb/er+tZbwVQ0fk748+f7iR1770i1h2oIAVqOYx7uxSwLbiGJ2DdO91RKF3QZtLLsDOzHc",
+	"lZ4/2kLipQeLtRV7233KoOS+WraoLGSRJY2dMm22S2fbk+4ckKdWr1Zk0Uo+pxWWQKmm2+h6JOGYeKfN",
+	"EAJGqNWFp4tCZaOXo7kxuX65u0tzPmHPzyY0z5EQ3ABfK8eTKqHv10ZlnvqPmIM5/Bv50o4Bqb7eMOc7",
+	"52xZ+82FBZR/V2qt8qdALqsA8eUBv1z/vwEA",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
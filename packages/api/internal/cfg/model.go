@@ -107,6 +107,20 @@ type Config struct {
 	DefaultPersistentVolumeType string `env:"DEFAULT_PERSISTENT_VOLUME_TYPE"`
 
 	DomainName string `env:"DOMAIN_NAME" envDefault:""`
+
+	// WebhooksDispatcherEnabled makes this instance consume the sandbox events stream and deliver
+	// the events to team webhooks. Registration endpoints are available regardless of this setting.
+	WebhooksDispatcherEnabled bool `env:"WEBHOOKS_DISPATCHER_ENABLED" envDefault:"false"`
+
+	// SecretsEncryptionKey is the base64-encoded 32-byte key team secrets are encrypted with.
+	// Team secrets are disabled when empty.
+	SecretsEncryptionKey string `env:"SECRETS_ENCRYPTION_KEY"`
+
+	// SandboxIdentityPublicKeysBase64 is the base64-encoded PEM bundle of the public keys the
+	// orchestrators sign the sandbox identity tokens with. The OIDC discovery is disabled when empty.
+	// The issuer must be the URL the API is reachable at.
+	SandboxIdentityIssuer           string `env:"SANDBOX_IDENTITY_ISSUER"`
+	SandboxIdentityPublicKeysBase64 string `env:"SANDBOX_IDENTITY_PUBLIC_KEYS_BASE64"`
 }
 
 type FailureCondition string
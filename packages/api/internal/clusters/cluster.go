@@ -77,6 +77,7 @@ func newLocalCluster(
 	clickhouse clickhouse.Clickhouse,
 	queryLogsProvider *loki.LokiQueryProvider,
 	sandboxLogsReader ClickhouseLogsReader,
+	egressLogsReader ClickhouseEgressLogsReader,
 	featureFlags *featureflags.Client,
 	config cfg.Config,
 ) *Cluster {
@@ -96,7 +97,7 @@ func newLocalCluster(
 		"",
 		instances,
 		synchronization.NewSynchronize("cluster-instances", "Cluster instances", store),
-		newLocalClusterResourceProvider(clickhouse, queryLogsProvider, sandboxLogsReader, featureFlags, instances, config),
+		newLocalClusterResourceProvider(clickhouse, queryLogsProvider, sandboxLogsReader, egressLogsReader, featureFlags, instances, config),
 	)
 
 	// Periodically sync cluster instances
@@ -51,6 +51,7 @@ func NewPool(
 	queryMetricsProvider clickhouse.Clickhouse,
 	queryLogsProvider *loki.LokiQueryProvider,
 	sandboxLogsReader ClickhouseLogsReader,
+	egressLogsReader ClickhouseEgressLogsReader,
 	featureFlags *featureflags.Client,
 	config cfg.Config,
 ) (*Pool, error) {
@@ -75,6 +76,7 @@ func NewPool(
 				queryLogsProvider:    queryLogsProvider,
 				queryMetricsProvider: queryMetricsProvider,
 				sandboxLogsReader:    sandboxLogsReader,
+				egressLogsReader:     egressLogsReader,
 				featureFlags:         featureFlags,
 			},
 		),
@@ -120,6 +122,7 @@ type clustersSyncStore struct {
 	queryMetricsProvider clickhouse.Clickhouse
 	queryLogsProvider    *loki.LokiQueryProvider
 	sandboxLogsReader    ClickhouseLogsReader
+	egressLogsReader     ClickhouseEgressLogsReader
 	featureFlags         *featureflags.Client
 	config               cfg.Config
 }
@@ -178,7 +181,7 @@ func (d clustersSyncStore) PoolInsert(ctx context.Context, cluster queries.Clust
 
 	// Local cluster
 	if cluster.ID == consts.LocalClusterID {
-		c = newLocalCluster(context.WithoutCancel(ctx), d.tel, d.localDiscovery, d.queryMetricsProvider, d.queryLogsProvider, d.sandboxLogsReader, d.featureFlags, d.config)
+		c = newLocalCluster(context.WithoutCancel(ctx), d.tel, d.localDiscovery, d.queryMetricsProvider, d.queryLogsProvider, d.sandboxLogsReader, d.egressLogsReader, d.featureFlags, d.config)
 		d.clusters.Insert(clusterID, c)
 		logger.L().Info(ctx, "Local cluster initialized successfully", logger.WithClusterID(cluster.ID))
 
@@ -159,8 +159,8 @@ func (_c *MockClusterResource_GetBuildLogs_Call) RunAndReturn(run func(ctx conte
 }
 
 // GetSandboxLogs provides a mock function for the type MockClusterResource
-func (_mock *MockClusterResource) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string) (api.SandboxLogs, *api.APIError) {
-	ret := _mock.Called(ctx, teamID, sandboxID, start, end, limit, direction, level, search)
+func (_mock *MockClusterResource) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string) (api.SandboxLogs, *api.APIError) {
+	ret := _mock.Called(ctx, teamID, sandboxID, start, end, limit, direction, level, search, processTag, stream)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSandboxLogs")
@@ -168,16 +168,16 @@ func (_mock *MockClusterResource) GetSandboxLogs(ctx context.Context, teamID str
 
 	var r0 api.SandboxLogs
 	var r1 *api.APIError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string) (api.SandboxLogs, *api.APIError)); ok {
-		return returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string, *string, *string) (api.SandboxLogs, *api.APIError)); ok {
+		return returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search, processTag, stream)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string) api.SandboxLogs); ok {
-		r0 = returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string, *string, *string) api.SandboxLogs); ok {
+		r0 = returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search, processTag, stream)
 	} else {
 		r0 = ret.Get(0).(api.SandboxLogs)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string) *api.APIError); ok {
-		r1 = returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *int64, *int64, *int32, *api.LogsDirection, *logs.LogLevel, *string, *string, *string) *api.APIError); ok {
+		r1 = returnFunc(ctx, teamID, sandboxID, start, end, limit, direction, level, search, processTag, stream)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*api.APIError)
@@ -201,11 +201,13 @@ type MockClusterResource_GetSandboxLogs_Call struct {
 //   - direction *api.LogsDirection
 //   - level *logs.LogLevel
 //   - search *string
-func (_e *MockClusterResource_Expecter) GetSandboxLogs(ctx interface{}, teamID interface{}, sandboxID interface{}, start interface{}, end interface{}, limit interface{}, direction interface{}, level interface{}, search interface{}) *MockClusterResource_GetSandboxLogs_Call {
-	return &MockClusterResource_GetSandboxLogs_Call{Call: _e.mock.On("GetSandboxLogs", ctx, teamID, sandboxID, start, end, limit, direction, level, search)}
+//   - processTag *string
+//   - stream *string
+func (_e *MockClusterResource_Expecter) GetSandboxLogs(ctx interface{}, teamID interface{}, sandboxID interface{}, start interface{}, end interface{}, limit interface{}, direction interface{}, level interface{}, search interface{}, processTag interface{}, stream interface{}) *MockClusterResource_GetSandboxLogs_Call {
+	return &MockClusterResource_GetSandboxLogs_Call{Call: _e.mock.On("GetSandboxLogs", ctx, teamID, sandboxID, start, end, limit, direction, level, search, processTag, stream)}
 }
 
-func (_c *MockClusterResource_GetSandboxLogs_Call) Run(run func(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string)) *MockClusterResource_GetSandboxLogs_Call {
+func (_c *MockClusterResource_GetSandboxLogs_Call) Run(run func(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string)) *MockClusterResource_GetSandboxLogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -243,6 +245,14 @@ func (_c *MockClusterResource_GetSandboxLogs_Call) Run(run func(ctx context.Cont
 		if args[8] != nil {
 			arg8 = args[8].(*string)
 		}
+		var arg9 *string
+		if args[9] != nil {
+			arg9 = args[9].(*string)
+		}
+		var arg10 *string
+		if args[10] != nil {
+			arg10 = args[10].(*string)
+		}
 		run(
 			arg0,
 			arg1,
@@ -253,6 +263,8 @@ func (_c *MockClusterResource_GetSandboxLogs_Call) Run(run func(ctx context.Cont
 			arg6,
 			arg7,
 			arg8,
+			arg9,
+			arg10,
 		)
 	})
 	return _c
@@ -263,7 +275,7 @@ func (_c *MockClusterResource_GetSandboxLogs_Call) Return(sandboxLogs api.Sandbo
 	return _c
 }
 
-func (_c *MockClusterResource_GetSandboxLogs_Call) RunAndReturn(run func(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string) (api.SandboxLogs, *api.APIError)) *MockClusterResource_GetSandboxLogs_Call {
+func (_c *MockClusterResource_GetSandboxLogs_Call) RunAndReturn(run func(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string) (api.SandboxLogs, *api.APIError)) *MockClusterResource_GetSandboxLogs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -356,6 +368,112 @@ func (_c *MockClusterResource_GetSandboxMetrics_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetSandboxNetworkLogs provides a mock function for the type MockClusterResource
+func (_mock *MockClusterResource) GetSandboxNetworkLogs(ctx context.Context, teamID string, sandboxID string, cursor *time.Time, limit *int32, direction api.LogsDirection, decision *api.SandboxNetworkDecision, host *string) ([]api.SandboxNetworkLogEntry, *api.APIError) {
+	ret := _mock.Called(ctx, teamID, sandboxID, cursor, limit, direction, decision, host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSandboxNetworkLogs")
+	}
+
+	var r0 []api.SandboxNetworkLogEntry
+	var r1 *api.APIError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *time.Time, *int32, api.LogsDirection, *api.SandboxNetworkDecision, *string) ([]api.SandboxNetworkLogEntry, *api.APIError)); ok {
+		return returnFunc(ctx, teamID, sandboxID, cursor, limit, direction, decision, host)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *time.Time, *int32, api.LogsDirection, *api.SandboxNetworkDecision, *string) []api.SandboxNetworkLogEntry); ok {
+		r0 = returnFunc(ctx, teamID, sandboxID, cursor, limit, direction, decision, host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.SandboxNetworkLogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *time.Time, *int32, api.LogsDirection, *api.SandboxNetworkDecision, *string) *api.APIError); ok {
+		r1 = returnFunc(ctx, teamID, sandboxID, cursor, limit, direction, decision, host)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*api.APIError)
+		}
+	}
+	return r0, r1
+}
+
+// MockClusterResource_GetSandboxNetworkLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSandboxNetworkLogs'
+type MockClusterResource_GetSandboxNetworkLogs_Call struct {
+	*mock.Call
+}
+
+// GetSandboxNetworkLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - sandboxID string
+//   - cursor *time.Time
+//   - limit *int32
+//   - direction api.LogsDirection
+//   - decision *api.SandboxNetworkDecision
+//   - host *string
+func (_e *MockClusterResource_Expecter) GetSandboxNetworkLogs(ctx interface{}, teamID interface{}, sandboxID interface{}, cursor interface{}, limit interface{}, direction interface{}, decision interface{}, host interface{}) *MockClusterResource_GetSandboxNetworkLogs_Call {
+	return &MockClusterResource_GetSandboxNetworkLogs_Call{Call: _e.mock.On("GetSandboxNetworkLogs", ctx, teamID, sandboxID, cursor, limit, direction, decision, host)}
+}
+
+func (_c *MockClusterResource_GetSandboxNetworkLogs_Call) Run(run func(ctx context.Context, teamID string, sandboxID string, cursor *time.Time, limit *int32, direction api.LogsDirection, decision *api.SandboxNetworkDecision, host *string)) *MockClusterResource_GetSandboxNetworkLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		var arg4 *int32
+		if args[4] != nil {
+			arg4 = args[4].(*int32)
+		}
+		var arg5 api.LogsDirection
+		if args[5] != nil {
+			arg5 = args[5].(api.LogsDirection)
+		}
+		var arg6 *api.SandboxNetworkDecision
+		if args[6] != nil {
+			arg6 = args[6].(*api.SandboxNetworkDecision)
+		}
+		var arg7 *string
+		if args[7] != nil {
+			arg7 = args[7].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+			arg7,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClusterResource_GetSandboxNetworkLogs_Call) Return(sandboxNetworkLogEntrys []api.SandboxNetworkLogEntry, aPIError *api.APIError) *MockClusterResource_GetSandboxNetworkLogs_Call {
+	_c.Call.Return(sandboxNetworkLogEntrys, aPIError)
+	return _c
+}
+
+func (_c *MockClusterResource_GetSandboxNetworkLogs_Call) RunAndReturn(run func(ctx context.Context, teamID string, sandboxID string, cursor *time.Time, limit *int32, direction api.LogsDirection, decision *api.SandboxNetworkDecision, host *string) ([]api.SandboxNetworkLogEntry, *api.APIError)) *MockClusterResource_GetSandboxNetworkLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSandboxesMetrics provides a mock function for the type MockClusterResource
 func (_mock *MockClusterResource) GetSandboxesMetrics(ctx context.Context, teamID string, sandboxIDs []string) (map[string]api.SandboxMetric, *api.APIError) {
 	ret := _mock.Called(ctx, teamID, sandboxIDs)
@@ -20,8 +20,9 @@ import (
 type ClusterResource interface {
 	GetSandboxMetrics(ctx context.Context, teamID string, sandboxID string, qStart *int64, qEnd *int64) ([]api.SandboxMetric, *api.APIError)
 	GetSandboxesMetrics(ctx context.Context, teamID string, sandboxIDs []string) (map[string]api.SandboxMetric, *api.APIError)
-	GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string) (api.SandboxLogs, *api.APIError)
+	GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, direction *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string) (api.SandboxLogs, *api.APIError)
 	GetBuildLogs(ctx context.Context, nodeID *string, templateID string, buildID string, offset int32, limit int32, level *logs.LogLevel, cursor *time.Time, direction api.LogsDirection, source *api.LogsSource) ([]logs.LogEntry, *api.APIError)
+	GetSandboxNetworkLogs(ctx context.Context, teamID string, sandboxID string, cursor *time.Time, limit *int32, direction api.LogsDirection, decision *api.SandboxNetworkDecision, host *string) ([]api.SandboxNetworkLogEntry, *api.APIError)
 }
 
 const (
@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/cfg"
 	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/sandboxlogs"
 	clickhouseutils "github.com/e2b-dev/infra/packages/clickhouse/pkg/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
@@ -26,12 +28,20 @@ import (
 // ClickhouseLogsReader is the narrow ClickHouse sandbox_logs reader the local
 // cluster needs.
 type ClickhouseLogsReader interface {
-	QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, level *logs.LogLevel, search *string) ([]logs.LogEntry, error)
+	QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, level *logs.LogLevel, search *string, processTag *string, stream *string) ([]logs.LogEntry, error)
 	QueryBuildLogs(ctx context.Context, templateID, buildID string, start, end time.Time, limit int, offset int32, level *logs.LogLevel, order sandboxlogs.SortOrder) ([]logs.LogEntry, error)
 }
 
 var _ ClickhouseLogsReader = (*sandboxlogs.Reader)(nil)
 
+// ClickhouseEgressLogsReader is the narrow ClickHouse sandbox_egress_logs
+// reader the local cluster needs.
+type ClickhouseEgressLogsReader interface {
+	QuerySandboxEgressLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, filter egresslogs.QueryFilter) ([]egresslogs.SandboxEgressLog, error)
+}
+
+var _ ClickhouseEgressLogsReader = (*egresslogs.Reader)(nil)
+
 // logReadMeter/logReadErrorCount count ClickHouse log read failures so
 // operators can alert on them, broken down by log kind.
 var (
@@ -64,6 +74,7 @@ type LocalClusterResourceProvider struct {
 	querySandboxMetricsProvider clickhouse.SandboxQueriesProvider
 	queryLogsProvider           *loki.LokiQueryProvider
 	sandboxLogsReader           ClickhouseLogsReader
+	egressLogsReader            ClickhouseEgressLogsReader
 	featureFlags                *featureflags.Client
 	instances                   *smap.Map[*Instance]
 }
@@ -72,6 +83,7 @@ func newLocalClusterResourceProvider(
 	querySandboxMetricsProvider clickhouse.SandboxQueriesProvider,
 	queryLogsProvider *loki.LokiQueryProvider,
 	sandboxLogsReader ClickhouseLogsReader,
+	egressLogsReader ClickhouseEgressLogsReader,
 	featureFlags *featureflags.Client,
 	instances *smap.Map[*Instance],
 	config cfg.Config,
@@ -81,6 +93,7 @@ func newLocalClusterResourceProvider(
 		querySandboxMetricsProvider: querySandboxMetricsProvider,
 		queryLogsProvider:           queryLogsProvider,
 		sandboxLogsReader:           sandboxLogsReader,
+		egressLogsReader:            egressLogsReader,
 		featureFlags:                featureFlags,
 		instances:                   instances,
 	}
@@ -184,7 +197,7 @@ func (l *LocalClusterResourceProvider) GetSandboxesMetrics(ctx context.Context,
 	return metrics, nil
 }
 
-func (l *LocalClusterResourceProvider) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, qStart *int64, qEnd *int64, qLimit *int32, qDirection *api.LogsDirection, level *logs.LogLevel, search *string) (api.SandboxLogs, *api.APIError) {
+func (l *LocalClusterResourceProvider) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, qStart *int64, qEnd *int64, qLimit *int32, qDirection *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string) (api.SandboxLogs, *api.APIError) {
 	start, end := time.Now().Add(-logsOldestLimit), time.Now()
 	if qStart != nil {
 		start = time.UnixMilli(*qStart)
@@ -213,12 +226,12 @@ func (l *LocalClusterResourceProvider) GetSandboxLogs(ctx context.Context, teamI
 			}
 		}
 
-		raw, err = l.sandboxLogsReader.QuerySandboxLogs(ctx, teamUUID, sandboxID, start, end, limit, apiLogDirectionToSandboxLogsSortOrder(qDirection), level, search)
+		raw, err = l.sandboxLogsReader.QuerySandboxLogs(ctx, teamUUID, sandboxID, start, end, limit, apiLogDirectionToSandboxLogsSortOrder(qDirection), level, search, processTag, stream)
 		if err != nil {
 			recordClickhouseLogReadError(ctx, "sandbox")
 		}
 	} else {
-		raw, err = l.queryLogsProvider.QuerySandboxLogs(ctx, teamID, sandboxID, start, end, limit, apiLogDirectionToLokiProtoDirection(qDirection), level, search)
+		raw, err = l.queryLogsProvider.QuerySandboxLogs(ctx, teamID, sandboxID, start, end, limit, apiLogDirectionToLokiProtoDirection(qDirection), level, search, processTag, stream)
 	}
 	if err != nil {
 		return api.SandboxLogs{}, &api.APIError{
@@ -303,3 +316,84 @@ func (l *LocalClusterResourceProvider) logsFromLocalLoki(ctx context.Context, te
 		return entries, nil
 	}
 }
+
+func (l *LocalClusterResourceProvider) GetSandboxNetworkLogs(ctx context.Context, teamID string, sandboxID string, cursor *time.Time, qLimit *int32, direction api.LogsDirection, decision *api.SandboxNetworkDecision, host *string) ([]api.SandboxNetworkLogEntry, *api.APIError) {
+	if l.egressLogsReader == nil {
+		return nil, &api.APIError{
+			Err:       errors.New("no ClickHouse egress logs reader configured"),
+			ClientMsg: "Sandbox network logs are not available",
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	teamUUID, err := uuid.Parse(teamID)
+	if err != nil {
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("invalid team ID %q: %w", teamID, err),
+			ClientMsg: "Invalid team ID",
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	limit := defaultLogsLimit
+	if qLimit != nil {
+		limit = int(*qLimit)
+	}
+
+	var filter egresslogs.QueryFilter
+	if decision != nil {
+		filter.Decision = string(*decision)
+	}
+	if host != nil {
+		filter.Hostname = *host
+	}
+
+	start, end := LogQueryWindow(cursor, direction)
+
+	raw, err := l.egressLogsReader.QuerySandboxEgressLogs(ctx, teamUUID, sandboxID, start, end, limit, apiLogDirectionToSandboxLogsSortOrder(&direction), filter)
+	if err != nil {
+		recordClickhouseLogReadError(ctx, "egress")
+
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("error when fetching sandbox network logs: %w", err),
+			ClientMsg: "Failed to fetch sandbox network logs",
+			Code:      http.StatusInternalServerError,
+		}
+	}
+
+	entries := make([]api.SandboxNetworkLogEntry, len(raw))
+	for i, row := range raw {
+		entries[i] = egressLogToAPI(row)
+	}
+
+	return entries, nil
+}
+
+// egressLogToAPI converts a stored egress log row into its API representation,
+// omitting optional fields that were not recorded.
+func egressLogToAPI(row egresslogs.SandboxEgressLog) api.SandboxNetworkLogEntry {
+	entry := api.SandboxNetworkLogEntry{
+		Timestamp:       row.Timestamp,
+		Protocol:        api.SandboxNetworkLogEntryProtocol(row.Protocol),
+		Host:            row.Hostname,
+		DestinationIP:   row.DestinationIP,
+		DestinationPort: int32(row.DestinationPort),
+		Decision:        api.SandboxNetworkDecision(row.Decision),
+		MatchType:       api.SandboxNetworkLogEntryMatchType(row.MatchType),
+		DurationMs:      int64(row.DurationMs),
+		BytesSent:       int64(row.BytesSent),
+		BytesReceived:   int64(row.BytesReceived),
+	}
+
+	if row.Method != "" {
+		entry.Method = &row.Method
+	}
+	if row.ResolvedIP != "" {
+		entry.ResolvedIP = &row.ResolvedIP
+	}
+	if row.Error != "" {
+		entry.Error = &row.Error
+	}
+
+	return entry
+}
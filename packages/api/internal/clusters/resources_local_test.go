@@ -10,23 +10,33 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/sandboxlogs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 )
 
 type stubClickhouseLogsReader struct {
-	sandbox func(context.Context, uuid.UUID, string, time.Time, time.Time, int, sandboxlogs.SortOrder, *logs.LogLevel, *string) ([]logs.LogEntry, error)
+	sandbox func(context.Context, uuid.UUID, string, time.Time, time.Time, int, sandboxlogs.SortOrder, *logs.LogLevel, *string, *string, *string) ([]logs.LogEntry, error)
 	build   func(context.Context, string, string, time.Time, time.Time, int, int32, *logs.LogLevel, sandboxlogs.SortOrder) ([]logs.LogEntry, error)
 }
 
-func (s *stubClickhouseLogsReader) QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, level *logs.LogLevel, search *string) ([]logs.LogEntry, error) {
-	return s.sandbox(ctx, teamID, sandboxID, start, end, limit, order, level, search)
+func (s *stubClickhouseLogsReader) QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, level *logs.LogLevel, search *string, processTag *string, stream *string) ([]logs.LogEntry, error) {
+	return s.sandbox(ctx, teamID, sandboxID, start, end, limit, order, level, search, processTag, stream)
 }
 
 func (s *stubClickhouseLogsReader) QueryBuildLogs(ctx context.Context, templateID, buildID string, start, end time.Time, limit int, offset int32, level *logs.LogLevel, order sandboxlogs.SortOrder) ([]logs.LogEntry, error) {
 	return s.build(ctx, templateID, buildID, start, end, limit, offset, level, order)
 }
 
+type stubClickhouseEgressLogsReader struct {
+	query func(context.Context, uuid.UUID, string, time.Time, time.Time, int, sandboxlogs.SortOrder, egresslogs.QueryFilter) ([]egresslogs.SandboxEgressLog, error)
+}
+
+func (s *stubClickhouseEgressLogsReader) QuerySandboxEgressLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, filter egresslogs.QueryFilter) ([]egresslogs.SandboxEgressLog, error) {
+	return s.query(ctx, teamID, sandboxID, start, end, limit, order, filter)
+}
+
 // TestBuildLogsFromClickhouseFailsFast asserts that a ClickHouse read error
 // during GetBuildLogs is propagated directly (no automatic Loki fallback).
 // The migration relies on the logs-read-config flag plus alerting on
@@ -82,3 +92,109 @@ func TestBuildLogsFromClickhouseFailsFast(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSandboxNetworkLogs(t *testing.T) {
+	t.Parallel()
+
+	teamID := uuid.New()
+	ts := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	cursor := time.Now().Add(-time.Hour)
+
+	provider := &LocalClusterResourceProvider{
+		egressLogsReader: &stubClickhouseEgressLogsReader{query: func(_ context.Context, gotTeamID uuid.UUID, sandboxID string, _, end time.Time, limit int, order sandboxlogs.SortOrder, filter egresslogs.QueryFilter) ([]egresslogs.SandboxEgressLog, error) {
+			assert.Equal(t, teamID, gotTeamID)
+			assert.Equal(t, "sandbox-id", sandboxID)
+			assert.Equal(t, cursor, end)
+			assert.Equal(t, 10, limit)
+			assert.Equal(t, sandboxlogs.SortOrderBackward, order)
+			assert.Equal(t, egresslogs.QueryFilter{Decision: "allowed", Hostname: "example.com"}, filter)
+
+			return []egresslogs.SandboxEgressLog{
+				{
+					Timestamp:       ts,
+					Protocol:        "http",
+					Hostname:        "example.com",
+					Method:          "GET",
+					DestinationIP:   "93.184.216.34",
+					ResolvedIP:      "93.184.216.34",
+					DestinationPort: 80,
+					Decision:        "allowed",
+					MatchType:       "domain",
+					DurationMs:      12,
+					BytesSent:       100,
+					BytesReceived:   2048,
+				},
+				{
+					Timestamp:       ts,
+					Protocol:        "tls",
+					Hostname:        "example.com",
+					DestinationIP:   "93.184.216.34",
+					DestinationPort: 443,
+					Decision:        "allowed",
+					MatchType:       "none",
+					Error:           "dial tcp: connection refused",
+				},
+			}, nil
+		}},
+	}
+
+	decision := api.SandboxNetworkDecisionAllowed
+	host := "example.com"
+	limit := int32(10)
+
+	got, apiErr := provider.GetSandboxNetworkLogs(t.Context(), teamID.String(), "sandbox-id", &cursor, &limit, api.LogsDirectionBackward, &decision, &host)
+	require.Nil(t, apiErr)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, api.SandboxNetworkLogEntry{
+		Timestamp:       ts,
+		Protocol:        api.SandboxNetworkProtocolHTTP,
+		Host:            "example.com",
+		Method:          new("GET"),
+		DestinationIP:   "93.184.216.34",
+		ResolvedIP:      new("93.184.216.34"),
+		DestinationPort: 80,
+		Decision:        api.SandboxNetworkDecisionAllowed,
+		MatchType:       api.SandboxNetworkMatchTypeDomain,
+		DurationMs:      12,
+		BytesSent:       100,
+		BytesReceived:   2048,
+	}, got[0])
+
+	// Unrecorded optional fields are omitted.
+	assert.Nil(t, got[1].Method)
+	assert.Nil(t, got[1].ResolvedIP)
+	assert.Equal(t, new("dial tcp: connection refused"), got[1].Error)
+}
+
+func TestGetSandboxNetworkLogsErrors(t *testing.T) {
+	t.Parallel()
+
+	clickhouseErr := errors.New("clickhouse unavailable")
+	failing := &stubClickhouseEgressLogsReader{query: func(context.Context, uuid.UUID, string, time.Time, time.Time, int, sandboxlogs.SortOrder, egresslogs.QueryFilter) ([]egresslogs.SandboxEgressLog, error) {
+		return nil, clickhouseErr
+	}}
+
+	tests := []struct {
+		name     string
+		reader   ClickhouseEgressLogsReader
+		teamID   string
+		wantCode int
+	}{
+		{name: "no reader configured", teamID: uuid.NewString(), wantCode: 400},
+		{name: "invalid team ID", reader: failing, teamID: "not-a-uuid", wantCode: 400},
+		{name: "ClickHouse error", reader: failing, teamID: uuid.NewString(), wantCode: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &LocalClusterResourceProvider{egressLogsReader: tt.reader}
+
+			_, apiErr := provider.GetSandboxNetworkLogs(t.Context(), tt.teamID, "sandbox-id", nil, nil, api.LogsDirectionForward, nil, nil)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, tt.wantCode, apiErr.Code)
+		})
+	}
+}
@@ -107,16 +107,18 @@ func (r *ClusterResourceProviderImpl) GetSandboxesMetrics(ctx context.Context, t
 	return items, nil
 }
 
-func (r *ClusterResourceProviderImpl) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, dr *api.LogsDirection, level *logs.LogLevel, search *string) (api.SandboxLogs, *api.APIError) {
+func (r *ClusterResourceProviderImpl) GetSandboxLogs(ctx context.Context, teamID string, sandboxID string, start *int64, end *int64, limit *int32, dr *api.LogsDirection, level *logs.LogLevel, search *string, processTag *string, stream *string) (api.SandboxLogs, *api.APIError) {
 	direction := apiLogDirectionToEdgeSandboxLogsDirection(dr)
 	params := &edgeapi.V1SandboxLogsParams{
-		TeamID:    teamID,
-		Start:     start,
-		End:       end,
-		Limit:     limit,
-		Direction: direction,
-		Level:     logToEdgeLevel(level),
-		Search:    search,
+		TeamID:     teamID,
+		Start:      start,
+		End:        end,
+		Limit:      limit,
+		Direction:  direction,
+		Level:      logToEdgeLevel(level),
+		Search:     search,
+		ProcessTag: processTag,
+		Stream:     (*edgeapi.V1SandboxLogsParamsStream)(stream),
 	}
 	res, err := r.client.V1SandboxLogsWithResponse(ctx, sandboxID, params)
 	if err != nil {
@@ -133,6 +135,7 @@ func (r *ClusterResourceProviderImpl) GetSandboxLogs(ctx context.Context, teamID
 
 	raw := *res.JSON200
 	r.logEdgeLogsFilteringCompatibility(ctx, sandboxID, level, search, res.HTTPResponse)
+	r.logEdgeLogsProcessFilteringCompatibility(ctx, sandboxID, processTag, stream, res.HTTPResponse)
 
 	l := make([]api.SandboxLog, 0, len(raw.Logs))
 	le := make([]api.SandboxLogEntry, 0, len(raw.LogEntries))
@@ -175,6 +178,16 @@ func (r *ClusterResourceProviderImpl) GetBuildLogs(
 	return getBuildLogsWithSources(ctx, r.instances, nodeID, templateID, buildID, offset, limit, level, cursor, direction, source, persistentFetcher)
 }
 
+// GetSandboxNetworkLogs is not supported for remote clusters: their egress
+// audit logs are stored in the cluster's own ClickHouse, which the edge API does not expose.
+func (r *ClusterResourceProviderImpl) GetSandboxNetworkLogs(_ context.Context, _ string, _ string, _ *time.Time, _ *int32, _ api.LogsDirection, _ *api.SandboxNetworkDecision, _ *string) ([]api.SandboxNetworkLogEntry, *api.APIError) {
+	return nil, &api.APIError{
+		Err:       fmt.Errorf("sandbox network logs are not supported for cluster %s", r.clusterID),
+		ClientMsg: "Sandbox network logs are not available for this cluster",
+		Code:      http.StatusBadRequest,
+	}
+}
+
 func (r *ClusterResourceProviderImpl) getBuildLogsFromEdge(ctx context.Context, templateID string, buildID string, offset int32, limit int32, level *logs.LogLevel, start time.Time, end time.Time, dr api.LogsDirection) logSourceFunc {
 	return func() ([]logs.LogEntry, *api.APIError) {
 		direction := apiLogDirectionToEdgeBuildLogsDirection(&dr)
@@ -270,3 +283,21 @@ func (r *ClusterResourceProviderImpl) logEdgeLogsFilteringCompatibility(ctx cont
 		zap.Bool("search_filter", search != nil && *search != ""),
 	)
 }
+
+func (r *ClusterResourceProviderImpl) logEdgeLogsProcessFilteringCompatibility(ctx context.Context, sandboxID string, processTag *string, stream *string, response *http.Response) {
+	filtersRequested := processTag != nil || stream != nil
+	filtersApplied := response != nil && response.Header.Get(consts.EdgeFeatureSandboxLogsProcessFilteringEnabledHeader) != ""
+	if !filtersRequested || filtersApplied {
+		return
+	}
+
+	edgeapi.WarnMissingFeatureHeader(
+		ctx,
+		consts.EdgeFeatureSandboxLogsProcessFilteringEnabledHeader,
+		"sandbox logs process tag+stream filtering not supported",
+		logger.WithClusterID(r.clusterID),
+		logger.WithSandboxID(sandboxID),
+		zap.Bool("process_tag_filter", processTag != nil),
+		zap.Bool("stream_filter", stream != nil),
+	)
+}
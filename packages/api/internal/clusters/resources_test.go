@@ -36,6 +36,10 @@ func (m *mockTemplateServiceClient) TemplateBuildDelete(_ context.Context, _ *te
 	return &emptypb.Empty{}, nil
 }
 
+func (m *mockTemplateServiceClient) TemplateBuildReferences(_ context.Context, _ *templatemanagergrpc.TemplateBuildReferencesRequest, _ ...grpc.CallOption) (*templatemanagergrpc.TemplateBuildReferencesResponse, error) {
+	return &templatemanagergrpc.TemplateBuildReferencesResponse{}, nil
+}
+
 func (m *mockTemplateServiceClient) InitLayerFileUpload(_ context.Context, _ *templatemanagergrpc.InitLayerFileUploadRequest, _ ...grpc.CallOption) (*templatemanagergrpc.InitLayerFileUploadResponse, error) {
 	return &templatemanagergrpc.InitLayerFileUploadResponse{}, nil
 }
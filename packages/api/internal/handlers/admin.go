@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/clusters"
 	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 func (a *APIStore) GetNodes(c *gin.Context, params api.GetNodesParams) {
@@ -75,5 +77,9 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 		return
 	}
 
+	if body.Status == api.NodeStatusDraining && sharedUtils.DerefOrDefault(body.MigrateSandboxes, false) {
+		go a.orchestrator.MigrateNodeSandboxes(context.WithoutCancel(ctx), node)
+	}
+
 	c.Status(http.StatusNoContent)
 }
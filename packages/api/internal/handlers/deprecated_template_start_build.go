@@ -218,6 +218,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		nil, // fromImageRegistry not supported in v1 handler
 		&forceRebuild,
 		nil,
+		nil, // dockerfile not supported in v1 handler
 		apiutils.WithClusterFallback(team.ClusterID),
 		builderNode.NodeID,
 		templates.TemplateV1Version,
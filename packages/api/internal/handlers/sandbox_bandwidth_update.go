@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/team"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	dbtypes "github.com/e2b-dev/infra/packages/db/pkg/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PutSandboxesSandboxIDBandwidth(c *gin.Context, sandboxID string) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	teamInfo := auth.MustGetTeamInfo(c)
+
+	body, err := ginutils.ParseBody[api.SandboxBandwidthLimits](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	// PUT is full-replace: limits that aren't set fall back to the team limits.
+	limits, apiErr := team.LimitBandwidth(teamInfo.Limits, &body)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	if apiErr := a.orchestrator.UpdateSandboxBandwidthLimits(ctx, teamInfo.Team.ID, sandboxID, limits); apiErr != nil {
+		telemetry.ReportErrorByCode(ctx, apiErr.Code, "error updating sandbox bandwidth limits", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func bandwidthLimitsToAPI(limits *dbtypes.SandboxBandwidthLimits) *api.SandboxBandwidthLimits {
+	if limits == nil {
+		return nil
+	}
+
+	// 0 leaves the value unlimited and is omitted.
+	return &api.SandboxBandwidthLimits{
+		NetworkMbps:     optionalBandwidthValue(limits.NetworkMbps),
+		DiskMBPerSecond: optionalBandwidthValue(limits.DiskMBPerSecond),
+		DiskIops:        optionalBandwidthValue(limits.DiskIops),
+	}
+}
+
+func optionalBandwidthValue(value int32) *int32 {
+	if value == 0 {
+		return nil
+	}
+
+	return &value
+}
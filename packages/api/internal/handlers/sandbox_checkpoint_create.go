@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// PostSandboxesSandboxIDCheckpoints checkpoints a running sandbox in place and
+// records the snapshot as a restore point of the sandbox. Only the newest
+// checkpoints up to the team's limit are kept.
+func (a *APIStore) PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := auth.MustGetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	sandboxID, err := utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(telemetry.WithSandboxID(sandboxID), telemetry.WithTeamID(teamID.String()))
+
+	body, err := ginutils.ParseOptionalBody[api.PostSandboxesSandboxIDCheckpointsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		return
+	}
+
+	if body.Name != nil {
+		if *body.Name == "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Checkpoint name cannot be empty")
+
+			return
+		}
+
+		// Checked upfront so a conflicting name doesn't cost a snapshot; the
+		// unique index still guards against concurrent requests below.
+		existing, err := a.sqlcDB.GetSandboxCheckpoints(ctx, queries.GetSandboxCheckpointsParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+		})
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error when getting sandbox checkpoints", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating checkpoint")
+
+			return
+		}
+
+		for _, checkpoint := range existing {
+			if checkpoint.Name != nil && *checkpoint.Name == *body.Name {
+				a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", *body.Name, sandboxID))
+
+				return
+			}
+		}
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(ctx, teamID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			apiErr := checkpointHandleNotRunningSandbox(ctx, a, sandboxID, teamID)
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+			return
+		}
+
+		telemetry.ReportError(ctx, "error getting sandbox for checkpoint", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating checkpoint")
+
+		return
+	}
+
+	if err := sharedUtils.CheckEnvdVersionForSnapshot(sbx.EnvdVersion); err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	buildID, err := a.orchestrator.CheckpointSandbox(ctx, teamID, sandboxID)
+	var transErr *sandbox.InvalidStateTransitionError
+
+	switch {
+	case err == nil:
+	case errors.Is(err, sandbox.ErrNotFound):
+		apiErr := checkpointHandleNotRunningSandbox(ctx, a, sandboxID, teamID)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	case errors.As(err, &transErr):
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox '%s' cannot be checkpointed while in '%s' state", sandboxID, transErr.CurrentState))
+
+		return
+	case errors.Is(err, orchestrator.PauseQueueExhaustedError{}):
+		a.sendAPIStoreError(c, http.StatusServiceUnavailable, fmt.Sprintf("Sandbox '%s' cannot be checkpointed right now because its node is busy, please retry", sandboxID))
+
+		return
+	default:
+		telemetry.ReportError(ctx, "error checkpointing sandbox", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating checkpoint")
+
+		return
+	}
+
+	checkpoint, err := a.sqlcDB.CreateSandboxCheckpoint(ctx, queries.CreateSandboxCheckpointParams{
+		TeamID:    teamID,
+		SandboxID: sandboxID,
+		BuildID:   buildID,
+		Name:      body.Name,
+	})
+	switch {
+	case err == nil:
+	case dberrors.IsUniqueConstraintViolation(err):
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", sharedUtils.Sprintp(body.Name), sandboxID))
+
+		return
+	default:
+		telemetry.ReportCriticalError(ctx, "error when creating sandbox checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating checkpoint")
+
+		return
+	}
+
+	// The dropped checkpoints' builds stay assigned to the snapshot template
+	// and are cleaned up together with it.
+	dropped, err := a.sqlcDB.DeleteExcessSandboxCheckpoints(context.WithoutCancel(ctx), queries.DeleteExcessSandboxCheckpointsParams{
+		TeamID:    teamID,
+		SandboxID: sandboxID,
+		Keep:      teamInfo.Limits.MaxSandboxCheckpoints,
+	})
+	if err != nil {
+		telemetry.ReportError(ctx, "error when enforcing sandbox checkpoint retention", err, telemetry.WithSandboxID(sandboxID))
+	} else if len(dropped) > 0 {
+		logger.L().Debug(ctx, "Dropped sandbox checkpoints over the team limit", logger.WithSandboxID(sandboxID), zap.Int("count", len(dropped)))
+	}
+
+	c.JSON(http.StatusCreated, toAPISandboxCheckpoint(checkpoint))
+}
+
+// checkpointHandleNotRunningSandbox classifies a checkpoint request for a
+// sandbox that is not running, mirroring the pause endpoint.
+func checkpointHandleNotRunningSandbox(ctx context.Context, a *APIStore, sandboxID string, teamID uuid.UUID) api.APIError {
+	apiErr := pauseHandleNotRunningSandbox(ctx, a.snapshotCache, sandboxID, teamID)
+	switch apiErr.Code {
+	case http.StatusConflict:
+		apiErr.ClientMsg = fmt.Sprintf("Sandbox '%s' is paused and cannot be checkpointed; resume it first", sandboxID)
+	case http.StatusInternalServerError:
+		apiErr.ClientMsg = "Error when creating checkpoint"
+	}
+
+	return apiErr
+}
+
+func toAPISandboxCheckpoint(checkpoint queries.SandboxCheckpoint) api.SandboxCheckpoint {
+	return api.SandboxCheckpoint{
+		CheckpointID: checkpoint.ID,
+		Name:         checkpoint.Name,
+		CreatedAt:    checkpoint.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// DeleteSandboxesSandboxIDCheckpointsCheckpointID removes the restore point.
+// The checkpoint's build stays assigned to the sandbox's snapshot template and
+// is cleaned up together with it.
+func (a *APIStore) DeleteSandboxesSandboxIDCheckpointsCheckpointID(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	teamID := auth.MustGetTeamID(c)
+
+	sandboxID, err := utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		telemetry.WithTeamID(teamID.String()),
+	)
+
+	deleted, err := a.sqlcDB.DeleteSandboxCheckpoint(ctx, queries.DeleteSandboxCheckpointParams{
+		CheckpointID: checkpointID,
+		TeamID:       teamID,
+		SandboxID:    sandboxID,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting checkpoint")
+		telemetry.ReportCriticalError(ctx, "error when deleting sandbox checkpoint", err)
+
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Checkpoint not found")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestore rolls the sandbox back
+// to the checkpoint. The checkpoint's build is made the sandbox's latest
+// snapshot first; only once that succeeded is a running sandbox killed,
+// discarding its state since the checkpoint, and resumed from it under the
+// same ID.
+func (a *APIStore) PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	teamInfo := auth.MustGetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	sandboxID, err := utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(telemetry.WithSandboxID(sandboxID), telemetry.WithTeamID(teamID.String()))
+
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := ginutils.ParseOptionalBody[api.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		return
+	}
+
+	timeout := sandbox.SandboxTimeoutDefault
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Limits.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Limits.MaxLengthHours))
+
+			return
+		}
+	}
+
+	checkpoint, err := a.sqlcDB.GetSandboxCheckpoint(ctx, queries.GetSandboxCheckpointParams{
+		CheckpointID: checkpointID,
+		TeamID:       teamID,
+		SandboxID:    sandboxID,
+	})
+	switch {
+	case err == nil:
+	case dberrors.IsNotFoundError(err):
+		a.sendAPIStoreError(c, http.StatusNotFound, "Checkpoint not found")
+
+		return
+	default:
+		telemetry.ReportCriticalError(ctx, "error when getting sandbox checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when restoring checkpoint")
+
+		return
+	}
+
+	running, apiErr := a.prepareSandboxForRestore(ctx, teamID, sandboxID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	assignmentID, err := a.sqlcDB.PromoteSandboxCheckpointBuild(ctx, queries.PromoteSandboxCheckpointBuildParams{
+		CheckpointID: checkpoint.ID,
+		TeamID:       teamID,
+	})
+	switch {
+	case err == nil:
+	case dberrors.IsNotFoundError(err):
+		// The snapshot template of the sandbox was deleted since the checkpoint was read.
+		a.sendAPIStoreError(c, http.StatusNotFound, "Checkpoint not found")
+
+		return
+	default:
+		telemetry.ReportCriticalError(ctx, "error when promoting sandbox checkpoint build", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when restoring checkpoint")
+
+		return
+	}
+
+	a.snapshotCache.Invalidate(context.WithoutCancel(ctx), sandboxID)
+
+	// The running sandbox is only killed once its next resume is known to start from the checkpoint.
+	lastSnapshot, err := a.snapshotCache.Get(ctx, sandboxID)
+	if err != nil {
+		a.revertCheckpointPromotion(ctx, sandboxID, assignmentID)
+		telemetry.ReportCriticalError(ctx, "error when getting promoted sandbox checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when restoring checkpoint")
+
+		return
+	}
+
+	if lastSnapshot.EnvBuild.ID != checkpoint.BuildID {
+		a.revertCheckpointPromotion(ctx, sandboxID, assignmentID)
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox '%s' was snapshotted during the restore, try again", sandboxID))
+
+		return
+	}
+
+	if running {
+		if apiErr := a.killSandboxForRestore(ctx, teamID, sandboxID); apiErr != nil {
+			a.revertCheckpointPromotion(ctx, sandboxID, assignmentID)
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+			return
+		}
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID: sandboxID,
+		TeamID:    teamID.String(),
+	}).Debug(ctx, "Restoring sandbox from checkpoint", logger.WithBuildID(checkpoint.BuildID.String()))
+
+	sbx, createErr := a.startSandbox(
+		ctx,
+		sandboxID,
+		timeout,
+		teamInfo,
+		a.buildResumeSandboxData(sandboxID, nil),
+		&c.Request.Header,
+		true,
+		nil, // mcp
+	)
+	if createErr != nil {
+		// The checkpoint stays the latest snapshot, resuming the sandbox finishes the restore.
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &sbx)
+}
+
+// prepareSandboxForRestore waits for a pausing sandbox to finish and refuses
+// restoring a sandbox that is changing state. It reports whether the sandbox
+// is running and must be killed before the restore.
+func (a *APIStore) prepareSandboxForRestore(ctx context.Context, teamID uuid.UUID, sandboxID string) (bool, *api.APIError) {
+	sbx, err := a.orchestrator.GetSandbox(ctx, teamID, sandboxID)
+	if errors.Is(err, sandbox.ErrNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when restoring checkpoint", Err: err}
+	}
+
+	switch sbx.State {
+	case sandbox.StatePausing:
+		if err := a.orchestrator.WaitForStateChange(ctx, teamID, sandboxID); err != nil {
+			telemetry.ReportCriticalError(ctx, "error waiting for sandbox to pause", err, telemetry.WithSandboxID(sandboxID))
+
+			return false, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error waiting for sandbox to pause", Err: err}
+		}
+
+		return false, nil
+	case sandbox.StateKilling:
+		return false, &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sandboxID)}
+	case sandbox.StateSnapshotting:
+		return false, &api.APIError{Code: http.StatusConflict, ClientMsg: fmt.Sprintf("Sandbox snapshot is currently being created for sandbox '%s'", sandboxID)}
+	case sandbox.StateRunning:
+		return true, nil
+	default:
+		return false, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Sandbox is in an unknown state", Err: fmt.Errorf("state: %s", sbx.State)}
+	}
+}
+
+// killSandboxForRestore kills the running sandbox. Killing keeps the
+// sandbox's snapshots, so it can be resumed from the checkpoint afterwards.
+func (a *APIStore) killSandboxForRestore(ctx context.Context, teamID uuid.UUID, sandboxID string) *api.APIError {
+	err := a.orchestrator.RemoveSandbox(ctx, teamID, sandboxID, sandbox.RemoveOpts{
+		Action: sandbox.StateActionKill,
+		Reason: sandbox.KillReasonRequest,
+	})
+	switch {
+	case err == nil, errors.Is(err, orchestrator.ErrSandboxNotFound):
+		return nil
+	default:
+		telemetry.ReportError(ctx, "error killing sandbox for restore", err, telemetry.WithSandboxID(sandboxID))
+
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("Error stopping sandbox: %s", err), Err: err}
+	}
+}
+
+// revertCheckpointPromotion makes the snapshot the sandbox had before the
+// restore its latest again.
+func (a *APIStore) revertCheckpointPromotion(ctx context.Context, sandboxID string, assignmentID uuid.UUID) {
+	ctx = context.WithoutCancel(ctx)
+
+	if err := a.sqlcDB.DeleteSandboxCheckpointPromotion(ctx, assignmentID); err != nil {
+		telemetry.ReportCriticalError(ctx, "error when reverting sandbox checkpoint promotion", err, telemetry.WithSandboxID(sandboxID))
+	}
+
+	a.snapshotCache.Invalidate(ctx, sandboxID)
+}
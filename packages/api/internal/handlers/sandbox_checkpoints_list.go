@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := auth.MustGetTeamID(c)
+
+	sandboxID, err := utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		telemetry.WithTeamID(teamID.String()),
+	)
+
+	result, err := a.sqlcDB.GetSandboxCheckpoints(ctx, queries.GetSandboxCheckpointsParams{
+		TeamID:    teamID,
+		SandboxID: sandboxID,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting checkpoints")
+		telemetry.ReportCriticalError(ctx, "error when getting sandbox checkpoints", err)
+
+		return
+	}
+
+	checkpoints := make([]api.SandboxCheckpoint, len(result))
+	for i, checkpoint := range result {
+		checkpoints[i] = toAPISandboxCheckpoint(checkpoint)
+	}
+
+	c.JSON(http.StatusOK, checkpoints)
+}
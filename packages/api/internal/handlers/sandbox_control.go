@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/pause"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// PostSandboxesSandboxIDControlTimeout sets the timeout of the sandbox on its own request.
+func (a *APIStore) PostSandboxesSandboxIDControlTimeout(c *gin.Context, sandboxID api.SandboxID) {
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	policy, ok := a.getSandboxControlPolicy(c, sandboxID)
+	if !ok {
+		return
+	}
+
+	if !policy.ExtendTimeout {
+		a.sendAPIStoreError(c, http.StatusForbidden, "The sandbox is not allowed to set its timeout")
+
+		return
+	}
+
+	a.PostSandboxesSandboxIDTimeout(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDControlPause pauses the sandbox on its own request. The pause
+// freezes the caller, so it runs after the response is sent.
+func (a *APIStore) PostSandboxesSandboxIDControlPause(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	policy, ok := a.getSandboxControlPolicy(c, sandboxID)
+	if !ok {
+		return
+	}
+
+	if !policy.Pause {
+		a.sendAPIStoreError(c, http.StatusForbidden, "The sandbox is not allowed to pause itself")
+
+		return
+	}
+
+	teamID := auth.MustGetTeamID(c)
+
+	pause.LogInitiated(ctx, sandboxID, teamID.String(), pause.ReasonControl)
+
+	go a.pauseSandboxOnControl(context.WithoutCancel(ctx), teamID, sandboxID)
+
+	c.Status(http.StatusAccepted)
+}
+
+func (a *APIStore) pauseSandboxOnControl(ctx context.Context, teamID uuid.UUID, sandboxID string) {
+	err := a.orchestrator.RemoveSandbox(ctx, teamID, sandboxID, sandbox.RemoveOpts{Action: sandbox.StateActionPause})
+	if err != nil {
+		pause.LogFailure(ctx, sandboxID, teamID.String(), pause.ReasonControl, err)
+		telemetry.ReportError(ctx, "error pausing sandbox on control request", err)
+
+		return
+	}
+
+	pause.LogSuccess(ctx, sandboxID, teamID.String(), pause.ReasonControl)
+}
+
+// PostSandboxesSandboxIDControlSandboxes creates a sandbox in the team of the sandbox on its own request.
+func (a *APIStore) PostSandboxesSandboxIDControlSandboxes(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	teamInfo := auth.MustGetTeamInfo(c)
+
+	c.Set("teamID", teamInfo.Team.ID.String())
+	telemetry.SetAttributes(ctx, telemetry.WithSandboxID(sandboxID))
+
+	body, err := ginutils.ParseBody[api.PostSandboxesSandboxIDControlSandboxesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	// Spawned sandboxes can't spawn further, otherwise the limit could be bypassed.
+	if body.ControlPolicy != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Sandboxes created by a sandbox can't have a control policy")
+
+		return
+	}
+
+	parent, err := a.orchestrator.GetSandbox(ctx, teamInfo.Team.ID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, utils.SandboxNotFoundMsg(sandboxID))
+		} else {
+			telemetry.ReportError(ctx, "error getting sandbox for control request", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get sandbox")
+		}
+
+		return
+	}
+
+	if apiErr := a.orchestrator.ReserveSpawnedSandbox(ctx, teamInfo.Team.ID, sandboxID); apiErr != nil {
+		telemetry.ReportErrorByCode(ctx, apiErr.Code, "error reserving spawned sandbox", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	a.createSandbox(c, teamInfo, body, &parent)
+
+	if c.Writer.Status() != http.StatusCreated {
+		a.orchestrator.ReleaseSpawnedSandbox(context.WithoutCancel(ctx), teamInfo.Team.ID, sandboxID)
+	}
+}
+
+// inheritParentNetwork returns the network config and internet access of a
+// sandbox created by the parent sandbox. The child inherits the egress settings
+// it doesn't set and can only tighten the ones it sets: it can't re-enable
+// internet access, allow addresses the parent doesn't, drop denied addresses or
+// change the network rules, which hold the parent's secret transforms. The
+// parent's egress proxy is always kept.
+func inheritParentNetwork(parent sandbox.Sandbox, body api.NewSandbox, network *types.SandboxNetworkConfig) (*types.SandboxNetworkConfig, *bool, *api.APIError) {
+	var parentEgress types.SandboxNetworkEgressConfig
+	if parent.Network != nil && parent.Network.Egress != nil {
+		parentEgress = *parent.Network.Egress
+	}
+
+	allowInternetAccess := body.AllowInternetAccess
+	switch {
+	case allowInternetAccess == nil:
+		allowInternetAccess = parent.AllowInternetAccess
+	case *allowInternetAccess && parent.AllowInternetAccess != nil && !*parent.AllowInternetAccess:
+		return nil, nil, parentNetworkError("internet access", "The parent sandbox has no internet access")
+	}
+
+	n := sharedUtils.DerefOrDefault(body.Network, api.SandboxNetworkConfig{})
+	if n.EgressProxy != nil {
+		return nil, nil, parentNetworkError("egress proxy", "Sandboxes created by a sandbox use the egress proxy of the parent sandbox")
+	}
+
+	egress := &types.SandboxNetworkEgressConfig{
+		AllowedAddresses:    parentEgress.AllowedAddresses,
+		DeniedAddresses:     parentEgress.DeniedAddresses,
+		Rules:               parentEgress.Rules,
+		EgressProxyAddress:  parentEgress.EgressProxyAddress,
+		EgressProxyUsername: parentEgress.EgressProxyUsername,
+		EgressProxyPassword: parentEgress.EgressProxyPassword,
+	}
+
+	if n.AllowOut != nil {
+		for _, address := range *n.AllowOut {
+			if !slices.Contains(parentEgress.AllowedAddresses, address) {
+				return nil, nil, parentNetworkError("allowOut", fmt.Sprintf("Allowed address %q is not allowed for the parent sandbox", address))
+			}
+		}
+
+		egress.AllowedAddresses = *n.AllowOut
+	}
+
+	if n.DenyOut != nil {
+		for _, address := range parentEgress.DeniedAddresses {
+			if !slices.Contains(*n.DenyOut, address) {
+				return nil, nil, parentNetworkError("denyOut", fmt.Sprintf("Denied address %q of the parent sandbox must be kept", address))
+			}
+		}
+
+		egress.DeniedAddresses = *n.DenyOut
+	}
+
+	if n.Rules != nil {
+		rules := apiRulesToDBRules(n.Rules)
+		if !equalNetworkRules(rules, parentEgress.Rules) {
+			return nil, nil, parentNetworkError("rules", "Network rules must be the same as the parent sandbox's")
+		}
+
+		egress.Rules = rules
+	}
+
+	var ingress *types.SandboxNetworkIngressConfig
+	if network != nil {
+		ingress = network.Ingress
+	}
+
+	return &types.SandboxNetworkConfig{Egress: egress, Ingress: ingress}, allowInternetAccess, nil
+}
+
+func parentNetworkError(field, msg string) *api.APIError {
+	return &api.APIError{
+		Code:      http.StatusForbidden,
+		ClientMsg: msg,
+		Err:       fmt.Errorf("%s is looser than the parent sandbox's", field),
+	}
+}
+
+// equalNetworkRules compares the rules by their stored form, so empty and
+// missing fields are the same.
+func equalNetworkRules(a, b map[string][]types.SandboxNetworkRule) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+// getSandboxControlPolicy returns the control policy of the running sandbox, it
+// sends the error response itself when the policy can't be returned.
+func (a *APIStore) getSandboxControlPolicy(c *gin.Context, sandboxID string) (types.SandboxControlPolicy, bool) {
+	ctx := c.Request.Context()
+	teamID := auth.MustGetTeamID(c)
+
+	sbx, err := a.orchestrator.GetSandbox(ctx, teamID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, utils.SandboxNotFoundMsg(sandboxID))
+		} else {
+			telemetry.ReportError(ctx, "error getting sandbox for control request", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get sandbox")
+		}
+
+		return types.SandboxControlPolicy{}, false
+	}
+
+	if sbx.ControlPolicy == nil {
+		return types.SandboxControlPolicy{}, true
+	}
+
+	return *sbx.ControlPolicy, true
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+)
+
+func TestInheritParentNetwork(t *testing.T) {
+	t.Parallel()
+
+	parentRules := map[string][]types.SandboxNetworkRule{
+		"api.openai.com": {{Transform: &types.SandboxNetworkTransform{Headers: map[string]string{
+			"Authorization": "Bearer {{secret:OPENAI_KEY}}",
+		}}}},
+	}
+	parent := sandbox.Sandbox{
+		AllowInternetAccess: new(false),
+		Network: &types.SandboxNetworkConfig{
+			Egress: &types.SandboxNetworkEgressConfig{
+				AllowedAddresses:   []string{"api.openai.com", "8.8.8.8"},
+				DeniedAddresses:    []string{"0.0.0.0/0"},
+				Rules:              parentRules,
+				EgressProxyAddress: "10.0.0.1:1080",
+			},
+		},
+	}
+
+	t.Run("inherits the parent's egress", func(t *testing.T) {
+		t.Parallel()
+
+		network, allowInternetAccess, apiErr := inheritParentNetwork(parent, api.NewSandbox{}, nil)
+		require.Nil(t, apiErr)
+		assert.Equal(t, new(false), allowInternetAccess)
+		assert.Equal(t, parent.Network.Egress, network.Egress)
+	})
+
+	t.Run("tightening is allowed", func(t *testing.T) {
+		t.Parallel()
+
+		ingress := &types.SandboxNetworkIngressConfig{AllowPublicAccess: new(false)}
+		network, _, apiErr := inheritParentNetwork(parent, api.NewSandbox{
+			Network: &api.SandboxNetworkConfig{
+				AllowOut: &[]string{"api.openai.com"},
+				DenyOut:  &[]string{"0.0.0.0/0", "1.1.1.1"},
+				Rules: &map[string][]api.SandboxNetworkRule{
+					"api.openai.com": {{Transform: &api.SandboxNetworkTransform{Headers: &map[string]string{
+						"Authorization": "Bearer {{secret:OPENAI_KEY}}",
+					}}}},
+				},
+			},
+		}, &types.SandboxNetworkConfig{Ingress: ingress})
+		require.Nil(t, apiErr)
+		assert.Equal(t, []string{"api.openai.com"}, network.Egress.AllowedAddresses)
+		assert.Equal(t, []string{"0.0.0.0/0", "1.1.1.1"}, network.Egress.DeniedAddresses)
+		assert.Equal(t, "10.0.0.1:1080", network.Egress.EgressProxyAddress)
+		assert.Equal(t, ingress, network.Ingress)
+	})
+
+	escalations := []struct {
+		name string
+		body api.NewSandbox
+	}{
+		{
+			name: "internet access",
+			body: api.NewSandbox{AllowInternetAccess: new(true)},
+		},
+		{
+			name: "allowed address",
+			body: api.NewSandbox{Network: &api.SandboxNetworkConfig{AllowOut: &[]string{"attacker.example.com"}}},
+		},
+		{
+			name: "dropped denied address",
+			body: api.NewSandbox{Network: &api.SandboxNetworkConfig{DenyOut: &[]string{}}},
+		},
+		{
+			name: "secret transform to another host",
+			body: api.NewSandbox{
+				AllowInternetAccess: new(true),
+				Network: &api.SandboxNetworkConfig{
+					AllowOut: &[]string{"attacker.example.com"},
+					DenyOut:  &[]string{},
+					Rules: &map[string][]api.SandboxNetworkRule{
+						"attacker.example.com": {{Transform: &api.SandboxNetworkTransform{Headers: &map[string]string{
+							"Authorization": "{{secret:OPENAI_KEY}}",
+						}}}},
+					},
+				},
+			},
+		},
+		{
+			name: "secret transform on an allowed host",
+			body: api.NewSandbox{Network: &api.SandboxNetworkConfig{
+				Rules: &map[string][]api.SandboxNetworkRule{
+					"api.openai.com": {{Transform: &api.SandboxNetworkTransform{Headers: &map[string]string{
+						"Authorization": "Bearer {{secret:OTHER_KEY}}",
+					}}}},
+				},
+			}},
+		},
+		{
+			name: "dropped access rules",
+			body: api.NewSandbox{Network: &api.SandboxNetworkConfig{Rules: &map[string][]api.SandboxNetworkRule{}}},
+		},
+		{
+			name: "egress proxy",
+			body: api.NewSandbox{Network: &api.SandboxNetworkConfig{EgressProxy: &api.SandboxEgressProxyConfig{Address: "attacker.example.com:1080"}}},
+		},
+	}
+
+	for _, tc := range escalations {
+		t.Run("refuses "+tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, apiErr := inheritParentNetwork(parent, tc.body, nil)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, http.StatusForbidden, apiErr.Code)
+		})
+	}
+
+	t.Run("open parent allows internet access", func(t *testing.T) {
+		t.Parallel()
+
+		network, allowInternetAccess, apiErr := inheritParentNetwork(sandbox.Sandbox{}, api.NewSandbox{AllowInternetAccess: new(true)}, nil)
+		require.Nil(t, apiErr)
+		assert.Equal(t, new(true), allowInternetAccess)
+		assert.Empty(t, network.Egress.AllowedAddresses)
+	})
+}
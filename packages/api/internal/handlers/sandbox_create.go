@@ -24,7 +24,9 @@ import (
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
 	apiorch "github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/team"
 	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	typesteam "github.com/e2b-dev/infra/packages/auth/pkg/types"
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/db/pkg/types"
 	"github.com/e2b-dev/infra/packages/db/queries"
@@ -54,6 +56,11 @@ const (
 	maxNetworkRuleHeaderNameLen       = 64
 	maxNetworkRuleHeaderValueLen      = 2048
 	maxNetworkRuleHeadersPerRule      = 20
+
+	maxNetworkRuleAccessRulesPerDomain = 20
+	maxNetworkRuleMethodsPerRule       = 10
+	maxNetworkRuleMethodLen            = 16
+	maxNetworkRulePathLen              = 1024
 )
 
 func (a *APIStore) PostSandboxes(c *gin.Context) {
@@ -79,6 +86,14 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	telemetry.ReportEvent(ctx, "Parsed body")
 
+	a.createSandbox(c, teamInfo, body, nil)
+}
+
+// createSandbox creates a sandbox from the parsed request body and writes the response.
+// A sandbox created by the parent sandbox can't have looser egress than the parent.
+func (a *APIStore) createSandbox(c *gin.Context, teamInfo *typesteam.Team, body api.NewSandbox, parent *sandbox.Sandbox) {
+	ctx := c.Request.Context()
+
 	identifier, tag, err := id.ParseName(body.TemplateID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid template reference: %s", err))
@@ -255,6 +270,40 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		}
 	}
 
+	if parent != nil {
+		inherited, inheritedAllowInternetAccess, apiErr := inheritParentNetwork(*parent, body, network)
+		if apiErr != nil {
+			telemetry.ReportError(ctx, "network config looser than the parent sandbox", apiErr.Err, telemetry.WithSandboxID(sandboxID))
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+			return
+		}
+
+		network, allowInternetAccess = inherited, inheritedAllowInternetAccess
+	}
+
+	bandwidthLimits, apiErr := team.LimitBandwidth(teamInfo.Limits, body.BandwidthLimits)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "invalid bandwidth limits", apiErr.Err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	if apiErr := validateLogProcessOutput(sharedUtils.DerefOrDefault(build.EnvdVersion, ""), body.LogProcessOutput); apiErr != nil {
+		telemetry.ReportError(ctx, "invalid process output logging", apiErr.Err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	controlPolicy, apiErr := buildControlPolicy(body.ControlPolicy)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
 	sbxVolumeMounts, err := convertAPIVolumesToOrchestratorVolumes(
 		ctx, a.sqlcDB, a.featureFlags, teamInfo.ID, apiVolumeMounts, build,
 	)
@@ -299,6 +348,9 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 			AutoPause:               autoPause,
 			AutoPauseFilesystemOnly: autoPauseFilesystemOnly,
 			AutoResume:              autoResume,
+			LogProcessOutput:        sharedUtils.DerefOrDefault(body.LogProcessOutput, false),
+			BandwidthLimits:         bandwidthLimits,
+			ControlPolicy:           controlPolicy,
 			VolumeMounts:            sbxVolumeMounts,
 			EnvdAccessToken:         envdAccessToken,
 		}, nil
@@ -351,6 +403,35 @@ func buildAutoResumeConfig(autoResume *api.SandboxAutoResumeConfig) *types.Sandb
 	}
 }
 
+// buildControlPolicy converts the control policy of the request, a policy that
+// doesn't allow anything is dropped so the sandbox doesn't get a control token.
+func buildControlPolicy(policy *api.SandboxControlPolicy) (*types.SandboxControlPolicy, *api.APIError) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	controlPolicy := &types.SandboxControlPolicy{
+		ExtendTimeout:       sharedUtils.DerefOrDefault(policy.ExtendTimeout, false),
+		Pause:               sharedUtils.DerefOrDefault(policy.Pause, false),
+		SpawnSandboxes:      sharedUtils.DerefOrDefault(policy.SpawnSandboxes, false),
+		MaxSpawnedSandboxes: sharedUtils.DerefOrDefault(policy.MaxSpawnedSandboxes, 0),
+	}
+
+	if policy.MaxSpawnedSandboxes != nil && !controlPolicy.SpawnSandboxes {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "controlPolicy.maxSpawnedSandboxes only applies when controlPolicy.spawnSandboxes is true.",
+			Err:       errors.New("max spawned sandboxes set without spawn sandboxes"),
+		}
+	}
+
+	if !controlPolicy.ExtendTimeout && !controlPolicy.Pause && !controlPolicy.SpawnSandboxes {
+		return nil, nil
+	}
+
+	return controlPolicy, nil
+}
+
 func dedupeVolumeNames(items []api.SandboxVolumeMount) []string {
 	itemsSet := make(map[string]struct{}, len(items))
 	for _, item := range items {
@@ -400,6 +481,10 @@ const minEnvdVersionForNetworkRules = "0.5.13"
 
 const minEnvdVersionForVolumes = "0.5.14"
 
+var errLogProcessOutputNotSupported = errors.New("logging process output is not supported")
+
+const minEnvdVersionForLogProcessOutput = "0.6.19"
+
 // checkEnvdVersionRequirement returns errNoEnvdVersion when buildVersion is empty, a parse
 // error when the version string is invalid, or a wrapped featureErr when the build does not
 // meet requiredMinVersion. The caller decides how to convert the returned error into an API
@@ -421,6 +506,31 @@ func checkEnvdVersionRequirement(buildVersion, requiredMinVersion string, featur
 	return nil
 }
 
+// validateLogProcessOutput checks the template's envd can ship the process output to the sandbox logs.
+func validateLogProcessOutput(envdVersion string, logProcessOutput *bool) *api.APIError {
+	if !sharedUtils.DerefOrDefault(logProcessOutput, false) {
+		return nil
+	}
+
+	if err := checkEnvdVersionRequirement(envdVersion, minEnvdVersionForLogProcessOutput, errLogProcessOutputNotSupported); err != nil {
+		if errors.Is(err, errLogProcessOutputNotSupported) || errors.Is(err, errNoEnvdVersion) {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       err,
+				ClientMsg: err.Error(),
+			}
+		}
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			Err:       err,
+			ClientMsg: "internal error while validating process output logging",
+		}
+	}
+
+	return nil
+}
+
 func convertAPIVolumesToOrchestratorVolumes(ctx context.Context, sqlClient *sqlcdb.Client, featureFlags featureFlagsClient, teamID uuid.UUID, volumeMounts []api.SandboxVolumeMount, env *queries.EnvBuild) ([]*orchestrator.SandboxVolumeMount, error) {
 	// are any volumes configured?
 	if len(volumeMounts) == 0 {
@@ -617,6 +727,12 @@ func apiRulesToDBRules(apiRules *map[string][]api.SandboxNetworkRule) map[string
 				}
 			}
 
+			if r.Action != nil {
+				dbRule.Action = string(*r.Action)
+				dbRule.Methods = sharedUtils.DerefOrDefault(r.Methods, nil)
+				dbRule.Path = sharedUtils.DerefOrDefault(r.Path, "")
+			}
+
 			dbDomainRules = append(dbDomainRules, dbRule)
 		}
 
@@ -666,7 +782,11 @@ func validateNetworkConfig(ctx context.Context, featureFlags featureFlagsClient,
 		return err
 	}
 
-	return validateNetworkRules(ctx, featureFlags, teamID, envdVersion, network.Rules)
+	if err := validateNetworkRules(ctx, featureFlags, teamID, envdVersion, network.Rules); err != nil {
+		return err
+	}
+
+	return validateTransformTargets(allowOut, denyOut, network.Rules)
 }
 
 // validateEgressRules validates egress allow/deny rules:
@@ -710,6 +830,39 @@ func validateEgressRules(allowOut, denyOut []string) *api.APIError {
 	return nil
 }
 
+// validateTransformTargets rejects transform headers the proxy could never inject.
+// With all traffic denied, a rule domain is only reachable when allowOut lists it as
+// a domain; an upstream allowed only by IP or CIDR never receives the headers.
+func validateTransformTargets(allowOut, denyOut []string, rules *map[string][]api.SandboxNetworkRule) *api.APIError {
+	if rules == nil || !slices.Contains(denyOut, sandbox_network.AllInternetTrafficCIDR) {
+		return nil
+	}
+
+	_, allowedDomains := sandbox_network.ParseAddressesAndDomains(allowOut)
+
+	for domain, domainRules := range *rules {
+		hasHeaders := slices.ContainsFunc(domainRules, func(rule api.SandboxNetworkRule) bool {
+			return rule.Transform != nil && len(sharedUtils.DerefOrDefault(rule.Transform.Headers, nil)) > 0
+		})
+		if !hasHeaders {
+			continue
+		}
+
+		allowed := slices.ContainsFunc(allowedDomains, func(pattern string) bool {
+			return sandbox_network.MatchDomain(domain, pattern)
+		})
+		if !allowed {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       fmt.Errorf("transform headers for domain %q, which is not allowed by domain", domain),
+				ClientMsg: fmt.Sprintf("Domain %q has transform headers but is not in allowOut. Headers are only added to requests to allowed domains.", domain),
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateNetworkRules(ctx context.Context, featureFlags featureFlagsClient, teamID uuid.UUID, envdVersion string, rules *map[string][]api.SandboxNetworkRule) *api.APIError {
 	if rules == nil {
 		return nil
@@ -772,15 +925,36 @@ func validateNetworkRules(ctx context.Context, featureFlags featureFlagsClient,
 			}
 		}
 
-		if len(domainRules) > maxNetworkRuleTransformsPerDomain {
+		var transforms, accessRules int
+		for _, rule := range domainRules {
+			if rule.Action != nil || rule.Methods != nil || rule.Path != nil {
+				accessRules++
+			} else {
+				transforms++
+			}
+		}
+
+		if transforms > maxNetworkRuleTransformsPerDomain {
 			return &api.APIError{
 				Code:      http.StatusBadRequest,
-				Err:       fmt.Errorf("domain %q has %d transforms (max %d)", domain, len(domainRules), maxNetworkRuleTransformsPerDomain),
+				Err:       fmt.Errorf("domain %q has %d transforms (max %d)", domain, transforms, maxNetworkRuleTransformsPerDomain),
 				ClientMsg: fmt.Sprintf("Domain %q can have at most %d transform rule.", domain, maxNetworkRuleTransformsPerDomain),
 			}
 		}
 
+		if accessRules > maxNetworkRuleAccessRulesPerDomain {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       fmt.Errorf("domain %q has %d access rules (max %d)", domain, accessRules, maxNetworkRuleAccessRulesPerDomain),
+				ClientMsg: fmt.Sprintf("Domain %q can have at most %d access rules.", domain, maxNetworkRuleAccessRulesPerDomain),
+			}
+		}
+
 		for _, rule := range domainRules {
+			if apiErr := validateNetworkAccessRule(domain, rule); apiErr != nil {
+				return apiErr
+			}
+
 			if rule.Transform == nil {
 				continue
 			}
@@ -840,3 +1014,66 @@ func validateNetworkRules(ctx context.Context, featureFlags featureFlagsClient,
 
 	return nil
 }
+
+// validateNetworkAccessRule validates the method/path access fields of a rule.
+// Access rules are evaluated by the egress proxy and cannot carry a transform.
+func validateNetworkAccessRule(domain string, rule api.SandboxNetworkRule) *api.APIError {
+	if rule.Action == nil {
+		if rule.Methods != nil || rule.Path != nil {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       fmt.Errorf("rule for domain %q has methods or path but no action", domain),
+				ClientMsg: fmt.Sprintf("Rule for domain %q must set an action when methods or path are specified.", domain),
+			}
+		}
+
+		return nil
+	}
+
+	if !rule.Action.Valid() {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			Err:       fmt.Errorf("rule for domain %q has invalid action %q", domain, *rule.Action),
+			ClientMsg: fmt.Sprintf("Rule for domain %q has invalid action %q, expected 'allow' or 'deny'.", domain, *rule.Action),
+		}
+	}
+
+	if rule.Transform != nil {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			Err:       fmt.Errorf("rule for domain %q combines an action with a transform", domain),
+			ClientMsg: fmt.Sprintf("Rule for domain %q cannot have both an action and a transform.", domain),
+		}
+	}
+
+	methods := sharedUtils.DerefOrDefault(rule.Methods, nil)
+	if len(methods) > maxNetworkRuleMethodsPerRule {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			Err:       fmt.Errorf("rule for domain %q has %d methods (max %d)", domain, len(methods), maxNetworkRuleMethodsPerRule),
+			ClientMsg: fmt.Sprintf("Rule for domain %q can have at most %d methods.", domain, maxNetworkRuleMethodsPerRule),
+		}
+	}
+
+	for _, method := range methods {
+		if len(method) == 0 || len(method) > maxNetworkRuleMethodLen || strings.ToUpper(method) != method || !httpguts.ValidHeaderFieldName(method) {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       fmt.Errorf("rule for domain %q has invalid method %q", domain, method),
+				ClientMsg: fmt.Sprintf("Rule for domain %q has invalid method %q, expected an upper-case HTTP method such as 'GET'.", domain, method),
+			}
+		}
+	}
+
+	if path := sharedUtils.DerefOrDefault(rule.Path, ""); path != "" {
+		if !strings.HasPrefix(path, "/") || len(path) > maxNetworkRulePathLen || strings.Contains(strings.TrimSuffix(path, "*"), "*") {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				Err:       fmt.Errorf("rule for domain %q has invalid path %q", domain, path),
+				ClientMsg: fmt.Sprintf("Rule for domain %q has invalid path %q, expected a path starting with '/' of at most %d characters, optionally ending with '*'.", domain, path, maxNetworkRulePathLen),
+			}
+		}
+	}
+
+	return nil
+}
@@ -86,6 +86,82 @@ func TestBuildAutoResumeConfig(t *testing.T) {
 	}
 }
 
+func TestBuildControlPolicy(t *testing.T) {
+	t.Parallel()
+
+	enabled := true
+	limit := int32(3)
+
+	t.Run("nil policy returns nil", func(t *testing.T) {
+		t.Parallel()
+
+		got, apiErr := buildControlPolicy(nil)
+		require.Nil(t, apiErr)
+		assert.Nil(t, got)
+	})
+
+	t.Run("policy allowing nothing returns nil", func(t *testing.T) {
+		t.Parallel()
+
+		got, apiErr := buildControlPolicy(&api.SandboxControlPolicy{})
+		require.Nil(t, apiErr)
+		assert.Nil(t, got)
+	})
+
+	t.Run("spawn limit is kept", func(t *testing.T) {
+		t.Parallel()
+
+		got, apiErr := buildControlPolicy(&api.SandboxControlPolicy{SpawnSandboxes: &enabled, MaxSpawnedSandboxes: &limit})
+		require.Nil(t, apiErr)
+		assert.Equal(t, &dbtypes.SandboxControlPolicy{SpawnSandboxes: true, MaxSpawnedSandboxes: 3}, got)
+	})
+
+	t.Run("spawn limit without spawning is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		_, apiErr := buildControlPolicy(&api.SandboxControlPolicy{Pause: &enabled, MaxSpawnedSandboxes: &limit})
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+	})
+}
+
+func TestValidateLogProcessOutput(t *testing.T) {
+	t.Parallel()
+
+	enabled := true
+	disabled := false
+
+	t.Run("not requested needs no envd version", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, validateLogProcessOutput("", nil))
+		require.Nil(t, validateLogProcessOutput("0.1.0", &disabled))
+	})
+
+	t.Run("supported envd version", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, validateLogProcessOutput(minEnvdVersionForLogProcessOutput, &enabled))
+	})
+
+	t.Run("older envd version is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		apiErr := validateLogProcessOutput("0.6.18", &enabled)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+		assert.Contains(t, apiErr.ClientMsg, "template must be rebuilt")
+	})
+
+	t.Run("missing envd version is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		apiErr := validateLogProcessOutput("", &enabled)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+	})
+}
+
 func TestValidateNetworkConfig(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -970,6 +1046,107 @@ func TestValidateNetworkRules(t *testing.T) {
 			}),
 			setupFF: ffEnabled,
 		},
+		// ── access rules ──────────────────────────────────────────────────────────
+		{
+			name:        "access rules alongside a transform are valid",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {
+					simpleRule(map[string]string{"Authorization": "Bearer token"}),
+					{Action: new(api.SandboxNetworkRuleActionAllow), Methods: new([]string{"GET"}), Path: new("/repos/*")},
+					{Action: new(api.SandboxNetworkRuleActionDeny), Path: new("/admin")},
+				},
+			}),
+			setupFF: ffEnabled,
+		},
+		{
+			name:        "too many access rules returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: func() *map[string][]api.SandboxNetworkRule {
+				rules := make([]api.SandboxNetworkRule, maxNetworkRuleAccessRulesPerDomain+1)
+				for i := range rules {
+					rules[i] = api.SandboxNetworkRule{Action: new(api.SandboxNetworkRuleActionAllow)}
+				}
+
+				return new(map[string][]api.SandboxNetworkRule{"api.github.com": rules})
+			}(),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  fmt.Sprintf("at most %d access rules", maxNetworkRuleAccessRulesPerDomain),
+		},
+		{
+			name:        "path without action returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Path: new("/repos/*")}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "must set an action",
+		},
+		{
+			name:        "invalid action returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleAction("block"))}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid action",
+		},
+		{
+			name:        "action combined with transform returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{
+					Action:    new(api.SandboxNetworkRuleActionAllow),
+					Transform: &api.SandboxNetworkTransform{Headers: new(map[string]string{"X-Custom": "value"})},
+				}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "cannot have both an action and a transform",
+		},
+		{
+			name:        "lower-case method returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleActionAllow), Methods: new([]string{"get"})}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid method",
+		},
+		{
+			name:        "method with spaces returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleActionAllow), Methods: new([]string{"GET POST"})}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid method",
+		},
+		{
+			name:        "relative path returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleActionAllow), Path: new("repos/*")}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid path",
+		},
+		{
+			name:        "wildcard in the middle of path returns 400",
+			envdVersion: minEnvdVersionForNetworkRules,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleActionAllow), Path: new("/repos/*/issues")}},
+			}),
+			setupFF:  ffEnabled,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid path",
+		},
 		// ── header name ───────────────────────────────────────────────────────────
 		{
 			name:        "empty header name returns 400",
@@ -1049,6 +1226,55 @@ func TestValidateNetworkRules(t *testing.T) {
 	}
 }
 
+func TestValidateTransformTargets(t *testing.T) {
+	t.Parallel()
+
+	rules := new(map[string][]api.SandboxNetworkRule{
+		"api.openai.com": {simpleRule(map[string]string{"Authorization": "Bearer {{secret:OPENAI_KEY}}"})},
+	})
+	blockAll := []string{sandbox_network.AllInternetTrafficCIDR}
+
+	tests := []struct {
+		name     string
+		allowOut []string
+		denyOut  []string
+		rules    *map[string][]api.SandboxNetworkRule
+		wantErr  bool
+	}{
+		{name: "nil rules", denyOut: blockAll},
+		{name: "traffic allowed by default", rules: rules},
+		{name: "domain allowed", allowOut: []string{"api.openai.com"}, denyOut: blockAll, rules: rules},
+		{name: "wildcard domain allowed", allowOut: []string{"*.openai.com"}, denyOut: blockAll, rules: rules},
+		{name: "domain not allowed", allowOut: []string{"github.com"}, denyOut: blockAll, rules: rules, wantErr: true},
+		{name: "only CIDR allowed", allowOut: []string{"1.1.1.1"}, denyOut: blockAll, rules: rules, wantErr: true},
+		{
+			name:    "access rules only",
+			denyOut: blockAll,
+			rules: new(map[string][]api.SandboxNetworkRule{
+				"api.github.com": {{Action: new(api.SandboxNetworkRuleActionAllow)}},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiErr := validateTransformTargets(tt.allowOut, tt.denyOut, tt.rules)
+			if !tt.wantErr {
+				assert.Nil(t, apiErr)
+
+				return
+			}
+
+			if assert.NotNil(t, apiErr) {
+				assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+				assert.Contains(t, apiErr.ClientMsg, "not in allowOut")
+			}
+		})
+	}
+}
+
 func createTestTemplateAliasWithName(ctx context.Context, t *testing.T, db *testutils.Database, templateID, aliasName string, namespace *string) {
 	t.Helper()
 
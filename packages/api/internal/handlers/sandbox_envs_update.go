@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+var errEnvVarsUpdateNotSupported = errors.New("updating env vars of a running sandbox is not supported")
+
+const minEnvdVersionForEnvVarsUpdate = "0.6.20"
+
+// redactedEnvVarValue replaces the values of secret env vars in responses.
+const redactedEnvVarValue = "<redacted>"
+
+func (a *APIStore) PatchSandboxesSandboxIDEnvs(c *gin.Context, sandboxID string) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	teamID := auth.MustGetTeamID(c)
+
+	body, err := ginutils.ParseBody[api.SandboxEnvVarsUpdate](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	envVars, secretKeys, unset, err := parseEnvVarsUpdate(body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	sbxInfo, err := a.orchestrator.GetSandbox(ctx, teamID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, utils.SandboxNotFoundMsg(sandboxID))
+		} else {
+			telemetry.ReportError(ctx, "error getting sandbox for env vars update", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get sandbox")
+		}
+
+		return
+	}
+
+	if err := checkEnvdVersionRequirement(sbxInfo.EnvdVersion, minEnvdVersionForEnvVarsUpdate, errEnvVarsUpdateNotSupported); err != nil {
+		if errors.Is(err, errEnvVarsUpdateNotSupported) || errors.Is(err, errNoEnvdVersion) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+		} else {
+			telemetry.ReportError(ctx, "error checking envd version for env vars update", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to check sandbox envd version")
+		}
+
+		return
+	}
+
+	if apiErr := a.orchestrator.UpdateSandboxEnvVars(ctx, teamID, sandboxID, envVars, secretKeys, unset); apiErr != nil {
+		telemetry.ReportErrorByCode(ctx, apiErr.Code, "error updating sandbox env vars", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseEnvVarsUpdate validates the update and splits it into the values to set, the secret keys and the keys to unset.
+func parseEnvVarsUpdate(body api.SandboxEnvVarsUpdate) (map[string]string, []string, []string, error) {
+	envVars := make(map[string]string)
+	var secretKeys []string
+
+	for key, envVar := range sharedUtils.DerefOrDefault(body.EnvVars, nil) {
+		if err := validateEnvVarKey(key); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if strings.ContainsRune(envVar.Value, 0) {
+			return nil, nil, nil, fmt.Errorf("value of env var %q must not contain a NUL character", key)
+		}
+
+		envVars[key] = envVar.Value
+		if sharedUtils.DerefOrDefault(envVar.Secret, false) {
+			secretKeys = append(secretKeys, key)
+		}
+	}
+
+	unset := sharedUtils.DerefOrDefault(body.Unset, nil)
+	for _, key := range unset {
+		if err := validateEnvVarKey(key); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if _, ok := envVars[key]; ok {
+			return nil, nil, nil, fmt.Errorf("env var %q can't be both set and unset", key)
+		}
+	}
+
+	if len(envVars) == 0 && len(unset) == 0 {
+		return nil, nil, nil, errors.New("no env vars to set or unset")
+	}
+
+	return envVars, secretKeys, unset, nil
+}
+
+func validateEnvVarKey(key string) error {
+	if key == "" {
+		return errors.New("env var name must not be empty")
+	}
+
+	if strings.ContainsAny(key, "=\x00") {
+		return fmt.Errorf("env var name %q must not contain '=' or a NUL character", key)
+	}
+
+	return nil
+}
+
+// openEnvVarsUpdate returns the env vars set on the sandbox with the secret values opened.
+func (a *APIStore) openEnvVarsUpdate(teamID uuid.UUID, update *types.SandboxEnvVarsUpdate) (map[string]string, error) {
+	if update == nil || len(update.Set)+len(update.SealedSecrets) == 0 {
+		return nil, nil
+	}
+
+	envVars := maps.Clone(update.Set)
+	if envVars == nil {
+		envVars = make(map[string]string, len(update.SealedSecrets))
+	}
+
+	for key, sealed := range update.SealedSecrets {
+		value, err := a.secrets.OpenEnvVar(teamID, key, sealed)
+		if err != nil {
+			return nil, err
+		}
+
+		envVars[key] = value
+	}
+
+	return envVars, nil
+}
+
+// redactEnvVars returns the env vars set on the sandbox with the secret values replaced.
+func redactEnvVars(update *types.SandboxEnvVarsUpdate) *map[string]string {
+	if update == nil || len(update.Set)+len(update.SealedSecrets) == 0 {
+		return nil
+	}
+
+	redacted := maps.Clone(update.Set)
+	if redacted == nil {
+		redacted = make(map[string]string, len(update.SealedSecrets))
+	}
+
+	for key := range update.SealedSecrets {
+		redacted[key] = redactedEnvVarValue
+	}
+
+	return &redacted
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+)
+
+func TestParseEnvVarsUpdate(t *testing.T) {
+	t.Parallel()
+
+	secret := true
+	envVars, secretKeys, unset, err := parseEnvVarsUpdate(api.SandboxEnvVarsUpdate{
+		EnvVars: &map[string]api.SandboxEnvVar{
+			"A":     {Value: "1"},
+			"TOKEN": {Value: "abc", Secret: &secret},
+		},
+		Unset: &[]string{"B"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"A": "1", "TOKEN": "abc"}, envVars)
+	assert.Equal(t, []string{"TOKEN"}, secretKeys)
+	assert.Equal(t, []string{"B"}, unset)
+
+	for name, body := range map[string]api.SandboxEnvVarsUpdate{
+		"empty":          {},
+		"empty key":      {EnvVars: &map[string]api.SandboxEnvVar{"": {Value: "1"}}},
+		"key with =":     {Unset: &[]string{"A=B"}},
+		"value with NUL": {EnvVars: &map[string]api.SandboxEnvVar{"A": {Value: "a\x00b"}}},
+		"set and unset":  {EnvVars: &map[string]api.SandboxEnvVar{"A": {Value: "1"}}, Unset: &[]string{"A"}},
+	} {
+		_, _, _, err := parseEnvVarsUpdate(body)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRedactEnvVars(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, redactEnvVars(nil))
+	assert.Nil(t, redactEnvVars(&types.SandboxEnvVarsUpdate{}))
+
+	redacted := redactEnvVars(&types.SandboxEnvVarsUpdate{
+		Set:           map[string]string{"A": "1"},
+		SealedSecrets: map[string][]byte{"TOKEN": []byte("sealed")},
+	})
+	require.NotNil(t, redacted)
+	assert.Equal(t, map[string]string{"A": "1", "TOKEN": redactedEnvVarValue}, *redacted)
+}
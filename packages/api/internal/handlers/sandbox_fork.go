@@ -121,7 +121,7 @@ func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.Sand
 	// Checkpoint the sandbox in place: it is briefly paused on its node,
 	// snapshotted, and resumed under the same execution ID, so the original
 	// keeps its ID, expiration, and concurrency slot.
-	err = a.orchestrator.CheckpointSandbox(ctx, teamID, sandboxID)
+	_, err = a.orchestrator.CheckpointSandbox(ctx, teamID, sandboxID)
 	var transErr *sandbox.InvalidStateTransitionError
 
 	switch {
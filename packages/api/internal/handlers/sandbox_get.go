@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 
@@ -68,6 +69,15 @@ func dbNetworkConfigToAPI(network *dbtypes.SandboxNetworkConfig) *api.SandboxNet
 							Headers: h,
 						}
 					}
+					if r.Action != "" {
+						apiRule.Action = new(api.SandboxNetworkRuleAction(r.Action))
+						if len(r.Methods) > 0 {
+							apiRule.Methods = new(slices.Clone(r.Methods))
+						}
+						if r.Path != "" {
+							apiRule.Path = new(r.Path)
+						}
+					}
 					apiDomainRules = append(apiDomainRules, apiRule)
 				}
 				apiRules[domain] = apiDomainRules
@@ -162,6 +172,8 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			Network:             dbNetworkConfigToAPI(sbx.Network),
 			Lifecycle:           sandboxLifecycleToAPI(sbx.AutoPause, sbx.AutoResume),
 			VolumeMounts:        convertFromDBMountsToAPIMounts(sbx.VolumeMounts),
+			UpdatedEnvVars:      redactEnvVars(sbx.EnvVarsUpdate),
+			BandwidthLimits:     bandwidthLimitsToAPI(sbx.BandwidthLimits),
 		}
 
 		if sbx.Metadata != nil {
@@ -232,9 +244,13 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 
 	var autoResumeConfig *dbtypes.SandboxAutoResumeConfig
 	var networkConfig *dbtypes.SandboxNetworkConfig
+	var envVars *map[string]string
+	var bandwidthLimits *api.SandboxBandwidthLimits
 	if lastSnapshot.Snapshot.Config != nil {
 		autoResumeConfig = lastSnapshot.Snapshot.Config.AutoResume
 		networkConfig = lastSnapshot.Snapshot.Config.Network
+		envVars = redactEnvVars(lastSnapshot.Snapshot.Config.EnvVarsUpdate)
+		bandwidthLimits = bandwidthLimitsToAPI(lastSnapshot.Snapshot.Config.BandwidthLimits)
 	}
 
 	pausedAlias := firstAlias(lastSnapshot.Aliases)
@@ -255,6 +271,8 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 		Domain:              nil,
 		Network:             dbNetworkConfigToAPI(networkConfig),
 		Lifecycle:           sandboxLifecycleToAPI(lastSnapshot.Snapshot.AutoPause, autoResumeConfig),
+		UpdatedEnvVars:      envVars,
+		BandwidthLimits:     bandwidthLimits,
 	}
 
 	sandbox.Alias = &pausedAlias
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetWellKnownOpenidConfiguration serves the OpenID Provider metadata of the sandbox identity tokens.
+func (a *APIStore) GetWellKnownOpenidConfiguration(c *gin.Context) {
+	if a.sandboxIdentityKeys == nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Sandbox identity tokens are not enabled")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, a.sandboxIdentityKeys.Discovery())
+}
+
+// GetWellKnownJwksJson serves the public keys the sandbox identity tokens are verified with.
+func (a *APIStore) GetWellKnownJwksJson(c *gin.Context) {
+	if a.sandboxIdentityKeys == nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Sandbox identity tokens are not enabled")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, a.sandboxIdentityKeys.JWKS())
+}
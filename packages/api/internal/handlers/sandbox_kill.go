@@ -14,6 +14,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -29,6 +30,15 @@ func (a *APIStore) deleteSnapshot(ctx context.Context, sandboxID string, teamID
 		return fmt.Errorf("error deleting template from db: %w", dbErr)
 	}
 
+	// The checkpoints are restore points of the deleted snapshot template.
+	err = a.sqlcDB.DeleteSandboxCheckpoints(ctx, queries.DeleteSandboxCheckpointsParams{
+		TeamID:    teamID,
+		SandboxID: sandboxID,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting sandbox checkpoints: %w", err)
+	}
+
 	a.templateCache.InvalidateAllTags(context.WithoutCancel(ctx), snapshot.TemplateID)
 	a.templateCache.InvalidateAliasesByTemplateID(context.WithoutCancel(ctx), snapshot.TemplateID, aliasKeys)
 	a.snapshotCache.Invalidate(context.WithoutCancel(ctx), sandboxID)
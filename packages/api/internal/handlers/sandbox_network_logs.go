@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	clustersshared "github.com/e2b-dev/infra/packages/shared/pkg/clusters"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDNetworkLogs(c *gin.Context, sandboxID api.SandboxID, params api.GetSandboxesSandboxIDNetworkLogsParams) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	team := auth.MustGetTeamInfo(c)
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	direction := api.LogsDirectionBackward
+	if params.Direction != nil {
+		direction = *params.Direction
+	}
+
+	var cursor *time.Time
+	if params.Cursor != nil {
+		cursor = new(time.UnixMilli(*params.Cursor))
+	}
+
+	clusterID := clustersshared.WithClusterFallback(team.ClusterID)
+	cluster, ok := a.clusters.GetClusterById(clusterID)
+	if !ok {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get cluster")
+		telemetry.ReportCriticalError(ctx, "error when getting cluster for sandbox network logs", fmt.Errorf("cluster with ID '%s' not found", clusterID))
+
+		return
+	}
+
+	logs, apiErr := cluster.GetResources().GetSandboxNetworkLogs(ctx, team.ID.String(), sandboxID, cursor, params.Limit, direction, params.Decision, params.Host)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportErrorByCode(ctx, apiErr.Code, "error when returning network logs for sandbox", apiErr.Err)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SandboxNetworkLogsResponse{Logs: logs})
+}
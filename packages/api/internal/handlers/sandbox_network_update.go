@@ -98,6 +98,12 @@ func (a *APIStore) PutSandboxesSandboxIDNetwork(c *gin.Context, sandboxID string
 
 			return
 		}
+
+		if apiErr := validateTransformTargets(allowedEntries, deniedEntries, body.Rules); apiErr != nil {
+			a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+			return
+		}
 	}
 
 	rules := apiRulesToDBRules(body.Rules)
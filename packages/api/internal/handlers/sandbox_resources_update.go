@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/team"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/auth/pkg/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PatchSandboxesSandboxIDResources(c *gin.Context, sandboxID string) {
+	ctx := c.Request.Context()
+
+	var err error
+	sandboxID, err = utils.ShortID(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid sandbox ID")
+
+		return
+	}
+
+	teamInfo := auth.MustGetTeamInfo(c)
+
+	body, err := ginutils.ParseBody[api.SandboxResourcesUpdate](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	// The same limits as for the templates apply to the resized memory.
+	_, ramMB, apiErr := team.LimitResources(teamInfo.Limits, nil, &body.MemoryMB)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	sbxInfo, err := a.orchestrator.GetSandbox(ctx, teamInfo.Team.ID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, utils.SandboxNotFoundMsg(sandboxID))
+		} else {
+			telemetry.ReportError(ctx, "error getting sandbox for resize", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get sandbox")
+		}
+
+		return
+	}
+
+	// Firecracker can't hotplug vCPUs.
+	if body.CpuCount != nil && int64(*body.CpuCount) != sbxInfo.VCpu {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("CPU count of a running sandbox can't be changed, the sandbox has %d CPUs", sbxInfo.VCpu))
+
+		return
+	}
+
+	if ramMB == sbxInfo.RamMB {
+		c.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if apiErr := a.orchestrator.UpdateSandboxMemory(ctx, teamInfo.Team.ID, sandboxID, ramMB); apiErr != nil {
+		telemetry.ReportErrorByCode(ctx, apiErr.Code, "error resizing sandbox", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
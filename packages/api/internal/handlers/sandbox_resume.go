@@ -240,15 +240,43 @@ func (a *APIStore) buildResumeSandboxDataFromSnapshot(snapshotSandboxID, sandbox
 		// snapshot: there is no resume-time override for it. Changing the kind
 		// requires creating a new sandbox with the desired autoPauseMemory.
 		var autoPauseFilesystemOnly bool
+		var logProcessOutput bool
+		var envVars map[string]string
+		var envVarsUpdate *types.SandboxEnvVarsUpdate
+		var bandwidthLimits *types.SandboxBandwidthLimits
+		var controlPolicy *types.SandboxControlPolicy
+		var spawnedSandboxes int32
 		if snap.Config != nil {
 			network = snap.Config.Network
 			autoResume = snap.Config.AutoResume
 			volumes = snap.Config.VolumeMounts
 			autoPauseFilesystemOnly = snap.Config.AutoPauseFilesystemOnly
+			logProcessOutput = snap.Config.LogProcessOutput
+			envVarsUpdate = snap.Config.EnvVarsUpdate
+			bandwidthLimits = snap.Config.BandwidthLimits
+			controlPolicy = snap.Config.ControlPolicy
+			spawnedSandboxes = snap.Config.SpawnedSandboxes
+
+			// envd keeps the env vars in memory snapshots, a filesystem-only one boots without them.
+			if snap.Config.FilesystemOnly {
+				envVars, err = a.openEnvVarsUpdate(snap.TeamID, envVarsUpdate)
+				if err != nil {
+					return orchestrator.SandboxMetadata{}, &api.APIError{
+						Code:      http.StatusInternalServerError,
+						ClientMsg: "Error when restoring sandbox env vars",
+						Err:       fmt.Errorf("error opening env vars of sandbox '%s': %w", snapshotSandboxID, err),
+					}
+				}
+			}
 		}
 
 		return orchestrator.SandboxMetadata{
 			Metadata:                snap.Metadata,
+			EnvVars:                 envVars,
+			EnvVarsUpdate:           envVarsUpdate,
+			BandwidthLimits:         bandwidthLimits,
+			ControlPolicy:           controlPolicy,
+			SpawnedSandboxes:        spawnedSandboxes,
 			Build:                   build,
 			AllowInternetAccess:     snap.AllowInternetAccess,
 			Network:                 network,
@@ -258,6 +286,7 @@ func (a *APIStore) buildResumeSandboxDataFromSnapshot(snapshotSandboxID, sandbox
 			AutoPause:               autoPause,
 			AutoPauseFilesystemOnly: autoPauseFilesystemOnly,
 			AutoResume:              autoResume,
+			LogProcessOutput:        logProcessOutput,
 			VolumeMounts:            convertDatabaseMountsToOrchestratorMounts(volumes),
 			EnvdAccessToken:         envdAccessToken,
 			NodeID:                  &nodeID,
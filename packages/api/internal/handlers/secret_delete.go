@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/posthog/posthog-go"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) DeleteSecretsSecretName(c *gin.Context, secretName api.SecretName) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	deleted, err := a.sqlcDB.DeleteTeamSecret(ctx, queries.DeleteTeamSecretParams{
+		TeamID: team.ID,
+		Name:   secretName,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting secret")
+		telemetry.ReportCriticalError(ctx, "error when deleting secret", err)
+
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Secret not found")
+		telemetry.ReportError(ctx, "secret not found", nil)
+
+		return
+	}
+
+	a.posthog.CreateAnalyticsTeamEvent(ctx, team.ID.String(), "deleted_secret", posthog.NewProperties())
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/posthog/posthog-go"
+	"golang.org/x/net/http/httpguts"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	sandbox_network "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	maxSecretsPerTeam    = 100
+	maxSecretValueLength = 4096
+)
+
+func (a *APIStore) PutSecretsSecretName(c *gin.Context, secretName api.SecretName) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	body, err := ginutils.ParseBody[api.PutSecretsSecretNameJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if !sandbox_network.IsValidSecretName(secretName) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Secret name must start with a letter or underscore, contain only letters, digits and underscores, and be at most %d characters", sandbox_network.MaxSecretNameLength))
+		telemetry.ReportError(ctx, "invalid secret name", nil)
+
+		return
+	}
+
+	// Secrets are injected into request headers by the egress proxy.
+	if len(body.Value) == 0 || len(body.Value) > maxSecretValueLength || !httpguts.ValidHeaderFieldValue(body.Value) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Secret value must be a non-empty valid HTTP header value of at most %d characters", maxSecretValueLength))
+		telemetry.ReportError(ctx, "invalid secret value", nil)
+
+		return
+	}
+
+	secret, err := a.secrets.Set(ctx, team.ID, secretName, body.Value, maxSecretsPerTeam)
+	if err != nil {
+		if errors.Is(err, secrets.ErrNotConfigured) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Team secrets are not available")
+			telemetry.ReportError(ctx, "team secrets are not configured", err)
+
+			return
+		}
+
+		if errors.Is(err, secrets.ErrLimitReached) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Team can have at most %d secrets", maxSecretsPerTeam))
+			telemetry.ReportError(ctx, "secrets limit reached", nil)
+
+			return
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting secret")
+		telemetry.ReportCriticalError(ctx, "error when setting secret", err)
+
+		return
+	}
+
+	a.posthog.IdentifyAnalyticsTeam(ctx, team.ID.String(), team.Name)
+	a.posthog.CreateAnalyticsTeamEvent(ctx, team.ID.String(), "set_secret", posthog.NewProperties().
+		Set("created", secret.Created),
+	)
+
+	c.JSON(http.StatusOK, toAPITeamSecret(secret.Name, secret.CreatedAt.Time, secret.UpdatedAt.Time))
+}
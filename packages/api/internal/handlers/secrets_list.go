@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSecrets(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	result, err := a.sqlcDB.GetTeamSecrets(ctx, team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting secrets")
+		telemetry.ReportCriticalError(ctx, "error when getting secrets", err)
+
+		return
+	}
+
+	secrets := make([]api.TeamSecret, len(result))
+	for i, s := range result {
+		secrets[i] = toAPITeamSecret(s.Name, s.CreatedAt.Time, s.UpdatedAt.Time)
+	}
+
+	c.JSON(http.StatusOK, secrets)
+}
+
+func toAPITeamSecret(name string, createdAt, updatedAt time.Time) api.TeamSecret {
+	return api.TeamSecret{
+		Name:      name,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
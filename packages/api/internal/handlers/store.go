@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"math"
@@ -28,12 +29,16 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	orchdiscovery "github.com/e2b-dev/infra/packages/api/internal/orchestrator/discovery"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	sharedauth "github.com/e2b-dev/infra/packages/auth/pkg/auth"
 	"github.com/e2b-dev/infra/packages/auth/pkg/types"
 	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/sandboxlogs"
+	chwebhooks "github.com/e2b-dev/infra/packages/clickhouse/pkg/webhooks"
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	authdb "github.com/e2b-dev/infra/packages/db/pkg/auth"
 	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
@@ -43,6 +48,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs/loki"
+	"github.com/e2b-dev/infra/packages/shared/pkg/sandboxidentity"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	sharedutils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -82,12 +88,19 @@ type APIStore struct {
 	templateSpawnCounter  *utils.TemplateSpawnCounter
 	clickhouseStore       clickhouse.Clickhouse
 	sandboxLogsReader     *sandboxlogs.Reader
+	egressLogsReader      *egresslogs.Reader
 	accessTokenGenerator  *sandbox.AccessTokenGenerator
 	featureFlags          *featureflags.Client
 	clusters              *clusters.Pool
 	snapshotUpsertSem     *sharedutils.AdjustableSemaphore
 	sandboxListSem        *sharedutils.AdjustableSemaphore
 	snapshotBuildQuerySem *sharedutils.AdjustableSemaphore
+	webhookDeliveries     chwebhooks.Delivery
+	webhookSender         *webhooks.Sender
+	webhookDispatcher     *webhooks.Dispatcher
+	secrets               *secrets.Store
+	// sandboxIdentityKeys publishes the keys of the sandbox identity tokens, nil when disabled.
+	sandboxIdentityKeys *sandboxidentity.KeySet
 }
 
 func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.UniversalClient, featureFlags *featureflags.Client, config cfg.Config) *APIStore {
@@ -144,6 +157,43 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.U
 		clusterLogsReader = sandboxLogsReader
 	}
 
+	// ClickHouse-backed sandbox egress audit log reader for the local cluster,
+	// left as a nil interface when unset for the same reason as above.
+	var egressLogsReader *egresslogs.Reader
+	var clusterEgressLogsReader clusters.ClickhouseEgressLogsReader
+	if config.ClickhouseConnectionString != "" {
+		conn, readerErr := clickhouse.NewDriver(config.ClickhouseConnectionString)
+		if readerErr != nil {
+			logger.L().Fatal(ctx, "initializing ClickHouse sandbox egress logs reader", zap.Error(readerErr))
+		}
+		egressLogsReader = egresslogs.NewReader(conn)
+		clusterEgressLogsReader = egressLogsReader
+	}
+
+	// Webhook delivery attempts are recorded to ClickHouse when configured, otherwise discarded.
+	webhookDeliveries := chwebhooks.NewNoopDelivery()
+	if config.ClickhouseConnectionString != "" {
+		conn, connErr := clickhouse.NewDriver(config.ClickhouseConnectionString)
+		if connErr != nil {
+			logger.L().Fatal(ctx, "initializing ClickHouse webhook deliveries connection", zap.Error(connErr))
+		}
+
+		webhookDeliveries, err = chwebhooks.NewDefaultClickhouseWebhookDelivery(ctx, conn, featureFlags, chwebhooks.DefaultBatcherName)
+		if err != nil {
+			logger.L().Fatal(ctx, "initializing ClickHouse webhook deliveries", zap.Error(err))
+		}
+	}
+
+	webhookSender := webhooks.NewSender(nil, webhookDeliveries)
+
+	var webhookDispatcher *webhooks.Dispatcher
+	if config.WebhooksDispatcherEnabled {
+		webhookDispatcher = webhooks.NewDispatcher(redisClient, sqlcDB, webhookSender)
+		if err := webhookDispatcher.Start(ctx); err != nil {
+			logger.L().Fatal(ctx, "Starting webhook dispatcher", zap.Error(err))
+		}
+	}
+
 	posthogClient, posthogErr := analyticscollector.NewPosthogClient(ctx, config.PosthogAPIKey)
 	if posthogErr != nil {
 		logger.L().Fatal(ctx, "Initializing Posthog client", zap.Error(posthogErr))
@@ -214,7 +264,7 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.U
 		logger.L().Fatal(ctx, "error when getting logs query provider", zap.Error(err))
 	}
 
-	clusters, err := clusters.NewPool(ctx, tel, sqlcDB, templateBuilderDiscovery, clickhouseStore, queryLogsProvider, clusterLogsReader, featureFlags, config)
+	clusters, err := clusters.NewPool(ctx, tel, sqlcDB, templateBuilderDiscovery, clickhouseStore, queryLogsProvider, clusterLogsReader, clusterEgressLogsReader, featureFlags, config)
 	if err != nil {
 		logger.L().Fatal(ctx, "initializing edge clusters pool failed", zap.Error(err))
 	}
@@ -241,7 +291,20 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.U
 		logger.L().Fatal(ctx, "failed to create snapshot build query semaphore", zap.Error(err))
 	}
 
-	orch, err := orchestrator.New(ctx, config, tel, nodeDiscovery, posthogClient, redisClient, sqlcDB, clusters, featureFlags, accessTokenGenerator, snapshotCache, snapshotUpsertSem)
+	secretsStore, err := secrets.NewStore(sqlcDB, config.SecretsEncryptionKey)
+	if err != nil {
+		logger.L().Fatal(ctx, "Initializing team secrets store", zap.Error(err))
+	}
+
+	var sandboxIdentityKeys *sandboxidentity.KeySet
+	if config.SandboxIdentityPublicKeysBase64 != "" {
+		sandboxIdentityKeys, err = sandboxidentity.NewKeySet(config.SandboxIdentityIssuer, config.SandboxIdentityPublicKeysBase64)
+		if err != nil {
+			logger.L().Fatal(ctx, "Initializing sandbox identity keys", zap.Error(err))
+		}
+	}
+
+	orch, err := orchestrator.New(ctx, config, tel, nodeDiscovery, posthogClient, redisClient, sqlcDB, clusters, featureFlags, accessTokenGenerator, snapshotCache, snapshotUpsertSem, secretsStore)
 	if err != nil {
 		logger.L().Fatal(ctx, "Initializing Orchestrator client", zap.Error(err))
 	}
@@ -280,6 +343,7 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.U
 		templateSpawnCounter:  templateSpawnCounter,
 		clickhouseStore:       clickhouseStore,
 		sandboxLogsReader:     sandboxLogsReader,
+		egressLogsReader:      egressLogsReader,
 		accessTokenGenerator:  accessTokenGenerator,
 		clusters:              clusters,
 		featureFlags:          featureFlags,
@@ -287,6 +351,11 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, redisClient redis.U
 		snapshotUpsertSem:     snapshotUpsertSem,
 		sandboxListSem:        sandboxListSem,
 		snapshotBuildQuerySem: snapshotBuildQuerySem,
+		webhookDeliveries:     webhookDeliveries,
+		webhookSender:         webhookSender,
+		webhookDispatcher:     webhookDispatcher,
+		secrets:               secretsStore,
+		sandboxIdentityKeys:   sandboxIdentityKeys,
 	}
 
 	go a.updateDBThrottleLimits(ctx)
@@ -324,6 +393,12 @@ func (a *APIStore) Close(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("closing Orchestrator client: %w", err))
 	}
 
+	if a.webhookDispatcher != nil {
+		if err := a.webhookDispatcher.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("closing webhook dispatcher: %w", err))
+		}
+	}
+
 	if a.templateCache != nil {
 		if err := a.templateCache.Close(ctx); err != nil {
 			errs = append(errs, fmt.Errorf("closing template cache: %w", err))
@@ -366,6 +441,15 @@ func (a *APIStore) Close(ctx context.Context) error {
 			errs = append(errs, fmt.Errorf("closing ClickHouse sandbox logs reader: %w", err))
 		}
 	}
+	if a.egressLogsReader != nil {
+		if err := a.egressLogsReader.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("closing ClickHouse sandbox egress logs reader: %w", err))
+		}
+	}
+
+	if err := a.webhookDeliveries.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("closing ClickHouse webhook deliveries: %w", err))
+	}
 
 	return errors.Join(errs...)
 }
@@ -503,3 +587,71 @@ func (a *APIStore) GetTeamFromAdminToken(ctx context.Context, _ *gin.Context, te
 
 	return team, nil
 }
+
+// GetTeamFromSandboxControlToken validates the token the orchestrator sends on behalf of
+// the sandbox in the path, the token is bound to the X-Team-ID team and the current
+// execution of the sandbox, which must be running.
+func (a *APIStore) GetTeamFromSandboxControlToken(ctx context.Context, ginCtx *gin.Context, token string) (*types.Team, *api.APIError) {
+	sandboxID, err := utils.ShortID(ginCtx.Param("sandboxID"))
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Invalid sandbox ID",
+			Err:       fmt.Errorf("failed to parse sandbox ID: %w", err),
+		}
+	}
+
+	teamID := ginCtx.GetHeader(sharedauth.HeaderTeamID)
+	teamUUID, err := uuid.Parse(teamID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Invalid team ID",
+			Err:       fmt.Errorf("failed to parse team ID: %w", err),
+		}
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(ctx, teamUUID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			return nil, &api.APIError{
+				Code:      http.StatusUnauthorized,
+				ClientMsg: "Invalid sandbox control token",
+				Err:       fmt.Errorf("sandbox '%s' of the control token not found: %w", sandboxID, err),
+			}
+		}
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Backend authentication failed",
+			Err:       fmt.Errorf("failed to get sandbox of the control token: %w", err),
+		}
+	}
+
+	if sbx.State != sandbox.StateRunning {
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: utils.SandboxChangingStateMsg(sandboxID, sbx.State),
+			Err:       fmt.Errorf("sandbox '%s' of the control token is %s", sandboxID, sbx.State),
+		}
+	}
+
+	expected, err := a.accessTokenGenerator.GenerateControlAccessToken(teamUUID, sandboxID, sbx.ExecutionID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Backend authentication failed",
+			Err:       fmt.Errorf("failed to generate control access token: %w", err),
+		}
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return nil, &api.APIError{
+			Code:      http.StatusUnauthorized,
+			ClientMsg: "Invalid sandbox control token",
+			Err:       errors.New("sandbox control token mismatch"),
+		}
+	}
+
+	return a.GetTeamFromAdminToken(ctx, ginCtx, teamID)
+}
@@ -34,6 +34,8 @@ type dockerfileStore struct {
 	FromImage    *string             `json:"from_image"`
 	FromTemplate *string             `json:"from_template"`
 	Steps        *[]api.TemplateStep `json:"steps"`
+
+	Dockerfile *api.TemplateBuildDockerfile `json:"dockerfile,omitempty"`
 }
 
 // PostV2TemplatesTemplateIDBuildsBuildID triggers a new build
@@ -48,6 +50,12 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		return
 	}
 
+	if body.Dockerfile != nil && (body.FromImage != nil || body.FromTemplate != nil || (body.Steps != nil && len(*body.Steps) > 0)) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Dockerfile cannot be combined with fromImage, fromTemplate or steps")
+
+		return
+	}
+
 	buildUUID, err := uuid.Parse(buildID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", buildID))
@@ -118,6 +126,7 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		FromImage:    body.FromImage,
 		FromTemplate: body.FromTemplate,
 		Steps:        body.Steps,
+		Dockerfile:   body.Dockerfile,
 	})
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when processing steps: %s", err))
@@ -181,6 +190,7 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		body.FromImageRegistry,
 		body.Force,
 		body.Steps,
+		body.Dockerfile,
 		clusters.WithClusterFallback(team.ClusterID),
 		builderNode.NodeID,
 		version,
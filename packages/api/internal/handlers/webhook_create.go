@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/ginutils"
+	sandbox_network "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const maxWebhooksPerTeam = 10
+
+func (a *APIStore) PostWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	body, err := ginutils.ParseBody[api.PostWebhooksJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if !isValidWebhookURL(body.Url) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Webhook URL must be an absolute HTTPS URL of a public host")
+		telemetry.ReportError(ctx, "invalid webhook url", nil)
+
+		return
+	}
+
+	eventTypes := make([]string, 0)
+	if body.Events != nil {
+		for _, e := range *body.Events {
+			if !e.Valid() {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid event type '%s'", e))
+				telemetry.ReportError(ctx, "invalid webhook event type", nil)
+
+				return
+			}
+
+			eventTypes = append(eventTypes, string(e))
+		}
+	}
+
+	secret, err := webhooks.NewSigningSecret()
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "error when generating webhook secret", err)
+
+		return
+	}
+
+	client, tx, err := a.sqlcDB.WithTx(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create transaction")
+		telemetry.ReportCriticalError(ctx, "Failed to create transaction", err)
+
+		return
+	}
+	defer func(ctx context.Context) {
+		_ = tx.Rollback(ctx)
+	}(context.WithoutCancel(ctx))
+
+	// Lock the team so concurrent requests can't exceed the limit between the count and the insert,
+	// and so the delivery key is updated in the same order as the team's webhooks.
+	if _, err := client.LockTeamWebhooks(ctx, team.ID); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "error when locking team for webhook create", err)
+
+		return
+	}
+
+	count, err := client.CountWebhooksByTeamID(ctx, team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "error when counting webhooks", err)
+
+		return
+	}
+
+	if count >= maxWebhooksPerTeam {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Team can have at most %d webhooks", maxWebhooksPerTeam))
+		telemetry.ReportError(ctx, "webhooks limit reached", nil)
+
+		return
+	}
+
+	webhook, err := client.CreateWebhook(ctx, queries.CreateWebhookParams{
+		TeamID:        team.ID,
+		Url:           body.Url,
+		Events:        eventTypes,
+		SigningSecret: secret,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "error when creating webhook", err)
+
+		return
+	}
+
+	// Orchestrators publish the team's events to the stream only while the delivery key exists.
+	if err := webhooks.SyncDeliveryKey(ctx, a.redisClient, client, team.ID); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "error when updating webhook delivery key", err)
+
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+		telemetry.ReportCriticalError(ctx, "failed to commit transaction", err)
+
+		return
+	}
+
+	a.posthog.IdentifyAnalyticsTeam(ctx, team.ID.String(), team.Name)
+	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
+	a.posthog.CreateAnalyticsTeamEvent(ctx, team.ID.String(), "created_webhook", properties.
+		Set("webhook_id", webhook.ID.String()),
+	)
+
+	result := toAPIWebhook(webhook)
+	c.JSON(http.StatusCreated, api.CreatedWebhook{
+		WebhookID:     result.WebhookID,
+		Url:           result.Url,
+		Events:        result.Events,
+		Enabled:       result.Enabled,
+		CreatedAt:     result.CreatedAt,
+		SigningSecret: webhook.SigningSecret,
+	})
+}
+
+func isValidWebhookURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != "https" || u.Hostname() == "" {
+		return false
+	}
+
+	// Hostnames are checked again at connect time, when they are resolved.
+	if ip := net.ParseIP(u.Hostname()); ip != nil && sandbox_network.IsIPInDeniedSandboxCIDRs(ip) {
+		return false
+	}
+
+	return u.Hostname() != "localhost"
+}
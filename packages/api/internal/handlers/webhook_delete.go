@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/posthog/posthog-go"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) DeleteWebhooksWebhookID(c *gin.Context, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	client, tx, err := a.sqlcDB.WithTx(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to create transaction")
+		telemetry.ReportCriticalError(ctx, "Failed to create transaction", err)
+
+		return
+	}
+	defer func(ctx context.Context) {
+		_ = tx.Rollback(ctx)
+	}(context.WithoutCancel(ctx))
+
+	// Lock the team so a concurrent create can't set the delivery key before this delete removes it.
+	if _, err := client.LockTeamWebhooks(ctx, team.ID); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting webhook")
+		telemetry.ReportCriticalError(ctx, "error when locking team for webhook delete", err)
+
+		return
+	}
+
+	deleted, err := client.DeleteWebhook(ctx, queries.DeleteWebhookParams{
+		TeamID:    team.ID,
+		WebhookID: webhookID,
+	})
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting webhook")
+		telemetry.ReportCriticalError(ctx, "error when deleting webhook", err)
+
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, "Webhook not found")
+		telemetry.ReportError(ctx, "webhook not found", nil)
+
+		return
+	}
+
+	// Stop publishing events for the team once its last webhook is gone.
+	if err := webhooks.SyncDeliveryKey(ctx, a.redisClient, client, team.ID); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting webhook")
+		telemetry.ReportCriticalError(ctx, "error when updating webhook delivery key", err)
+
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting webhook")
+		telemetry.ReportCriticalError(ctx, "failed to commit transaction", err)
+
+		// The webhook wasn't deleted, restore the key in case it was just removed.
+		if err := webhooks.SyncDeliveryKey(context.WithoutCancel(ctx), a.redisClient, a.sqlcDB, team.ID); err != nil {
+			telemetry.ReportCriticalError(ctx, "error when restoring webhook delivery key", err)
+		}
+
+		return
+	}
+
+	a.posthog.CreateAnalyticsTeamEvent(ctx, team.ID.String(), "deleted_webhook", posthog.NewProperties().
+		Set("webhook_id", webhookID.String()),
+	)
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const webhookTestEvent = "webhook.test"
+
+func (a *APIStore) PostWebhooksWebhookIDTest(c *gin.Context, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	webhook, team, ok := a.getWebhook(c, webhookID)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhooks.DeliveryPolicy.AttemptTimeout)
+	defer cancel()
+
+	result := a.webhookSender.Send(ctx, webhook, events.SandboxEvent{
+		ID:            uuid.New(),
+		Version:       events.StructureVersionV2,
+		Type:          webhookTestEvent,
+		Timestamp:     time.Now(),
+		SandboxTeamID: team.ID,
+	})
+
+	response := api.WebhookTestResult{
+		Success:    result.Success(),
+		DurationMs: result.Duration.Milliseconds(),
+	}
+
+	if result.StatusCode != 0 {
+		response.StatusCode = &result.StatusCode
+	}
+
+	if result.Err != nil {
+		// The upstream error is not returned as is, it could reveal details of the network the API runs in.
+		errMsg := webhookTestErrorMessage(result)
+		response.Error = &errMsg
+		telemetry.ReportEvent(ctx, "webhook test delivery failed", attribute.String("error_class", result.ErrorClass))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func webhookTestErrorMessage(result webhooks.AttemptResult) string {
+	switch result.ErrorClass {
+	case webhooks.ErrorClassHTTPStatus:
+		return fmt.Sprintf("Webhook endpoint responded with status %d", result.StatusCode)
+	case webhooks.ErrorClassTimeout:
+		return "Webhook endpoint did not respond in time"
+	case webhooks.ErrorClassBlockedAddress:
+		return "Webhook host resolves to an address that is not allowed"
+	case webhooks.ErrorClassRequest:
+		return "Failed to create the webhook request"
+	default:
+		return "Failed to connect to the webhook endpoint"
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/auth/pkg/types"
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) getWebhook(c *gin.Context, webhookID api.WebhookID) (queries.Webhook, *types.Team, bool) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return queries.Webhook{}, team, false
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	webhook, err := a.sqlcDB.GetWebhook(ctx, queries.GetWebhookParams{
+		WebhookID: webhookID,
+		TeamID:    team.ID,
+	})
+
+	switch {
+	case dberrors.IsNotFoundError(err):
+		a.sendAPIStoreError(c, http.StatusNotFound, "Webhook not found")
+		telemetry.ReportError(ctx, "webhook not found", err)
+
+		return webhook, team, false
+	case err != nil:
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhook")
+		telemetry.ReportCriticalError(ctx, "error when getting webhook", err)
+
+		return webhook, team, false
+	default:
+		return webhook, team, true
+	}
+}
+
+func toAPIWebhook(webhook queries.Webhook) api.Webhook {
+	eventTypes := make([]api.SandboxEventType, len(webhook.Events))
+	for i, e := range webhook.Events {
+		eventTypes[i] = api.SandboxEventType(e)
+	}
+
+	return api.Webhook{
+		WebhookID: webhook.ID,
+		Url:       webhook.Url,
+		Events:    eventTypes,
+		Enabled:   webhook.Enabled,
+		CreatedAt: webhook.CreatedAt.Time,
+	}
+}
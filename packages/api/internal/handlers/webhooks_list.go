@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	team, apiErr := a.GetTeam(ctx, c, nil)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team", apiErr.Err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+	)
+
+	result, err := a.sqlcDB.GetWebhooksByTeamID(ctx, team.ID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhooks")
+		telemetry.ReportCriticalError(ctx, "error when getting webhooks", err)
+
+		return
+	}
+
+	webhooks := make([]api.Webhook, len(result))
+	for i, w := range result {
+		webhooks[i] = toAPIWebhook(w)
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
@@ -20,6 +20,7 @@ var blockedTeamAllowlist = auth.BlockedTeamAllowlist{
 		"/sandboxes/:sandboxID":                         {},
 		"/sandboxes/:sandboxID/logs":                    {},
 		"/sandboxes/:sandboxID/metrics":                 {},
+		"/sandboxes/:sandboxID/network/logs":            {},
 		"/snapshots":                                    {},
 		"/teams/:teamID/metrics":                        {},
 		"/teams/:teamID/metrics/max":                    {},
@@ -22,14 +22,15 @@ import (
 // briefly paused on its node, snapshotted, and resumed under the same
 // execution ID, so it keeps running with its ID, expiration, and reservation
 // untouched. The snapshot is written to the sandbox's own snapshots row, so
-// it can immediately be resumed or forked from.
-func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID, sandboxID string) error {
+// it can immediately be resumed or forked from. It returns the ID of the
+// snapshot build holding the checkpoint.
+func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID, sandboxID string) (uuid.UUID, error) {
 	ctx, span := tracer.Start(ctx, "checkpoint-sandbox")
 	defer span.End()
 
 	sbx, alreadyDone, finishSnapshotting, err := o.sandboxStore.StartRemoving(ctx, teamID, sandboxID, sandbox.RemoveOpts{Action: sandbox.StateActionSnapshot})
 	if err != nil {
-		return fmt.Errorf("failed to start snapshotting: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to start snapshotting: %w", err)
 	}
 
 	// alreadyDone conflates joining a concurrent checkpoint that just
@@ -38,7 +39,7 @@ func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID,
 	// stale state, so report a conflict and let the caller retry (same
 	// behavior as CreateSnapshotTemplate).
 	if alreadyDone {
-		return &sandbox.InvalidStateTransitionError{
+		return uuid.Nil, &sandbox.InvalidStateTransitionError{
 			CurrentState: sandbox.StateSnapshotting,
 			TargetState:  sandbox.StateSnapshotting,
 		}
@@ -58,12 +59,12 @@ func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID,
 
 	node := o.getOrConnectNode(ctx, sbx.ClusterID, sbx.NodeID)
 	if node == nil {
-		return fmt.Errorf("node '%s' not found", sbx.NodeID)
+		return uuid.Nil, fmt.Errorf("node '%s' not found", sbx.NodeID)
 	}
 
 	upsertResult, err := o.throttledUpsertSnapshot(ctx, buildUpsertSnapshotParams(sbx, node, false))
 	if err != nil {
-		return fmt.Errorf("error upserting snapshot: %w", err)
+		return uuid.Nil, fmt.Errorf("error upserting snapshot: %w", err)
 	}
 
 	// Checkpoint pauses the sandbox, snapshots it, and resumes it on the
@@ -91,11 +92,11 @@ func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID,
 			case codes.FailedPrecondition:
 				finish(nil)
 
-				return fmt.Errorf("checkpoint rejected: %w", err)
+				return uuid.Nil, fmt.Errorf("checkpoint rejected: %w", err)
 			case codes.ResourceExhausted:
 				finish(nil)
 
-				return PauseQueueExhaustedError{}
+				return uuid.Nil, PauseQueueExhaustedError{}
 			}
 		}
 
@@ -108,7 +109,7 @@ func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID,
 			telemetry.ReportError(cleanupCtx, "error killing sandbox after failed checkpoint", killErr)
 		}
 
-		return fmt.Errorf("checkpoint failed: %w", err)
+		return uuid.Nil, fmt.Errorf("checkpoint failed: %w", err)
 	}
 
 	now := time.Now()
@@ -119,12 +120,12 @@ func (o *Orchestrator) CheckpointSandbox(ctx context.Context, teamID uuid.UUID,
 		BuildID:    upsertResult.BuildID,
 	})
 	if err != nil {
-		return fmt.Errorf("error updating build status: %w", err)
+		return uuid.Nil, fmt.Errorf("error updating build status: %w", err)
 	}
 
 	o.snapshotCache.Invalidate(context.WithoutCancel(ctx), sandboxID)
 
 	telemetry.ReportEvent(ctx, "Checkpointed sandbox")
 
-	return nil
+	return upsertResult.BuildID, nil
 }
@@ -57,6 +57,16 @@ type SandboxMetadata struct {
 	// SnapshotSandboxID is the sandbox ID the resume snapshot is stored under.
 	// It differs from the ID of the sandbox being started when forking.
 	SnapshotSandboxID string
+	// LogProcessOutput ships the output of processes started in the sandbox to the sandbox logs by default.
+	LogProcessOutput bool
+	// EnvVarsUpdate are the env vars set on the sandbox while it ran before, carried over a resume.
+	EnvVarsUpdate *types.SandboxEnvVarsUpdate
+	// BandwidthLimits are the bandwidth and IOPS limits of the sandbox, nil when unlimited.
+	BandwidthLimits *types.SandboxBandwidthLimits
+	// ControlPolicy are the actions the sandbox can take on itself, nil when none.
+	// SpawnedSandboxes carries the spawned sandboxes count over a resume.
+	ControlPolicy    *types.SandboxControlPolicy
+	SpawnedSandboxes int32
 }
 
 // buildEgressConfig constructs the orchestrator egress configuration from
@@ -82,6 +92,14 @@ func buildEgressConfig(allowedEntries, deniedEntries []string, rules map[string]
 						Headers: r.Transform.Headers,
 					}
 				}
+				switch r.Action {
+				case types.SandboxNetworkRuleActionAllow:
+					orchRule.Action = orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW
+				case types.SandboxNetworkRuleActionDeny:
+					orchRule.Action = orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_DENY
+				}
+				orchRule.Methods = r.Methods
+				orchRule.Path = r.Path
 				orchRuleList = append(orchRuleList, orchRule)
 			}
 			orchRules[domain] = &orchestrator.SandboxNetworkDomainRules{Rules: orchRuleList}
@@ -262,7 +280,24 @@ func (o *Orchestrator) CreateSandbox(
 		trafficAccessToken = &accessToken
 	}
 
+	var controlAccessToken *string
+	if sbxData.ControlPolicy != nil {
+		accessToken, err := o.accessTokenGenerator.GenerateControlAccessToken(team.ID, sandboxID, executionID)
+		if err != nil {
+			return sandbox.Sandbox{}, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Failed to create control access token",
+				Err:       fmt.Errorf("failed to create control access token for sandbox %s: %w", sandboxID, err),
+			}
+		}
+
+		controlAccessToken = &accessToken
+	}
+
 	sbxNetwork := buildNetworkConfig(network, sbxData.AllowInternetAccess, trafficAccessToken)
+	if apiErr := o.resolveEgressSecrets(ctx, team.ID, sbxNetwork.GetEgress(), isResume); apiErr != nil {
+		return sandbox.Sandbox{}, apiErr
+	}
 
 	var orchAutoResume *orchestrator.SandboxAutoResumeConfig
 	if sbxData.AutoResume != nil {
@@ -296,10 +331,14 @@ func (o *Orchestrator) CreateSandbox(
 			AutoPause:               sbxData.AutoPause,
 			AutoPauseFilesystemOnly: sbxData.AutoPauseFilesystemOnly,
 			AutoResume:              orchAutoResume,
+			LogProcessOutput:        sbxData.LogProcessOutput,
 			AllowInternetAccess:     sbxData.AllowInternetAccess,
 			Network:                 sbxNetwork,
 			TotalDiskSizeMb:         ut.FromPtr(sbxData.Build.TotalDiskSizeMb),
 			VolumeMounts:            sbxData.VolumeMounts,
+			BandwidthLimits:         bandwidthLimitsToProto(sbxData.BandwidthLimits),
+			ControlAccessToken:      controlAccessToken,
+			ControlPolicy:           controlPolicyToProto(sbxData.ControlPolicy),
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
@@ -382,6 +421,10 @@ func (o *Orchestrator) CreateSandbox(
 		sbxData.AutoPause,
 		sbxData.AutoPauseFilesystemOnly,
 		sbxData.AutoResume,
+		sbxData.LogProcessOutput,
+		sbxData.EnvVarsUpdate,
+		sbxData.BandwidthLimits,
+		sbxData.ControlPolicy,
 		sbxData.EnvdAccessToken,
 		sbxData.AllowInternetAccess,
 		sbxData.BaseTemplateID,
@@ -390,6 +433,7 @@ func (o *Orchestrator) CreateSandbox(
 		trafficAccessToken,
 		nodemanager.ConvertOrchestratorMountsToDatabaseMounts(sbxData.VolumeMounts),
 	)
+	sbx.SpawnedSandboxes = sbxData.SpawnedSandboxes
 
 	err = o.sandboxStore.Add(ctx, sbx, &creationMeta)
 	if err != nil {
@@ -0,0 +1,106 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sandbox_network "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-network"
+)
+
+// egressSecretNames returns the secret names referenced by the rule transform headers.
+func egressSecretNames(egress *orchestrator.SandboxNetworkEgressConfig) []string {
+	var names []string
+	for _, domainRules := range egress.GetRules() {
+		for _, r := range domainRules.GetRules() {
+			for _, value := range r.GetTransform().GetHeaders() {
+				for _, name := range sandbox_network.SecretPlaceholderNames(value) {
+					if !slices.Contains(names, name) {
+						names = append(names, name)
+					}
+				}
+			}
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// resolveEgressSecrets attaches the values of the team secrets referenced by the
+// rule transform headers, so the egress proxy can substitute the placeholders.
+// The values are only sent to the node and never stored with the sandbox.
+//
+// The rules of a resumed sandbox were accepted when it was created, so a secret
+// deleted since then drops the headers referencing it instead of failing the resume.
+func (o *Orchestrator) resolveEgressSecrets(ctx context.Context, teamID uuid.UUID, egress *orchestrator.SandboxNetworkEgressConfig, isResume bool) *api.APIError {
+	names := egressSecretNames(egress)
+	if len(names) == 0 {
+		return nil
+	}
+
+	values, err := o.secrets.Resolve(ctx, teamID, names)
+
+	var missingErr *secrets.MissingSecretsError
+	if isResume && errors.As(err, &missingErr) {
+		logger.L().Warn(ctx, "Dropping network rule headers referencing deleted secrets",
+			logger.WithTeamID(teamID.String()),
+			zap.Strings("secrets", missingErr.Names),
+		)
+
+		dropSecretHeaders(egress, missingErr.Names)
+		values, err = o.secrets.Resolve(ctx, teamID, egressSecretNames(egress))
+	}
+
+	if err != nil {
+		switch {
+		case errors.As(err, &missingErr):
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Secrets referenced by network rules not found: %s", strings.Join(missingErr.Names, ", ")),
+				Err:       err,
+			}
+		case errors.Is(err, secrets.ErrNotConfigured):
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: "Team secrets are not available",
+				Err:       err,
+			}
+		default:
+			return &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Error resolving secrets referenced by network rules",
+				Err:       err,
+			}
+		}
+	}
+
+	egress.Secrets = values
+
+	return nil
+}
+
+// dropSecretHeaders removes the rule transform headers referencing any of the named secrets.
+func dropSecretHeaders(egress *orchestrator.SandboxNetworkEgressConfig, names []string) {
+	for _, domainRules := range egress.GetRules() {
+		for _, r := range domainRules.GetRules() {
+			headers := r.GetTransform().GetHeaders()
+			for header, value := range headers {
+				if slices.ContainsFunc(sandbox_network.SecretPlaceholderNames(value), func(name string) bool { return slices.Contains(names, name) }) {
+					delete(headers, header)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package orchestrator
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/db/pkg/testutils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestEgressSecretNames(t *testing.T) {
+	t.Parallel()
+
+	egress := &orchestrator.SandboxNetworkEgressConfig{
+		Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+			"api.openai.com": {Rules: []*orchestrator.SandboxNetworkRule{
+				{Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{
+					"Authorization": "Bearer {{secret:OPENAI_KEY}}",
+					"X-Org":         "{{secret:ORG}}",
+				}}},
+			}},
+			"api.github.com": {Rules: []*orchestrator.SandboxNetworkRule{
+				{Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{
+					"Authorization": "token {{secret:GITHUB_TOKEN}} {{secret:ORG}}",
+				}}},
+				{Action: orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW},
+			}},
+		},
+	}
+
+	assert.Equal(t, []string{"GITHUB_TOKEN", "OPENAI_KEY", "ORG"}, egressSecretNames(egress))
+	assert.Empty(t, egressSecretNames(nil))
+}
+
+func TestDropSecretHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := map[string]string{
+		"Authorization": "token {{secret:GITHUB_TOKEN}} {{secret:ORG}}",
+		"X-Org":         "{{secret:ORG}}",
+		"X-Other":       "{{secret:OTHER}}",
+		"X-Plain":       "value",
+	}
+	egress := &orchestrator.SandboxNetworkEgressConfig{
+		Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+			"api.github.com": {Rules: []*orchestrator.SandboxNetworkRule{
+				{Transform: &orchestrator.SandboxNetworkTransform{Headers: headers}},
+				{Action: orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW},
+			}},
+		},
+	}
+
+	dropSecretHeaders(egress, []string{"ORG"})
+	assert.Equal(t, map[string]string{"X-Other": "{{secret:OTHER}}", "X-Plain": "value"}, headers)
+}
+
+func TestResolveEgressSecrets_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	store, err := secrets.NewStore(nil, "")
+	require.NoError(t, err)
+	o := &Orchestrator{secrets: store}
+
+	// Rules without placeholders need no secrets.
+	plain := &orchestrator.SandboxNetworkEgressConfig{
+		Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+			"api.openai.com": {Rules: []*orchestrator.SandboxNetworkRule{
+				{Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{"X-Plain": "value"}}},
+			}},
+		},
+	}
+	require.Nil(t, o.resolveEgressSecrets(t.Context(), uuid.New(), plain, false))
+	assert.Empty(t, plain.GetSecrets())
+
+	withSecret := &orchestrator.SandboxNetworkEgressConfig{
+		Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+			"api.openai.com": {Rules: []*orchestrator.SandboxNetworkRule{
+				{Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{"Authorization": "Bearer {{secret:OPENAI_KEY}}"}}},
+			}},
+		},
+	}
+	apiErr := o.resolveEgressSecrets(t.Context(), uuid.New(), withSecret, false)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+}
+
+func TestResolveEgressSecrets_DeletedSecret(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.SetupDatabase(t)
+	teamID := testutils.CreateTestTeam(t, db)
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	store, err := secrets.NewStore(db.SqlcClient, base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	o := &Orchestrator{secrets: store}
+
+	for _, name := range []string{"OPENAI_KEY", "ORG"} {
+		_, err := store.Set(t.Context(), teamID, name, name+"-value", 10)
+		require.NoError(t, err)
+	}
+
+	newEgress := func() *orchestrator.SandboxNetworkEgressConfig {
+		return &orchestrator.SandboxNetworkEgressConfig{
+			Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+				"api.openai.com": {Rules: []*orchestrator.SandboxNetworkRule{
+					{Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{
+						"Authorization": "Bearer {{secret:OPENAI_KEY}}",
+						"X-Org":         "{{secret:ORG}}",
+						"X-Plain":       "value",
+					}}},
+				}},
+			},
+		}
+	}
+
+	deleted, err := db.SqlcClient.DeleteTeamSecret(t.Context(), queries.DeleteTeamSecretParams{TeamID: teamID, Name: "OPENAI_KEY"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+
+	// New rules must reference existing secrets.
+	apiErr := o.resolveEgressSecrets(t.Context(), teamID, newEgress(), false)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	// Resuming drops the headers referencing the deleted secret.
+	egress := newEgress()
+	require.Nil(t, o.resolveEgressSecrets(t.Context(), teamID, egress, true))
+	assert.Equal(t, map[string]string{
+		"X-Org":   "{{secret:ORG}}",
+		"X-Plain": "value",
+	}, egress.GetRules()["api.openai.com"].GetRules()[0].GetTransform().GetHeaders())
+	assert.Equal(t, map[string]string{"ORG": "ORG-value"}, egress.GetSecrets())
+}
@@ -0,0 +1,287 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gogo/status"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/placement"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// maxConcurrentNodeMigrations limits how many sandboxes of a drained node are migrated at once.
+const maxConcurrentNodeMigrations = 4
+
+// MigrateSandbox live-migrates a running sandbox to another node of its cluster.
+// The source node pauses the sandbox only for the stop-and-copy into its local
+// cache, the target node resumes from that snapshot and faults the rest of the
+// memory in from the source while the snapshot is uploaded. The sandbox keeps
+// its ID, execution ID, and expiration, and the routing catalog is switched
+// to the target node once it runs there.
+func (o *Orchestrator) MigrateSandbox(ctx context.Context, teamID uuid.UUID, sandboxID string) error {
+	ctx, span := tracer.Start(ctx, "migrate-sandbox")
+	defer span.End()
+
+	sbx, alreadyDone, finishMigrating, err := o.sandboxStore.StartRemoving(ctx, teamID, sandboxID, sandbox.RemoveOpts{Action: sandbox.StateActionMigrate})
+	if err != nil {
+		return fmt.Errorf("failed to start migrating: %w", err)
+	}
+
+	// The sandbox is already being snapshotted or migrated by another request,
+	// there is nothing to join.
+	if alreadyDone {
+		return &sandbox.InvalidStateTransitionError{
+			CurrentState: sandbox.StateSnapshotting,
+			TargetState:  sandbox.StateSnapshotting,
+		}
+	}
+
+	// finish completes the migrating transition exactly once.
+	// On success (nil) it restores the sandbox to Running.
+	var once sync.Once
+	finish := func(err error) {
+		once.Do(func() {
+			finishMigrating(context.WithoutCancel(ctx), err)
+		})
+	}
+	defer finish(nil)
+
+	sourceNode := o.getOrConnectNode(ctx, sbx.ClusterID, sbx.NodeID)
+	if sourceNode == nil {
+		return fmt.Errorf("node '%s' not found", sbx.NodeID)
+	}
+
+	upsertResult, err := o.throttledUpsertSnapshot(ctx, buildUpsertSnapshotParams(sbx, sourceNode, false))
+	if err != nil {
+		return fmt.Errorf("error upserting snapshot: %w", err)
+	}
+
+	client, childCtx := sourceNode.GetSandboxDeleteCtx(ctx, sbx.SandboxID, sbx.ExecutionID)
+	resp, err := client.Sandbox.Migrate(childCtx, &orchestrator.SandboxMigrateRequest{
+		SandboxId:  sbx.SandboxID,
+		TemplateId: upsertResult.TemplateID,
+		BuildId:    upsertResult.BuildID.String(),
+	})
+	if err != nil {
+		cleanupCtx := context.WithoutCancel(ctx)
+
+		o.failSnapshotBuild(cleanupCtx, upsertResult.BuildID, err)
+
+		// The source node rejects these before pausing the VM, so the sandbox
+		// is still running there.
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.FailedPrecondition:
+				finish(nil)
+
+				return fmt.Errorf("migration rejected: %w", err)
+			case codes.ResourceExhausted:
+				finish(nil)
+
+				return PauseQueueExhaustedError{}
+			}
+		}
+
+		finish(err)
+
+		if killErr := o.RemoveSandbox(cleanupCtx, teamID, sandboxID, sandbox.RemoveOpts{Action: sandbox.StateActionKill}); killErr != nil {
+			telemetry.ReportError(cleanupCtx, "error killing sandbox after failed migration", killErr)
+		}
+
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	now := time.Now()
+	err = o.sqlcDB.UpdateEnvBuildStatus(ctx, queries.UpdateEnvBuildStatusParams{
+		Status:     types.BuildStatusSuccess,
+		FinishedAt: &now,
+		Reason:     types.BuildReason{},
+		BuildID:    upsertResult.BuildID,
+	})
+	if err != nil {
+		// The sandbox is already stopped on the source node.
+		finish(err)
+		o.settleMigrationAsPaused(context.WithoutCancel(ctx), sbx)
+
+		return fmt.Errorf("error updating build status: %w", err)
+	}
+
+	o.snapshotCache.Invalidate(context.WithoutCancel(ctx), sandboxID)
+
+	sbxRequest := buildMigrationCreateRequest(sbx, resp.GetConfig(), upsertResult.TemplateID, upsertResult.BuildID)
+
+	requiredLabels, labelFilteringEnabled := o.migrationNodeLabels(ctx, sbx, sourceNode)
+
+	// The source is excluded explicitly, its draining status reaches the node pool only with the next sync.
+	targetNodes := slices.DeleteFunc(o.GetClusterNodes(sbx.ClusterID), func(n *nodemanager.Node) bool {
+		return n.ID == sourceNode.ID
+	})
+
+	placed, err := placement.PlaceSandbox(ctx, o.placementAlgorithm, targetNodes, nil, sbxRequest, sourceNode.MachineInfo(), labelFilteringEnabled, requiredLabels)
+	if err != nil {
+		// The snapshot is persisted, so the sandbox can still be resumed later.
+		finish(err)
+		o.settleMigrationAsPaused(context.WithoutCancel(ctx), sbx)
+
+		return fmt.Errorf("failed to place migrated sandbox: %w", err)
+	}
+
+	migrated, err := o.sandboxStore.Update(ctx, teamID, sandboxID, func(s sandbox.Sandbox) (sandbox.Sandbox, error) {
+		s.NodeID = placed.Node.ID
+		s.ClusterID = placed.Node.ClusterID
+
+		return s, nil
+	})
+	if err != nil {
+		// Untracked, the sandbox would run on the target node past its expiration.
+		// It's stopped there and can be resumed from the snapshot instead.
+		cleanupCtx := context.WithoutCancel(ctx)
+
+		finish(err)
+
+		if killErr := o.killSandboxOnNode(cleanupCtx, placed.Node, sbx, sandbox.KillReasonUnknown); killErr != nil {
+			telemetry.ReportCriticalError(cleanupCtx, "error killing migrated sandbox on target node", killErr, telemetry.WithSandboxID(sandboxID))
+		}
+
+		o.settleMigrationAsPaused(cleanupCtx, sbx)
+
+		return fmt.Errorf("error updating migrated sandbox: %w", err)
+	}
+
+	sbx = migrated
+
+	// Overwrites the record of the source node in one write.
+	o.addSandboxToRoutingTable(ctx, sbx)
+
+	// The sandbox runs again, it mustn't look paused at the migration snapshot.
+	o.deleteMigrationSnapshot(context.WithoutCancel(ctx), sandboxID, upsertResult.BuildID)
+
+	logger.L().Info(ctx, "Migrated sandbox",
+		logger.WithSandboxID(sbx.SandboxID),
+		zap.String("source_node_id", sourceNode.ID),
+		zap.String("target_node_id", placed.Node.ID),
+		zap.String("build_id", upsertResult.BuildID.String()),
+	)
+
+	telemetry.ReportEvent(ctx, "Migrated sandbox")
+
+	return nil
+}
+
+// MigrateNodeSandboxes migrates all sandboxes running on the node, used when the node is drained.
+// Sandboxes that fail to migrate keep running on the node, or end up paused if they were already stopped.
+func (o *Orchestrator) MigrateNodeSandboxes(ctx context.Context, node *nodemanager.Node) {
+	ctx, span := tracer.Start(ctx, "migrate-node-sandboxes")
+	defer span.End()
+
+	sandboxes, err := node.GetSandboxes(ctx)
+	if err != nil {
+		logger.L().Error(ctx, "Error listing sandboxes to migrate", zap.Error(err), logger.WithNodeID(node.ID))
+
+		return
+	}
+
+	logger.L().Info(ctx, "Migrating sandboxes from node", logger.WithNodeID(node.ID), zap.Int("sandbox_count", len(sandboxes)))
+
+	var wg errgroup.Group
+	wg.SetLimit(maxConcurrentNodeMigrations)
+
+	for _, sbx := range sandboxes {
+		wg.Go(func() error {
+			err := o.MigrateSandbox(ctx, sbx.TeamID, sbx.SandboxID)
+			if err != nil {
+				logger.L().Error(ctx, "Error migrating sandbox", zap.Error(err), logger.WithSandboxID(sbx.SandboxID), logger.WithNodeID(node.ID))
+			}
+
+			return nil
+		})
+	}
+
+	_ = wg.Wait()
+}
+
+// settleMigrationAsPaused cleans up the API-side state of a sandbox that was
+// stopped on the source node but couldn't be resumed on another one.
+func (o *Orchestrator) settleMigrationAsPaused(ctx context.Context, sbx sandbox.Sandbox) {
+	err := o.routingCatalog.DeleteSandbox(ctx, sbx.SandboxID, sbx.ExecutionID)
+	if err != nil {
+		logger.L().Error(ctx, "error removing routing record from catalog", zap.Error(err), logger.WithSandboxID(sbx.SandboxID))
+	}
+
+	o.sandboxStore.Remove(ctx, sbx.TeamID, sbx.SandboxID)
+	go o.analyticsRemove(ctx, sbx, sandbox.StateActionPause)
+}
+
+// deleteMigrationSnapshot removes the build the migrated sandbox was resumed
+// from, and the snapshot if the sandbox wasn't paused before. The build's files
+// stay in the storage, the sandbox reads its memory and rootfs from them.
+func (o *Orchestrator) deleteMigrationSnapshot(ctx context.Context, sandboxID string, buildID uuid.UUID) {
+	defer o.snapshotCache.Invalidate(ctx, sandboxID)
+
+	client, tx, err := o.sqlcDB.WithTx(ctx)
+	if err != nil {
+		telemetry.ReportError(ctx, "error deleting migration snapshot", err, telemetry.WithSandboxID(sandboxID))
+
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := client.DeleteSnapshotBuild(ctx, buildID); err != nil {
+		telemetry.ReportError(ctx, "error deleting migration snapshot build", err, telemetry.WithSandboxID(sandboxID))
+
+		return
+	}
+
+	if err := client.DeleteEmptySnapshot(ctx, sandboxID); err != nil {
+		telemetry.ReportError(ctx, "error deleting migration snapshot", err, telemetry.WithSandboxID(sandboxID))
+
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		telemetry.ReportError(ctx, "error deleting migration snapshot", err, telemetry.WithSandboxID(sandboxID))
+	}
+}
+
+// buildMigrationCreateRequest resumes the migrated sandbox from the snapshot
+// with the live config returned by the source node.
+func buildMigrationCreateRequest(sbx sandbox.Sandbox, config *orchestrator.SandboxConfig, templateID string, buildID uuid.UUID) *orchestrator.SandboxCreateRequest {
+	config.TemplateId = templateID
+	config.BuildId = buildID.String()
+	config.Snapshot = true
+
+	return &orchestrator.SandboxCreateRequest{
+		Sandbox:   config,
+		StartTime: timestamppb.New(sbx.StartTime),
+		EndTime:   timestamppb.New(sbx.EndTime),
+		Migration: true,
+	}
+}
+
+// migrationNodeLabels requires the labels of the source node, so the sandbox
+// lands on a node of the same kind.
+func (o *Orchestrator) migrationNodeLabels(ctx context.Context, sbx sandbox.Sandbox, sourceNode *nodemanager.Node) ([]string, bool) {
+	labelFilteringEnabled := o.featureFlagsClient.BoolFlag(ctx, featureflags.SandboxLabelBasedSchedulingFlag, featureflags.TeamContext(sbx.TeamID.String()), featureflags.SandboxContext(sbx.SandboxID))
+	if !labelFilteringEnabled {
+		return nil, false
+	}
+
+	return slices.Sorted(maps.Keys(sourceNode.Labels())), true
+}
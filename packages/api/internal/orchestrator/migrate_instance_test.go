@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestBuildMigrationCreateRequest(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Now().Add(-time.Hour).UTC()
+	endTime := startTime.Add(2 * time.Hour)
+	buildID := uuid.New()
+
+	sbx := sandbox.Sandbox{SandboxID: "sbx", StartTime: startTime, EndTime: endTime}
+	config := &orchestrator.SandboxConfig{
+		SandboxId:   "sbx",
+		ExecutionId: "exec",
+		TemplateId:  "base",
+		BuildId:     uuid.NewString(),
+		EnvVars:     map[string]string{"A": "1"},
+	}
+
+	req := buildMigrationCreateRequest(sbx, config, "snapshot-template", buildID)
+
+	require.NotNil(t, req.GetSandbox())
+	assert.True(t, req.GetMigration())
+	assert.True(t, req.GetSandbox().GetSnapshot())
+	assert.Equal(t, "snapshot-template", req.GetSandbox().GetTemplateId())
+	assert.Equal(t, buildID.String(), req.GetSandbox().GetBuildId())
+	// The sandbox keeps its execution and the live config from the source node.
+	assert.Equal(t, "exec", req.GetSandbox().GetExecutionId())
+	assert.Equal(t, map[string]string{"A": "1"}, req.GetSandbox().GetEnvVars())
+	assert.True(t, startTime.Equal(req.GetStartTime().AsTime()))
+	assert.True(t, endTime.Equal(req.GetEndTime().AsTime()))
+}
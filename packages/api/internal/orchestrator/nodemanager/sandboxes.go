@@ -84,6 +84,14 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]sandbox.Sandbox, error) {
 									Headers: t.GetHeaders(),
 								}
 							}
+							switch r.GetAction() {
+							case orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW:
+								dbRule.Action = types.SandboxNetworkRuleActionAllow
+							case orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_DENY:
+								dbRule.Action = types.SandboxNetworkRuleActionDeny
+							}
+							dbRule.Methods = r.GetMethods()
+							dbRule.Path = r.GetPath()
 							ruleList = append(ruleList, dbRule)
 						}
 						dbRules[domain] = ruleList
@@ -108,6 +116,25 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]sandbox.Sandbox, error) {
 			}
 		}
 
+		var bandwidthLimits *types.SandboxBandwidthLimits
+		if limits := config.GetBandwidthLimits(); limits != nil {
+			bandwidthLimits = &types.SandboxBandwidthLimits{
+				NetworkMbps:     int32(limits.GetNetworkMbps()),
+				DiskMBPerSecond: int32(limits.GetDiskMbPerSecond()),
+				DiskIops:        int32(limits.GetDiskIops()),
+			}
+		}
+
+		var controlPolicy *types.SandboxControlPolicy
+		if policy := config.GetControlPolicy(); policy != nil {
+			controlPolicy = &types.SandboxControlPolicy{
+				ExtendTimeout:       policy.GetExtendTimeout(),
+				Pause:               policy.GetPause(),
+				SpawnSandboxes:      policy.GetSpawnSandboxes(),
+				MaxSpawnedSandboxes: policy.GetMaxSpawnedSandboxes(),
+			}
+		}
+
 		sandboxesInfo = append(
 			sandboxesInfo,
 			sandbox.NewSandbox(
@@ -133,6 +160,10 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]sandbox.Sandbox, error) {
 				config.GetAutoPause(),
 				config.GetAutoPauseFilesystemOnly(),
 				autoResume,
+				config.GetLogProcessOutput(),
+				nil, // envVarsUpdate, the node doesn't know which values are secret
+				bandwidthLimits,
+				controlPolicy,
 				config.EnvdAccessToken,     //nolint:protogetter // we need the nil check too
 				config.AllowInternetAccess, //nolint:protogetter // we need the nil check too
 				config.GetBaseTemplateId(),
@@ -160,6 +160,11 @@ func buildUpsertSnapshotParams(sbx sandbox.Sandbox, node *nodemanager.Node, file
 			VolumeMounts:            sbx.VolumeMounts,
 			FilesystemOnly:          filesystemOnly,
 			AutoPauseFilesystemOnly: sbx.AutoPauseFilesystemOnly,
+			LogProcessOutput:        sbx.LogProcessOutput,
+			EnvVarsUpdate:           sbx.EnvVarsUpdate,
+			BandwidthLimits:         sbx.BandwidthLimits,
+			ControlPolicy:           sbx.ControlPolicy,
+			SpawnedSandboxes:        sbx.SpawnedSandboxes,
 		},
 		OriginNodeID: node.ID,
 		Status:       types.BuildStatusSnapshotting,
@@ -0,0 +1,93 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+var (
+	errSpawnNotAllowed      = errors.New("sandbox is not allowed to spawn sandboxes")
+	errSpawnLimitReached    = errors.New("sandbox has reached the spawned sandboxes limit")
+	errSpawnCounterMismatch = errors.New("spawned sandboxes counter is already zero")
+)
+
+// ReserveSpawnedSandbox counts a sandbox spawned by the sandbox against its control policy.
+// The reservation must be released with ReleaseSpawnedSandbox if the spawned sandbox isn't created.
+func (o *Orchestrator) ReserveSpawnedSandbox(ctx context.Context, teamID uuid.UUID, sandboxID string) *api.APIError {
+	var limit int32
+
+	_, err := o.sandboxStore.Update(ctx, teamID, sandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		policy := sbx.ControlPolicy
+		if policy == nil || !policy.SpawnSandboxes {
+			return sbx, errSpawnNotAllowed
+		}
+
+		limit = policy.MaxSpawnedSandboxes
+		if limit > 0 && sbx.SpawnedSandboxes >= limit {
+			return sbx, errSpawnLimitReached
+		}
+
+		sbx.SpawnedSandboxes++
+
+		return sbx, nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sandbox.ErrNotFound):
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sandboxID), Err: err}
+		case errors.Is(err, errSpawnNotAllowed):
+			return &api.APIError{Code: http.StatusForbidden, ClientMsg: "The sandbox is not allowed to create sandboxes", Err: err}
+		case errors.Is(err, errSpawnLimitReached):
+			return &api.APIError{
+				Code:      http.StatusForbidden,
+				ClientMsg: fmt.Sprintf("The sandbox has already created the maximum number of sandboxes (%d)", limit),
+				Err:       err,
+			}
+		default:
+			return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error creating sandbox", Err: err}
+		}
+	}
+
+	return nil
+}
+
+// ReleaseSpawnedSandbox returns a reservation made by ReserveSpawnedSandbox.
+func (o *Orchestrator) ReleaseSpawnedSandbox(ctx context.Context, teamID uuid.UUID, sandboxID string) {
+	_, err := o.sandboxStore.Update(ctx, teamID, sandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		if sbx.SpawnedSandboxes <= 0 {
+			return sbx, errSpawnCounterMismatch
+		}
+
+		sbx.SpawnedSandboxes--
+
+		return sbx, nil
+	})
+	if err != nil && !errors.Is(err, sandbox.ErrNotFound) {
+		logger.L().Warn(ctx, "Failed to release spawned sandbox", zap.Error(err), logger.WithSandboxID(sandboxID))
+	}
+}
+
+func controlPolicyToProto(policy *types.SandboxControlPolicy) *orchestratorgrpc.SandboxControlPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &orchestratorgrpc.SandboxControlPolicy{
+		ExtendTimeout:       policy.ExtendTimeout,
+		Pause:               policy.Pause,
+		SpawnSandboxes:      policy.SpawnSandboxes,
+		MaxSpawnedSandboxes: policy.MaxSpawnedSandboxes,
+	}
+}
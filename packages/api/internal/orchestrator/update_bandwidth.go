@@ -0,0 +1,116 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// UpdateSandboxBandwidthLimits replaces the bandwidth limits of a running sandbox, nil removes them.
+func (o *Orchestrator) UpdateSandboxBandwidthLimits(
+	ctx context.Context,
+	teamID uuid.UUID,
+	sandboxID string,
+	limits *types.SandboxBandwidthLimits,
+) *api.APIError {
+	updateFunc := func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		if sbx.State != sandbox.StateRunning {
+			return sbx, &sandbox.NotRunningError{SandboxID: sandboxID, State: sbx.State}
+		}
+
+		sbx.BandwidthLimits = limits
+
+		return sbx, nil
+	}
+
+	var sbxNotRunningErr *sandbox.NotRunningError
+
+	sbx, err := o.sandboxStore.Update(ctx, teamID, sandboxID, updateFunc)
+	if err != nil {
+		switch {
+		case errors.As(err, &sbxNotRunningErr):
+			return &api.APIError{Code: http.StatusConflict, ClientMsg: utils.SandboxChangingStateMsg(sandboxID, sbxNotRunningErr.State), Err: err}
+		case errors.Is(err, sandbox.ErrNotFound):
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sandboxID), Err: err}
+		default:
+			return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error updating sandbox bandwidth limits", Err: err}
+		}
+	}
+
+	return o.updateSandboxBandwidthLimitsOnNode(ctx, sbx)
+}
+
+func (o *Orchestrator) updateSandboxBandwidthLimitsOnNode(ctx context.Context, sbx sandbox.Sandbox) *api.APIError {
+	ctx, span := tracer.Start(ctx, "update-sandbox-bandwidth-limits-on-node",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer span.End()
+
+	node := o.getOrConnectNode(ctx, sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Node hosting sandbox '%s' not found", sbx.SandboxID),
+			Err:       fmt.Errorf("node '%s' not found for cluster '%s'", sbx.NodeID, sbx.ClusterID),
+		}
+	}
+
+	// An empty message resets the limits on the node, unlike a missing one.
+	limits := bandwidthLimitsToProto(sbx.BandwidthLimits)
+	if limits == nil {
+		limits = &orchestratorgrpc.SandboxBandwidthLimits{}
+	}
+
+	client, ctx := node.GetClient(ctx)
+	_, err := client.Sandbox.Update(ctx, &orchestratorgrpc.SandboxUpdateRequest{
+		SandboxId:       sbx.SandboxID,
+		BandwidthLimits: limits,
+	})
+	if err != nil {
+		grpcErr, ok := status.FromError(err)
+		if ok && grpcErr.Code() == codes.NotFound {
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sbx.SandboxID), Err: err}
+		}
+
+		err = utils.UnwrapGRPCError(err)
+		telemetry.ReportCriticalError(ctx, "failed to update sandbox bandwidth limits on node", err)
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error applying bandwidth limits to sandbox",
+			Err:       fmt.Errorf("failed to update sandbox bandwidth limits on node: %w", err),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Updated sandbox bandwidth limits on node")
+
+	return nil
+}
+
+func bandwidthLimitsToProto(limits *types.SandboxBandwidthLimits) *orchestratorgrpc.SandboxBandwidthLimits {
+	if limits == nil {
+		return nil
+	}
+
+	return &orchestratorgrpc.SandboxBandwidthLimits{
+		NetworkMbps:     uint32(max(limits.NetworkMbps, 0)),
+		DiskMbPerSecond: uint32(max(limits.DiskMBPerSecond, 0)),
+		DiskIops:        uint32(max(limits.DiskIops, 0)),
+	}
+}
@@ -0,0 +1,160 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/secrets"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// UpdateSandboxEnvVars sets and unsets the default env vars of a running sandbox.
+// The keys in secretKeys must be a subset of the keys in envVars. The sandbox is
+// updated first, the store only records the update once it's applied, with the
+// secret values sealed.
+func (o *Orchestrator) UpdateSandboxEnvVars(
+	ctx context.Context,
+	teamID uuid.UUID,
+	sandboxID string,
+	envVars map[string]string,
+	secretKeys []string,
+	unset []string,
+) *api.APIError {
+	// Sealing comes first, an update that can't be recorded isn't applied.
+	sealed := make(map[string][]byte, len(secretKeys))
+	for _, key := range secretKeys {
+		value, err := o.secrets.SealEnvVar(teamID, key, envVars[key])
+		if errors.Is(err, secrets.ErrNotConfigured) {
+			return &api.APIError{Code: http.StatusBadRequest, ClientMsg: "Secret env vars are not available, the secrets encryption key is not configured", Err: err}
+		}
+
+		if err != nil {
+			return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error updating sandbox env vars", Err: err}
+		}
+
+		sealed[key] = value
+	}
+
+	sbx, err := o.sandboxStore.Get(ctx, teamID, sandboxID)
+	if err != nil {
+		if errors.Is(err, sandbox.ErrNotFound) {
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sandboxID), Err: err}
+		}
+
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error updating sandbox env vars", Err: err}
+	}
+
+	if sbx.State != sandbox.StateRunning {
+		return &api.APIError{Code: http.StatusConflict, ClientMsg: utils.SandboxChangingStateMsg(sandboxID, sbx.State), Err: &sandbox.NotRunningError{SandboxID: sandboxID, State: sbx.State}}
+	}
+
+	if apiErr := o.updateSandboxEnvVarsOnNode(ctx, sbx, envVars, unset); apiErr != nil {
+		return apiErr
+	}
+
+	_, err = o.sandboxStore.Update(ctx, teamID, sandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		sbx.EnvVarsUpdate = mergeEnvVarsUpdate(sbx.EnvVarsUpdate, envVars, sealed, unset)
+
+		return sbx, nil
+	})
+	if err != nil {
+		// The sandbox has the env vars, only a filesystem-only snapshot of it misses them.
+		telemetry.ReportCriticalError(ctx, "failed to record sandbox env vars update", err, telemetry.WithSandboxID(sandboxID))
+
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error storing sandbox env vars", Err: err}
+	}
+
+	return nil
+}
+
+// mergeEnvVarsUpdate applies the update on a copy of the current one. A key set
+// without being marked as secret stops being a secret.
+func mergeEnvVarsUpdate(current *types.SandboxEnvVarsUpdate, envVars map[string]string, sealed map[string][]byte, unset []string) *types.SandboxEnvVarsUpdate {
+	merged := &types.SandboxEnvVarsUpdate{}
+	if current != nil {
+		merged.Set = maps.Clone(current.Set)
+		merged.SealedSecrets = maps.Clone(current.SealedSecrets)
+	}
+
+	for _, key := range unset {
+		delete(merged.Set, key)
+		delete(merged.SealedSecrets, key)
+	}
+
+	for key, value := range envVars {
+		if sealedValue, ok := sealed[key]; ok {
+			delete(merged.Set, key)
+			if merged.SealedSecrets == nil {
+				merged.SealedSecrets = make(map[string][]byte)
+			}
+			merged.SealedSecrets[key] = sealedValue
+
+			continue
+		}
+
+		delete(merged.SealedSecrets, key)
+		if merged.Set == nil {
+			merged.Set = make(map[string]string)
+		}
+		merged.Set[key] = value
+	}
+
+	return merged
+}
+
+func (o *Orchestrator) updateSandboxEnvVarsOnNode(ctx context.Context, sbx sandbox.Sandbox, envVars map[string]string, unset []string) *api.APIError {
+	ctx, span := tracer.Start(ctx, "update-sandbox-env-vars-on-node",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer span.End()
+
+	node := o.getOrConnectNode(ctx, sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Node hosting sandbox '%s' not found", sbx.SandboxID),
+			Err:       fmt.Errorf("node '%s' not found for cluster '%s'", sbx.NodeID, sbx.ClusterID),
+		}
+	}
+
+	client, ctx := node.GetClient(ctx)
+	_, err := client.Sandbox.Update(ctx, &orchestratorgrpc.SandboxUpdateRequest{
+		SandboxId: sbx.SandboxID,
+		EnvVars:   &orchestratorgrpc.SandboxEnvVarsUpdate{Set: envVars, Unset: unset},
+	})
+	if err != nil {
+		grpcErr, ok := status.FromError(err)
+		if ok && grpcErr.Code() == codes.NotFound {
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sbx.SandboxID), Err: err}
+		}
+
+		err = utils.UnwrapGRPCError(err)
+		telemetry.ReportCriticalError(ctx, "failed to update sandbox env vars on node", err)
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error applying env vars to sandbox",
+			Err:       fmt.Errorf("failed to update sandbox env vars on node: %w", err),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Updated sandbox env vars on node")
+
+	return nil
+}
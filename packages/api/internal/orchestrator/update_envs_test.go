@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/db/pkg/types"
+)
+
+func TestMergeEnvVarsUpdate(t *testing.T) {
+	t.Parallel()
+
+	current := &types.SandboxEnvVarsUpdate{
+		Set:           map[string]string{"A": "1", "KEY": "old"},
+		SealedSecrets: map[string][]byte{"TOKEN": []byte("sealed-token"), "OTHER": []byte("sealed-other")},
+	}
+
+	merged := mergeEnvVarsUpdate(current,
+		map[string]string{"KEY": "new", "PASSWORD": "hunter2", "OTHER": "plain", "B": "2"},
+		map[string][]byte{"PASSWORD": []byte("sealed-password")},
+		[]string{"TOKEN", "MISSING"},
+	)
+
+	// OTHER was set without being marked as secret and TOKEN was unset.
+	assert.Equal(t, map[string]string{"A": "1", "B": "2", "KEY": "new", "OTHER": "plain"}, merged.Set)
+	assert.Equal(t, map[string][]byte{"PASSWORD": []byte("sealed-password")}, merged.SealedSecrets)
+
+	// The current update is left untouched.
+	assert.Equal(t, map[string]string{"A": "1", "KEY": "old"}, current.Set)
+	assert.Len(t, current.SealedSecrets, 2)
+
+	merged = mergeEnvVarsUpdate(nil, map[string]string{"A": "1"}, nil, nil)
+	assert.Equal(t, map[string]string{"A": "1"}, merged.Set)
+	assert.Empty(t, merged.SealedSecrets)
+}
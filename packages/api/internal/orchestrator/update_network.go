@@ -45,6 +45,9 @@ func (o *Orchestrator) UpdateSandboxNetworkConfig(
 	network := &types.SandboxNetworkConfig{Egress: egressConfig}
 	orchNetwork := buildNetworkConfig(network, allowInternetAccess, nil)
 	egress := orchNetwork.GetEgress()
+	if apiErr := o.resolveEgressSecrets(ctx, teamID, egress, false); apiErr != nil {
+		return apiErr
+	}
 
 	updateFunc := func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
 		if sbx.State != sandbox.StateRunning {
@@ -0,0 +1,166 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fcversion"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+var errMemoryResizeUnsupported = errors.New("memory resize is not supported by the sandbox firecracker version")
+
+// UpdateSandboxMemory grows or shrinks the memory of a running sandbox to ramMB.
+func (o *Orchestrator) UpdateSandboxMemory(ctx context.Context, teamID uuid.UUID, sandboxID string, ramMB int64) *api.APIError {
+	var oldRamMB int64
+
+	updateFunc := func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		if sbx.State != sandbox.StateRunning {
+			return sbx, &sandbox.NotRunningError{SandboxID: sandboxID, State: sbx.State}
+		}
+
+		fcInfo, err := fcversion.New(sbx.FirecrackerVersion)
+		if err != nil || !fcInfo.HasMemoryHotplug() {
+			return sbx, errMemoryResizeUnsupported
+		}
+
+		oldRamMB = sbx.RamMB
+		sbx.RamMB = ramMB
+
+		return sbx, nil
+	}
+
+	var sbxNotRunningErr *sandbox.NotRunningError
+
+	sbx, err := o.sandboxStore.Update(ctx, teamID, sandboxID, updateFunc)
+	if err != nil {
+		switch {
+		case errors.As(err, &sbxNotRunningErr):
+			return &api.APIError{Code: http.StatusConflict, ClientMsg: utils.SandboxChangingStateMsg(sandboxID, sbxNotRunningErr.State), Err: err}
+		case errors.Is(err, sandbox.ErrNotFound):
+			return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sandboxID), Err: err}
+		case errors.Is(err, errMemoryResizeUnsupported):
+			return &api.APIError{Code: http.StatusBadRequest, ClientMsg: "The sandbox doesn't support resizing its memory, rebuild the template to enable it", Err: err}
+		default:
+			return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error resizing sandbox", Err: err}
+		}
+	}
+
+	if apiErr := o.updateSandboxMemoryOnNode(ctx, sbx, oldRamMB); apiErr != nil {
+		// Keep the stored size in sync with the sandbox, unless it has been resized again since.
+		_, revertErr := o.sandboxStore.Update(ctx, teamID, sandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+			if sbx.RamMB == ramMB {
+				sbx.RamMB = oldRamMB
+			}
+
+			return sbx, nil
+		})
+		if revertErr != nil {
+			logger.L().Warn(ctx, "Failed to revert sandbox memory size", zap.Error(revertErr), logger.WithSandboxID(sandboxID))
+		}
+
+		return apiErr
+	}
+
+	return nil
+}
+
+func (o *Orchestrator) updateSandboxMemoryOnNode(ctx context.Context, sbx sandbox.Sandbox, oldRamMB int64) *api.APIError {
+	ctx, span := tracer.Start(ctx, "update-sandbox-memory-on-node",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+			attribute.Int64("instance.ram_mb", sbx.RamMB),
+		),
+	)
+	defer span.End()
+
+	node := o.getOrConnectNode(ctx, sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Node hosting sandbox '%s' not found", sbx.SandboxID),
+			Err:       fmt.Errorf("node '%s' not found for cluster '%s'", sbx.NodeID, sbx.ClusterID),
+		}
+	}
+
+	if delta := sbx.RamMB - oldRamMB; delta > 0 && !hasFreeMemory(node.Metrics(), delta) {
+		return &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("The node hosting sandbox '%s' doesn't have %d MiB of free memory to grow it", sbx.SandboxID, delta),
+			Err:       fmt.Errorf("node '%s' doesn't have %d MiB of free memory", node.ID, delta),
+		}
+	}
+
+	client, ctx := node.GetClient(ctx)
+	_, err := client.Sandbox.Update(ctx, &orchestratorgrpc.SandboxUpdateRequest{
+		SandboxId: sbx.SandboxID,
+		RamMb:     &sbx.RamMB,
+	})
+	if err != nil {
+		grpcErr, ok := status.FromError(err)
+		if ok {
+			switch grpcErr.Code() {
+			case codes.NotFound:
+				return &api.APIError{Code: http.StatusNotFound, ClientMsg: utils.SandboxNotFoundMsg(sbx.SandboxID), Err: err}
+			case codes.FailedPrecondition:
+				// Templates get the memory hotplug region only when the memory-hotplug-size-mb
+				// feature flag is set, by default sandboxes can't grow past the template memory.
+				return &api.APIError{
+					Code: http.StatusBadRequest,
+					ClientMsg: fmt.Sprintf("The sandbox can't be resized to %d MiB: %s. Growing the memory past the template memory requires "+
+						"a template built with a memory hotplug region, enabled by the memory-hotplug-size-mb feature flag", sbx.RamMB, grpcErr.Message()),
+					Err: err,
+				}
+			}
+		}
+
+		err = utils.UnwrapGRPCError(err)
+		telemetry.ReportCriticalError(ctx, "failed to update sandbox memory on node", err)
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error resizing sandbox",
+			Err:       fmt.Errorf("failed to update sandbox memory on node: %w", err),
+		}
+	}
+
+	// Account the new size until the next node sync reports it.
+	if delta := sbx.RamMB - oldRamMB; delta > 0 {
+		node.OptimisticAdd(ctx, nodemanager.SandboxResources{MiBMemory: delta})
+	} else if delta < 0 {
+		node.OptimisticRemove(ctx, nodemanager.SandboxResources{MiBMemory: -delta})
+	}
+
+	telemetry.ReportEvent(ctx, "Updated sandbox memory on node")
+
+	return nil
+}
+
+// hasFreeMemory reports whether the node has mib of memory not used by the host
+// or its sandboxes. Nodes that haven't reported their memory yet aren't checked.
+func hasFreeMemory(metrics nodemanager.Metrics, mib int64) bool {
+	if metrics.MemoryTotalBytes == 0 {
+		return true
+	}
+
+	if metrics.MemoryUsedBytes >= metrics.MemoryTotalBytes {
+		return false
+	}
+
+	return metrics.MemoryTotalBytes-metrics.MemoryUsedBytes >= uint64(mib)*1024*1024
+}
@@ -0,0 +1,25 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
+)
+
+func TestHasFreeMemory(t *testing.T) {
+	t.Parallel()
+
+	const mib = 1024 * 1024
+
+	metrics := nodemanager.Metrics{MemoryTotalBytes: 4096 * mib, MemoryUsedBytes: 3072 * mib}
+	assert.True(t, hasFreeMemory(metrics, 1024))
+	assert.False(t, hasFreeMemory(metrics, 1025))
+
+	// Used can exceed the total when the reports are out of sync.
+	assert.False(t, hasFreeMemory(nodemanager.Metrics{MemoryTotalBytes: 1024 * mib, MemoryUsedBytes: 2048 * mib}, 1))
+
+	// Nodes without memory metrics aren't checked.
+	assert.True(t, hasFreeMemory(nodemanager.Metrics{}, 1024))
+}
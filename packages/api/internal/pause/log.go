@@ -13,6 +13,8 @@ type Reason string
 const (
 	ReasonRequest Reason = "request"
 	ReasonTimeout Reason = "timeout"
+	// ReasonControl is a pause the sandbox requested on itself.
+	ReasonControl Reason = "control"
 )
 
 type SkipReason string
@@ -58,6 +58,7 @@ var (
 	StateActionPause    = sandboxtypes.StateActionPause
 	StateActionKill     = sandboxtypes.StateActionKill
 	StateActionSnapshot = sandboxtypes.StateActionSnapshot
+	StateActionMigrate  = sandboxtypes.StateActionMigrate
 )
 
 // NewSandbox constructs a Sandbox. Re-exported from sandboxtypes.
@@ -4,11 +4,16 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 )
 
-const sandboxTrafficPrefix = "sandbox-traffic"
+const (
+	sandboxTrafficPrefix = "sandbox-traffic"
+	sandboxControlPrefix = "sandbox-control"
+)
 
 type AccessTokenGenerator struct {
 	hasher *keys.HMACSha256Hashing
@@ -33,3 +38,12 @@ func (g *AccessTokenGenerator) GenerateTrafficAccessToken(id api.SandboxID) (str
 
 	return g.hasher.Hash([]byte(key))
 }
+
+// GenerateControlAccessToken generates the token the orchestrator uses to act on
+// behalf of the sandbox. It's bound to the team and the execution of the sandbox,
+// so it can't be replayed across teams or after the sandbox is killed or paused.
+func (g *AccessTokenGenerator) GenerateControlAccessToken(teamID uuid.UUID, id api.SandboxID, executionID string) (string, error) {
+	key := fmt.Sprintf("%s-%s-%s-%s", sandboxControlPrefix, teamID, id, executionID)
+
+	return g.hasher.Hash([]byte(key))
+}
@@ -33,6 +33,10 @@ func NewSandbox(
 	autoPause bool,
 	autoPauseFilesystemOnly bool,
 	autoResume *types.SandboxAutoResumeConfig,
+	logProcessOutput bool,
+	envVarsUpdate *types.SandboxEnvVarsUpdate,
+	bandwidthLimits *types.SandboxBandwidthLimits,
+	controlPolicy *types.SandboxControlPolicy,
 	envdAccessToken *string,
 	allowInternetAccess *bool,
 	baseTemplateID string,
@@ -69,6 +73,10 @@ func NewSandbox(
 		AutoPause:               autoPause,
 		AutoPauseFilesystemOnly: autoPauseFilesystemOnly,
 		AutoResume:              autoResume,
+		LogProcessOutput:        logProcessOutput,
+		EnvVarsUpdate:           envVarsUpdate,
+		BandwidthLimits:         bandwidthLimits,
+		ControlPolicy:           controlPolicy,
 		State:                   StateRunning,
 		BaseTemplateID:          baseTemplateID,
 		Network:                 network,
@@ -110,6 +118,18 @@ type Sandbox struct {
 	AutoResume              *types.SandboxAutoResumeConfig    `json:"autoResume,omitempty"`
 	Network                 *types.SandboxNetworkConfig       `json:"network"`
 	VolumeMounts            []*types.SandboxVolumeMountConfig `json:"volumeMounts"`
+	// LogProcessOutput is the sandbox-wide default for shipping the process
+	// output to the sandbox logs. Kept so it survives a pause/resume cycle.
+	LogProcessOutput bool `json:"logProcessOutput,omitempty"`
+	// EnvVarsUpdate are the env vars set on the sandbox while it runs, the
+	// create-time env vars are never stored.
+	EnvVarsUpdate *types.SandboxEnvVarsUpdate `json:"envVarsUpdate,omitempty"`
+	// BandwidthLimits are the bandwidth and IOPS limits of the sandbox, nil when unlimited.
+	BandwidthLimits *types.SandboxBandwidthLimits `json:"bandwidthLimits,omitempty"`
+	// ControlPolicy are the actions the sandbox can take on itself, nil when none.
+	// SpawnedSandboxes counts the sandboxes it created through the control API.
+	ControlPolicy    *types.SandboxControlPolicy `json:"controlPolicy,omitempty"`
+	SpawnedSandboxes int32                       `json:"spawnedSandboxes,omitempty"`
 
 	State State `json:"state"`
 }
@@ -44,6 +44,13 @@ var (
 		TargetState: StateSnapshotting,
 		Effect:      TransitionTransient,
 	}
+	// StateActionMigrate moves a running sandbox to another node. The sandbox
+	// is snapshotted for the stop-and-copy, so it's reported as snapshotting.
+	StateActionMigrate = StateAction{
+		Name:        "migrate",
+		TargetState: StateSnapshotting,
+		Effect:      TransitionTransient,
+	}
 )
 
 type KillReason string
@@ -208,6 +208,10 @@ func createTestSandbox() Sandbox {
 		false, // autoPause
 		false, // autoPauseFilesystemOnly
 		nil,   // autoResume
+		false, // logProcessOutput
+		nil,   // envVarsUpdate
+		nil,   // bandwidthLimits
+		nil,   // controlPolicy
 		nil,   // envdAccessToken
 		nil,   // allowInternetAccess
 		"base-template",
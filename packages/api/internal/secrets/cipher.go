@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const keyBytes = 32
+
+// Cipher seals secret values with AES-256-GCM. The team ID and secret name are
+// bound as additional data, so a sealed value can't be moved to another team or name.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a Cipher from a base64-encoded 32-byte key.
+func NewCipher(key string) (*Cipher, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode secrets encryption key: %w", err)
+	}
+
+	if len(raw) != keyBytes {
+		return nil, fmt.Errorf("secrets encryption key must be %d bytes, got %d", keyBytes, len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secrets cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secrets cipher: %w", err)
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal encrypts the value, prepending the random nonce to the ciphertext.
+func (c *Cipher) Seal(teamID uuid.UUID, name, value string) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return c.aead.Seal(nonce, nonce, []byte(value), additionalData(teamID, name)), nil
+}
+
+// Open decrypts a value sealed by Seal for the same team and name.
+func (c *Cipher) Open(teamID uuid.UUID, name string, sealed []byte) (string, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("sealed secret is too short")
+	}
+
+	value, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData(teamID, name))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %q: %w", name, err)
+	}
+
+	return string(value), nil
+}
+
+func additionalData(teamID uuid.UUID, name string) []byte {
+	return append(teamID[:], name...)
+}
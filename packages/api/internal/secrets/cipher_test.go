@@ -0,0 +1,115 @@
+package secrets
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) string {
+	t.Helper()
+
+	key := make([]byte, keyBytes)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewCipher(newTestKey(t))
+	require.NoError(t, err)
+
+	teamID := uuid.New()
+	sealed, err := c.Seal(teamID, "OPENAI_KEY", "sk-123")
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "sk-123")
+
+	value, err := c.Open(teamID, "OPENAI_KEY", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-123", value)
+
+	// Sealing twice uses a fresh nonce.
+	sealedAgain, err := c.Seal(teamID, "OPENAI_KEY", "sk-123")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, sealedAgain)
+}
+
+func TestCipher_BoundToTeamAndName(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewCipher(newTestKey(t))
+	require.NoError(t, err)
+
+	teamID := uuid.New()
+	sealed, err := c.Seal(teamID, "OPENAI_KEY", "sk-123")
+	require.NoError(t, err)
+
+	_, err = c.Open(uuid.New(), "OPENAI_KEY", sealed)
+	require.Error(t, err)
+
+	_, err = c.Open(teamID, "OTHER_KEY", sealed)
+	require.Error(t, err)
+
+	_, err = c.Open(teamID, "OPENAI_KEY", sealed[:4])
+	require.Error(t, err)
+}
+
+func TestNewCipher_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCipher("not base64!")
+	require.Error(t, err)
+
+	_, err = NewCipher(base64.StdEncoding.EncodeToString([]byte("too short")))
+	require.ErrorContains(t, err, "must be 32 bytes")
+}
+
+func TestStore_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStore(nil, "")
+	require.NoError(t, err)
+
+	_, err = s.Set(t.Context(), uuid.New(), "OPENAI_KEY", "sk-123", 1)
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	_, err = s.Resolve(t.Context(), uuid.New(), []string{"OPENAI_KEY"})
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	// Nothing to resolve doesn't need the key.
+	values, err := s.Resolve(t.Context(), uuid.New(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestStore_EnvVar(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStore(nil, newTestKey(t))
+	require.NoError(t, err)
+
+	teamID := uuid.New()
+	sealed, err := s.SealEnvVar(teamID, "OPENAI_KEY", "sk-123")
+	require.NoError(t, err)
+
+	value, err := s.OpenEnvVar(teamID, "OPENAI_KEY", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-123", value)
+
+	// Sealed env vars can't be opened as the team secret of the same name.
+	_, err = s.cipher.Open(teamID, "OPENAI_KEY", sealed)
+	require.Error(t, err)
+
+	s, err = NewStore(nil, "")
+	require.NoError(t, err)
+
+	_, err = s.SealEnvVar(teamID, "OPENAI_KEY", "sk-123")
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
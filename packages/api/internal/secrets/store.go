@@ -0,0 +1,165 @@
+// Package secrets stores team secrets encrypted at rest in Postgres and resolves
+// them for the egress proxy, which substitutes {{secret:NAME}} placeholders.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+// ErrNotConfigured is returned when the API runs without a secrets encryption key.
+var ErrNotConfigured = errors.New("team secrets are not configured")
+
+// ErrLimitReached is returned when creating a secret would exceed the team's limit.
+var ErrLimitReached = errors.New("team secrets limit reached")
+
+// MissingSecretsError lists referenced secrets the team doesn't have.
+type MissingSecretsError struct {
+	Names []string
+}
+
+func (e *MissingSecretsError) Error() string {
+	return fmt.Sprintf("secrets not found: %s", strings.Join(e.Names, ", "))
+}
+
+type Store struct {
+	db     *sqlcdb.Client
+	cipher *Cipher
+}
+
+// NewStore creates the team secrets store. An empty key disables secrets,
+// in which case setting and resolving them returns ErrNotConfigured.
+func NewStore(db *sqlcdb.Client, key string) (*Store, error) {
+	s := &Store{db: db}
+	if key == "" {
+		return s, nil
+	}
+
+	c, err := NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	s.cipher = c
+
+	return s, nil
+}
+
+// Set creates the secret or replaces its value. Creating a secret fails with
+// ErrLimitReached once the team has maxSecrets of them.
+func (s *Store) Set(ctx context.Context, teamID uuid.UUID, name, value string, maxSecrets int64) (queries.UpsertTeamSecretRow, error) {
+	if s.cipher == nil {
+		return queries.UpsertTeamSecretRow{}, ErrNotConfigured
+	}
+
+	sealed, err := s.cipher.Seal(teamID, name, value)
+	if err != nil {
+		return queries.UpsertTeamSecretRow{}, err
+	}
+
+	client, tx, err := s.db.WithTx(ctx)
+	if err != nil {
+		return queries.UpsertTeamSecretRow{}, fmt.Errorf("failed to create transaction: %w", err)
+	}
+	defer func(ctx context.Context) {
+		_ = tx.Rollback(ctx)
+	}(context.WithoutCancel(ctx))
+
+	// Lock the team so concurrent requests can't exceed the limit between the count and the insert.
+	if _, err := client.LockTeamSecrets(ctx, teamID); err != nil {
+		return queries.UpsertTeamSecretRow{}, fmt.Errorf("failed to lock team secrets: %w", err)
+	}
+
+	secret, err := client.UpsertTeamSecret(ctx, queries.UpsertTeamSecretParams{
+		TeamID:     teamID,
+		Name:       name,
+		Value:      sealed,
+		MaxSecrets: maxSecrets,
+	})
+	if dberrors.IsNotFoundError(err) {
+		return queries.UpsertTeamSecretRow{}, ErrLimitReached
+	}
+
+	if err != nil {
+		return queries.UpsertTeamSecretRow{}, fmt.Errorf("failed to upsert team secret: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return queries.UpsertTeamSecretRow{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return secret, nil
+}
+
+// Resolve returns the decrypted values of the named secrets. All of them must exist.
+func (s *Store) Resolve(ctx context.Context, teamID uuid.UUID, names []string) (map[string]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	if s.cipher == nil {
+		return nil, ErrNotConfigured
+	}
+
+	rows, err := s.db.GetTeamSecretValues(ctx, queries.GetTeamSecretValuesParams{
+		TeamID: teamID,
+		Names:  names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team secrets: %w", err)
+	}
+
+	values := make(map[string]string, len(rows))
+	for _, row := range rows {
+		value, err := s.cipher.Open(teamID, row.Name, row.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		values[row.Name] = value
+	}
+
+	var missing []string
+	for _, name := range names {
+		if _, ok := values[name]; !ok && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, &MissingSecretsError{Names: missing}
+	}
+
+	return values, nil
+}
+
+// SealEnvVar seals the value of a secret sandbox env var, so it can be kept
+// with the sandbox. The env: prefix can't collide with a secret name.
+func (s *Store) SealEnvVar(teamID uuid.UUID, key, value string) ([]byte, error) {
+	if s.cipher == nil {
+		return nil, ErrNotConfigured
+	}
+
+	return s.cipher.Seal(teamID, envVarSecretName(key), value)
+}
+
+// OpenEnvVar returns the value of a secret sandbox env var sealed by SealEnvVar.
+func (s *Store) OpenEnvVar(teamID uuid.UUID, key string, sealed []byte) (string, error) {
+	if s.cipher == nil {
+		return "", ErrNotConfigured
+	}
+
+	return s.cipher.Open(teamID, envVarSecretName(key), sealed)
+}
+
+func envVarSecretName(key string) string {
+	return "env:" + key
+}
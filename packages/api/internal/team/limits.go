@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/constants"
 	"github.com/e2b-dev/infra/packages/auth/pkg/types"
+	dbtypes "github.com/e2b-dev/infra/packages/db/pkg/types"
 )
 
 func LimitResources(limits *types.TeamLimits, cpuCount, memoryMB *int32) (int64, int64, *api.APIError) {
@@ -79,3 +81,64 @@ func LimitResources(limits *types.TeamLimits, cpuCount, memoryMB *int32) (int64,
 
 	return cpu, ramMB, nil
 }
+
+// LimitBandwidth validates the requested bandwidth limits against the team limits.
+// Limits that aren't requested default to the team limits. A team limit of 0
+// leaves the sandbox at the node default, which the node also caps the requested
+// limits at. nil is returned when the sandbox has no limits of its own.
+func LimitBandwidth(limits *types.TeamLimits, requested *api.SandboxBandwidthLimits) (*dbtypes.SandboxBandwidthLimits, *api.APIError) {
+	if requested == nil {
+		requested = &api.SandboxBandwidthLimits{}
+	}
+
+	networkMbps, apiErr := limitBandwidthValue("Network bandwidth", "Mbps", requested.NetworkMbps, limits.MaxNetworkMbps)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	diskMBPerSecond, apiErr := limitBandwidthValue("Disk throughput", "MB/s", requested.DiskMBPerSecond, limits.MaxDiskMBPerSecond)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	diskIops, apiErr := limitBandwidthValue("Disk IOPS", "", requested.DiskIops, limits.MaxDiskIops)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	if networkMbps == 0 && diskMBPerSecond == 0 && diskIops == 0 {
+		return nil, nil
+	}
+
+	return &dbtypes.SandboxBandwidthLimits{
+		NetworkMbps:     networkMbps,
+		DiskMBPerSecond: diskMBPerSecond,
+		DiskIops:        diskIops,
+	}, nil
+}
+
+func limitBandwidthValue(name, unit string, requested *int32, maxValue int64) (int32, *api.APIError) {
+	if requested == nil {
+		return int32(maxValue), nil
+	}
+
+	if *requested < 1 {
+		return 0, &api.APIError{
+			Err:       fmt.Errorf("%s must be at least 1", name),
+			ClientMsg: fmt.Sprintf("%s must be at least 1", name),
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	if maxValue > 0 && int64(*requested) > maxValue {
+		limit := strings.TrimSpace(fmt.Sprintf("%d %s", maxValue, unit))
+
+		return 0, &api.APIError{
+			Err:       fmt.Errorf("%s exceeds team limits (%s)", strings.ToLower(name), limit),
+			ClientMsg: fmt.Sprintf("%s can't be higher than %s (if you need to increase this limit, please contact support)", name, limit),
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	return *requested, nil
+}
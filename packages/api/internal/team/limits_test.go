@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/constants"
 	"github.com/e2b-dev/infra/packages/auth/pkg/types"
+	dbtypes "github.com/e2b-dev/infra/packages/db/pkg/types"
 )
 
 func TestLimitResources(t *testing.T) {
@@ -132,3 +134,60 @@ func TestLimitResources(t *testing.T) {
 		assert.Equal(t, constants.DefaultTemplateMemory, memResult)
 	})
 }
+
+func TestLimitBandwidth(t *testing.T) {
+	t.Parallel()
+
+	limits := &types.TeamLimits{
+		MaxNetworkMbps: 100,
+		MaxDiskIops:    3000,
+	}
+
+	t.Run("defaults to the team limits", func(t *testing.T) {
+		t.Parallel()
+
+		result, apiErr := LimitBandwidth(limits, nil)
+		require.Nil(t, apiErr)
+		assert.Equal(t, &dbtypes.SandboxBandwidthLimits{NetworkMbps: 100, DiskIops: 3000}, result)
+	})
+
+	t.Run("requested limits within the team limits", func(t *testing.T) {
+		t.Parallel()
+
+		result, apiErr := LimitBandwidth(limits, &api.SandboxBandwidthLimits{
+			NetworkMbps:     new(int32(50)),
+			DiskMBPerSecond: new(int32(500)),
+		})
+		require.Nil(t, apiErr)
+		assert.Equal(t, &dbtypes.SandboxBandwidthLimits{NetworkMbps: 50, DiskMBPerSecond: 500, DiskIops: 3000}, result)
+	})
+
+	t.Run("requested limit above the team limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, apiErr := LimitBandwidth(limits, &api.SandboxBandwidthLimits{DiskIops: new(int32(5000))})
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+	})
+
+	t.Run("requested limit below 1", func(t *testing.T) {
+		t.Parallel()
+
+		_, apiErr := LimitBandwidth(limits, &api.SandboxBandwidthLimits{NetworkMbps: new(int32(0))})
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+	})
+
+	t.Run("team without limits keeps the node defaults", func(t *testing.T) {
+		t.Parallel()
+
+		result, apiErr := LimitBandwidth(&types.TeamLimits{}, nil)
+		require.Nil(t, apiErr)
+		assert.Nil(t, result)
+
+		// The node caps the requested limits at its defaults.
+		result, apiErr = LimitBandwidth(&types.TeamLimits{}, &api.SandboxBandwidthLimits{NetworkMbps: new(int32(10_000))})
+		require.Nil(t, apiErr)
+		assert.Equal(t, &dbtypes.SandboxBandwidthLimits{NetworkMbps: 10_000}, result)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
@@ -52,6 +54,7 @@ func (tm *TemplateManager) CreateTemplate(
 	fromImageRegistry *api.FromImageRegistry,
 	force *bool,
 	steps *[]api.TemplateStep,
+	dockerfile *api.TemplateBuildDockerfile,
 	clusterID uuid.UUID,
 	nodeID string,
 	version string,
@@ -128,7 +131,7 @@ func (tm *TemplateManager) CreateTemplate(
 		FromImageRegistry:  imageRegistry,
 	}
 
-	err = setTemplateSource(ctx, tm, teamID, teamSlug, template, fromImage, fromTemplate)
+	err = setTemplateSource(ctx, tm, teamID, teamSlug, template, fromImage, fromTemplate, dockerfile)
 	if err != nil {
 		// If the error is related to fromTemplate, set the build status to failed with the appropriate message
 		// This is to unify the error handling with fromImage errors
@@ -161,6 +164,25 @@ func (tm *TemplateManager) CreateTemplate(
 		},
 	)
 
+	if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument && dockerfile != nil {
+		// The Dockerfile is translated by the template manager, report the
+		// problems with it to the user the same way as other build errors
+		err = tm.SetStatus(
+			ctx,
+			buildID,
+			types.BuildStatusGroupFailed,
+			&templatemanagergrpc.TemplateBuildStatusReason{
+				Message: st.Message(),
+				Step:    new("dockerfile"),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set build status: %w", err)
+		}
+
+		return nil
+	}
+
 	err = utils.UnwrapGRPCError(err)
 	if err != nil {
 		return fmt.Errorf("failed to create template '%s': %w", templateID, err)
@@ -285,18 +307,34 @@ func convertImageRegistry(registry *api.FromImageRegistry) (*templatemanagergrpc
 	}
 }
 
-// setTemplateSource sets the source (either fromImage or fromTemplate)
-func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, teamSlug string, template *templatemanagergrpc.TemplateConfig, fromImage *string, fromTemplate *string) error {
+// setTemplateSource sets the source (either fromImage, fromTemplate or dockerfile)
+func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, teamSlug string, template *templatemanagergrpc.TemplateConfig, fromImage *string, fromTemplate *string, dockerfile *api.TemplateBuildDockerfile) error {
 	// hasImage can be empty for v1 template builds
 	hasImage := fromImage != nil
 	hasTemplate := fromTemplate != nil && *fromTemplate != ""
+	hasDockerfile := dockerfile != nil
 
 	// Validate input: exactly one source must be provided
 	switch {
+	case hasDockerfile && (hasImage || hasTemplate):
+		return errors.New("cannot specify dockerfile together with fromImage or fromTemplate")
 	case hasImage && hasTemplate:
 		return errors.New("cannot specify both fromImage and fromTemplate")
-	case !hasImage && !hasTemplate:
-		return errors.New("must specify either fromImage or fromTemplate")
+	case !hasImage && !hasTemplate && !hasDockerfile:
+		return errors.New("must specify either fromImage, fromTemplate or dockerfile")
+	case hasDockerfile:
+		var buildArgs map[string]string
+		if dockerfile.BuildArgs != nil {
+			buildArgs = *dockerfile.BuildArgs
+		}
+
+		template.Source = &templatemanagergrpc.TemplateConfig_Dockerfile{
+			Dockerfile: &templatemanagergrpc.DockerfileConfig{
+				Content:   dockerfile.Content,
+				BuildArgs: buildArgs,
+				FilesHash: dockerfile.FilesHash,
+			},
+		}
 	case hasTemplate:
 		identifier, tag, err := id.ParseName(*fromTemplate)
 		if err != nil {
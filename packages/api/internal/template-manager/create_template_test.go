@@ -42,7 +42,7 @@ func TestSetTemplateSource_FromTemplateUsesResolvedBuild(t *testing.T) {
 	fromTemplate := id.WithTag(id.WithNamespace(teamSlug, "base-template"), "v1")
 	template := &templatemanagergrpc.TemplateConfig{}
 
-	err := setTemplateSource(ctx, tm, teamID, teamSlug, template, nil, &fromTemplate)
+	err := setTemplateSource(ctx, tm, teamID, teamSlug, template, nil, &fromTemplate, nil)
 	require.NoError(t, err)
 
 	require.NotNil(t, template.GetFromTemplate())
@@ -129,7 +129,7 @@ func TestSetTemplateSource_FromTemplateMissingBuildReturnsNotFound(t *testing.T)
 			fromTemplate := id.WithTag(id.WithNamespace(ownerTeamSlug, "base-template-missing"), "v2")
 			template := &templatemanagergrpc.TemplateConfig{}
 
-			err := setTemplateSource(ctx, tm, requesterTeamID, requesterTeamSlug, template, nil, &fromTemplate)
+			err := setTemplateSource(ctx, tm, requesterTeamID, requesterTeamSlug, template, nil, &fromTemplate, nil)
 			require.Error(t, err)
 
 			var fromTemplateErr *FromTemplateError
@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/events"
+)
+
+type webhookCounter interface {
+	CountWebhooksByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error)
+}
+
+// SyncDeliveryKey sets or removes the team's delivery key depending on whether the team has any webhooks.
+// Orchestrators only publish events to the stream for teams with the key present.
+//
+// Call it with the transaction that changed the team's webhooks, while it
+// holds the team's webhooks lock, so concurrent creates and deletes update
+// the key in the same order as the webhooks.
+func SyncDeliveryKey(ctx context.Context, redisClient redis.UniversalClient, store webhookCounter, teamID uuid.UUID) error {
+	count, err := store.CountWebhooksByTeamID(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to count team webhooks: %w", err)
+	}
+
+	key := events.DeliveryKey(teamID)
+	if count == 0 {
+		err = redisClient.Del(ctx, key).Err()
+	} else {
+		err = redisClient.Set(ctx, key, 1, 0).Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update delivery key: %w", err)
+	}
+
+	return nil
+}
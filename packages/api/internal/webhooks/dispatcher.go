@@ -0,0 +1,328 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/retry"
+)
+
+const (
+	ConsumerGroup  = "webhooks-dispatcher"
+	DeadLetterList = "sandbox.events.webhooks.dead-letter"
+
+	payloadField = "payload"
+
+	readCount        = 64
+	readBlock        = 5 * time.Second
+	claimInterval    = 30 * time.Second
+	deadLetterMaxLen = 10_000
+	maxConcurrency   = 32
+)
+
+// DeliveryPolicy controls retries of a single event to a single webhook.
+var DeliveryPolicy = retry.Policy{
+	TotalBudget:    5 * time.Minute,
+	AttemptTimeout: 10 * time.Second,
+	InitialBackoff: time.Second,
+	MaxBackoff:     time.Minute,
+	Multiplier:     2,
+}
+
+// claimMinIdle is how long a message stays pending before another consumer takes it over.
+// It has to be longer than the delivery budget so in-flight retries are not duplicated.
+var claimMinIdle = 2 * DeliveryPolicy.TotalBudget
+
+type Store interface {
+	GetEnabledWebhooksByTeamID(ctx context.Context, teamID uuid.UUID) ([]queries.Webhook, error)
+}
+
+// DeadLetter is the entry pushed to the dead-letter list when all delivery attempts fail.
+type DeadLetter struct {
+	WebhookID uuid.UUID           `json:"webhook_id"`
+	TeamID    uuid.UUID           `json:"team_id"`
+	Event     events.SandboxEvent `json:"event"`
+	Error     string              `json:"error"`
+	FailedAt  time.Time           `json:"failed_at"`
+}
+
+// Dispatcher consumes sandbox events from the Redis stream as a member of a consumer group
+// and delivers them to the webhooks registered by the event's team.
+type Dispatcher struct {
+	redisClient redis.UniversalClient
+	store       Store
+	sender      *Sender
+	consumer    string
+
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func NewDispatcher(redisClient redis.UniversalClient, store Store, sender *Sender) *Dispatcher {
+	return &Dispatcher{
+		redisClient: redisClient,
+		store:       store,
+		sender:      sender,
+		consumer:    uuid.NewString(),
+		sem:         make(chan struct{}, maxConcurrency),
+	}
+}
+
+// Start joins the consumer group and reads the stream in the background until Close is called.
+// Messages are acknowledged only after all deliveries have finished, so events of a stopped
+// or crashed instance are picked up by the others.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	err := d.redisClient.XGroupCreateMkStream(ctx, events.SandboxEventsStreamName, ConsumerGroup, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group: %w", err)
+	}
+
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.wg.Go(func() {
+		d.run(ctx)
+	})
+
+	return nil
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	lastClaim := time.Time{}
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) > claimInterval {
+			d.claimStale(ctx)
+			lastClaim = time.Now()
+		}
+
+		streams, err := d.redisClient.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    ConsumerGroup,
+			Consumer: d.consumer,
+			Streams:  []string{events.SandboxEventsStreamName, ">"},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+
+			logger.L().Error(ctx, "failed to read sandbox events stream", zap.Error(err))
+			sleep(ctx, readBlock)
+
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				d.dispatch(ctx, msg)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) claimStale(ctx context.Context) {
+	start := "0-0"
+	for {
+		msgs, next, err := d.redisClient.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   events.SandboxEventsStreamName,
+			Group:    ConsumerGroup,
+			Consumer: d.consumer,
+			MinIdle:  claimMinIdle,
+			Start:    start,
+			Count:    readCount,
+		}).Result()
+		if err != nil {
+			logger.L().Error(ctx, "failed to claim stale sandbox events", zap.Error(err))
+
+			return
+		}
+
+		for _, msg := range msgs {
+			d.dispatch(ctx, msg)
+		}
+
+		if next == "0-0" || len(msgs) == 0 {
+			return
+		}
+
+		start = next
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, msg redis.XMessage) {
+	select {
+	case d.sem <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+
+	d.wg.Go(func() {
+		defer func() { <-d.sem }()
+
+		d.process(ctx, msg)
+	})
+}
+
+func (d *Dispatcher) process(ctx context.Context, msg redis.XMessage) {
+	event, err := decodeEvent(msg)
+	if err != nil {
+		// Malformed messages would be redelivered forever, drop them.
+		logger.L().Error(ctx, "dropping malformed sandbox event", zap.String("message_id", msg.ID), zap.Error(err))
+		d.ack(ctx, msg.ID)
+
+		return
+	}
+
+	webhooks, err := d.store.GetEnabledWebhooksByTeamID(ctx, event.SandboxTeamID)
+	if err != nil {
+		// Leave the message pending, it will be claimed again later.
+		logger.L().Error(ctx, "failed to get webhooks for team", zap.Error(err), logger.WithTeamID(event.SandboxTeamID.String()))
+
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, webhook := range webhooks {
+		if !subscribed(webhook, event.Type) {
+			continue
+		}
+
+		wg.Go(func() {
+			d.deliver(ctx, webhook, event)
+		})
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	d.ack(ctx, msg.ID)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, webhook queries.Webhook, event events.SandboxEvent) {
+	err := retry.Do(
+		ctx,
+		DeliveryPolicy,
+		func(err error) bool {
+			var attemptErr *attemptError
+
+			return errors.As(err, &attemptErr) && attemptErr.result.retryable()
+		},
+		func(ctx context.Context) error {
+			result := d.sender.Send(ctx, webhook, event)
+			if result.Success() {
+				return nil
+			}
+
+			return &attemptError{result: result}
+		},
+		nil,
+	)
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	logger.L().Warn(ctx, "webhook delivery failed",
+		zap.Error(err),
+		logger.WithTeamID(webhook.TeamID.String()),
+		zap.String("webhook_id", webhook.ID.String()),
+		zap.String("event_type", event.Type),
+	)
+
+	data, marshalErr := json.Marshal(DeadLetter{
+		WebhookID: webhook.ID,
+		TeamID:    webhook.TeamID,
+		Event:     event,
+		Error:     err.Error(),
+		FailedAt:  time.Now(),
+	})
+	if marshalErr != nil {
+		logger.L().Error(ctx, "failed to marshal dead letter", zap.Error(marshalErr))
+
+		return
+	}
+
+	_, pipeErr := d.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, DeadLetterList, data)
+		pipe.LTrim(ctx, DeadLetterList, 0, deadLetterMaxLen-1)
+
+		return nil
+	})
+	if pipeErr != nil {
+		logger.L().Error(ctx, "failed to push webhook dead letter", zap.Error(pipeErr))
+	}
+}
+
+func (d *Dispatcher) ack(ctx context.Context, id string) {
+	if err := d.redisClient.XAck(ctx, events.SandboxEventsStreamName, ConsumerGroup, id).Err(); err != nil {
+		logger.L().Error(ctx, "failed to ack sandbox event", zap.String("message_id", id), zap.Error(err))
+	}
+}
+
+// Close stops reading the stream and waits for in-flight deliveries to return.
+// Interrupted deliveries are not acknowledged and will be retried by another consumer.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type attemptError struct {
+	result AttemptResult
+}
+
+func (e *attemptError) Error() string {
+	return e.result.Err.Error()
+}
+
+func (e *attemptError) Unwrap() error {
+	return e.result.Err
+}
+
+func decodeEvent(msg redis.XMessage) (events.SandboxEvent, error) {
+	var event events.SandboxEvent
+
+	raw, ok := msg.Values[payloadField].(string)
+	if !ok {
+		return event, fmt.Errorf("missing %q field", payloadField)
+	}
+
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		return event, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	return event, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,261 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	chwebhooks "github.com/e2b-dev/infra/packages/clickhouse/pkg/webhooks"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sandbox_network "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-network"
+)
+
+const (
+	userAgent = "E2B-Webhooks/1.0"
+
+	// maxRecordedResponseBytes caps how much of the customer's response body is kept in the delivery record.
+	maxRecordedResponseBytes = 4 << 10
+
+	ErrorClassTimeout        = "timeout"
+	ErrorClassConnection     = "connection"
+	ErrorClassBlockedAddress = "blocked_address"
+	ErrorClassHTTPStatus     = "http_status"
+	ErrorClassRequest        = "request"
+
+	dialTimeout = 10 * time.Second
+)
+
+// ErrBlockedAddress is returned when the webhook host resolves to an internal address.
+var ErrBlockedAddress = errors.New("webhook host resolves to a blocked address")
+
+// AttemptResult describes the outcome of a single HTTP request to a webhook endpoint.
+type AttemptResult struct {
+	StatusCode int
+	Duration   time.Duration
+	ErrorClass string
+	Err        error
+}
+
+func (r AttemptResult) Success() bool {
+	return r.Err == nil
+}
+
+// retryable reports whether the receiver might accept the same request later.
+// Client errors other than timeouts and rate limiting are treated as permanent.
+func (r AttemptResult) retryable() bool {
+	if r.ErrorClass != ErrorClassHTTPStatus {
+		return r.ErrorClass != ErrorClassRequest && r.ErrorClass != ErrorClassBlockedAddress
+	}
+
+	return r.StatusCode >= http.StatusInternalServerError ||
+		r.StatusCode == http.StatusRequestTimeout ||
+		r.StatusCode == http.StatusTooManyRequests
+}
+
+// Sender performs signed HTTP requests to webhook endpoints and records every attempt.
+type Sender struct {
+	client     *http.Client
+	deliveries chwebhooks.Delivery
+}
+
+// NewSender creates the sender, a nil client defaults to one that refuses to connect to internal addresses.
+func NewSender(client *http.Client, deliveries chwebhooks.Delivery) *Sender {
+	if client == nil {
+		client = newClient()
+	}
+
+	return &Sender{
+		client:     client,
+		deliveries: deliveries,
+	}
+}
+
+// Send makes a single delivery attempt of the event to the webhook.
+func (s *Sender) Send(ctx context.Context, webhook queries.Webhook, event events.SandboxEvent) AttemptResult {
+	deliveryID := uuid.New()
+	now := time.Now()
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return AttemptResult{ErrorClass: ErrorClassRequest, Err: fmt.Errorf("failed to marshal event: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		result := AttemptResult{ErrorClass: ErrorClassRequest, Err: fmt.Errorf("failed to create request: %w", err)}
+		s.record(ctx, deliveryID, now, webhook, event, body, nil, nil, result)
+
+		return result
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderWebhookID, webhook.ID.String())
+	req.Header.Set(HeaderDeliveryID, deliveryID.String())
+	req.Header.Set(HeaderEventType, event.Type)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.SigningSecret, now, body))
+
+	resp, err := s.client.Do(req)
+	result := AttemptResult{Duration: time.Since(now)}
+	if err != nil {
+		result.ErrorClass = classifyError(err)
+		result.Err = err
+		s.record(ctx, deliveryID, now, webhook, event, body, req.Header, nil, result)
+
+		return result
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxRecordedResponseBytes))
+	result.Duration = time.Since(now)
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		result.ErrorClass = ErrorClassHTTPStatus
+		result.Err = fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+
+	s.record(ctx, deliveryID, now, webhook, event, body, req.Header, &response{header: resp.Header, body: respBody}, result)
+
+	return result
+}
+
+// newClient returns a client that only connects to public addresses of the webhook host.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		// ControlContext is called with the resolved address right before connecting, so hostnames
+		// that resolve (or are rebound) to internal addresses are refused too.
+		ControlContext: func(_ context.Context, _, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("failed to parse resolved address %q: %w", address, err)
+			}
+
+			if sandbox_network.IsIPInDeniedSandboxCIDRs(net.ParseIP(host)) {
+				return ErrBlockedAddress
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would make the connection on our behalf, bypassing the address check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		// Do not follow redirects, the registered URL is the only allowed target.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type response struct {
+	header http.Header
+	body   []byte
+}
+
+func (s *Sender) record(
+	ctx context.Context,
+	deliveryID uuid.UUID,
+	timestamp time.Time,
+	webhook queries.Webhook,
+	event events.SandboxEvent,
+	requestBody []byte,
+	requestHeaders http.Header,
+	resp *response,
+	result AttemptResult,
+) {
+	record := chwebhooks.WebhookDelivery{
+		ID:             deliveryID,
+		Timestamp:      timestamp,
+		TeamID:         webhook.TeamID,
+		WebhookID:      webhook.ID,
+		EventID:        event.ID,
+		SandboxID:      event.SandboxID,
+		EventType:      event.Type,
+		DeliveryStatus: chwebhooks.DeliveryStatusSucceeded,
+		DurationMs:     uint32(result.Duration.Milliseconds()),
+		RequestBody:    string(requestBody),
+		RequestHeaders: encodeHeaders(requestHeaders),
+		RequestURL:     webhook.Url,
+		ErrorClass:     result.ErrorClass,
+	}
+
+	if resp != nil {
+		statusCode := uint16(result.StatusCode)
+		record.ResponseHTTPStatusCode = &statusCode
+		record.ResponseBody = sql.NullString{String: string(resp.body), Valid: true}
+		record.ResponseHeaders = sql.NullString{String: encodeHeaders(resp.header), Valid: true}
+	}
+
+	if result.Err != nil {
+		record.DeliveryStatus = chwebhooks.DeliveryStatusFailed
+		record.ErrorMessage = sql.NullString{String: result.Err.Error(), Valid: true}
+	}
+
+	if err := s.deliveries.Push(record); err != nil {
+		logger.L().Error(ctx, "failed to record webhook delivery", zap.Error(err), logger.WithTeamID(webhook.TeamID.String()))
+	}
+}
+
+func encodeHeaders(header http.Header) string {
+	flat := make(map[string]string, len(header))
+	for key := range header {
+		// The signature is derived from the secret and is of no use in the delivery log.
+		if key == http.CanonicalHeaderKey(HeaderSignature) {
+			continue
+		}
+
+		flat[key] = header.Get(key)
+	}
+
+	data, err := json.Marshal(flat)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(data)
+}
+
+func classifyError(err error) string {
+	if errors.Is(err, ErrBlockedAddress) {
+		return ErrorClassBlockedAddress
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorClassTimeout
+	}
+
+	return ErrorClassConnection
+}
+
+// subscribed reports whether the webhook should receive the event type.
+// A webhook without explicit event types receives all events.
+func subscribed(webhook queries.Webhook, eventType string) bool {
+	return len(webhook.Events) == 0 || slices.Contains(webhook.Events, eventType)
+}
@@ -0,0 +1,159 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chwebhooks "github.com/e2b-dev/infra/packages/clickhouse/pkg/webhooks"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events"
+)
+
+type recordingDelivery struct {
+	mu      sync.Mutex
+	records []chwebhooks.WebhookDelivery
+}
+
+func (r *recordingDelivery) Push(delivery chwebhooks.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = append(r.records, delivery)
+
+	return nil
+}
+
+func (r *recordingDelivery) Close(context.Context) error { return nil }
+
+func TestSignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	secret, err := NewSigningSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	ts := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"type":"sandbox.lifecycle.created"}`)
+	signature := Sign(secret, now, body)
+
+	assert.True(t, Verify(secret, ts, body, signature))
+	assert.False(t, Verify(secret, ts, []byte(`{}`), signature))
+	assert.False(t, Verify("other", ts, body, signature))
+	assert.False(t, Verify(secret, strconv.FormatInt(now.Unix()+1, 10), body, signature))
+	assert.False(t, Verify(secret, ts, body, "sha256=zz"))
+}
+
+func TestSubscribed(t *testing.T) {
+	t.Parallel()
+
+	all := queries.Webhook{}
+	assert.True(t, subscribed(all, events.SandboxKilledEvent))
+
+	paused := queries.Webhook{Events: []string{events.SandboxPausedEvent}}
+	assert.True(t, subscribed(paused, events.SandboxPausedEvent))
+	assert.False(t, subscribed(paused, events.SandboxKilledEvent))
+}
+
+func TestSenderSend(t *testing.T) {
+	t.Parallel()
+
+	secret, err := NewSigningSecret()
+	require.NoError(t, err)
+
+	var gotBody []byte
+	var gotHeader http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	deliveries := &recordingDelivery{}
+	sender := NewSender(server.Client(), deliveries)
+
+	webhook := queries.Webhook{ID: uuid.New(), TeamID: uuid.New(), Url: server.URL, SigningSecret: secret}
+	event := events.SandboxEvent{ID: uuid.New(), Type: events.SandboxCreatedEvent, SandboxID: "sbx", SandboxTeamID: webhook.TeamID}
+
+	result := sender.Send(t.Context(), webhook, event)
+	require.True(t, result.Success())
+	assert.Equal(t, http.StatusNoContent, result.StatusCode)
+
+	assert.Equal(t, webhook.ID.String(), gotHeader.Get(HeaderWebhookID))
+	assert.Equal(t, event.Type, gotHeader.Get(HeaderEventType))
+	assert.True(t, Verify(secret, gotHeader.Get(HeaderTimestamp), gotBody, gotHeader.Get(HeaderSignature)))
+
+	require.Len(t, deliveries.records, 1)
+	record := deliveries.records[0]
+	assert.Equal(t, chwebhooks.DeliveryStatusSucceeded, record.DeliveryStatus)
+	assert.Equal(t, event.ID, record.EventID)
+	assert.NotContains(t, record.RequestHeaders, gotHeader.Get(HeaderSignature))
+	require.NotNil(t, record.ResponseHTTPStatusCode)
+	assert.Equal(t, uint16(http.StatusNoContent), *record.ResponseHTTPStatusCode)
+}
+
+func TestSenderSendRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		status    int
+		retryable bool
+	}{
+		{name: "server error", status: http.StatusBadGateway, retryable: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, retryable: true},
+		{name: "client error", status: http.StatusBadRequest, retryable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(server.Close)
+
+			deliveries := &recordingDelivery{}
+			sender := NewSender(server.Client(), deliveries)
+
+			result := sender.Send(t.Context(), queries.Webhook{ID: uuid.New(), Url: server.URL}, events.SandboxEvent{})
+			require.False(t, result.Success())
+			assert.Equal(t, ErrorClassHTTPStatus, result.ErrorClass)
+			assert.Equal(t, tt.retryable, result.retryable())
+
+			require.Len(t, deliveries.records, 1)
+			assert.Equal(t, chwebhooks.DeliveryStatusFailed, deliveries.records[0].DeliveryStatus)
+		})
+	}
+}
+
+func TestSenderBlocksInternalAddresses(t *testing.T) {
+	t.Parallel()
+
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	deliveries := &recordingDelivery{}
+	sender := NewSender(nil, deliveries)
+
+	result := sender.Send(t.Context(), queries.Webhook{ID: uuid.New(), Url: server.URL}, events.SandboxEvent{})
+	require.False(t, result.Success())
+	assert.Equal(t, ErrorClassBlockedAddress, result.ErrorClass)
+	assert.False(t, result.retryable())
+	assert.False(t, called)
+}
@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderWebhookID  = "E2B-Webhook-Id"
+	HeaderDeliveryID = "E2B-Delivery-Id"
+	HeaderEventType  = "E2B-Event-Type"
+	HeaderTimestamp  = "E2B-Webhook-Timestamp"
+	HeaderSignature  = "E2B-Signature"
+
+	signatureScheme     = "sha256="
+	signingSecretPrefix = "whsec_"
+	signingSecretBytes  = 32
+)
+
+// NewSigningSecret generates a random secret used to sign payloads for a single webhook.
+func NewSigningSecret() (string, error) {
+	b := make([]byte, signingSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate signing secret: %w", err)
+	}
+
+	return signingSecretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign returns the value of the signature header for the given payload.
+// The signed content is "<unix timestamp>.<body>", so receivers can reject replayed requests
+// by checking the timestamp header before verifying the signature.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return signatureScheme + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+// Verify checks the signature header value against the payload in constant time.
+func Verify(secret string, timestamp string, body []byte, signature string) bool {
+	if len(signature) <= len(signatureScheme) || signature[:len(signatureScheme)] != signatureScheme {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature[len(signatureScheme):])
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, mac(secret, timestamp, body))
+}
+
+func mac(secret string, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
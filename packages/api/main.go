@@ -194,6 +194,7 @@ func NewGinServer(ctx context.Context, config cfg.Config, tel *telemetry.Client,
 			auth.NewAuthProviderTeamAuthenticator(apiStore.GetTeamFromAuthProviderToken),
 			auth.NewAdminApiKeyAuthenticator(config.AdminToken),
 			auth.NewAdminTeamAuthenticator(apiStore.GetTeamFromAdminToken),
+			auth.NewSandboxControlAuthenticator(apiStore.GetTeamFromSandboxControlToken),
 		},
 		metricsMiddleware.SetProcessingStartTime,
 	)
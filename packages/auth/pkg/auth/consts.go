@@ -7,6 +7,8 @@ const (
 	HeaderTeamID        = "X-Team-ID"
 	HeaderAdminToken    = "X-Admin-Token"
 
+	HeaderSandboxControlToken = "X-Sandbox-Control-Token"
+
 	// Token prefixes.
 	PrefixAPIKey      = "e2b_"
 	PrefixAccessToken = "sk_e2b_"
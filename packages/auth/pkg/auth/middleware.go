@@ -221,6 +221,19 @@ func newAdminTeamAuthenticator(
 	}
 }
 
+// NewSandboxControlAuthenticator creates an authenticator for the SandboxControlAuth security scheme (X-Sandbox-Control-Token header).
+func NewSandboxControlAuthenticator(validationFunc func(ctx context.Context, ginCtx *gin.Context, token string) (*types.Team, *APIError)) Authenticator {
+	return &commonAuthenticator[*types.Team]{
+		schemeName: "SandboxControlAuth",
+		header: headerKey{
+			name: HeaderSandboxControlToken,
+		},
+		validationFunc: validationFunc,
+		setContextFunc: setTeamInfo,
+		errorMessage:   "Invalid sandbox control token.",
+	}
+}
+
 // CreateAuthenticationFunc creates an OpenAPI authentication function from a list of authenticators.
 func CreateAuthenticationFunc(
 	authenticators []Authenticator,
@@ -10,4 +10,11 @@ type TeamLimits struct {
 	DiskMb   int64
 
 	EventsTTLDays int64
+
+	MaxSandboxCheckpoints int64
+
+	// Bandwidth limits of the sandboxes, 0 leaves them at the node defaults.
+	MaxNetworkMbps     int64
+	MaxDiskMBPerSecond int64
+	MaxDiskIops        int64
 }
@@ -18,13 +18,17 @@ func newTeamLimits(
 	teamLimits *authqueries.TeamLimit,
 ) *TeamLimits {
 	return &TeamLimits{
-		SandboxConcurrency: int64(teamLimits.ConcurrentSandboxes),
-		BuildConcurrency:   int64(teamLimits.ConcurrentTemplateBuilds),
-		MaxLengthHours:     teamLimits.MaxLengthHours,
-		MaxVcpu:            int64(teamLimits.MaxVcpu),
-		MaxRamMb:           int64(teamLimits.MaxRamMb),
-		DiskMb:             int64(teamLimits.DiskMb),
-		EventsTTLDays:      int64(teamLimits.EventsTtlDays),
+		SandboxConcurrency:    int64(teamLimits.ConcurrentSandboxes),
+		BuildConcurrency:      int64(teamLimits.ConcurrentTemplateBuilds),
+		MaxLengthHours:        teamLimits.MaxLengthHours,
+		MaxVcpu:               int64(teamLimits.MaxVcpu),
+		MaxRamMb:              int64(teamLimits.MaxRamMb),
+		DiskMb:                int64(teamLimits.DiskMb),
+		EventsTTLDays:         int64(teamLimits.EventsTtlDays),
+		MaxSandboxCheckpoints: int64(teamLimits.MaxSandboxCheckpoints),
+		MaxNetworkMbps:        teamLimits.MaxNetworkMbps,
+		MaxDiskMBPerSecond:    teamLimits.MaxDiskMbPerSecond,
+		MaxDiskIops:           teamLimits.MaxDiskIops,
 	}
 }
 
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sandbox_egress_logs_local (
+    timestamp DateTime64(9) CODEC (Delta, ZSTD(1)),
+    ingested_at DateTime64(9) DEFAULT now64(9) CODEC (Delta, ZSTD(1)),
+    team_id UUID CODEC (ZSTD(1)),
+    sandbox_id String CODEC (ZSTD(1)),
+    sandbox_execution_id String CODEC (ZSTD(1)),
+    template_id String CODEC (ZSTD(1)),
+    build_id String CODEC (ZSTD(1)),
+    protocol LowCardinality(String) CODEC (ZSTD(1)),
+    hostname String CODEC (ZSTD(1)),
+    method LowCardinality(String) CODEC (ZSTD(1)),
+    destination_ip String CODEC (ZSTD(1)),
+    resolved_ip String CODEC (ZSTD(1)),
+    destination_port UInt16 CODEC (ZSTD(1)),
+    decision LowCardinality(String) CODEC (ZSTD(1)),
+    match_type LowCardinality(String) CODEC (ZSTD(1)),
+    error String CODEC (ZSTD(1)),
+    duration_ms UInt64 CODEC (ZSTD(1)),
+    bytes_sent UInt64 CODEC (ZSTD(1)),
+    bytes_received UInt64 CODEC (ZSTD(1)),
+    INDEX idx_hostname hostname TYPE bloom_filter GRANULARITY 4
+) ENGINE = MergeTree
+    PARTITION BY toDate(ingested_at)
+    ORDER BY (team_id, sandbox_id, timestamp)
+    TTL toDateTime(ingested_at) + INTERVAL 7 DAY
+    SETTINGS ttl_only_drop_parts = 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE sandbox_egress_logs AS sandbox_egress_logs_local
+    ENGINE = Distributed('cluster', currentDatabase(), 'sandbox_egress_logs_local', xxHash64(team_id));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sandbox_egress_logs;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sandbox_egress_logs_local;
+-- +goose StatementEnd
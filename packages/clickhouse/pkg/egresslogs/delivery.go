@@ -0,0 +1,173 @@
+package egresslogs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/batcher"
+	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const InsertSandboxEgressLogQuery = `INSERT INTO sandbox_egress_logs
+(
+    timestamp,
+    team_id,
+    sandbox_id,
+    sandbox_execution_id,
+    template_id,
+    build_id,
+    protocol,
+    hostname,
+    method,
+    destination_ip,
+    resolved_ip,
+    destination_port,
+    decision,
+    match_type,
+    error,
+    duration_ms,
+    bytes_sent,
+    bytes_received
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+type ClickhouseDelivery struct {
+	batcher *batcher.Batcher[SandboxEgressLog]
+	conn    driver.Conn
+}
+
+type GatedClickhouseDelivery struct {
+	*ClickhouseDelivery
+
+	ff *featureflags.Client
+}
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs")
+
+const DefaultBatcherName = "sandbox-egress-logs"
+
+func NewDefaultClickhouseEgressLogsDelivery(
+	ctx context.Context,
+	conn driver.Conn,
+	featureFlags *featureflags.Client,
+	batcherName string,
+) (*ClickhouseDelivery, error) {
+	maxBatchSize := featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherMaxBatchSize)
+	maxDelay := time.Duration(featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherMaxDelay)) * time.Millisecond
+	batcherQueueSize := featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherQueueSize)
+
+	return NewClickhouseEgressLogsDelivery(
+		ctx, conn, batcher.BatcherOptions{
+			Name:         batcherName,
+			MaxBatchSize: maxBatchSize,
+			MaxDelay:     maxDelay,
+			QueueSize:    batcherQueueSize,
+			ErrorHandler: func(err error) {
+				logger.L().Error(ctx, "error batching sandbox egress logs", zap.Error(err))
+			},
+		},
+	)
+}
+
+func NewGatedDelivery(inner *ClickhouseDelivery, featureFlags *featureflags.Client) *GatedClickhouseDelivery {
+	return &GatedClickhouseDelivery{ClickhouseDelivery: inner, ff: featureFlags}
+}
+
+func NewClickhouseEgressLogsDelivery(
+	ctx context.Context,
+	conn driver.Conn,
+	opts batcher.BatcherOptions,
+) (*ClickhouseDelivery, error) {
+	delivery := &ClickhouseDelivery{conn: conn}
+
+	var err error
+	delivery.batcher, err = batcher.NewBatcher(delivery.batchInserter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batcher: %w", err)
+	}
+
+	if err = delivery.batcher.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start batcher: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (c *ClickhouseDelivery) Push(log SandboxEgressLog) error {
+	return c.batcher.Push(log)
+}
+
+func (c *GatedClickhouseDelivery) Push(log SandboxEgressLog) error {
+	if c.ff != nil && c.ff.BoolFlag(context.Background(), featureflags.ClickhouseWriteFanoutFlag) {
+		return c.ClickhouseDelivery.Push(log)
+	}
+
+	return nil
+}
+
+// Close drains the batcher. ctx is ignored to avoid leaking the flush goroutine.
+func (c *ClickhouseDelivery) Close(_ context.Context) error {
+	return c.batcher.Stop()
+}
+
+func (c *ClickhouseDelivery) batchInserter(ctx context.Context, logs []SandboxEgressLog) error {
+	attrs := trace.WithAttributes(attribute.Int("batch.size", len(logs)))
+	ctx, span := tracer.Start(ctx, "Flush egress logs batch to Clickhouse", attrs)
+	defer span.End()
+
+	batch, err := c.conn.PrepareBatch(ctx, InsertSandboxEgressLogQuery, driver.WithReleaseConnection())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "prepare batch failed")
+
+		return fmt.Errorf("error preparing batch: %w", err)
+	}
+	defer batch.Close()
+
+	for _, log := range logs {
+		err := batch.Append(
+			log.Timestamp,
+			log.TeamID,
+			log.SandboxID,
+			log.SandboxExecutionID,
+			log.TemplateID,
+			log.BuildID,
+			log.Protocol,
+			log.Hostname,
+			log.Method,
+			log.DestinationIP,
+			log.ResolvedIP,
+			log.DestinationPort,
+			log.Decision,
+			log.MatchType,
+			log.Error,
+			log.DurationMs,
+			log.BytesSent,
+			log.BytesReceived,
+		)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "append failed")
+
+			return fmt.Errorf("error appending %d egress log to batch: %w", len(logs), err)
+		}
+	}
+
+	if err = batch.Send(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "send failed")
+
+		return fmt.Errorf("error sending %d egress logs batch: %w", len(logs), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Package egresslogs ships and reads the per-connection sandbox egress audit
+// log recorded by the orchestrator's TCP firewall into ClickHouse.
+package egresslogs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SandboxEgressLog is a single outbound connection attempt made by a sandbox.
+// Blocked connections carry no upstream details or byte counts.
+type SandboxEgressLog struct {
+	Timestamp          time.Time `ch:"timestamp"`
+	TeamID             uuid.UUID `ch:"team_id"`
+	SandboxID          string    `ch:"sandbox_id"`
+	SandboxExecutionID string    `ch:"sandbox_execution_id"`
+	TemplateID         string    `ch:"template_id"`
+	BuildID            string    `ch:"build_id"`
+
+	Protocol        string `ch:"protocol"`         // "http", "tls" or "other"
+	Hostname        string `ch:"hostname"`         // HTTP Host header or TLS SNI, empty when unknown
+	Method          string `ch:"method"`           // HTTP request method, plain HTTP only
+	DestinationIP   string `ch:"destination_ip"`   // original destination the sandbox connected to
+	ResolvedIP      string `ch:"resolved_ip"`      // upstream IP the proxy actually connected to
+	DestinationPort uint16 `ch:"destination_port"` // original destination port
+	Decision        string `ch:"decision"`         // "allowed" or "blocked"
+	MatchType       string `ch:"match_type"`       // "domain", "cidr", "rule" or "none"
+	Error           string `ch:"error"`            // upstream dial error, if any
+
+	DurationMs    uint64 `ch:"duration_ms"`
+	BytesSent     uint64 `ch:"bytes_sent"`     // sandbox → upstream
+	BytesReceived uint64 `ch:"bytes_received"` // upstream → sandbox
+}
+
+// Delivery is the interface for delivering egress logs to a storage backend.
+type Delivery interface {
+	Push(log SandboxEgressLog) error
+	Close(ctx context.Context) error
+}
+
+// noopDelivery is a Delivery that discards all logs.
+// Used in environments where egress logging is not needed (CLI tools, tests).
+type noopDelivery struct{}
+
+var _ Delivery = (*noopDelivery)(nil)
+
+// NewNoopDelivery returns a Delivery that silently discards all logs.
+func NewNoopDelivery() Delivery {
+	return &noopDelivery{}
+}
+
+func (d *noopDelivery) Push(_ SandboxEgressLog) error { return nil }
+func (d *noopDelivery) Close(_ context.Context) error { return nil }
+
+// multiDelivery fans out to every target, like hoststats' multiDelivery.
+type multiDelivery struct {
+	targets []Delivery
+}
+
+var _ Delivery = (*multiDelivery)(nil)
+
+// NewMultiDelivery returns noop for 0 targets and the target directly for 1,
+// so callers can wrap unconditionally.
+func NewMultiDelivery(targets ...Delivery) Delivery {
+	switch len(targets) {
+	case 0:
+		return NewNoopDelivery()
+	case 1:
+		return targets[0]
+	default:
+		return &multiDelivery{targets: targets}
+	}
+}
+
+func (m *multiDelivery) Push(log SandboxEgressLog) error {
+	var err error
+	for _, t := range m.targets {
+		if e := t.Push(log); e != nil {
+			err = errors.Join(err, e)
+		}
+	}
+
+	return err
+}
+
+func (m *multiDelivery) Close(ctx context.Context) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs error
+	)
+	for _, t := range m.targets {
+		wg.Go(func() {
+			if e := t.Close(ctx); e != nil {
+				mu.Lock()
+				errs = errors.Join(errs, e)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	return errs
+}
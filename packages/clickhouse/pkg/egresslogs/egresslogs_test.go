@@ -0,0 +1,93 @@
+package egresslogs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDelivery struct {
+	pushed   []SandboxEgressLog
+	pushErr  error
+	closed   bool
+	closeErr error
+}
+
+func (f *fakeDelivery) Push(log SandboxEgressLog) error {
+	f.pushed = append(f.pushed, log)
+
+	return f.pushErr
+}
+
+func (f *fakeDelivery) Close(_ context.Context) error {
+	f.closed = true
+
+	return f.closeErr
+}
+
+func TestNewMultiDelivery_Unwraps(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, NewNoopDelivery(), NewMultiDelivery())
+
+	single := &fakeDelivery{}
+	assert.Same(t, single, NewMultiDelivery(single))
+}
+
+func TestMultiDelivery_PushContinuesAfterTargetError(t *testing.T) {
+	t.Parallel()
+
+	bad := &fakeDelivery{pushErr: errors.New("boom")}
+	good := &fakeDelivery{}
+	md := NewMultiDelivery(bad, good)
+
+	log := SandboxEgressLog{SandboxID: "sbx-1", Hostname: "example.com"}
+	err := md.Push(log)
+
+	require.ErrorContains(t, err, "boom")
+	assert.Equal(t, []SandboxEgressLog{log}, good.pushed)
+	assert.Equal(t, []SandboxEgressLog{log}, bad.pushed)
+}
+
+func TestMultiDelivery_CloseClosesAllTargets(t *testing.T) {
+	t.Parallel()
+
+	a := &fakeDelivery{closeErr: errors.New("a failed")}
+	b := &fakeDelivery{}
+	md := NewMultiDelivery(a, b)
+
+	err := md.Close(t.Context())
+
+	require.ErrorContains(t, err, "a failed")
+	assert.True(t, a.closed)
+	assert.True(t, b.closed)
+}
+
+func TestQueryConditions(t *testing.T) {
+	t.Parallel()
+
+	teamID := uuid.New()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	conditions, args := queryConditions(teamID, "sbx-1", start, end, QueryFilter{})
+	assert.Len(t, conditions, 4)
+	assert.Equal(t, []any{
+		clickhouse.Named("team_id", teamID.String()),
+		clickhouse.Named("sandbox_id", "sbx-1"),
+		clickhouse.Named("start", start.UnixNano()),
+		clickhouse.Named("end", end.UnixNano()),
+	}, args)
+
+	conditions, args = queryConditions(teamID, "sbx-1", start, end, QueryFilter{Decision: "blocked", Hostname: "example.com"})
+	assert.Contains(t, conditions, "decision = {decision:String}")
+	assert.Contains(t, conditions, "hostname = {hostname:String}")
+	assert.Contains(t, args, clickhouse.Named("decision", "blocked"))
+	assert.Contains(t, args, clickhouse.Named("hostname", "example.com"))
+}
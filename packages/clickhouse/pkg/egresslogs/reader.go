@@ -0,0 +1,117 @@
+package egresslogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/sandboxlogs"
+)
+
+// Reader queries the ClickHouse sandbox_egress_logs table.
+type Reader struct {
+	conn driver.Conn
+}
+
+// NewReader builds a Reader over an existing ClickHouse driver connection.
+func NewReader(conn driver.Conn) *Reader {
+	return &Reader{conn: conn}
+}
+
+// Close closes the underlying ClickHouse connection.
+func (r *Reader) Close(_ context.Context) error {
+	return r.conn.Close()
+}
+
+const sandboxEgressLogsSelect = `
+SELECT
+    timestamp,
+    team_id,
+    sandbox_id,
+    sandbox_execution_id,
+    template_id,
+    build_id,
+    protocol,
+    hostname,
+    method,
+    destination_ip,
+    resolved_ip,
+    destination_port,
+    decision,
+    match_type,
+    error,
+    duration_ms,
+    bytes_sent,
+    bytes_received
+FROM sandbox_egress_logs
+`
+
+// QueryFilter narrows QuerySandboxEgressLogs results. Empty fields match everything.
+type QueryFilter struct {
+	Decision string
+	Hostname string
+}
+
+// queryConditions renders the WHERE conditions and their named arguments.
+func queryConditions(teamID uuid.UUID, sandboxID string, start, end time.Time, filter QueryFilter) ([]string, []any) {
+	conditions := []string{
+		"team_id = {team_id:String}",
+		"sandbox_id = {sandbox_id:String}",
+		"timestamp >= fromUnixTimestamp64Nano({start:Int64})",
+		"timestamp <= fromUnixTimestamp64Nano({end:Int64})",
+	}
+	args := []any{
+		clickhouse.Named("team_id", teamID.String()),
+		clickhouse.Named("sandbox_id", sandboxID),
+		clickhouse.Named("start", start.UTC().UnixNano()),
+		clickhouse.Named("end", end.UTC().UnixNano()),
+	}
+
+	if filter.Decision != "" {
+		conditions = append(conditions, "decision = {decision:String}")
+		args = append(args, clickhouse.Named("decision", filter.Decision))
+	}
+	if filter.Hostname != "" {
+		conditions = append(conditions, "hostname = {hostname:String}")
+		args = append(args, clickhouse.Named("hostname", filter.Hostname))
+	}
+
+	return conditions, args
+}
+
+func (r *Reader) QuerySandboxEgressLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order sandboxlogs.SortOrder, filter QueryFilter) ([]SandboxEgressLog, error) {
+	conditions, args := queryConditions(teamID, sandboxID, start, end, filter)
+
+	direction := "ASC"
+	if order == sandboxlogs.SortOrderBackward {
+		direction = "DESC"
+	}
+
+	q := sandboxEgressLogsSelect +
+		"WHERE " + strings.Join(conditions, "\n  AND ") + "\n" +
+		"ORDER BY timestamp " + direction + "\n" +
+		fmt.Sprintf("LIMIT %d", limit)
+
+	rows, err := r.conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying sandbox egress logs: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]SandboxEgressLog, 0)
+	for rows.Next() {
+		var l SandboxEgressLog
+		if err := rows.ScanStruct(&l); err != nil {
+			return nil, fmt.Errorf("error scanning sandbox egress log row: %w", err)
+		}
+
+		out = append(out, l)
+	}
+
+	return out, rows.Err()
+}
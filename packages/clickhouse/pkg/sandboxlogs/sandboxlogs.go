@@ -124,7 +124,7 @@ SELECT
 FROM sandbox_logs
 `
 
-func (r *Reader) QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order SortOrder, level *logs.LogLevel, search *string) ([]logs.LogEntry, error) {
+func (r *Reader) QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandboxID string, start, end time.Time, limit int, order SortOrder, level *logs.LogLevel, search *string, processTag *string, stream *string) ([]logs.LogEntry, error) {
 	filters := []string{
 		"team_id = {team_id:String}",
 		"sandbox_id = {sandbox_id:String}",
@@ -147,6 +147,15 @@ func (r *Reader) QuerySandboxLogs(ctx context.Context, teamID uuid.UUID, sandbox
 		filters = append(filters, "position(message, {search:String}) > 0")
 		args = append(args, clickhouse.Named("search", *search))
 	}
+	// The process output is tagged in the fields by envd.
+	if processTag != nil {
+		filters = append(filters, "JSONExtractString(fields, 'process_tag') = {process_tag:String}")
+		args = append(args, clickhouse.Named("process_tag", *processTag))
+	}
+	if stream != nil {
+		filters = append(filters, "JSONExtractString(fields, 'stream') = {stream:String}")
+		args = append(args, clickhouse.Named("stream", *stream))
+	}
 
 	q := sandboxLogsSelect +
 		"WHERE " + strings.Join(filters, "\n  AND ") + "\n" +
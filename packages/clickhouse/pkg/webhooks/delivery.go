@@ -0,0 +1,155 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/batcher"
+	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const InsertWebhookDeliveryQuery = `INSERT INTO webhook_deliveries
+(
+    id,
+    timestamp,
+    team_id,
+    webhook_id,
+    event_id,
+    sandbox_id,
+    event_type,
+    delivery_status,
+    duration_ms,
+    request_body,
+    request_headers,
+    request_url,
+    response_body,
+    response_headers,
+    response_http_status_code,
+    error_class,
+    error_message
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+type ClickhouseDelivery struct {
+	batcher *batcher.Batcher[WebhookDelivery]
+	conn    driver.Conn
+}
+
+var _ Delivery = (*ClickhouseDelivery)(nil)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/clickhouse/pkg/webhooks")
+
+const DefaultBatcherName = "webhook-deliveries"
+
+func NewDefaultClickhouseWebhookDelivery(
+	ctx context.Context,
+	conn driver.Conn,
+	featureFlags *featureflags.Client,
+	batcherName string,
+) (*ClickhouseDelivery, error) {
+	maxBatchSize := featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherMaxBatchSize)
+	maxDelay := time.Duration(featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherMaxDelay)) * time.Millisecond
+	batcherQueueSize := featureFlags.IntFlag(ctx, featureflags.ClickhouseBatcherQueueSize)
+
+	return NewClickhouseWebhookDelivery(
+		ctx, conn, batcher.BatcherOptions{
+			Name:         batcherName,
+			MaxBatchSize: maxBatchSize,
+			MaxDelay:     maxDelay,
+			QueueSize:    batcherQueueSize,
+			ErrorHandler: func(err error) {
+				logger.L().Error(ctx, "error batching webhook deliveries", zap.Error(err))
+			},
+		},
+	)
+}
+
+func NewClickhouseWebhookDelivery(
+	ctx context.Context,
+	conn driver.Conn,
+	opts batcher.BatcherOptions,
+) (*ClickhouseDelivery, error) {
+	delivery := &ClickhouseDelivery{conn: conn}
+
+	var err error
+	delivery.batcher, err = batcher.NewBatcher(delivery.batchInserter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batcher: %w", err)
+	}
+
+	if err = delivery.batcher.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start batcher: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (c *ClickhouseDelivery) Push(delivery WebhookDelivery) error {
+	return c.batcher.Push(delivery)
+}
+
+// Close drains the batcher. ctx is ignored to avoid leaking the flush goroutine.
+func (c *ClickhouseDelivery) Close(_ context.Context) error {
+	return c.batcher.Stop()
+}
+
+func (c *ClickhouseDelivery) batchInserter(ctx context.Context, deliveries []WebhookDelivery) error {
+	attrs := trace.WithAttributes(attribute.Int("batch.size", len(deliveries)))
+	ctx, span := tracer.Start(ctx, "Flush webhook deliveries batch to Clickhouse", attrs)
+	defer span.End()
+
+	batch, err := c.conn.PrepareBatch(ctx, InsertWebhookDeliveryQuery, driver.WithReleaseConnection())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "prepare batch failed")
+
+		return fmt.Errorf("error preparing batch: %w", err)
+	}
+	defer batch.Close()
+
+	for _, d := range deliveries {
+		err := batch.Append(
+			d.ID,
+			d.Timestamp,
+			d.TeamID,
+			d.WebhookID,
+			d.EventID,
+			d.SandboxID,
+			d.EventType,
+			d.DeliveryStatus,
+			d.DurationMs,
+			d.RequestBody,
+			d.RequestHeaders,
+			d.RequestURL,
+			d.ResponseBody,
+			d.ResponseHeaders,
+			d.ResponseHTTPStatusCode,
+			d.ErrorClass,
+			d.ErrorMessage,
+		)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "append failed")
+
+			return fmt.Errorf("error appending %d webhook delivery to batch: %w", len(deliveries), err)
+		}
+	}
+
+	if err = batch.Send(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "send failed")
+
+		return fmt.Errorf("error sending %d webhook deliveries batch: %w", len(deliveries), err)
+	}
+
+	return nil
+}
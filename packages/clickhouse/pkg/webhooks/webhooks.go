@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusFailed    = "failed"
+)
+
+// WebhookDelivery represents a single attempt to deliver a sandbox event
+// to a team webhook endpoint.
+type WebhookDelivery struct {
+	ID        uuid.UUID `ch:"id"`
+	Timestamp time.Time `ch:"timestamp"`
+	TeamID    uuid.UUID `ch:"team_id"`
+	WebhookID uuid.UUID `ch:"webhook_id"`
+	EventID   uuid.UUID `ch:"event_id"`
+	SandboxID string    `ch:"sandbox_id"`
+	EventType string    `ch:"event_type"`
+
+	DeliveryStatus string `ch:"delivery_status"`
+	DurationMs     uint32 `ch:"duration_ms"`
+
+	RequestBody    string `ch:"request_body"`
+	RequestHeaders string `ch:"request_headers"` // JSON-encoded map[string]string
+	RequestURL     string `ch:"request_url"`
+
+	ResponseBody           sql.NullString `ch:"response_body"`
+	ResponseHeaders        sql.NullString `ch:"response_headers"` // JSON-encoded map[string]string
+	ResponseHTTPStatusCode *uint16        `ch:"response_http_status_code"`
+
+	// ErrorClass is a low-cardinality classification of the failure ("timeout", "http_status", ...),
+	// empty for successful deliveries.
+	ErrorClass   string         `ch:"error_class"`
+	ErrorMessage sql.NullString `ch:"error_message"`
+}
+
+// Delivery is the interface for recording webhook deliveries to storage backend.
+type Delivery interface {
+	Push(delivery WebhookDelivery) error
+	Close(ctx context.Context) error
+}
+
+// noopDelivery is a Delivery that discards all records.
+// Used when ClickHouse is not configured.
+type noopDelivery struct{}
+
+var _ Delivery = (*noopDelivery)(nil)
+
+// NewNoopDelivery returns a Delivery that silently discards all records.
+func NewNoopDelivery() Delivery {
+	return &noopDelivery{}
+}
+
+func (d *noopDelivery) Push(_ WebhookDelivery) error  { return nil }
+func (d *noopDelivery) Close(_ context.Context) error { return nil }
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.webhooks (
+    id             UUID                        PRIMARY KEY     DEFAULT gen_random_uuid(),
+    team_id        UUID                        NOT NULL,
+    url            TEXT                        NOT NULL,
+    -- Event types the webhook is subscribed to, an empty list subscribes to all events.
+    events         TEXT[]                      NOT NULL        DEFAULT '{}',
+    signing_secret TEXT                        NOT NULL,
+    enabled        BOOLEAN                     NOT NULL        DEFAULT TRUE,
+    created_at     TIMESTAMP WITH TIME ZONE    NOT NULL        DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_webhooks_teams
+        FOREIGN KEY (team_id)
+        REFERENCES teams(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_team_id_idx ON public.webhooks (team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.webhooks;
+-- +goose StatementEnd
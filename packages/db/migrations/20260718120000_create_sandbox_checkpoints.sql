@@ -0,0 +1,101 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.sandbox_checkpoints (
+    id         UUID                        PRIMARY KEY     DEFAULT gen_random_uuid(),
+    team_id    UUID                        NOT NULL,
+    sandbox_id TEXT                        NOT NULL,
+    -- Snapshot build of the sandbox's snapshot template the checkpoint restores.
+    build_id   UUID                        NOT NULL,
+    name       TEXT,
+    created_at TIMESTAMP WITH TIME ZONE    NOT NULL        DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_sandbox_checkpoints_teams
+        FOREIGN KEY (team_id)
+        REFERENCES teams(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_sandbox_checkpoints_env_builds
+        FOREIGN KEY (build_id)
+        REFERENCES env_builds(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS sandbox_checkpoints_sandbox_id_created_at_idx ON public.sandbox_checkpoints (sandbox_id, created_at DESC);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sandbox_checkpoints_sandbox_id_name_uq ON public.sandbox_checkpoints (sandbox_id, name)
+WHERE name IS NOT NULL;
+
+ALTER TABLE "public"."tiers"
+    ADD COLUMN "max_sandbox_checkpoints" bigint NOT NULL DEFAULT 10,
+    ADD CONSTRAINT "tiers_max_sandbox_checkpoints_check" CHECK (max_sandbox_checkpoints > 0);
+
+ALTER TABLE "public"."addons"
+    ADD COLUMN "extra_max_sandbox_checkpoints" bigint NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE VIEW "team_limits"
+WITH (security_invoker=on) AS
+SELECT
+    t.id,
+    tier.max_length_hours,
+    (tier.concurrent_instances + a.extra_concurrent_sandboxes) as concurrent_sandboxes,
+    (tier.concurrent_template_builds + a.extra_concurrent_template_builds) as concurrent_template_builds,
+    (tier.max_vcpu + a.extra_max_vcpu) as max_vcpu,
+    (tier.max_ram_mb + a.extra_max_ram_mb) as max_ram_mb,
+    (tier.disk_mb + a.extra_disk_mb) as disk_mb,
+    (tier.events_ttl_days + a.extra_events_ttl_days) as events_ttl_days,
+    (tier.max_sandbox_checkpoints + a.extra_max_sandbox_checkpoints) as max_sandbox_checkpoints
+FROM "public".teams t
+JOIN "public"."tiers" tier on t.tier = tier.id
+LEFT JOIN LATERAL (
+    SELECT COALESCE(SUM(extra_concurrent_sandboxes),0)::bigint           as extra_concurrent_sandboxes,
+           COALESCE(SUM(extra_concurrent_template_builds),0)::bigint     as extra_concurrent_template_builds,
+           COALESCE(SUM(extra_max_vcpu),0)::bigint                       as extra_max_vcpu,
+           COALESCE(SUM(extra_max_ram_mb),0)::bigint                     as extra_max_ram_mb,
+           COALESCE(SUM(extra_disk_mb),0)::bigint                        as extra_disk_mb,
+           COALESCE(SUM(extra_events_ttl_days),0)::bigint                as extra_events_ttl_days,
+           COALESCE(SUM(extra_max_sandbox_checkpoints),0)::bigint        as extra_max_sandbox_checkpoints
+    FROM "public"."addons" addon
+    WHERE addon.team_id = t.id
+      AND addon.valid_from <= now()
+      AND (addon.valid_to IS NULL OR addon.valid_to > now())
+    ) a ON true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS "team_limits";
+
+CREATE VIEW "team_limits"
+WITH (security_invoker=on) AS
+SELECT
+    t.id,
+    tier.max_length_hours,
+    (tier.concurrent_instances + a.extra_concurrent_sandboxes) as concurrent_sandboxes,
+    (tier.concurrent_template_builds + a.extra_concurrent_template_builds) as concurrent_template_builds,
+    (tier.max_vcpu + a.extra_max_vcpu) as max_vcpu,
+    (tier.max_ram_mb + a.extra_max_ram_mb) as max_ram_mb,
+    (tier.disk_mb + a.extra_disk_mb) as disk_mb,
+    (tier.events_ttl_days + a.extra_events_ttl_days) as events_ttl_days
+FROM "public".teams t
+JOIN "public"."tiers" tier on t.tier = tier.id
+LEFT JOIN LATERAL (
+    SELECT COALESCE(SUM(extra_concurrent_sandboxes),0)::bigint           as extra_concurrent_sandboxes,
+           COALESCE(SUM(extra_concurrent_template_builds),0)::bigint     as extra_concurrent_template_builds,
+           COALESCE(SUM(extra_max_vcpu),0)::bigint                       as extra_max_vcpu,
+           COALESCE(SUM(extra_max_ram_mb),0)::bigint                     as extra_max_ram_mb,
+           COALESCE(SUM(extra_disk_mb),0)::bigint                        as extra_disk_mb,
+           COALESCE(SUM(extra_events_ttl_days),0)::bigint                as extra_events_ttl_days
+    FROM "public"."addons" addon
+    WHERE addon.team_id = t.id
+      AND addon.valid_from <= now()
+      AND (addon.valid_to IS NULL OR addon.valid_to > now())
+    ) a ON true;
+
+ALTER TABLE "public"."addons" DROP COLUMN IF EXISTS "extra_max_sandbox_checkpoints";
+
+ALTER TABLE "public"."tiers"
+    DROP CONSTRAINT IF EXISTS "tiers_max_sandbox_checkpoints_check",
+    DROP COLUMN IF EXISTS "max_sandbox_checkpoints";
+
+DROP TABLE IF EXISTS public.sandbox_checkpoints;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.team_secrets (
+    id         UUID                        PRIMARY KEY     DEFAULT gen_random_uuid(),
+    team_id    UUID                        NOT NULL,
+    name       TEXT                        NOT NULL,
+    -- AES-GCM sealed value, the nonce is prepended to the ciphertext.
+    value      BYTEA                       NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE    NOT NULL        DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE    NOT NULL        DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_team_secrets_teams
+        FOREIGN KEY (team_id)
+        REFERENCES teams(id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS team_secrets_team_id_name_uq ON public.team_secrets (team_id, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.team_secrets;
+-- +goose StatementEnd
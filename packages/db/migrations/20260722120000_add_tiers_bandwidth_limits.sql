@@ -0,0 +1,85 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Highest bandwidth limits the sandboxes of the tier can set, also used for
+-- sandboxes that don't set their own. 0 leaves the sandboxes at the node
+-- defaults, sandbox limits can only lower them.
+ALTER TABLE "public"."tiers"
+    ADD COLUMN "max_network_mbps" bigint NOT NULL DEFAULT 0,
+    ADD COLUMN "max_disk_mb_per_second" bigint NOT NULL DEFAULT 0,
+    ADD COLUMN "max_disk_iops" bigint NOT NULL DEFAULT 0,
+    ADD CONSTRAINT "tiers_max_network_mbps_check" CHECK (max_network_mbps >= 0),
+    ADD CONSTRAINT "tiers_max_disk_mb_per_second_check" CHECK (max_disk_mb_per_second >= 0),
+    ADD CONSTRAINT "tiers_max_disk_iops_check" CHECK (max_disk_iops >= 0);
+
+CREATE OR REPLACE VIEW "team_limits"
+WITH (security_invoker=on) AS
+SELECT
+    t.id,
+    tier.max_length_hours,
+    (tier.concurrent_instances + a.extra_concurrent_sandboxes) as concurrent_sandboxes,
+    (tier.concurrent_template_builds + a.extra_concurrent_template_builds) as concurrent_template_builds,
+    (tier.max_vcpu + a.extra_max_vcpu) as max_vcpu,
+    (tier.max_ram_mb + a.extra_max_ram_mb) as max_ram_mb,
+    (tier.disk_mb + a.extra_disk_mb) as disk_mb,
+    (tier.events_ttl_days + a.extra_events_ttl_days) as events_ttl_days,
+    (tier.max_sandbox_checkpoints + a.extra_max_sandbox_checkpoints) as max_sandbox_checkpoints,
+    tier.max_network_mbps,
+    tier.max_disk_mb_per_second,
+    tier.max_disk_iops
+FROM "public".teams t
+JOIN "public"."tiers" tier on t.tier = tier.id
+LEFT JOIN LATERAL (
+    SELECT COALESCE(SUM(extra_concurrent_sandboxes),0)::bigint           as extra_concurrent_sandboxes,
+           COALESCE(SUM(extra_concurrent_template_builds),0)::bigint     as extra_concurrent_template_builds,
+           COALESCE(SUM(extra_max_vcpu),0)::bigint                       as extra_max_vcpu,
+           COALESCE(SUM(extra_max_ram_mb),0)::bigint                     as extra_max_ram_mb,
+           COALESCE(SUM(extra_disk_mb),0)::bigint                        as extra_disk_mb,
+           COALESCE(SUM(extra_events_ttl_days),0)::bigint                as extra_events_ttl_days,
+           COALESCE(SUM(extra_max_sandbox_checkpoints),0)::bigint        as extra_max_sandbox_checkpoints
+    FROM "public"."addons" addon
+    WHERE addon.team_id = t.id
+      AND addon.valid_from <= now()
+      AND (addon.valid_to IS NULL OR addon.valid_to > now())
+    ) a ON true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS "team_limits";
+
+CREATE VIEW "team_limits"
+WITH (security_invoker=on) AS
+SELECT
+    t.id,
+    tier.max_length_hours,
+    (tier.concurrent_instances + a.extra_concurrent_sandboxes) as concurrent_sandboxes,
+    (tier.concurrent_template_builds + a.extra_concurrent_template_builds) as concurrent_template_builds,
+    (tier.max_vcpu + a.extra_max_vcpu) as max_vcpu,
+    (tier.max_ram_mb + a.extra_max_ram_mb) as max_ram_mb,
+    (tier.disk_mb + a.extra_disk_mb) as disk_mb,
+    (tier.events_ttl_days + a.extra_events_ttl_days) as events_ttl_days,
+    (tier.max_sandbox_checkpoints + a.extra_max_sandbox_checkpoints) as max_sandbox_checkpoints
+FROM "public".teams t
+JOIN "public"."tiers" tier on t.tier = tier.id
+LEFT JOIN LATERAL (
+    SELECT COALESCE(SUM(extra_concurrent_sandboxes),0)::bigint           as extra_concurrent_sandboxes,
+           COALESCE(SUM(extra_concurrent_template_builds),0)::bigint     as extra_concurrent_template_builds,
+           COALESCE(SUM(extra_max_vcpu),0)::bigint                       as extra_max_vcpu,
+           COALESCE(SUM(extra_max_ram_mb),0)::bigint                     as extra_max_ram_mb,
+           COALESCE(SUM(extra_disk_mb),0)::bigint                        as extra_disk_mb,
+           COALESCE(SUM(extra_events_ttl_days),0)::bigint                as extra_events_ttl_days,
+           COALESCE(SUM(extra_max_sandbox_checkpoints),0)::bigint        as extra_max_sandbox_checkpoints
+    FROM "public"."addons" addon
+    WHERE addon.team_id = t.id
+      AND addon.valid_from <= now()
+      AND (addon.valid_to IS NULL OR addon.valid_to > now())
+    ) a ON true;
+
+ALTER TABLE "public"."tiers"
+    DROP CONSTRAINT IF EXISTS "tiers_max_network_mbps_check",
+    DROP CONSTRAINT IF EXISTS "tiers_max_disk_mb_per_second_check",
+    DROP CONSTRAINT IF EXISTS "tiers_max_disk_iops_check",
+    DROP COLUMN IF EXISTS "max_network_mbps",
+    DROP COLUMN IF EXISTS "max_disk_mb_per_second",
+    DROP COLUMN IF EXISTS "max_disk_iops";
+-- +goose StatementEnd
@@ -60,7 +60,7 @@ func (q *Queries) GetAutoJoinTeamsBySSOOrganizationID(ctx context.Context, ssoOr
 }
 
 const getTeamWithTierByAPIKey = `-- name: GetTeamWithTierByAPIKey :one
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days FROM "public"."team_api_keys" tak
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days, tl.max_sandbox_checkpoints, tl.max_network_mbps, tl.max_disk_mb_per_second, tl.max_disk_iops FROM "public"."team_api_keys" tak
 JOIN "public"."teams" t ON tak.team_id = t.id
 JOIN "public"."team_limits" tl on tl.id = t.id
 WHERE tak.team_id = t.id
@@ -97,12 +97,16 @@ func (q *Queries) GetTeamWithTierByAPIKey(ctx context.Context, apiKeyHash string
 		&i.TeamLimit.MaxRamMb,
 		&i.TeamLimit.DiskMb,
 		&i.TeamLimit.EventsTtlDays,
+		&i.TeamLimit.MaxSandboxCheckpoints,
+		&i.TeamLimit.MaxNetworkMbps,
+		&i.TeamLimit.MaxDiskMbPerSecond,
+		&i.TeamLimit.MaxDiskIops,
 	)
 	return i, err
 }
 
 const getTeamWithTierByTeamAndUser = `-- name: GetTeamWithTierByTeamAndUser :one
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days, tl.max_sandbox_checkpoints, tl.max_network_mbps, tl.max_disk_mb_per_second, tl.max_disk_iops
 FROM "public"."teams" t
          JOIN "public"."users_teams" ut ON ut.team_id = t.id
          JOIN "public"."team_limits" tl on tl.id = t.id
@@ -144,12 +148,16 @@ func (q *Queries) GetTeamWithTierByTeamAndUser(ctx context.Context, arg GetTeamW
 		&i.TeamLimit.MaxRamMb,
 		&i.TeamLimit.DiskMb,
 		&i.TeamLimit.EventsTtlDays,
+		&i.TeamLimit.MaxSandboxCheckpoints,
+		&i.TeamLimit.MaxNetworkMbps,
+		&i.TeamLimit.MaxDiskMbPerSecond,
+		&i.TeamLimit.MaxDiskIops,
 	)
 	return i, err
 }
 
 const getTeamWithTierByTeamID = `-- name: GetTeamWithTierByTeamID :one
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days, tl.max_sandbox_checkpoints, tl.max_network_mbps, tl.max_disk_mb_per_second, tl.max_disk_iops
 FROM "public"."teams" t
          JOIN "public"."team_limits" tl on tl.id = t.id
 WHERE t.id = $1
@@ -185,12 +193,16 @@ func (q *Queries) GetTeamWithTierByTeamID(ctx context.Context, id uuid.UUID) (Ge
 		&i.TeamLimit.MaxRamMb,
 		&i.TeamLimit.DiskMb,
 		&i.TeamLimit.EventsTtlDays,
+		&i.TeamLimit.MaxSandboxCheckpoints,
+		&i.TeamLimit.MaxNetworkMbps,
+		&i.TeamLimit.MaxDiskMbPerSecond,
+		&i.TeamLimit.MaxDiskIops,
 	)
 	return i, err
 }
 
 const getTeamsWithUsersTeamsWithTier = `-- name: GetTeamsWithUsersTeamsWithTier :many
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, ut.is_default, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, t.sandbox_scheduling_labels, t.sso_organization_id, t.sso_auto_join, t.slug, ut.is_default, tl.id, tl.max_length_hours, tl.concurrent_sandboxes, tl.concurrent_template_builds, tl.max_vcpu, tl.max_ram_mb, tl.disk_mb, tl.events_ttl_days, tl.max_sandbox_checkpoints, tl.max_network_mbps, tl.max_disk_mb_per_second, tl.max_disk_iops
 FROM "public"."teams" t
          JOIN "public"."users_teams" ut ON ut.team_id = t.id
          JOIN "public"."team_limits" tl on tl.id = t.id
@@ -235,6 +247,10 @@ func (q *Queries) GetTeamsWithUsersTeamsWithTier(ctx context.Context, userID uui
 			&i.TeamLimit.MaxRamMb,
 			&i.TeamLimit.DiskMb,
 			&i.TeamLimit.EventsTtlDays,
+			&i.TeamLimit.MaxSandboxCheckpoints,
+			&i.TeamLimit.MaxNetworkMbps,
+			&i.TeamLimit.MaxDiskMbPerSecond,
+			&i.TeamLimit.MaxDiskIops,
 		); err != nil {
 			return nil, err
 		}
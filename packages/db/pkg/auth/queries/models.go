@@ -51,4 +51,8 @@ type TeamLimit struct {
 	MaxRamMb                 int32
 	DiskMb                   int32
 	EventsTtlDays            int32
+	MaxSandboxCheckpoints    int32
+	MaxNetworkMbps           int64
+	MaxDiskMbPerSecond       int64
+	MaxDiskIops              int64
 }
@@ -0,0 +1,149 @@
+package checkpoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/pkg/testutils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func TestQueries_SandboxCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	t.Run("restore promotes checkpoint build", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		db := testutils.SetupDatabase(t)
+
+		teamID := testutils.CreateTestTeam(t, db)
+		otherTeamID := testutils.CreateTestTeam(t, db)
+		baseTemplateID := testutils.CreateTestTemplate(t, db, teamID)
+
+		sandboxID := "sandbox-" + uuid.New().String()
+		snapshotTemplateID := "snapshot-template-" + uuid.New().String()
+
+		checkpointed := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+
+		name := "first"
+		checkpoint, err := db.SqlcClient.CreateSandboxCheckpoint(ctx, queries.CreateSandboxCheckpointParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+			BuildID:   checkpointed.BuildID,
+			Name:      &name,
+		})
+		require.NoError(t, err)
+
+		// name is unique within the sandbox
+		_, err = db.SqlcClient.CreateSandboxCheckpoint(ctx, queries.CreateSandboxCheckpointParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+			BuildID:   checkpointed.BuildID,
+			Name:      &name,
+		})
+		assert.True(t, dberrors.IsUniqueConstraintViolation(err))
+
+		time.Sleep(10 * time.Millisecond)
+		latest := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+
+		snapshot, err := db.SqlcClient.GetLastSnapshot(ctx, sandboxID)
+		require.NoError(t, err)
+		require.Equal(t, latest.BuildID, snapshot.EnvBuild.ID)
+
+		// other team = not found
+		_, err = db.SqlcClient.GetSandboxCheckpoint(ctx, queries.GetSandboxCheckpointParams{
+			CheckpointID: checkpoint.ID,
+			TeamID:       otherTeamID,
+			SandboxID:    sandboxID,
+		})
+		assert.True(t, dberrors.IsNotFoundError(err))
+
+		_, err = db.SqlcClient.PromoteSandboxCheckpointBuild(ctx, queries.PromoteSandboxCheckpointBuildParams{
+			CheckpointID: checkpoint.ID,
+			TeamID:       otherTeamID,
+		})
+		assert.True(t, dberrors.IsNotFoundError(err))
+
+		assignmentID, err := db.SqlcClient.PromoteSandboxCheckpointBuild(ctx, queries.PromoteSandboxCheckpointBuildParams{
+			CheckpointID: checkpoint.ID,
+			TeamID:       teamID,
+		})
+		require.NoError(t, err)
+
+		snapshot, err = db.SqlcClient.GetLastSnapshot(ctx, sandboxID)
+		require.NoError(t, err)
+		assert.Equal(t, checkpointed.BuildID, snapshot.EnvBuild.ID)
+
+		// reverting the promotion makes the previous snapshot the latest again
+		require.NoError(t, db.SqlcClient.DeleteSandboxCheckpointPromotion(ctx, assignmentID))
+
+		snapshot, err = db.SqlcClient.GetLastSnapshot(ctx, sandboxID)
+		require.NoError(t, err)
+		assert.Equal(t, latest.BuildID, snapshot.EnvBuild.ID)
+	})
+
+	t.Run("retention keeps newest", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		db := testutils.SetupDatabase(t)
+
+		teamID := testutils.CreateTestTeam(t, db)
+		baseTemplateID := testutils.CreateTestTemplate(t, db, teamID)
+
+		sandboxID := "sandbox-" + uuid.New().String()
+		snapshotTemplateID := "snapshot-template-" + uuid.New().String()
+
+		ids := make([]uuid.UUID, 3)
+		for i := range ids {
+			build := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+
+			checkpoint, err := db.SqlcClient.CreateSandboxCheckpoint(ctx, queries.CreateSandboxCheckpointParams{
+				TeamID:    teamID,
+				SandboxID: sandboxID,
+				BuildID:   build.BuildID,
+			})
+			require.NoError(t, err)
+			ids[i] = checkpoint.ID
+
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		dropped, err := db.SqlcClient.DeleteExcessSandboxCheckpoints(ctx, queries.DeleteExcessSandboxCheckpointsParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+			Keep:      2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{ids[0]}, dropped)
+
+		checkpoints, err := db.SqlcClient.GetSandboxCheckpoints(ctx, queries.GetSandboxCheckpointsParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+		})
+		require.NoError(t, err)
+		require.Len(t, checkpoints, 2)
+		assert.Equal(t, ids[2], checkpoints[0].ID)
+		assert.Equal(t, ids[1], checkpoints[1].ID)
+
+		// deleting the sandbox's checkpoints
+		err = db.SqlcClient.DeleteSandboxCheckpoints(ctx, queries.DeleteSandboxCheckpointsParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+		})
+		require.NoError(t, err)
+
+		checkpoints, err = db.SqlcClient.GetSandboxCheckpoints(ctx, queries.GetSandboxCheckpointsParams{
+			TeamID:    teamID,
+			SandboxID: sandboxID,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, checkpoints)
+	})
+}
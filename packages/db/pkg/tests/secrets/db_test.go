@@ -0,0 +1,97 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/pkg/testutils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func TestQueries_TeamSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	// init database
+	result := testutils.SetupDatabase(t)
+	db := result.SqlcClient
+
+	teamID := testutils.CreateTestTeam(t, result)
+	otherTeamID := testutils.CreateTestTeam(t, result)
+
+	// create secret = success
+	created, err := db.UpsertTeamSecret(ctx, queries.UpsertTeamSecretParams{
+		TeamID: teamID,
+		Name:       "OPENAI_KEY",
+		Value:      []byte("sealed-1"),
+		MaxSecrets: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, teamID, created.TeamID)
+	assert.Equal(t, "OPENAI_KEY", created.Name)
+	assert.True(t, created.Created)
+
+	// replace secret = same row, new value
+	updated, err := db.UpsertTeamSecret(ctx, queries.UpsertTeamSecretParams{
+		TeamID: teamID,
+		Name:       "OPENAI_KEY",
+		Value:      []byte("sealed-2"),
+		MaxSecrets: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+	assert.False(t, updated.Created)
+
+	// create secret over the limit = no rows
+	_, err = db.UpsertTeamSecret(ctx, queries.UpsertTeamSecretParams{
+		TeamID:     teamID,
+		Name:       "OTHER_KEY",
+		Value:      []byte("sealed-3"),
+		MaxSecrets: 1,
+	})
+	require.True(t, dberrors.IsNotFoundError(err))
+
+	// list secrets = success, other team sees nothing
+	list, err := db.GetTeamSecrets(ctx, teamID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "OPENAI_KEY", list[0].Name)
+
+	list, err = db.GetTeamSecrets(ctx, otherTeamID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// values = only the requested, existing names
+	values, err := db.GetTeamSecretValues(ctx, queries.GetTeamSecretValuesParams{
+		TeamID: teamID,
+		Names:  []string{"OPENAI_KEY", "MISSING"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []queries.GetTeamSecretValuesRow{{Name: "OPENAI_KEY", Value: []byte("sealed-2")}}, values)
+
+	values, err = db.GetTeamSecretValues(ctx, queries.GetTeamSecretValuesParams{
+		TeamID: otherTeamID,
+		Names:  []string{"OPENAI_KEY"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	// delete secret of other team = no rows
+	deleted, err := db.DeleteTeamSecret(ctx, queries.DeleteTeamSecretParams{TeamID: otherTeamID, Name: "OPENAI_KEY"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	// delete secret = success
+	deleted, err = db.DeleteTeamSecret(ctx, queries.DeleteTeamSecretParams{TeamID: teamID, Name: "OPENAI_KEY"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	list, err = db.GetTeamSecrets(ctx, teamID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
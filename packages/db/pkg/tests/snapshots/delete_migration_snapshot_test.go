@@ -0,0 +1,65 @@
+package snapshots
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/pkg/testutils"
+)
+
+func TestDeleteSnapshotBuild(t *testing.T) {
+	t.Parallel()
+
+	t.Run("previous snapshot becomes the latest again", func(t *testing.T) {
+		t.Parallel()
+
+		db := testutils.SetupDatabase(t)
+		ctx := t.Context()
+
+		teamID := testutils.CreateTestTeam(t, db)
+		baseTemplateID := testutils.CreateTestTemplate(t, db, teamID)
+
+		sandboxID := "sandbox-" + uuid.New().String()
+		snapshotTemplateID := "snapshot-template-" + uuid.New().String()
+
+		previous := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+		time.Sleep(10 * time.Millisecond)
+		migration := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+
+		require.NoError(t, db.SqlcClient.DeleteSnapshotBuild(ctx, migration.BuildID))
+		require.NoError(t, db.SqlcClient.DeleteEmptySnapshot(ctx, sandboxID))
+
+		snapshot, err := db.SqlcClient.GetLastSnapshot(ctx, sandboxID)
+		require.NoError(t, err)
+		assert.Equal(t, previous.BuildID, snapshot.EnvBuild.ID)
+	})
+
+	t.Run("only snapshot is removed", func(t *testing.T) {
+		t.Parallel()
+
+		db := testutils.SetupDatabase(t)
+		ctx := t.Context()
+
+		teamID := testutils.CreateTestTeam(t, db)
+		baseTemplateID := testutils.CreateTestTemplate(t, db, teamID)
+
+		sandboxID := "sandbox-" + uuid.New().String()
+		snapshotTemplateID := "snapshot-template-" + uuid.New().String()
+
+		migration := testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+
+		require.NoError(t, db.SqlcClient.DeleteSnapshotBuild(ctx, migration.BuildID))
+		require.NoError(t, db.SqlcClient.DeleteEmptySnapshot(ctx, sandboxID))
+
+		_, err := db.SqlcClient.GetLastSnapshot(ctx, sandboxID)
+		assert.True(t, dberrors.IsNotFoundError(err))
+
+		// The sandbox can be snapshotted again.
+		testutils.UpsertTestSnapshot(t, ctx, db, snapshotTemplateID, sandboxID, teamID, baseTemplateID)
+	})
+}
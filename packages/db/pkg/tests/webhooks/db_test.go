@@ -0,0 +1,86 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/pkg/dberrors"
+	"github.com/e2b-dev/infra/packages/db/pkg/testutils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func TestQueries_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		// init database
+		result := testutils.SetupDatabase(t)
+		db := result.SqlcClient
+
+		teamID := testutils.CreateTestTeam(t, result)
+		otherTeamID := testutils.CreateTestTeam(t, result)
+
+		// create webhook = success
+		webhook, err := db.CreateWebhook(ctx, queries.CreateWebhookParams{
+			TeamID:        teamID,
+			Url:           "https://example.com/hook",
+			Events:        []string{"sandbox.lifecycle.created"},
+			SigningSecret: "secret",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, webhook.ID)
+		assert.Equal(t, teamID, webhook.TeamID)
+		assert.Equal(t, []string{"sandbox.lifecycle.created"}, webhook.Events)
+		assert.True(t, webhook.Enabled)
+
+		// get webhook = success
+		gotWebhook, err := db.GetWebhook(ctx, queries.GetWebhookParams{
+			WebhookID: webhook.ID,
+			TeamID:    teamID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, webhook, gotWebhook)
+
+		// get webhook of other team = not found
+		_, err = db.GetWebhook(ctx, queries.GetWebhookParams{
+			WebhookID: webhook.ID,
+			TeamID:    otherTeamID,
+		})
+		assert.True(t, dberrors.IsNotFoundError(err))
+
+		// list webhooks = success
+		webhooks, err := db.GetEnabledWebhooksByTeamID(ctx, teamID)
+		require.NoError(t, err)
+		assert.Equal(t, []queries.Webhook{webhook}, webhooks)
+
+		count, err := db.CountWebhooksByTeamID(ctx, teamID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		// delete webhook of other team = no rows
+		deleted, err := db.DeleteWebhook(ctx, queries.DeleteWebhookParams{
+			TeamID:    otherTeamID,
+			WebhookID: webhook.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deleted)
+
+		// delete webhook = success
+		deleted, err = db.DeleteWebhook(ctx, queries.DeleteWebhookParams{
+			TeamID:    teamID,
+			WebhookID: webhook.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		webhooks, err = db.GetWebhooksByTeamID(ctx, teamID)
+		require.NoError(t, err)
+		assert.Empty(t, webhooks)
+	})
+}
@@ -61,6 +61,7 @@ type Addon struct {
 	AddedBy                       uuid.UUID
 	IdempotencyKey                pgtype.Text
 	ExtraEventsTtlDays            int64
+	ExtraMaxSandboxCheckpoints    int64
 }
 
 type AuthUser struct {
@@ -156,6 +157,15 @@ type EnvBuildAssignment struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type SandboxCheckpoint struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	SandboxID string
+	BuildID   uuid.UUID
+	Name      pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
 type Snapshot struct {
 	CreatedAt           pgtype.Timestamptz
 	EnvID               string
@@ -221,6 +231,19 @@ type TeamLimit struct {
 	MaxRamMb                 int32
 	DiskMb                   int32
 	EventsTtlDays            int32
+	MaxSandboxCheckpoints    int32
+	MaxNetworkMbps           int64
+	MaxDiskMbPerSecond       int64
+	MaxDiskIops              int64
+}
+
+type TeamSecret struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	Name      string
+	Value     []byte
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
 }
 
 type Tier struct {
@@ -235,6 +258,10 @@ type Tier struct {
 	// The number of concurrent template builds the team can run
 	ConcurrentTemplateBuilds int64
 	EventsTtlDays            int64
+	MaxSandboxCheckpoints    int64
+	MaxNetworkMbps           int64
+	MaxDiskMbPerSecond       int64
+	MaxDiskIops              int64
 }
 
 type User struct {
@@ -268,3 +295,13 @@ type Volume struct {
 	VolumeType string
 	CreatedAt  pgtype.Timestamptz
 }
+
+type Webhook struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	Url           string
+	Events        []string
+	SigningSecret string
+	Enabled       bool
+	CreatedAt     pgtype.Timestamptz
+}
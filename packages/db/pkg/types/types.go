@@ -67,8 +67,18 @@ type SandboxNetworkTransform struct {
 	Headers map[string]string `json:"headers,omitempty"`
 }
 
+const (
+	SandboxNetworkRuleActionAllow = "allow"
+	SandboxNetworkRuleActionDeny  = "deny"
+)
+
 type SandboxNetworkRule struct {
 	Transform *SandboxNetworkTransform `json:"transform,omitempty"`
+
+	// Access rule fields, Action is empty for transform-only rules.
+	Action  string   `json:"action,omitempty"`
+	Methods []string `json:"methods,omitempty"`
+	Path    string   `json:"path,omitempty"`
 }
 
 type SandboxNetworkEgressConfig struct {
@@ -101,6 +111,23 @@ type SandboxVolumeMountConfig struct {
 	Path string `json:"path"`
 }
 
+// SandboxBandwidthLimits are the bandwidth and IOPS limits of a sandbox, 0 leaves
+// the node default. NetworkMbps limits the network egress only.
+type SandboxBandwidthLimits struct {
+	NetworkMbps     int32 `json:"networkMbps,omitempty"`
+	DiskMBPerSecond int32 `json:"diskMBPerSecond,omitempty"`
+	DiskIops        int32 `json:"diskIops,omitempty"`
+}
+
+// SandboxControlPolicy are the actions a sandbox can take on itself from inside
+// the VM. MaxSpawnedSandboxes of 0 doesn't limit the spawned sandboxes.
+type SandboxControlPolicy struct {
+	ExtendTimeout       bool  `json:"extendTimeout,omitempty"`
+	Pause               bool  `json:"pause,omitempty"`
+	SpawnSandboxes      bool  `json:"spawnSandboxes,omitempty"`
+	MaxSpawnedSandboxes int32 `json:"maxSpawnedSandboxes,omitempty"`
+}
+
 type SandboxAutoResumePolicy string
 
 const (
@@ -113,6 +140,13 @@ type SandboxAutoResumeConfig struct {
 	Timeout uint64                  `json:"timeout,omitempty"`
 }
 
+// SandboxEnvVarsUpdate are the env vars set on a running sandbox. Secret
+// values are only kept sealed with the team secrets key.
+type SandboxEnvVarsUpdate struct {
+	Set           map[string]string `json:"set,omitempty"`
+	SealedSecrets map[string][]byte `json:"sealedSecrets,omitempty"`
+}
+
 type PausedSandboxConfig struct {
 	Version      string                      `json:"version"`
 	Network      *SandboxNetworkConfig       `json:"network,omitempty"`
@@ -132,6 +166,25 @@ type PausedSandboxConfig struct {
 	// pause/resume cycle. Distinct from FilesystemOnly, which records the kind of
 	// *this* snapshot. Pre-existing rows omit the key and decode to false.
 	AutoPauseFilesystemOnly bool `json:"autoPauseFilesystemOnly,omitempty"`
+
+	// LogProcessOutput is the sandbox-wide default for shipping the process
+	// output to the sandbox logs, restored on resume. Pre-existing rows omit the
+	// key and decode to false.
+	LogProcessOutput bool `json:"logProcessOutput,omitempty"`
+
+	// EnvVarsUpdate are the env vars set on the sandbox after it was created.
+	// Memory snapshots keep them in envd, filesystem-only snapshots get them
+	// applied again on resume. Pre-existing rows omit the key.
+	EnvVarsUpdate *SandboxEnvVarsUpdate `json:"envVarsUpdate,omitempty"`
+
+	// BandwidthLimits are restored on resume. Pre-existing rows omit the key and
+	// resume with the node defaults.
+	BandwidthLimits *SandboxBandwidthLimits `json:"bandwidthLimits,omitempty"`
+
+	// ControlPolicy and SpawnedSandboxes are restored on resume, so the paused
+	// sandbox can't spawn past its limit.
+	ControlPolicy    *SandboxControlPolicy `json:"controlPolicy,omitempty"`
+	SpawnedSandboxes int32                 `json:"spawnedSandboxes,omitempty"`
 }
 
 func (c PausedSandboxConfig) Value() (driver.Value, error) {
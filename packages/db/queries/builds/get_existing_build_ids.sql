@@ -0,0 +1,20 @@
+-- name: GetExistingBuildIDs :many
+-- Returns the subset of the given build IDs that are still in use: they have an
+-- env_builds row and are assigned to at least one template that is not deleted,
+-- or was deleted after deleted_before. Builds of deleted templates are left to
+-- the storage garbage collector, which keeps them while other builds map into them.
+SELECT b.id
+FROM public.env_builds b
+WHERE b.id = ANY(sqlc.arg(build_ids)::uuid[])
+  AND (
+    NOT EXISTS (
+      SELECT 1 FROM public.env_build_assignments eba WHERE eba.build_id = b.id
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM public.env_build_assignments eba
+      JOIN public.envs e ON e.id = eba.env_id
+      WHERE eba.build_id = b.id
+        AND (e.deleted_at IS NULL OR e.deleted_at > sqlc.arg(deleted_before)::timestamptz)
+    )
+  );
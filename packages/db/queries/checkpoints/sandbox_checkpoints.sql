@@ -0,0 +1,62 @@
+-- name: CreateSandboxCheckpoint :one
+INSERT INTO sandbox_checkpoints (team_id, sandbox_id, build_id, name)
+VALUES (@team_id, @sandbox_id, @build_id, sqlc.narg(name))
+RETURNING *;
+
+-- name: GetSandboxCheckpoint :one
+-- Checkpoints of killed sandboxes, whose snapshot template is deleted, are not returned.
+SELECT c.* FROM sandbox_checkpoints c
+WHERE c.id = @checkpoint_id
+  AND c.team_id = @team_id
+  AND c.sandbox_id = @sandbox_id
+  AND EXISTS (
+    SELECT 1 FROM snapshots s
+    JOIN active_envs e ON e.id = s.env_id
+    WHERE s.sandbox_id = c.sandbox_id
+  );
+
+-- name: GetSandboxCheckpoints :many
+-- Checkpoints of killed sandboxes, whose snapshot template is deleted, are not returned.
+SELECT c.* FROM sandbox_checkpoints c
+WHERE c.team_id = @team_id
+  AND c.sandbox_id = @sandbox_id
+  AND EXISTS (
+    SELECT 1 FROM snapshots s
+    JOIN active_envs e ON e.id = s.env_id
+    WHERE s.sandbox_id = c.sandbox_id
+  )
+ORDER BY c.created_at DESC;
+
+-- name: DeleteSandboxCheckpoint :execrows
+DELETE FROM sandbox_checkpoints
+WHERE id = @checkpoint_id AND team_id = @team_id AND sandbox_id = @sandbox_id;
+
+-- name: DeleteSandboxCheckpoints :exec
+DELETE FROM sandbox_checkpoints
+WHERE team_id = @team_id AND sandbox_id = @sandbox_id;
+
+-- name: DeleteExcessSandboxCheckpoints :many
+-- Enforces the team's retention limit: deletes all but the newest @keep checkpoints of the sandbox.
+DELETE FROM sandbox_checkpoints
+WHERE id IN (
+    SELECT c.id FROM sandbox_checkpoints c
+    WHERE c.team_id = @team_id AND c.sandbox_id = @sandbox_id
+    ORDER BY c.created_at DESC
+    OFFSET @keep::bigint
+)
+RETURNING id;
+
+-- name: PromoteSandboxCheckpointBuild :one
+-- Assigns the checkpoint's build as the newest default build of the sandbox's
+-- snapshot template, so the next resume of the sandbox starts from it. Returns
+-- the assignment, no row means the sandbox has no snapshot template anymore.
+INSERT INTO env_build_assignments (env_id, build_id, tag)
+SELECT s.env_id, c.build_id, 'default'
+FROM sandbox_checkpoints c
+JOIN snapshots s ON s.sandbox_id = c.sandbox_id
+WHERE c.id = @checkpoint_id AND c.team_id = @team_id
+RETURNING id;
+
+-- name: DeleteSandboxCheckpointPromotion :exec
+-- Reverts PromoteSandboxCheckpointBuild when the restore can't go ahead.
+DELETE FROM env_build_assignments WHERE id = @assignment_id;
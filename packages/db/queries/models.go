@@ -73,6 +73,15 @@ type EnvBuild struct {
 	TeamID             *uuid.UUID
 }
 
+type SandboxCheckpoint struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	SandboxID string
+	BuildID   uuid.UUID
+	Name      *string
+	CreatedAt pgtype.Timestamptz
+}
+
 type Snapshot struct {
 	CreatedAt           pgtype.Timestamptz
 	EnvID               string
@@ -106,3 +115,13 @@ type Volume struct {
 	VolumeType string
 	CreatedAt  pgtype.Timestamptz
 }
+
+type Webhook struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	Url           string
+	Events        []string
+	SigningSecret string
+	Enabled       bool
+	CreatedAt     pgtype.Timestamptz
+}
@@ -0,0 +1,21 @@
+-- name: LockTeamSecrets :one
+SELECT id FROM public.teams WHERE id = @team_id FOR UPDATE;
+
+-- name: UpsertTeamSecret :one
+-- Adds the secret only while the team has fewer than max_secrets, replacing an existing one always succeeds.
+-- Run it after LockTeamSecrets in the same transaction, so concurrent upserts can't both pass the count.
+INSERT INTO team_secrets (team_id, name, value)
+SELECT @team_id, @name, @value
+WHERE EXISTS (SELECT 1 FROM team_secrets WHERE team_id = @team_id AND name = @name)
+   OR (SELECT COUNT(*) FROM team_secrets WHERE team_id = @team_id) < @max_secrets::bigint
+ON CONFLICT (team_id, name) DO UPDATE SET value = EXCLUDED.value, updated_at = CURRENT_TIMESTAMP
+RETURNING id, team_id, name, created_at, updated_at, (xmax = 0)::boolean AS created;
+
+-- name: GetTeamSecrets :many
+SELECT id, team_id, name, created_at, updated_at FROM team_secrets WHERE team_id = @team_id ORDER BY name;
+
+-- name: GetTeamSecretValues :many
+SELECT name, value FROM team_secrets WHERE team_id = @team_id AND name = ANY(@names::text[]);
+
+-- name: DeleteTeamSecret :execrows
+DELETE FROM team_secrets WHERE team_id = @team_id AND name = @name;
@@ -0,0 +1,18 @@
+-- name: DeleteSnapshotBuild :exec
+-- Removes a snapshot build no resume should start from, its assignment goes
+-- with it. The build's files are kept, sandboxes started from it read them.
+DELETE FROM "public"."env_builds"
+WHERE id = @build_id;
+
+-- name: DeleteEmptySnapshot :exec
+-- Removes the sandbox's snapshot template, and with it the snapshot, when no
+-- build is assigned to it anymore.
+DELETE FROM "public"."envs" e
+USING "public"."snapshots" s
+WHERE s.sandbox_id = @sandbox_id
+  AND e.id = s.env_id
+  AND NOT EXISTS (
+      SELECT 1
+      FROM "public"."env_build_assignments" eba
+      WHERE eba.env_id = e.id
+  );
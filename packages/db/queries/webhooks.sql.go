@@ -0,0 +1,172 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhooks.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countWebhooksByTeamID = `-- name: CountWebhooksByTeamID :one
+SELECT COUNT(*) FROM webhooks WHERE team_id = $1
+`
+
+func (q *Queries) CountWebhooksByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhooksByTeamID, teamID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks (team_id, url, events, signing_secret)
+VALUES ($1, $2, $3::text[], $4)
+RETURNING id, team_id, url, events, signing_secret, enabled, created_at
+`
+
+type CreateWebhookParams struct {
+	TeamID        uuid.UUID
+	Url           string
+	Events        []string
+	SigningSecret string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.TeamID,
+		arg.Url,
+		arg.Events,
+		arg.SigningSecret,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Url,
+		&i.Events,
+		&i.SigningSecret,
+		&i.Enabled,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhooks WHERE team_id = $1 AND id = $2
+`
+
+type DeleteWebhookParams struct {
+	TeamID    uuid.UUID
+	WebhookID uuid.UUID
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, arg.TeamID, arg.WebhookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getEnabledWebhooksByTeamID = `-- name: GetEnabledWebhooksByTeamID :many
+SELECT id, team_id, url, events, signing_secret, enabled, created_at FROM webhooks WHERE team_id = $1 AND enabled = TRUE
+`
+
+func (q *Queries) GetEnabledWebhooksByTeamID(ctx context.Context, teamID uuid.UUID) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, getEnabledWebhooksByTeamID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Url,
+			&i.Events,
+			&i.SigningSecret,
+			&i.Enabled,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT id, team_id, url, events, signing_secret, enabled, created_at FROM webhooks WHERE id = $1 AND team_id = $2
+`
+
+type GetWebhookParams struct {
+	WebhookID uuid.UUID
+	TeamID    uuid.UUID
+}
+
+func (q *Queries) GetWebhook(ctx context.Context, arg GetWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhook, arg.WebhookID, arg.TeamID)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Url,
+		&i.Events,
+		&i.SigningSecret,
+		&i.Enabled,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhooksByTeamID = `-- name: GetWebhooksByTeamID :many
+SELECT id, team_id, url, events, signing_secret, enabled, created_at FROM webhooks WHERE team_id = $1 ORDER BY created_at
+`
+
+func (q *Queries) GetWebhooksByTeamID(ctx context.Context, teamID uuid.UUID) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, getWebhooksByTeamID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Url,
+			&i.Events,
+			&i.SigningSecret,
+			&i.Enabled,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTeamWebhooks = `-- name: LockTeamWebhooks :one
+SELECT id FROM public.teams WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockTeamWebhooks(ctx context.Context, teamID uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockTeamWebhooks, teamID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,22 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (team_id, url, events, signing_secret)
+VALUES (@team_id, @url, @events::text[], @signing_secret)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM webhooks WHERE id = @webhook_id AND team_id = @team_id;
+
+-- name: GetWebhooksByTeamID :many
+SELECT * FROM webhooks WHERE team_id = @team_id ORDER BY created_at;
+
+-- name: GetEnabledWebhooksByTeamID :many
+SELECT * FROM webhooks WHERE team_id = @team_id AND enabled = TRUE;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks WHERE team_id = @team_id AND id = @webhook_id;
+
+-- name: CountWebhooksByTeamID :one
+SELECT COUNT(*) FROM webhooks WHERE team_id = @team_id;
+
+-- name: LockTeamWebhooks :one
+SELECT id FROM public.teams WHERE id = @team_id FOR UPDATE;
@@ -21,12 +21,15 @@ const (
 
 // Defines values for EntryInfoType.
 const (
-	File EntryInfoType = "file"
+	Directory EntryInfoType = "directory"
+	File      EntryInfoType = "file"
 )
 
 // Valid indicates whether the value is a known member of the EntryInfoType enum.
 func (e EntryInfoType) Valid() bool {
 	switch e {
+	case Directory:
+		return true
 	case File:
 		return true
 	default:
@@ -34,6 +37,81 @@ func (e EntryInfoType) Valid() bool {
 	}
 }
 
+// Defines values for ArchiveFormat.
+const (
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+	ArchiveFormatZip   ArchiveFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the ArchiveFormat enum.
+func (e ArchiveFormat) Valid() bool {
+	switch e {
+	case ArchiveFormatTarGz:
+		return true
+	case ArchiveFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesParamsMode.
+const (
+	Append    PostFilesParamsMode = "append"
+	Overwrite PostFilesParamsMode = "overwrite"
+	Write     PostFilesParamsMode = "write"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesParamsMode enum.
+func (e PostFilesParamsMode) Valid() bool {
+	switch e {
+	case Append:
+		return true
+	case Overwrite:
+		return true
+	case Write:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetFilesArchiveParamsFormat.
+const (
+	GetFilesArchiveParamsFormatTarGz GetFilesArchiveParamsFormat = "tar.gz"
+	GetFilesArchiveParamsFormatZip   GetFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the GetFilesArchiveParamsFormat enum.
+func (e GetFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case GetFilesArchiveParamsFormatTarGz:
+		return true
+	case GetFilesArchiveParamsFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesArchiveParamsFormat.
+const (
+	TarGz PostFilesArchiveParamsFormat = "tar.gz"
+	Zip   PostFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesArchiveParamsFormat enum.
+func (e PostFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case TarGz:
+		return true
+	case Zip:
+		return true
+	default:
+		return false
+	}
+}
+
 // CollapseResult Per-call statistics from a heap collapse
 type CollapseResult struct {
 	// AlreadyHuge Chunks MADV_COLLAPSE accepted but were already hugepages (no work)
@@ -88,6 +166,15 @@ type EntryInfoType string
 // EnvVars Environment variables to set
 type EnvVars map[string]string
 
+// EnvVarsUpdate Environment variables to set and unset, other environment variables are kept
+type EnvVarsUpdate struct {
+	// Set Environment variables to set
+	Set *EnvVars `json:"set,omitempty"`
+
+	// Unset Names of the environment variables to unset
+	Unset *[]string `json:"unset,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -139,6 +226,9 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// ArchiveFormat defines model for ArchiveFormat.
+type ArchiveFormat string
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -208,8 +298,61 @@ type PostFilesParams struct {
 
 	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
 	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Mode How the uploaded content is written: `overwrite` replaces the file,
+	// `append` adds the content to its end, and `write` writes it at
+	// `offset`, keeping the rest of the file.
+	Mode *PostFilesParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Offset Byte offset to write the content at. Required with the `write` mode and not allowed with the other modes.
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostFilesParamsMode defines parameters for PostFiles.
+type PostFilesParamsMode string
+
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username *User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format *GetFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
 }
 
+// GetFilesArchiveParamsFormat defines parameters for GetFilesArchive.
+type GetFilesArchiveParamsFormat string
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username *User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format *PostFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PostFilesArchiveParamsFormat defines parameters for PostFilesArchive.
+type PostFilesArchiveParamsFormat string
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// AccessToken Access token for secure access to envd service
@@ -233,11 +376,17 @@ type PostInitJSONBody struct {
 	// LifecycleID Lifecycle ID of the sandbox
 	LifecycleID *string `json:"lifecycleID,omitempty"`
 
+	// LogProcessOutput Ship the output of started processes to the sandbox logs unless a process sets it explicitly
+	LogProcessOutput *bool `json:"logProcessOutput,omitempty"`
+
 	// Timestamp The current timestamp in RFC3339 format
 	Timestamp    *time.Time     `json:"timestamp,omitempty"`
 	VolumeMounts *[]VolumeMount `json:"volumeMounts,omitempty"`
 }
 
+// PatchEnvsJSONRequestBody defines body for PatchEnvs for application/json ContentType.
+type PatchEnvsJSONRequestBody = EnvVarsUpdate
+
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
@@ -255,12 +404,21 @@ type ServerInterface interface {
 	// Environment variables
 	// (GET /envs)
 	GetEnvs(w http.ResponseWriter, r *http.Request)
+	// Set and unset default environment variables used by new processes
+	// (PATCH /envs)
+	PatchEnvs(w http.ResponseWriter, r *http.Request)
 	// Download a file
 	// (GET /files)
 	GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesParams)
 	// Upload a file and ensure the parent directories exist. If the file exists, it will be overwritten.
 	// (POST /files)
 	PostFiles(w http.ResponseWriter, r *http.Request, params PostFilesParams)
+	// Download a directory recursively as a tar.gz or zip archive
+	// (GET /files/archive)
+	GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams)
+	// Extract a tar.gz or zip archive into a directory, creating it if it doesn't exist. Existing files are overwritten.
+	// (POST /files/archive)
+	PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams)
 	// Compose multiple files into a single file using zero-copy concatenation. Source files are deleted after successful composition.
 	// (POST /files/compose)
 	PostFilesCompose(w http.ResponseWriter, r *http.Request)
@@ -303,6 +461,12 @@ func (_ Unimplemented) GetEnvs(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Set and unset default environment variables used by new processes
+// (PATCH /envs)
+func (_ Unimplemented) PatchEnvs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Download a file
 // (GET /files)
 func (_ Unimplemented) GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesParams) {
@@ -315,6 +479,18 @@ func (_ Unimplemented) PostFiles(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Download a directory recursively as a tar.gz or zip archive
+// (GET /files/archive)
+func (_ Unimplemented) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Extract a tar.gz or zip archive into a directory, creating it if it doesn't exist. Existing files are overwritten.
+// (POST /files/archive)
+func (_ Unimplemented) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Compose multiple files into a single file using zero-copy concatenation. Source files are deleted after successful composition.
 // (POST /files/compose)
 func (_ Unimplemented) PostFilesCompose(w http.ResponseWriter, r *http.Request) {
@@ -412,6 +588,26 @@ func (siw *ServerInterfaceWrapper) GetEnvs(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
+// PatchEnvs operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnvs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEnvs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetFiles operation middleware
 func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Request) {
 
@@ -557,6 +753,32 @@ func (siw *ServerInterfaceWrapper) PostFiles(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "mode", r.URL.Query(), &params.Mode, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "mode"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", r.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		}
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.PostFiles(w, r, params)
 	}))
@@ -568,6 +790,188 @@ func (siw *ServerInterfaceWrapper) PostFiles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// GetFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "username", r.URL.Query(), &params.Username, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "signature", r.URL.Query(), &params.Signature, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature_expiration"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilesArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "username", r.URL.Query(), &params.Username, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "signature", r.URL.Query(), &params.Signature, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature_expiration"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostFilesCompose operation middleware
 func (siw *ServerInterfaceWrapper) PostFilesCompose(w http.ResponseWriter, r *http.Request) {
 
@@ -841,12 +1245,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/envs", wrapper.GetEnvs)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/envs", wrapper.PatchEnvs)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/files", wrapper.GetFiles)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/files", wrapper.PostFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/archive", wrapper.GetFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/archive", wrapper.PostFilesArchive)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/files/compose", wrapper.PostFilesCompose)
 	})
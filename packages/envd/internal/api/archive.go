@@ -0,0 +1,295 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"syscall"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/execcontext"
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+func (a *API) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	format := GetFilesArchiveParamsFormatTarGz
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	operationID := logs.AssignOperationID()
+
+	// signing authorization if needed
+	err := a.validateSigning(r, params.Signature, params.SignatureExpiration, params.Username, path, SigningReadOperation)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("error during auth validation")
+		jsonError(w, http.StatusUnauthorized, err)
+
+		return
+	}
+
+	username, err := execcontext.ResolveDefaultUsername(params.Username, a.defaults.User)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("no user specified")
+		jsonError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("path", path).
+			Str("format", string(format)).
+			Str("username", username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Directory archive read")
+	}()
+
+	if !format.Valid() {
+		errMsg = fmt.Errorf("unsupported archive format '%s'", format)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	u, err := user.Lookup(username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u, a.defaults.Workdir)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Stat(resolvedPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			errMsg = fmt.Errorf("path '%s' does not exist", resolvedPath)
+			errorCode = http.StatusNotFound
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		errMsg = fmt.Errorf("error checking if path exists '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if !stat.IsDir() {
+		errMsg = fmt.Errorf("path '%s' is not a directory", resolvedPath)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	filename := filepath.Base(resolvedPath) + "." + string(format)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	switch format {
+	case GetFilesArchiveParamsFormatZip:
+		w.Header().Set("Content-Type", "application/zip")
+		w.WriteHeader(http.StatusOK)
+		err = writeZipArchive(w, resolvedPath)
+	default:
+		w.Header().Set("Content-Type", "application/gzip")
+		w.WriteHeader(http.StatusOK)
+		err = writeTarGzArchive(w, resolvedPath)
+	}
+
+	// The response is already being streamed, the archive is left truncated
+	// so the client fails to read it.
+	if err != nil {
+		errMsg = fmt.Errorf("error writing archive of '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+	}
+}
+
+func (a *API) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	format := TarGz
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	operationID := logs.AssignOperationID()
+
+	// signing authorization if needed
+	err := a.validateSigning(r, params.Signature, params.SignatureExpiration, params.Username, path, SigningWriteOperation)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("error during auth validation")
+		jsonError(w, http.StatusUnauthorized, err)
+
+		return
+	}
+
+	username, err := execcontext.ResolveDefaultUsername(params.Username, a.defaults.User)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("no user specified")
+		jsonError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("path", path).
+			Str("format", string(format)).
+			Str("username", username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Directory archive write")
+	}()
+
+	if !format.Valid() {
+		errMsg = fmt.Errorf("unsupported archive format '%s'", format)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	u, err := user.Lookup(username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	uid, gid, err := permissions.GetUserIdInts(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u, a.defaults.Workdir)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(resolvedPath, uid, gid)
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	x := &extractor{root: resolvedPath, uid: uid, gid: gid}
+
+	switch format {
+	case Zip:
+		err = extractZipBody(x, r.Body)
+	default:
+		err = x.extractTarGz(r.Body)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, syscall.ENOSPC):
+			errMsg = ErrNoDiskSpace
+			errorCode = http.StatusInsufficientStorage
+		case errors.Is(err, errInvalidArchive):
+			errMsg = err
+			errorCode = http.StatusBadRequest
+		default:
+			errMsg = fmt.Errorf("error extracting archive to '%s': %w", resolvedPath, err)
+			errorCode = http.StatusInternalServerError
+		}
+
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	entry := EntryInfo{
+		Path: resolvedPath,
+		Name: filepath.Base(resolvedPath),
+		Type: Directory,
+	}
+	if err := json.NewEncoder(w).Encode(entry); err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("failed to encode archive response")
+	}
+}
+
+// extractZipBody spools the body to a temporary file as zip archives can
+// only be read with random access.
+func extractZipBody(x *extractor, body io.Reader) error {
+	tmp, err := os.CreateTemp("", "envd-archive-*.zip")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, body)
+	if err != nil {
+		return fmt.Errorf("error receiving archive: %w", err)
+	}
+
+	return x.extractZip(tmp, size)
+}
@@ -0,0 +1,423 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/shared/pkg/filesystem"
+)
+
+// maxSymlinkTargetLen caps the symlink targets read from zip archives.
+const maxSymlinkTargetLen = 4096
+
+var errInvalidArchive = errors.New("invalid archive")
+
+type extractedDir struct {
+	path    string
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+// extractor writes archive entries under root, owned by uid and gid. Entries
+// are never written through symlinks, so the archive can't escape the root.
+type extractor struct {
+	root string
+	uid  int
+	gid  int
+
+	// dirs get their mode and modification time once all entries are
+	// extracted, so read-only directories can still be filled.
+	dirs []extractedDir
+}
+
+// archiveReader marks the errors of reading the archive content as invalid archive errors.
+type archiveReader struct {
+	r io.Reader
+}
+
+func (r archiveReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+
+	return n, err
+}
+
+func (x *extractor) extractTarGz(r io.Reader) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", errInvalidArchive, err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = x.dir(header.Name, header.FileInfo().Mode(), header.ModTime, tarMetadata(header))
+		case tar.TypeReg:
+			err = x.file(header.Name, header.FileInfo().Mode(), header.ModTime, tarMetadata(header), archiveReader{tr})
+		case tar.TypeSymlink:
+			err = x.symlink(header.Name, header.Linkname)
+		case tar.TypeLink:
+			err = x.link(header.Name, header.Linkname)
+		default:
+			// Devices, pipes and global headers are skipped.
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return x.finish()
+}
+
+func (x *extractor) extractZip(r io.ReaderAt, size int64) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+
+	for _, f := range zr.File {
+		if err := x.zipEntry(f); err != nil {
+			return err
+		}
+	}
+
+	return x.finish()
+}
+
+func (x *extractor) zipEntry(f *zip.File) error {
+	metadata, err := parseZipMetadataExtra(f.Extra)
+	if err != nil {
+		return err
+	}
+
+	mode := f.Mode()
+	if !mode.IsDir() && !mode.IsRegular() && mode&fs.ModeSymlink == 0 {
+		return nil
+	}
+
+	if mode.IsDir() {
+		return x.dir(f.Name, mode, f.Modified, metadata)
+	}
+
+	content, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+	defer content.Close()
+
+	if mode.IsRegular() {
+		return x.file(f.Name, mode, f.Modified, metadata, archiveReader{content})
+	}
+
+	// Symlinks are stored with their target as the content.
+	target, err := io.ReadAll(io.LimitReader(archiveReader{content}, maxSymlinkTargetLen+1))
+	if err != nil {
+		return err
+	}
+
+	if len(target) > maxSymlinkTargetLen {
+		return fmt.Errorf("%w: symlink target of '%s' is too long", errInvalidArchive, f.Name)
+	}
+
+	return x.symlink(f.Name, string(target))
+}
+
+// resolve returns the path of the entry, making sure it stays under the root
+// and none of its existing parents is a symlink.
+func (x *extractor) resolve(name string) (string, error) {
+	rel := filepath.Clean(filepath.FromSlash(name))
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%w: entry '%s' is outside of the target directory", errInvalidArchive, name)
+	}
+
+	parent := x.root
+	for part := range strings.SplitSeq(filepath.Dir(rel), string(filepath.Separator)) {
+		if part == "." {
+			break
+		}
+
+		parent = filepath.Join(parent, part)
+
+		info, err := os.Lstat(parent)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if !info.IsDir() {
+			return "", fmt.Errorf("%w: parent of entry '%s' is not a directory", errInvalidArchive, name)
+		}
+	}
+
+	return filepath.Join(x.root, rel), nil
+}
+
+// create resolves the entry and replaces whatever non-directory is at its path.
+func (x *extractor) create(name string) (string, error) {
+	path, err := x.resolve(name)
+	if err != nil {
+		return "", err
+	}
+
+	if path == x.root {
+		return "", fmt.Errorf("%w: entry '%s' would replace the target directory", errInvalidArchive, name)
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(path), x.uid, x.gid)
+	if err != nil {
+		return "", fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return path, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if info.IsDir() {
+		return "", fmt.Errorf("%w: entry '%s' would replace a directory", errInvalidArchive, name)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func (x *extractor) dir(name string, mode fs.FileMode, modTime time.Time, metadata map[string]string) error {
+	path, err := x.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	// The target directory keeps its own attributes.
+	if path == x.root {
+		return nil
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(path), x.uid, x.gid)
+	if err != nil {
+		return fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	info, err := os.Lstat(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return err
+	case info.IsDir():
+	default:
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Mkdir(path, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return err
+	}
+
+	if err := os.Lchown(path, x.uid, x.gid); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	if err := writeArchiveMetadata(dir, metadata); err != nil {
+		return fmt.Errorf("error writing metadata of '%s': %w", name, err)
+	}
+
+	x.dirs = append(x.dirs, extractedDir{path: path, mode: mode, modTime: modTime})
+
+	return nil
+}
+
+func (x *extractor) file(name string, mode fs.FileMode, modTime time.Time, metadata map[string]string, content io.Reader) error {
+	path, err := x.create(name)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL|syscall.O_NOFOLLOW, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := file.Chown(x.uid, x.gid); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, content); err != nil {
+		return err
+	}
+
+	if err := file.Chmod(mode.Perm()); err != nil {
+		return err
+	}
+
+	if err := writeArchiveMetadata(file, metadata); err != nil {
+		return fmt.Errorf("error writing metadata of '%s': %w", name, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if modTime.IsZero() {
+		return nil
+	}
+
+	return os.Chtimes(path, modTime, modTime)
+}
+
+func (x *extractor) symlink(name, target string) error {
+	if target == "" {
+		return fmt.Errorf("%w: symlink '%s' has no target", errInvalidArchive, name)
+	}
+
+	path, err := x.create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Symlink(target, path); err != nil {
+		return err
+	}
+
+	return os.Lchown(path, x.uid, x.gid)
+}
+
+func (x *extractor) link(name, target string) error {
+	source, err := x.resolve(target)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Lstat(source)
+	if err != nil || info.IsDir() {
+		return fmt.Errorf("%w: hard link '%s' doesn't point to an extracted file", errInvalidArchive, name)
+	}
+
+	path, err := x.create(name)
+	if err != nil {
+		return err
+	}
+
+	// The link shares the inode, and with it the ownership, of the already extracted source.
+	return os.Link(source, path)
+}
+
+// finish applies the modes and modification times of the extracted directories, deepest first.
+func (x *extractor) finish() error {
+	for _, dir := range slices.Backward(x.dirs) {
+		if err := os.Chmod(dir.path, dir.mode.Perm()); err != nil {
+			return err
+		}
+
+		if dir.modTime.IsZero() {
+			continue
+		}
+
+		if err := os.Chtimes(dir.path, dir.modTime, dir.modTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeArchiveMetadata replaces the metadata of the extracted file, skipping
+// filesystems without xattr support.
+func writeArchiveMetadata(file *os.File, metadata map[string]string) error {
+	if err := filesystem.ValidateMetadata(metadata); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+
+	err := filesystem.WriteMetadata(file, metadata)
+	if err != nil && !filesystem.IsXattrUnsupported(err) {
+		return err
+	}
+
+	return nil
+}
+
+// parseZipMetadataExtra returns the metadata from the zip extra fields, nil if there is none.
+func parseZipMetadataExtra(extra []byte) (map[string]string, error) {
+	for len(extra) >= 4 {
+		id := binary.LittleEndian.Uint16(extra)
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		extra = extra[4:]
+
+		if size > len(extra) {
+			return nil, fmt.Errorf("%w: truncated zip extra field", errInvalidArchive)
+		}
+
+		if id == zipMetadataExtraID {
+			var metadata map[string]string
+			if err := json.Unmarshal(extra[:size], &metadata); err != nil {
+				return nil, fmt.Errorf("%w: invalid metadata: %w", errInvalidArchive, err)
+			}
+
+			return metadata, nil
+		}
+
+		extra = extra[size:]
+	}
+
+	return nil, nil
+}
+
+// tarMetadata returns the metadata from the xattr PAX records of the header.
+func tarMetadata(header *tar.Header) map[string]string {
+	var metadata map[string]string
+	for k, v := range header.PAXRecords {
+		key, ok := strings.CutPrefix(k, paxXattrPrefix+filesystem.MetadataXattrPrefix)
+		if !ok {
+			continue
+		}
+
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[key] = v
+	}
+
+	return metadata
+}
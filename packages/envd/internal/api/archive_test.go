@@ -0,0 +1,265 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/execcontext"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/cgroups"
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/filesystem"
+)
+
+func newArchiveTestAPI(t *testing.T) (*API, string) {
+	t.Helper()
+
+	currentUser, err := user.Current()
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	defaults := &execcontext.Defaults{
+		EnvVars: utils.NewEnvVars(),
+		User:    currentUser.Username,
+	}
+
+	return New(&logger, defaults, nil, false, cgroups.NewNoopManager()), currentUser.Username
+}
+
+func getArchive(t *testing.T, api *API, username, path, format string) *http.Response {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/files/archive", nil)
+	w := httptest.NewRecorder()
+
+	f := GetFilesArchiveParamsFormat(format)
+	api.GetFilesArchive(w, req, GetFilesArchiveParams{Path: &path, Username: &username, Format: &f})
+
+	return w.Result()
+}
+
+func postArchive(t *testing.T, api *API, username, path, format string, body []byte) *http.Response {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/files/archive", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	f := PostFilesArchiveParamsFormat(format)
+	api.PostFilesArchive(w, req, PostFilesArchiveParams{Path: &path, Username: &username, Format: &f})
+
+	return w.Result()
+}
+
+func TestFilesArchiveRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{"tar.gz", "zip"} {
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			api, username := newArchiveTestAPI(t)
+
+			src := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(src, "nested", "deeper"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(src, "nested", "deeper", "data.txt"), []byte("data"), 0o640))
+			require.NoError(t, os.WriteFile(filepath.Join(src, "run.sh"), []byte("#!/bin/sh\n"), 0o755))
+			require.NoError(t, os.Symlink("nested/deeper/data.txt", filepath.Join(src, "link")))
+			require.NoError(t, os.Chmod(filepath.Join(src, "nested"), 0o555))
+			t.Cleanup(func() { _ = os.Chmod(filepath.Join(src, "nested"), 0o755) })
+
+			file, err := os.OpenFile(filepath.Join(src, "run.sh"), os.O_RDWR, 0)
+			require.NoError(t, err)
+			err = filesystem.WriteMetadata(file, map[string]string{"author": "e2b"})
+			file.Close()
+			xattrSupported := !filesystem.IsXattrUnsupported(err)
+			if xattrSupported {
+				require.NoError(t, err)
+			}
+
+			resp := getArchive(t, api, username, src, format)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			archive, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			dst := filepath.Join(t.TempDir(), "restored")
+			resp = postArchive(t, api, username, dst, format, archive)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			t.Cleanup(func() { _ = os.Chmod(filepath.Join(dst, "nested"), 0o755) })
+
+			content, err := os.ReadFile(filepath.Join(dst, "nested", "deeper", "data.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "data", string(content))
+
+			info, err := os.Stat(filepath.Join(dst, "nested", "deeper", "data.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+
+			info, err = os.Stat(filepath.Join(dst, "run.sh"))
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+			info, err = os.Stat(filepath.Join(dst, "nested"))
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0o555), info.Mode().Perm())
+
+			target, err := os.Readlink(filepath.Join(dst, "link"))
+			require.NoError(t, err)
+			assert.Equal(t, "nested/deeper/data.txt", target)
+
+			if xattrSupported {
+				metadata, err := filesystem.ReadMetadata(filepath.Join(dst, "run.sh"))
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"author": "e2b"}, metadata)
+			}
+		})
+	}
+}
+
+func TestGetFilesArchiveNotDirectory(t *testing.T) {
+	t.Parallel()
+
+	api, username := newArchiveTestAPI(t)
+
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+
+	resp := getArchive(t, api, username, path, "tar.gz")
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func tarGz(t *testing.T, headers ...*tar.Header) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for _, header := range headers {
+		if header.Typeflag == tar.TypeReg {
+			header.Size = int64(len("data"))
+		}
+
+		require.NoError(t, tw.WriteHeader(header))
+
+		if header.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte("data"))
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
+
+func TestPostFilesArchiveRejectsEscapes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		headers []*tar.Header
+	}{
+		{
+			name: "parent traversal",
+			headers: []*tar.Header{
+				{Name: "../escaped.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+			},
+		},
+		{
+			name: "absolute path",
+			headers: []*tar.Header{
+				{Name: "/escaped.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+			},
+		},
+		{
+			name: "through symlink",
+			headers: []*tar.Header{
+				{Name: "link", Typeflag: tar.TypeSymlink, Linkname: ".."},
+				{Name: "link/escaped.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+			},
+		},
+		{
+			name: "hard link outside",
+			headers: []*tar.Header{
+				{Name: "escaped.txt", Typeflag: tar.TypeLink, Linkname: "../outside.txt"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, username := newArchiveTestAPI(t)
+
+			parent := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(parent, "outside.txt"), []byte("outside"), 0o644))
+			dst := filepath.Join(parent, "target")
+
+			resp := postArchive(t, api, username, dst, "tar.gz", tarGz(t, tt.headers...))
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			assert.NoFileExists(t, filepath.Join(parent, "escaped.txt"))
+			assert.NoFileExists(t, filepath.Join(dst, "escaped.txt"))
+		})
+	}
+}
+
+func TestPostFilesArchiveReplacesSymlink(t *testing.T) {
+	t.Parallel()
+
+	api, username := newArchiveTestAPI(t)
+
+	parent := t.TempDir()
+	outside := filepath.Join(parent, "outside.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("outside"), 0o644))
+
+	dst := filepath.Join(parent, "target")
+	require.NoError(t, os.Mkdir(dst, 0o755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dst, "file.txt")))
+
+	resp := postArchive(t, api, username, dst, "tar.gz", tarGz(t,
+		&tar.Header{Name: "file.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+	))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	content, err := os.ReadFile(outside)
+	require.NoError(t, err)
+	assert.Equal(t, "outside", string(content))
+
+	info, err := os.Lstat(filepath.Join(dst, "file.txt"))
+	require.NoError(t, err)
+	assert.True(t, info.Mode().IsRegular())
+}
+
+func TestPostFilesArchiveInvalid(t *testing.T) {
+	t.Parallel()
+
+	api, username := newArchiveTestAPI(t)
+
+	for _, format := range []string{"tar.gz", "zip"} {
+		resp := postArchive(t, api, username, t.TempDir(), format, []byte("not an archive"))
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, format)
+	}
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/filesystem"
+)
+
+// paxXattrPrefix is the PAX record prefix GNU tar and bsdtar use for extended attributes.
+const paxXattrPrefix = "SCHILY.xattr."
+
+// zipMetadataExtraID is the zip extra field holding the JSON encoded metadata of an entry.
+const zipMetadataExtraID = 0xe2b0
+
+// archiveEntry is a file, directory or symlink in the archived directory.
+type archiveEntry struct {
+	path     string
+	name     string
+	info     fs.FileInfo
+	link     string
+	metadata map[string]string
+}
+
+// walkArchive calls fn for every directory, regular file and symlink under
+// root in lexical order. Symlinks are not followed.
+func walkArchive(root string, fn func(entry archiveEntry) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == root {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		entry := archiveEntry{path: path, name: filepath.ToSlash(rel), info: info}
+
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			entry.link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		case info.IsDir(), info.Mode().IsRegular():
+			entry.metadata, err = filesystem.ReadMetadata(path)
+			if err != nil {
+				return fmt.Errorf("error reading metadata of '%s': %w", path, err)
+			}
+
+			// Metadata set directly in the sandbox may not fit the limits and
+			// couldn't be restored from the archive.
+			if filesystem.ValidateMetadata(entry.metadata) != nil {
+				entry.metadata = nil
+			}
+		default:
+			// Sockets, devices and pipes can't be meaningfully archived.
+			return nil
+		}
+
+		return fn(entry)
+	})
+}
+
+func writeTarGzArchive(w io.Writer, root string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	err := walkArchive(root, func(entry archiveEntry) error {
+		header, err := tar.FileInfoHeader(entry.info, entry.link)
+		if err != nil {
+			return err
+		}
+
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		}
+
+		if len(entry.metadata) > 0 {
+			header.Format = tar.FormatPAX
+			header.PAXRecords = make(map[string]string, len(entry.metadata))
+			for k, v := range entry.metadata {
+				header.PAXRecords[paxXattrPrefix+filesystem.MetadataXattrPrefix+k] = v
+			}
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !entry.info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFileTo(tw, entry.path)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+func writeZipArchive(w io.Writer, root string) error {
+	zw := zip.NewWriter(w)
+
+	err := walkArchive(root, func(entry archiveEntry) error {
+		header, err := zip.FileInfoHeader(entry.info)
+		if err != nil {
+			return err
+		}
+
+		header.Name = entry.name
+		if entry.info.IsDir() {
+			header.Name += "/"
+		}
+
+		if entry.info.Mode().IsRegular() {
+			header.Method = zip.Deflate
+		}
+
+		if len(entry.metadata) > 0 {
+			header.Extra, err = zipMetadataExtra(entry.metadata)
+			if err != nil {
+				return err
+			}
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entry.link != "":
+			// Symlinks are stored with their target as the content.
+			_, err = io.WriteString(fw, entry.link)
+
+			return err
+		case entry.info.Mode().IsRegular():
+			return copyFileTo(fw, entry.path)
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+
+	return err
+}
+
+func zipMetadataExtra(metadata map[string]string) ([]byte, error) {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// The metadata is validated, which caps it well below the extra field size limit.
+	extra := binary.LittleEndian.AppendUint16(nil, zipMetadataExtraID)
+	extra = binary.LittleEndian.AppendUint16(extra, uint16(len(data)))
+
+	return append(extra, data...), nil
+}
@@ -28,6 +28,8 @@ var authExcludedPaths = []string{
 	"GET/health",
 	"GET/files",
 	"POST/files",
+	"GET/files/archive",
+	"POST/files/archive",
 	"POST/init",
 }
 
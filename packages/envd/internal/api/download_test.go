@@ -169,6 +169,61 @@ func TestGetFilesContentDispositionWithNestedPath(t *testing.T) {
 	assert.Equal(t, `inline; filename=document.pdf`, contentDisposition, "Content-Disposition should contain only the filename, not the path")
 }
 
+func TestGetFiles_Range(t *testing.T) {
+	t.Parallel()
+
+	currentUser, err := user.Current()
+	require.NoError(t, err)
+
+	tempFile := filepath.Join(t.TempDir(), "log.txt")
+	err = os.WriteFile(tempFile, []byte("0123456789"), 0o644)
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	defaults := &execcontext.Defaults{
+		EnvVars: utils.NewEnvVars(),
+		User:    currentUser.Username,
+	}
+	api := New(&logger, defaults, nil, false, cgroups.NewNoopManager())
+
+	tests := []struct {
+		name         string
+		rangeHeader  string
+		expectedCode int
+		expectedBody string
+	}{
+		{name: "middle", rangeHeader: "bytes=2-4", expectedCode: http.StatusPartialContent, expectedBody: "234"},
+		{name: "suffix", rangeHeader: "bytes=-3", expectedCode: http.StatusPartialContent, expectedBody: "789"},
+		{name: "open ended", rangeHeader: "bytes=8-", expectedCode: http.StatusPartialContent, expectedBody: "89"},
+		{name: "not satisfiable", rangeHeader: "bytes=20-30", expectedCode: http.StatusRequestedRangeNotSatisfiable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/files?path="+url.QueryEscape(tempFile), nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			req.Header.Set("Range", tt.rangeHeader)
+			w := httptest.NewRecorder()
+
+			api.GetFiles(w, req, GetFilesParams{Path: &tempFile, Username: &currentUser.Username})
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Empty(t, resp.Header.Get("Content-Encoding"))
+
+			if tt.expectedBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, string(body))
+			}
+		})
+	}
+}
+
 func TestGetFiles_GzipEncoding_ExplicitIdentityOffWithRange(t *testing.T) {
 	t.Parallel()
 
@@ -2,7 +2,14 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/awnumar/memguard"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 )
@@ -22,3 +29,64 @@ func (a *API) GetEnvs(w http.ResponseWriter, _ *http.Request) {
 		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("Failed to encode env vars")
 	}
 }
+
+// PatchEnvs sets and unsets user env vars, the values are never logged as they can be secrets.
+func (a *API) PatchEnvs(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	operationID := logs.AssignOperationID()
+	logger := a.logger.With().Str(string(logs.OperationIDKey), operationID).Logger()
+
+	body, err := io.ReadAll(r.Body)
+	defer memguard.WipeBytes(body)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("error reading request body: %w", err))
+
+		return
+	}
+
+	var update PatchEnvsJSONRequestBody
+	if err := json.Unmarshal(body, &update); err != nil {
+		jsonError(w, http.StatusBadRequest, fmt.Errorf("error decoding env vars: %w", err))
+
+		return
+	}
+
+	var set map[string]string
+	if update.Set != nil {
+		set = *update.Set
+	}
+
+	var unset []string
+	if update.Unset != nil {
+		unset = *update.Unset
+	}
+
+	for key, value := range set {
+		if !isValidEnvVarKey(key) || strings.ContainsRune(value, 0) {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid env var %q", key))
+
+			return
+		}
+	}
+
+	for _, key := range unset {
+		if !isValidEnvVarKey(key) {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid env var %q", key))
+
+			return
+		}
+	}
+
+	logger.Debug().Msgf("Setting env vars: %s, unsetting: %s",
+		strings.Join(slices.Sorted(maps.Keys(set)), ", "), strings.Join(unset, ", "))
+
+	a.defaults.EnvVars.UpdateUserVars(set, unset)
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func isValidEnvVarKey(key string) bool {
+	return key != "" && !strings.ContainsAny(key, "=\x00")
+}
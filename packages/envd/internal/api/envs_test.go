@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func patchEnvs(t *testing.T, api *API, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPatch, "/envs", strings.NewReader(body))
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	api.PatchEnvs(rec, req)
+
+	return rec
+}
+
+func TestPatchEnvs(t *testing.T) {
+	t.Parallel()
+
+	api := newTestAPI(nil, nil)
+	api.defaults.EnvVars.Store("E2B_SANDBOX", "true")
+	api.defaults.EnvVars.ReplaceUserVars(map[string]string{"OLD": "1", "KEPT": "2"})
+
+	rec := patchEnvs(t, api, `{"set":{"API_KEY":"secret","E2B_SANDBOX":"spoofed"},"unset":["OLD"]}`)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	// The other user vars and the internal ones are kept.
+	want := map[string]string{"API_KEY": "secret", "KEPT": "2", "E2B_SANDBOX": "true"}
+	assert.Equal(t, want, api.defaults.EnvVars.All())
+
+	rec = patchEnvs(t, api, `{"set":{"A=B":"x"}}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = patchEnvs(t, api, `{"unset":[""]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = patchEnvs(t, api, `not json`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	assert.Equal(t, want, api.defaults.EnvVars.All())
+}
@@ -229,6 +229,11 @@ func (a *API) SetData(ctx context.Context, logger zerolog.Logger, data PostInitJ
 		a.defaults.Workdir = data.DefaultWorkdir
 	}
 
+	if data.LogProcessOutput != nil {
+		logger.Debug().Msgf("Setting process output logging to: %t", *data.LogProcessOutput)
+		a.defaults.LogProcessOutput = *data.LogProcessOutput
+	}
+
 	if data.CaBundle != nil && *data.CaBundle != "" {
 		if err := a.caCertInstaller.Install(ctx, *data.CaBundle); err != nil {
 			return fmt.Errorf("failed to install CA bundle: %w", err)
@@ -609,6 +609,10 @@ func (f *fakeCgroupManager) GetFileDescriptor(cgroups.ProcessType) (int, bool) {
 	return 0, false
 }
 
+func (f *fakeCgroupManager) CreateProcessCgroup(cgroups.ProcessType, map[string]string) (cgroups.ProcessCgroup, error) {
+	return nil, cgroups.ErrProcessCgroupsNotSupported
+}
+
 func (f *fakeCgroupManager) Freeze(pt cgroups.ProcessType) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -51,7 +51,51 @@ func extractMetadataHeaders(h http.Header) map[string]string {
 	return metadata
 }
 
-func processFile(r *http.Request, path string, part io.Reader, uid, gid int, metadata map[string]string, logger zerolog.Logger) (int, error) {
+// uploadWrite is how the uploaded content is written to the file.
+type uploadWrite struct {
+	mode   PostFilesParamsMode
+	offset int64
+}
+
+func uploadWriteFromParams(params PostFilesParams) (uploadWrite, error) {
+	write := uploadWrite{mode: Overwrite}
+	if params.Mode != nil {
+		write.mode = *params.Mode
+	}
+
+	if !write.mode.Valid() {
+		return uploadWrite{}, fmt.Errorf("unsupported upload mode '%s'", write.mode)
+	}
+
+	if write.mode != Write {
+		if params.Offset != nil {
+			return uploadWrite{}, fmt.Errorf("offset can't be used with the '%s' upload mode", write.mode)
+		}
+
+		return write, nil
+	}
+
+	if params.Offset == nil || *params.Offset < 0 {
+		return uploadWrite{}, errors.New("non-negative offset is required with the 'write' upload mode")
+	}
+
+	write.offset = *params.Offset
+
+	return write, nil
+}
+
+func (w uploadWrite) openFlags() int {
+	switch w.mode {
+	case Append:
+		return os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	case Write:
+		return os.O_WRONLY | os.O_CREATE
+	default:
+		return os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+}
+
+func processFile(r *http.Request, path string, part io.Reader, uid, gid int, metadata map[string]string, write uploadWrite, logger zerolog.Logger) (int, error) {
 	logger.Debug().
 		Str("path", path).
 		Msg("File processing")
@@ -92,7 +136,7 @@ func processFile(r *http.Request, path string, part io.Reader, uid, gid int, met
 		}
 	}
 
-	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+	file, err := os.OpenFile(path, write.openFlags(), 0o666)
 	if err != nil {
 		if errors.Is(err, syscall.ENOSPC) {
 			err = fmt.Errorf("not enough inodes available: %w", err)
@@ -116,6 +160,15 @@ func processFile(r *http.Request, path string, part io.Reader, uid, gid int, met
 		}
 	}
 
+	if write.mode == Write {
+		_, err = file.Seek(write.offset, io.SeekStart)
+		if err != nil {
+			err = fmt.Errorf("error seeking to offset %d: %w", write.offset, err)
+
+			return http.StatusInternalServerError, err
+		}
+	}
+
 	_, err = file.ReadFrom(part)
 	if err != nil {
 		if errors.Is(err, syscall.ENOSPC) {
@@ -132,10 +185,15 @@ func processFile(r *http.Request, path string, part io.Reader, uid, gid int, met
 		return http.StatusInternalServerError, err
 	}
 
-	// Always (re)write metadata, even with an empty/nil map, so that
-	// overwriting a file replaces its full metadata set: keys absent from
+	// Always (re)write metadata on overwrite, even with an empty/nil map, so
+	// that overwriting a file replaces its full metadata set: keys absent from
 	// this request are cleared (O_TRUNC truncates the body but preserves
-	// xattrs from a prior upload).
+	// xattrs from a prior upload). Partial writes keep the existing metadata
+	// unless new metadata is sent.
+	if write.mode != Overwrite && metadata == nil {
+		return http.StatusNoContent, nil
+	}
+
 	if err := filesystem.WriteMetadata(file, metadata); err != nil {
 		switch {
 		case filesystem.IsXattrUnsupported(err):
@@ -197,7 +255,7 @@ func resolvePath(part *multipart.Part, paths *UploadSuccess, u *user.User, defau
 	return filePath, nil
 }
 
-func (a *API) handlePart(r *http.Request, part *multipart.Part, paths UploadSuccess, u *user.User, uid, gid int, metadata map[string]string, operationID string, params PostFilesParams) (*EntryInfo, int, error) {
+func (a *API) handlePart(r *http.Request, part *multipart.Part, paths UploadSuccess, u *user.User, uid, gid int, metadata map[string]string, write uploadWrite, operationID string, params PostFilesParams) (*EntryInfo, int, error) {
 	defer part.Close()
 
 	if part.FormName() != "file" {
@@ -215,7 +273,7 @@ func (a *API) handlePart(r *http.Request, part *multipart.Part, paths UploadSucc
 		Str("event_type", "file_processing").
 		Logger()
 
-	status, err := processFile(r, filePath, part, uid, gid, metadata, logger)
+	status, err := processFile(r, filePath, part, uid, gid, metadata, write, logger)
 	if err != nil {
 		return nil, status, err
 	}
@@ -323,6 +381,15 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 		return
 	}
 
+	write, err := uploadWriteFromParams(params)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
 	// Use raw body upload only for application/octet-stream, default to multipart for backwards compatibility
 	contentType := r.Header.Get("Content-Type")
 	mediaType, _, _ := mime.ParseMediaType(contentType)
@@ -331,9 +398,9 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 
 	switch {
 	case mediaType == "application/octet-stream":
-		paths, errorCode, errMsg = a.handleRawUpload(r, u, uid, gid, metadata, operationID, params)
+		paths, errorCode, errMsg = a.handleRawUpload(r, u, uid, gid, metadata, write, operationID, params)
 	case strings.HasPrefix(mediaType, "multipart/"):
-		paths, errorCode, errMsg = a.handleMultipartUpload(r, u, uid, gid, metadata, operationID, params)
+		paths, errorCode, errMsg = a.handleMultipartUpload(r, u, uid, gid, metadata, write, operationID, params)
 	default:
 		errorCode = http.StatusBadRequest
 		errMsg = fmt.Errorf("unsupported content type: %s, expected multipart/form-data or application/octet-stream", contentType)
@@ -358,7 +425,7 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 	_, _ = w.Write(data)
 }
 
-func (a *API) handleMultipartUpload(r *http.Request, u *user.User, uid, gid int, metadata map[string]string, operationID string, params PostFilesParams) (UploadSuccess, int, error) {
+func (a *API) handleMultipartUpload(r *http.Request, u *user.User, uid, gid int, metadata map[string]string, write uploadWrite, operationID string, params PostFilesParams) (UploadSuccess, int, error) {
 	f, err := r.MultipartReader()
 	if err != nil {
 		return nil, http.StatusInternalServerError, fmt.Errorf("error parsing multipart form: %w", err)
@@ -375,7 +442,7 @@ func (a *API) handleMultipartUpload(r *http.Request, u *user.User, uid, gid int,
 			return nil, http.StatusInternalServerError, fmt.Errorf("error reading form: %w", partErr)
 		}
 
-		entry, status, err := a.handlePart(r, part, paths, u, uid, gid, metadata, operationID, params)
+		entry, status, err := a.handlePart(r, part, paths, u, uid, gid, metadata, write, operationID, params)
 		if err != nil {
 			return nil, status, err
 		}
@@ -388,7 +455,7 @@ func (a *API) handleMultipartUpload(r *http.Request, u *user.User, uid, gid int,
 	return paths, http.StatusOK, nil
 }
 
-func (a *API) handleRawUpload(r *http.Request, u *user.User, uid, gid int, metadata map[string]string, operationID string, params PostFilesParams) (UploadSuccess, int, error) {
+func (a *API) handleRawUpload(r *http.Request, u *user.User, uid, gid int, metadata map[string]string, write uploadWrite, operationID string, params PostFilesParams) (UploadSuccess, int, error) {
 	if params.Path == nil {
 		return nil, http.StatusBadRequest, errors.New("path query parameter is required for raw body upload")
 	}
@@ -404,7 +471,7 @@ func (a *API) handleRawUpload(r *http.Request, u *user.User, uid, gid int, metad
 		Str("event_type", "file_processing").
 		Logger()
 
-	status, err := processFile(r, filePath, r.Body, uid, gid, metadata, logger)
+	status, err := processFile(r, filePath, r.Body, uid, gid, metadata, write, logger)
 	if err != nil {
 		return nil, status, err
 	}
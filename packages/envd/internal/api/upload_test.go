@@ -36,10 +36,11 @@ func TestProcessFile(t *testing.T) {
 	var emptyReq http.Request
 	var emptyPart *bytes.Buffer
 	var emptyLogger zerolog.Logger
+	overwrite := uploadWrite{mode: Overwrite}
 
 	t.Run("failed to ensure directories", func(t *testing.T) {
 		t.Parallel()
-		httpStatus, err := processFile(&emptyReq, "/proc/invalid/not-real", emptyPart, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(&emptyReq, "/proc/invalid/not-real", emptyPart, uid, gid, nil, overwrite, emptyLogger)
 		require.Error(t, err)
 		assert.Equal(t, http.StatusInternalServerError, httpStatus)
 		assert.ErrorContains(t, err, "error ensuring directories: ")
@@ -49,7 +50,7 @@ func TestProcessFile(t *testing.T) {
 		t.Parallel()
 		tempDir := t.TempDir()
 
-		httpStatus, err := processFile(&emptyReq, tempDir, emptyPart, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(&emptyReq, tempDir, emptyPart, uid, gid, nil, overwrite, emptyLogger)
 		require.Error(t, err)
 		assert.Equal(t, http.StatusBadRequest, httpStatus, err.Error())
 		assert.ErrorContains(t, err, "path is a directory: ")
@@ -61,7 +62,7 @@ func TestProcessFile(t *testing.T) {
 		if runtime.GOOS != "linux" {
 			t.Skip("relies on Linux /proc virtual filesystem semantics")
 		}
-		httpStatus, err := processFile(&emptyReq, "/proc/invalid-filename", emptyPart, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(&emptyReq, "/proc/invalid-filename", emptyPart, uid, gid, nil, overwrite, emptyLogger)
 		require.Error(t, err)
 		assert.Equal(t, http.StatusInternalServerError, httpStatus)
 		assert.ErrorContains(t, err, "error opening file: ")
@@ -92,7 +93,7 @@ func TestProcessFile(t *testing.T) {
 		// try to replace it
 		request, buffer := newRequest(secondFileContents)
 		tempFile2 := filepath.Join(tempDir, "test-file-2")
-		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.Error(t, err)
 		assert.Equal(t, http.StatusInsufficientStorage, httpStatus)
 		assert.ErrorContains(t, err, "attempted to write 2048 bytes: not enough disk space")
@@ -106,7 +107,7 @@ func TestProcessFile(t *testing.T) {
 		content := []byte("test-file-contents")
 		request, buffer := newRequest(content)
 
-		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -132,7 +133,7 @@ func TestProcessFile(t *testing.T) {
 		// try to replace it
 		content := []byte("test-file-contents")
 		request, buffer := newRequest(content)
-		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 	})
@@ -155,7 +156,7 @@ func TestProcessFile(t *testing.T) {
 		tempFile2 := filepath.Join(tempDir, "test-file-2")
 		content := []byte("test-file-contents")
 		request, buffer := newRequest(content)
-		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.ErrorContains(t, err, "not enough disk space available")
 		assert.Equal(t, http.StatusInsufficientStorage, httpStatus)
 	})
@@ -177,7 +178,7 @@ func TestProcessFile(t *testing.T) {
 		tempFile2 := filepath.Join(tempDir, "test-file-2")
 		content := []byte("test-file-contents")
 		request, buffer := newRequest(content)
-		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile2, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.ErrorContains(t, err, "not enough inodes available")
 		assert.Equal(t, http.StatusInsufficientStorage, httpStatus)
 	})
@@ -192,7 +193,7 @@ func TestProcessFile(t *testing.T) {
 		newContent := []byte("102\n")
 		request, buffer := newRequest(newContent)
 
-		httpStatus, err := processFile(request, filePath, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, filePath, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -212,7 +213,7 @@ func TestProcessFile(t *testing.T) {
 		newContent := []byte("new-file-contents")
 		request, buffer := newRequest(newContent)
 
-		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -228,7 +229,7 @@ func TestProcessFile(t *testing.T) {
 
 		initialContent := []byte("old-contents")
 		request, buffer := newRequest(initialContent)
-		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, map[string]string{"purpose": "old"}, emptyLogger)
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, map[string]string{"purpose": "old"}, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -241,7 +242,7 @@ func TestProcessFile(t *testing.T) {
 
 		newContent := []byte("new-contents")
 		request, buffer = newRequest(newContent)
-		httpStatus, err = processFile(request, tempFile, buffer, uid, gid, nil, emptyLogger)
+		httpStatus, err = processFile(request, tempFile, buffer, uid, gid, nil, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -257,7 +258,7 @@ func TestProcessFile(t *testing.T) {
 
 		initialContent := []byte("old-contents")
 		request, buffer := newRequest(initialContent)
-		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, map[string]string{"author": "old", "purpose": "old"}, emptyLogger)
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, map[string]string{"author": "old", "purpose": "old"}, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -270,7 +271,7 @@ func TestProcessFile(t *testing.T) {
 
 		newContent := []byte("new-contents")
 		request, buffer = newRequest(newContent)
-		httpStatus, err = processFile(request, tempFile, buffer, uid, gid, map[string]string{"author": "new"}, emptyLogger)
+		httpStatus, err = processFile(request, tempFile, buffer, uid, gid, map[string]string{"author": "new"}, overwrite, emptyLogger)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNoContent, httpStatus)
 
@@ -278,6 +279,90 @@ func TestProcessFile(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, map[string]string{"author": "new"}, metadata)
 	})
+
+	t.Run("append to file", func(t *testing.T) {
+		t.Parallel()
+		tempFile := filepath.Join(t.TempDir(), "test-file")
+
+		err := os.WriteFile(tempFile, []byte("old-"), 0o644)
+		require.NoError(t, err)
+
+		request, buffer := newRequest([]byte("appended"))
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, nil, uploadWrite{mode: Append}, emptyLogger)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, httpStatus)
+
+		data, err := os.ReadFile(tempFile)
+		require.NoError(t, err)
+		assert.Equal(t, "old-appended", string(data))
+	})
+
+	t.Run("write at offset keeps the rest of the file and its metadata", func(t *testing.T) {
+		t.Parallel()
+		tempFile := filepath.Join(t.TempDir(), "test-file")
+
+		request, buffer := newRequest([]byte("0123456789"))
+		httpStatus, err := processFile(request, tempFile, buffer, uid, gid, map[string]string{"author": "old"}, overwrite, emptyLogger)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, httpStatus)
+
+		request, buffer = newRequest([]byte("abc"))
+		httpStatus, err = processFile(request, tempFile, buffer, uid, gid, nil, uploadWrite{mode: Write, offset: 4}, emptyLogger)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, httpStatus)
+
+		data, err := os.ReadFile(tempFile)
+		require.NoError(t, err)
+		assert.Equal(t, "0123abc789", string(data))
+
+		metadata, err := filesystem.ReadMetadata(tempFile)
+		require.NoError(t, err)
+		if metadata == nil {
+			t.Skip("filesystem does not support xattrs")
+		}
+		assert.Equal(t, map[string]string{"author": "old"}, metadata)
+	})
+}
+
+func TestUploadWriteFromParams(t *testing.T) {
+	t.Parallel()
+
+	offset := int64(10)
+	negative := int64(-1)
+	appendMode := Append
+	writeMode := Write
+	invalidMode := PostFilesParamsMode("invalid")
+
+	tests := []struct {
+		name     string
+		params   PostFilesParams
+		expected uploadWrite
+		wantErr  bool
+	}{
+		{name: "default overwrite", params: PostFilesParams{}, expected: uploadWrite{mode: Overwrite}},
+		{name: "append", params: PostFilesParams{Mode: &appendMode}, expected: uploadWrite{mode: Append}},
+		{name: "write at offset", params: PostFilesParams{Mode: &writeMode, Offset: &offset}, expected: uploadWrite{mode: Write, offset: 10}},
+		{name: "write without offset", params: PostFilesParams{Mode: &writeMode}, wantErr: true},
+		{name: "write at negative offset", params: PostFilesParams{Mode: &writeMode, Offset: &negative}, wantErr: true},
+		{name: "offset without write", params: PostFilesParams{Mode: &appendMode, Offset: &offset}, wantErr: true},
+		{name: "invalid mode", params: PostFilesParams{Mode: &invalidMode}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			write, err := uploadWriteFromParams(tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, write)
+		})
+	}
 }
 
 func createTmpfsMount(t *testing.T, sizeInBytes int) string {
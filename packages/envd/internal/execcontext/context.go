@@ -10,6 +10,8 @@ type Defaults struct {
 	EnvVars *utils.EnvVars
 	User    string
 	Workdir *string
+	// LogProcessOutput ships the output of processes that don't set it explicitly to the sandbox logs.
+	LogProcessOutput bool
 }
 
 func ResolveDefaultWorkdir(workdir string, defaultWorkdir *string) string {
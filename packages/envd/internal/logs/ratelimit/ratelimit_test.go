@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+
+	b := NewBucket(10, 3)
+	now := b.last
+
+	for range 3 {
+		assert.True(t, b.allowAt(now))
+	}
+	assert.False(t, b.allowAt(now))
+
+	// 10 per second refills a token every 100ms.
+	now = now.Add(100 * time.Millisecond)
+	assert.True(t, b.allowAt(now))
+	assert.False(t, b.allowAt(now))
+
+	// The refill is capped at the burst.
+	now = now.Add(time.Minute)
+	for range 3 {
+		assert.True(t, b.allowAt(now))
+	}
+	assert.False(t, b.allowAt(now))
+}
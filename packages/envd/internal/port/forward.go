@@ -1,21 +1,22 @@
 // portf (port forward) periodaically scans opened TCP ports on the 127.0.0.1 (or localhost)
-// and launches `socat` process for every such port in the background.
-// socat forward traffic from `sourceIP`:port to the 127.0.0.1:port.
-
-// WARNING: portf isn't thread safe!
+// and forwards the connections to `sourceIP`:port to the localhost port from envd itself,
+// so any number of ports can be forwarded without a process per port.
 
 package port
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"io"
 	"net"
-	"os/exec"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/rs/zerolog"
-
-	"github.com/e2b-dev/infra/packages/envd/internal/services/cgroups"
+	psnet "github.com/shirou/gopsutil/v4/net"
 )
 
 type PortState string
@@ -25,23 +26,57 @@ const (
 	PortStateDelete  PortState = "DELETE"
 )
 
-var defaultGatewayIP = net.IPv4(169, 254, 0, 21)
+const (
+	forwardDialTimeout = 5 * time.Second
+	// acceptRetryDelay throttles accepting after a failure, e.g. when out of file descriptors.
+	acceptRetryDelay = 50 * time.Millisecond
+	// listenRetryDelay is the delay before listening on a port is retried after the first
+	// failure, doubling with every further failure up to maxListenRetryDelay.
+	listenRetryDelay    = 1 * time.Second
+	maxListenRetryDelay = 1 * time.Minute
+	// halfCloseIdleTimeout bounds how long a connection one side stopped sending on
+	// may stay idle before it's closed.
+	halfCloseIdleTimeout = 5 * time.Minute
+)
+
+// DefaultGatewayIP is the address the forwarded localhost ports listen on.
+var DefaultGatewayIP = net.IPv4(169, 254, 0, 21)
+
+// ForwardStats are the connection counters of a forwarded port. Bytes are
+// counted from the point of view of the clients connecting from outside.
+type ForwardStats struct {
+	ActiveConnections uint32
+	TotalConnections  uint64
+	BytesReceived     uint64
+	BytesSent         uint64
+}
 
 type PortToForward struct {
-	socat *exec.Cmd
-	// Process ID of the process that's listening on port.
-	pid int32
-	// family version of the ip.
+	listener net.Listener
+	// family version of the ip of the first localhost listener seen, tried first when connecting.
 	family uint32
 	state  PortState
 	port   uint32
+
+	// listenFailures and nextListen schedule the retries of a failed listen.
+	listenFailures int
+	nextListen     time.Time
+
+	activeConnections atomic.Uint32
+	totalConnections  atomic.Uint64
+	bytesReceived     atomic.Uint64
+	bytesSent         atomic.Uint64
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
 }
 
 type Forwarder struct {
-	logger        *zerolog.Logger
-	cgroupManager cgroups.Manager
-	// Map of ports that are being currently forwarded.
-	ports             map[string]*PortToForward
+	logger *zerolog.Logger
+	mu     sync.Mutex
+	// Map of ports that are being currently forwarded, by port.
+	ports             map[uint32]*PortToForward
 	scannerSubscriber *ScannerSubscriber
 	sourceIP          net.IP
 }
@@ -49,7 +84,6 @@ type Forwarder struct {
 func NewForwarder(
 	logger *zerolog.Logger,
 	scanner *Scanner,
-	cgroupManager cgroups.Manager,
 ) *Forwarder {
 	scannerSub := scanner.AddSubscriber(
 		logger,
@@ -63,13 +97,30 @@ func NewForwarder(
 
 	return &Forwarder{
 		logger:            logger,
-		sourceIP:          defaultGatewayIP,
-		ports:             make(map[string]*PortToForward),
+		sourceIP:          DefaultGatewayIP,
+		ports:             make(map[uint32]*PortToForward),
 		scannerSubscriber: scannerSub,
-		cgroupManager:     cgroupManager,
 	}
 }
 
+// Stats returns the counters of the port if it's being forwarded.
+func (f *Forwarder) Stats(port uint32) (ForwardStats, bool) {
+	f.mu.Lock()
+	p, ok := f.ports[port]
+	f.mu.Unlock()
+
+	if !ok || p.listener == nil {
+		return ForwardStats{}, false
+	}
+
+	return ForwardStats{
+		ActiveConnections: p.activeConnections.Load(),
+		TotalConnections:  p.totalConnections.Load(),
+		BytesReceived:     p.bytesReceived.Load(),
+		BytesSent:         p.bytesSent.Load(),
+	}, true
+}
+
 func (f *Forwarder) StartForwarding(ctx context.Context) {
 	if f.scannerSubscriber == nil {
 		f.logger.Error().Msg("Cannot start forwarding because scanner subscriber is nil")
@@ -77,6 +128,8 @@ func (f *Forwarder) StartForwarding(ctx context.Context) {
 		return
 	}
 
+	defer f.stopAll()
+
 	for {
 		// Wait for the next scan result or context cancellation.
 		select {
@@ -87,129 +140,277 @@ func (f *Forwarder) StartForwarding(ctx context.Context) {
 				return
 			}
 
-			// Now we are going to refresh all ports that are being forwarded in the `ports` map. Maybe add new ones
-			// and maybe remove some.
+			f.refresh(ctx, procs)
+		}
+	}
+}
 
-			// Go through the ports that are currently being forwarded and set all of them
-			// to the `DELETE` state. We don't know yet if they will be there after refresh.
-			for _, v := range f.ports {
-				v.state = PortStateDelete
-			}
+// refresh starts forwarding the newly opened ports and stops forwarding the closed ones.
+func (f *Forwarder) refresh(ctx context.Context, procs []psnet.ConnectionStat) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-			// Let's refresh our map of currently forwarded ports and mark the currently opened ones with the "FORWARD" state.
-			// This will make sure we won't delete them later.
-			for _, p := range procs {
-				key := fmt.Sprintf("%d-%d", p.Pid, p.Laddr.Port)
-
-				// We check if the opened port is in our map of forwarded ports.
-				val, portOk := f.ports[key]
-				if portOk {
-					// Just mark the port as being forwarded so we don't delete it.
-					// The actual socat process that handles forwarding should be running from the last iteration.
-					val.state = PortStateForward
-				} else {
-					f.logger.Debug().
-						Str("ip", p.Laddr.IP).
-						Uint32("port", p.Laddr.Port).
-						Uint32("family", familyToIPVersion(p.Family)).
-						Str("state", p.Status).
-						Msg("Detected new opened port on localhost that is not forwarded")
-
-					// The opened port wasn't in the map so we create a new PortToForward and start forwarding.
-					ptf := &PortToForward{
-						pid:    p.Pid,
-						port:   p.Laddr.Port,
-						state:  PortStateForward,
-						family: familyToIPVersion(p.Family),
-					}
-					f.ports[key] = ptf
-					f.startPortForwarding(ctx, ptf)
-				}
-			}
+	// Go through the ports that are currently being forwarded and set all of them
+	// to the `DELETE` state. We don't know yet if they will be there after refresh.
+	for _, v := range f.ports {
+		v.state = PortStateDelete
+	}
 
-			// We go through the ports map one more time and stop forwarding all ports
-			// that stayed marked as "DELETE".
-			for _, v := range f.ports {
-				if v.state == PortStateDelete {
-					f.stopPortForwarding(v)
-				}
+	// Let's refresh our map of currently forwarded ports and mark the currently opened ones with the "FORWARD" state.
+	// This will make sure we won't delete them later.
+	for _, p := range procs {
+		// We check if the opened port is in our map of forwarded ports.
+		val, portOk := f.ports[p.Laddr.Port]
+		if portOk {
+			// Just mark the port as being forwarded so we don't delete it.
+			// The listener should be running from the last iteration, unless listening failed.
+			val.state = PortStateForward
+			if val.listener == nil && !time.Now().Before(val.nextListen) {
+				f.startPortForwarding(ctx, val)
 			}
+
+			continue
 		}
+
+		f.logger.Debug().
+			Str("ip", p.Laddr.IP).
+			Uint32("port", p.Laddr.Port).
+			Uint32("family", familyToIPVersion(p.Family)).
+			Str("state", p.Status).
+			Msg("Detected new opened port on localhost that is not forwarded")
+
+		// The opened port wasn't in the map so we create a new PortToForward and start forwarding.
+		ptf := &PortToForward{
+			port:   p.Laddr.Port,
+			state:  PortStateForward,
+			family: familyToIPVersion(p.Family),
+			conns:  make(map[net.Conn]struct{}),
+		}
+		f.ports[ptf.port] = ptf
+		f.startPortForwarding(ctx, ptf)
 	}
-}
 
-func (f *Forwarder) startPortForwarding(ctx context.Context, p *PortToForward) {
-	// https://unix.stackexchange.com/questions/311492/redirect-application-listening-on-localhost-to-listening-on-external-interface
-	// socat -d -d TCP4-LISTEN:4000,bind=169.254.0.21,fork TCP4:localhost:4000
-	// reuseaddr is used to fix the "Address already in use" error when restarting socat quickly.
-	cmd := exec.CommandContext(ctx,
-		"socat", "-d", "-d", "-d",
-		fmt.Sprintf("TCP4-LISTEN:%v,bind=%s,reuseaddr,fork", p.port, f.sourceIP.To4()),
-		fmt.Sprintf("TCP%d:localhost:%v", p.family, p.port),
-	)
+	// We go through the ports map one more time and stop forwarding all ports
+	// that stayed marked as "DELETE".
+	for port, v := range f.ports {
+		if v.state == PortStateDelete {
+			f.stopPortForwarding(v)
+			delete(f.ports, port)
+		}
+	}
+}
 
-	cgroupFD, ok := f.cgroupManager.GetFileDescriptor(cgroups.ProcessTypeSocat)
+func (f *Forwarder) stopAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: true,
+	for port, v := range f.ports {
+		f.stopPortForwarding(v)
+		delete(f.ports, port)
 	}
-	applyCgroupFD(cmd.SysProcAttr, cgroupFD, ok)
+}
 
-	f.logger.Debug().
-		Str("socatCmd", cmd.String()).
-		Int32("pid", p.pid).
+func (f *Forwarder) startPortForwarding(ctx context.Context, p *PortToForward) {
+	address := net.JoinHostPort(f.sourceIP.String(), strconv.Itoa(int(p.port)))
+
+	logger := f.logger.With().
 		Uint32("family", p.family).
-		IPAddr("sourceIP", f.sourceIP.To4()).
+		Str("address", address).
 		Uint32("port", p.port).
-		Msg("About to start port forwarding")
+		Logger()
 
-	if err := cmd.Start(); err != nil {
-		f.logger.
-			Error().
-			Str("socatCmd", cmd.String()).
-			Err(err).
-			Msg("Failed to start port forwarding - failed to start socat")
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp4", address)
+	if err != nil {
+		// The port stays in the map and is retried on a later scan, backing off so a
+		// port that can't be listened on isn't retried on every scan. The shift is
+		// bounded as the delay is capped long before it could overflow.
+		delay := min(listenRetryDelay<<min(p.listenFailures, 6), maxListenRetryDelay)
+		p.listenFailures++
+		p.nextListen = time.Now().Add(delay)
+
+		logger.Error().Err(err).Int("failures", p.listenFailures).Dur("retry_in", delay).Msg("Failed to start port forwarding")
 
 		return
 	}
 
-	go func() {
-		if err := cmd.Wait(); err != nil {
-			f.logger.
-				Debug().
-				Str("socatCmd", cmd.String()).
-				Err(err).
-				Msg("Port forwarding socat process exited")
-		}
-	}()
+	logger.Debug().Msg("Started port forwarding")
 
-	p.socat = cmd
+	p.listener = listener
+	p.wg.Go(func() {
+		p.serve(ctx, &logger)
+	})
 }
 
 func (f *Forwarder) stopPortForwarding(p *PortToForward) {
-	if p.socat == nil {
+	if p.listener == nil {
 		return
 	}
 
-	defer func() { p.socat = nil }()
+	f.logger.Debug().Uint32("port", p.port).Msg("Stopping port forwarding")
 
-	logger := f.logger.With().
-		Str("socatCmd", p.socat.String()).
-		Int32("pid", p.pid).
-		Uint32("family", p.family).
-		IPAddr("sourceIP", f.sourceIP.To4()).
-		Uint32("port", p.port).
-		Logger()
+	p.listener.Close()
+
+	// The forwarded connections can't outlive the closed port.
+	p.mu.Lock()
+	for conn := range p.conns {
+		conn.Close()
+	}
+	p.conns = nil
+	p.mu.Unlock()
+
+	p.wg.Wait()
+
+	f.logger.Debug().Uint32("port", p.port).Msg("Stopped port forwarding")
+}
+
+func (p *PortToForward) serve(ctx context.Context, logger *zerolog.Logger) {
+	for {
+		conn, err := p.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed to accept forwarded connection")
+			time.Sleep(acceptRetryDelay)
 
-	logger.Debug().Msg("Stopping port forwarding")
+			continue
+		}
+
+		if !p.track(conn) {
+			conn.Close()
+
+			return
+		}
+
+		p.wg.Go(func() {
+			defer p.untrack(conn)
+
+			p.forward(ctx, logger, conn)
+		})
+	}
+}
+
+// track registers the connection so it's closed when forwarding stops. It
+// returns false if forwarding has already stopped.
+func (p *PortToForward) track(conn net.Conn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns == nil {
+		return false
+	}
+
+	p.conns[conn] = struct{}{}
 
-	if err := syscall.Kill(-p.socat.Process.Pid, syscall.SIGKILL); err != nil {
-		logger.Error().Err(err).Msg("Failed to kill process group")
+	return true
+}
+
+func (p *PortToForward) untrack(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.conns, conn)
+	conn.Close()
+}
+
+func (p *PortToForward) forward(ctx context.Context, logger *zerolog.Logger, client net.Conn) {
+	p.totalConnections.Add(1)
+	p.activeConnections.Add(1)
+	defer p.activeConnections.Add(^uint32(0))
+
+	target, err := p.dial(ctx)
+	if err != nil {
+		logger.Debug().Err(err).Msg("Failed to connect to the forwarded port")
 
 		return
 	}
 
-	logger.Debug().Msg("Stopped port forwarding")
+	if !p.track(target) {
+		target.Close()
+
+		return
+	}
+	defer p.untrack(target)
+
+	clientConn := &relayConn{Conn: client}
+	targetConn := &relayConn{Conn: target}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		relay(targetConn, clientConn, &p.bytesReceived)
+	})
+	relay(clientConn, targetConn, &p.bytesSent)
+	wg.Wait()
+}
+
+// dial connects to the localhost port, trying the family of the listener seen
+// first and falling back to the other one.
+func (p *PortToForward) dial(ctx context.Context) (net.Conn, error) {
+	hosts := []string{"127.0.0.1", "::1"}
+	if p.family == 6 {
+		hosts = []string{"::1", "127.0.0.1"}
+	}
+
+	dialer := &net.Dialer{Timeout: forwardDialTimeout}
+
+	var errs []error
+	for _, host := range hosts {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(p.port))))
+		if err == nil {
+			return conn, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// relay copies from src to dst until src is done, then half-closes dst so the
+// other direction can finish, as long as it doesn't stay idle.
+func relay(dst, src *relayConn, counter *atomic.Uint64) {
+	_, _ = io.Copy(&countingWriter{w: dst, counter: counter}, src)
+
+	if tcp, ok := dst.Conn.(*net.TCPConn); ok {
+		_ = tcp.CloseWrite()
+		dst.setIdleTimeout()
+	} else {
+		dst.Close()
+	}
+}
+
+// relayConn is a forwarded connection whose reads time out after
+// halfCloseIdleTimeout without data once the connection was half-closed.
+type relayConn struct {
+	net.Conn
+
+	idleTimeout atomic.Bool
+}
+
+func (c *relayConn) Read(b []byte) (int, error) {
+	if c.idleTimeout.Load() {
+		_ = c.SetReadDeadline(time.Now().Add(halfCloseIdleTimeout))
+	}
+
+	return c.Conn.Read(b)
+}
+
+// setIdleTimeout makes the reads time out when idle, including a read already waiting.
+func (c *relayConn) setIdleTimeout() {
+	c.idleTimeout.Store(true)
+	_ = c.SetReadDeadline(time.Now().Add(halfCloseIdleTimeout))
+}
+
+type countingWriter struct {
+	w       io.Writer
+	counter *atomic.Uint64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.counter.Add(uint64(n))
+
+	return n, err
 }
 
 func familyToIPVersion(family uint32) uint32 {
@@ -0,0 +1,153 @@
+package port
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	psnet "github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestForwarder forwards from 127.0.0.2, so the forwarded ports can listen on 127.0.0.1.
+func newTestForwarder() *Forwarder {
+	logger := zerolog.Nop()
+
+	return &Forwarder{
+		logger:   &logger,
+		sourceIP: net.IPv4(127, 0, 0, 2),
+		ports:    make(map[uint32]*PortToForward),
+	}
+}
+
+func listenLocalhost(t *testing.T) (net.Listener, psnet.ConnectionStat) {
+	t.Helper()
+
+	l, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	port := uint32(l.Addr().(*net.TCPAddr).Port)
+
+	return l, psnet.ConnectionStat{Laddr: psnet.Addr{IP: "127.0.0.1", Port: port}, Status: "LISTEN", Family: 2}
+}
+
+func TestForwarder(t *testing.T) {
+	t.Parallel()
+
+	target, conn := listenLocalhost(t)
+
+	// Echo server with a greeting.
+	go func() {
+		for {
+			c, err := target.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer c.Close()
+
+				_, _ = c.Write([]byte("hi "))
+				_, _ = io.Copy(c, c)
+			}()
+		}
+	}()
+
+	f := newTestForwarder()
+	f.refresh(t.Context(), []psnet.ConnectionStat{conn})
+	t.Cleanup(f.stopAll)
+
+	client, err := (&net.Dialer{}).DialContext(t.Context(), "tcp", net.JoinHostPort("127.0.0.2", strconv.Itoa(int(conn.Laddr.Port))))
+	require.NoError(t, err)
+
+	_, err = client.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, client.(*net.TCPConn).CloseWrite())
+
+	data, err := io.ReadAll(client)
+	require.NoError(t, err)
+	assert.Equal(t, "hi ping", string(data))
+	client.Close()
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		stats, ok := f.Stats(conn.Laddr.Port)
+		require.True(c, ok)
+		assert.Equal(c, ForwardStats{ActiveConnections: 0, TotalConnections: 1, BytesReceived: 4, BytesSent: 7}, stats)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The port is no longer listening.
+	f.refresh(t.Context(), nil)
+
+	_, ok := f.Stats(conn.Laddr.Port)
+	assert.False(t, ok)
+	assert.Empty(t, f.ports)
+
+	_, err = (&net.Dialer{}).DialContext(t.Context(), "tcp", net.JoinHostPort("127.0.0.2", strconv.Itoa(int(conn.Laddr.Port))))
+	require.Error(t, err)
+}
+
+func TestForwarderClosesConnectionsOfClosedPort(t *testing.T) {
+	t.Parallel()
+
+	target, conn := listenLocalhost(t)
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, err := target.Accept()
+		if err == nil {
+			accepted <- c
+		}
+	}()
+
+	f := newTestForwarder()
+	f.refresh(t.Context(), []psnet.ConnectionStat{conn})
+
+	client, err := (&net.Dialer{}).DialContext(t.Context(), "tcp", net.JoinHostPort("127.0.0.2", strconv.Itoa(int(conn.Laddr.Port))))
+	require.NoError(t, err)
+	defer client.Close()
+
+	server := <-accepted
+	defer server.Close()
+
+	f.refresh(t.Context(), nil)
+
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = client.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestForwarderRetriesFailedListen(t *testing.T) {
+	t.Parallel()
+
+	_, conn := listenLocalhost(t)
+
+	// The forwarded address is taken, so the first listen fails.
+	address := net.JoinHostPort("127.0.0.2", strconv.Itoa(int(conn.Laddr.Port)))
+	taken, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp4", address)
+	require.NoError(t, err)
+
+	f := newTestForwarder()
+	f.refresh(t.Context(), []psnet.ConnectionStat{conn})
+	t.Cleanup(f.stopAll)
+
+	_, ok := f.Stats(conn.Laddr.Port)
+	assert.False(t, ok)
+
+	// The next scan doesn't retry before the backoff passed.
+	require.NoError(t, taken.Close())
+	f.refresh(t.Context(), []psnet.ConnectionStat{conn})
+
+	_, ok = f.Stats(conn.Laddr.Port)
+	assert.False(t, ok)
+
+	f.ports[conn.Laddr.Port].nextListen = time.Time{}
+	f.refresh(t.Context(), []psnet.ConnectionStat{conn})
+
+	_, ok = f.Stats(conn.Laddr.Port)
+	assert.True(t, ok)
+}
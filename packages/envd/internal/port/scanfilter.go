@@ -17,7 +17,8 @@ func (sf *ScannerFilter) Match(proc *net.ConnectionStat) bool {
 		return false
 	}
 
-	ipMatch := slices.Contains(sf.IPs, proc.Laddr.IP)
+	// No IPs match any IP.
+	ipMatch := len(sf.IPs) == 0 || slices.Contains(sf.IPs, proc.Laddr.IP)
 
 	if ipMatch && sf.State == proc.Status {
 		return true
@@ -8,19 +8,26 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/rs/zerolog"
 	"golang.org/x/sys/unix"
 )
 
 type Cgroup2Manager struct {
 	cgroupFDs   map[ProcessType]int
 	cgroupPaths map[ProcessType]string
+
+	// processCgroupParents are the cgroups per-process cgroups are nested under.
+	processCgroupParents map[ProcessType]string
+	processCgroups       *processCgroupState
 }
 
 var _ Manager = (*Cgroup2Manager)(nil)
 
 type cgroup2Config struct {
-	rootPath     string
-	processTypes map[ProcessType]Cgroup2Config
+	rootPath       string
+	processTypes   map[ProcessType]Cgroup2Config
+	processCgroups map[ProcessType]struct{}
+	logger         *zerolog.Logger
 }
 
 type Cgroup2ManagerOption func(*cgroup2Config)
@@ -40,14 +47,34 @@ func WithCgroup2ProcessType(processType ProcessType, path string, properties map
 	}
 }
 
+// WithCgroup2ProcessCgroups allows creating a cgroup per process of the type,
+// nested under its cgroup, so limits and accounting apply to a single process.
+func WithCgroup2ProcessCgroups(processType ProcessType) Cgroup2ManagerOption {
+	return func(config *cgroup2Config) {
+		if config.processCgroups == nil {
+			config.processCgroups = make(map[ProcessType]struct{})
+		}
+		config.processCgroups[processType] = struct{}{}
+	}
+}
+
+// WithCgroup2Logger sets the logger for cgroup setup problems envd continues without.
+func WithCgroup2Logger(logger *zerolog.Logger) Cgroup2ManagerOption {
+	return func(config *cgroup2Config) {
+		config.logger = logger
+	}
+}
+
 type Cgroup2Config struct {
 	Path       string
 	Properties map[string]string
 }
 
 func NewCgroup2Manager(opts ...Cgroup2ManagerOption) (*Cgroup2Manager, error) {
+	nop := zerolog.Nop()
 	config := cgroup2Config{
 		rootPath: "/sys/fs/cgroup",
+		logger:   &nop,
 	}
 
 	for _, opt := range opts {
@@ -71,7 +98,31 @@ func NewCgroup2Manager(opts ...Cgroup2ManagerOption) (*Cgroup2Manager, error) {
 		return nil, fmt.Errorf("failed to create cgroups: %w", err)
 	}
 
-	return &Cgroup2Manager{cgroupFDs: cgroupFDs, cgroupPaths: cgroupPaths}, nil
+	processCgroupParents := make(map[ProcessType]string)
+	for procType := range config.processCgroups {
+		parentPath, ok := cgroupPaths[procType]
+		if !ok {
+			continue
+		}
+
+		leafFD, err := enableProcessCgroups(config.logger, parentPath)
+		if err != nil {
+			config.logger.Warn().Err(err).Str("process_type", string(procType)).Msg("per-process cgroups unavailable")
+
+			continue
+		}
+
+		_ = unix.Close(cgroupFDs[procType])
+		cgroupFDs[procType] = leafFD
+		processCgroupParents[procType] = parentPath
+	}
+
+	return &Cgroup2Manager{
+		cgroupFDs:            cgroupFDs,
+		cgroupPaths:          cgroupPaths,
+		processCgroupParents: processCgroupParents,
+		processCgroups:       &processCgroupState{},
+	}, nil
 }
 
 func createCgroups(configs cgroup2Config) (map[ProcessType]int, map[ProcessType]string, error) {
@@ -83,7 +134,7 @@ func createCgroups(configs cgroup2Config) (map[ProcessType]int, map[ProcessType]
 
 	for procType, config := range configs.processTypes {
 		fullPath := filepath.Join(configs.rootPath, config.Path)
-		fd, err := createCgroup(fullPath, config.Properties)
+		fd, err := createCgroup(configs.logger, fullPath, config.Properties)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to create %s cgroup: %w", procType, err))
 
@@ -120,7 +171,7 @@ func writeCgroupProp(path, value string) error {
 	return err
 }
 
-func createCgroup(fullPath string, properties map[string]string) (int, error) {
+func createCgroup(logger *zerolog.Logger, fullPath string, properties map[string]string) (int, error) {
 	if err := os.MkdirAll(fullPath, 0o755); err != nil {
 		return -1, fmt.Errorf("failed to create cgroup root: %w", err)
 	}
@@ -130,7 +181,7 @@ func createCgroup(fullPath string, properties map[string]string) (int, error) {
 		if err := writeCgroupProp(filepath.Join(fullPath, name), value); err != nil {
 			// Skip properties whose controller isn't enabled in subtree_control.
 			if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
-				fmt.Fprintf(os.Stderr, "cgroup property %q unavailable at %q, skipping\n", name, fullPath)
+				logger.Warn().Str("property", name).Str("path", fullPath).Msg("cgroup property unavailable, skipping")
 
 				continue
 			}
@@ -150,6 +201,15 @@ func (c Cgroup2Manager) GetFileDescriptor(procType ProcessType) (int, bool) {
 	return fd, ok
 }
 
+func (c Cgroup2Manager) CreateProcessCgroup(procType ProcessType, properties map[string]string) (ProcessCgroup, error) {
+	parentPath, ok := c.processCgroupParents[procType]
+	if !ok {
+		return nil, fmt.Errorf("%w for %s processes", ErrProcessCgroupsNotSupported, procType)
+	}
+
+	return c.processCgroups.create(parentPath, properties)
+}
+
 func (c Cgroup2Manager) Freeze(procType ProcessType) error {
 	return c.setFreezeState(procType, "1")
 }
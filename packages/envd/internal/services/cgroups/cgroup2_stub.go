@@ -2,6 +2,8 @@
 
 package cgroups
 
+import "github.com/rs/zerolog"
+
 // Cgroup2Manager is a non-functional stub on non-Linux platforms.
 // cgroups v2 is a Linux-only kernel feature.
 type Cgroup2Manager struct{}
@@ -43,6 +45,14 @@ func (c Cgroup2Manager) GetFileDescriptor(ProcessType) (int, bool) {
 	return 0, false
 }
 
+func WithCgroup2ProcessCgroups(ProcessType) Cgroup2ManagerOption {
+	return func(*cgroup2Config) {}
+}
+
+func (c Cgroup2Manager) CreateProcessCgroup(ProcessType, map[string]string) (ProcessCgroup, error) {
+	return nil, ErrProcessCgroupsNotSupported
+}
+
 func (c Cgroup2Manager) Freeze(ProcessType) error {
 	return nil
 }
@@ -54,3 +64,7 @@ func (c Cgroup2Manager) Unfreeze(ProcessType) error {
 func (c Cgroup2Manager) Close() error {
 	return nil
 }
+
+func WithCgroup2Logger(*zerolog.Logger) Cgroup2ManagerOption {
+	return func(*cgroup2Config) {}
+}
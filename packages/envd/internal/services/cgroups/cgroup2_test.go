@@ -110,7 +110,7 @@ func TestCgroupRoundTrip(t *testing.T) {
 
 		// create manager
 		m, err := NewCgroup2Manager(
-			WithCgroup2ProcessType(ProcessTypeSocat, cgroupPath, map[string]string{
+			WithCgroup2ProcessType(ProcessTypePTY, cgroupPath, map[string]string{
 				"memory.max": strconv.Itoa(1 * kilobyte),
 			}),
 		)
@@ -122,7 +122,7 @@ func TestCgroupRoundTrip(t *testing.T) {
 		})
 
 		// create new child process
-		cmd := startProcess(t, m, ProcessTypeSocat)
+		cmd := startProcess(t, m, ProcessTypePTY)
 
 		// wait for child process to die
 		err = waitForProcess(t, cmd, maxTimeout)
@@ -1,18 +1,45 @@
 package cgroups
 
+import (
+	"errors"
+	"time"
+)
+
 type ProcessType string
 
 const (
-	ProcessTypePTY   ProcessType = "pty"
-	ProcessTypeUser  ProcessType = "user"
-	ProcessTypeSocat ProcessType = "socat"
+	ProcessTypePTY  ProcessType = "pty"
+	ProcessTypeUser ProcessType = "user"
 	// ProcessTypeSystem stays in envd's root cgroup so it's unaffected by freeze.
 	ProcessTypeSystem ProcessType = "system"
 )
 
+// ErrProcessCgroupsNotSupported is returned by CreateProcessCgroup when the
+// process type has no per-process cgroups enabled.
+var ErrProcessCgroupsNotSupported = errors.New("per-process cgroups are not supported")
+
 type Manager interface {
 	GetFileDescriptor(procType ProcessType) (int, bool)
+	// CreateProcessCgroup creates a cgroup for a single process, nested under
+	// the procType cgroup, with the given properties written to it.
+	CreateProcessCgroup(procType ProcessType, properties map[string]string) (ProcessCgroup, error)
 	Freeze(procType ProcessType) error
 	Unfreeze(procType ProcessType) error
 	Close() error
 }
+
+// ProcessCgroup is a cgroup holding a single started process and its descendants.
+type ProcessCgroup interface {
+	GetFileDescriptor() int
+	Stats() (Stats, error)
+	Close() error
+}
+
+// Stats is the resource usage accounted to a process cgroup. Values of
+// controllers not enabled for the cgroup are zero.
+type Stats struct {
+	CPUUsage      time.Duration
+	MemoryCurrent uint64
+	MemoryPeak    uint64
+	OOMKills      uint64
+}
@@ -12,6 +12,10 @@ func (n NoopManager) GetFileDescriptor(ProcessType) (int, bool) {
 	return 0, false
 }
 
+func (n NoopManager) CreateProcessCgroup(ProcessType, map[string]string) (ProcessCgroup, error) {
+	return nil, ErrProcessCgroupsNotSupported
+}
+
 func (n NoopManager) Freeze(ProcessType) error {
 	return nil
 }
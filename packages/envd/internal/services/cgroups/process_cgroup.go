@@ -0,0 +1,220 @@
+//go:build linux
+
+package cgroups
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// defaultLeafName is the cgroup processes without their own cgroup are started in.
+	defaultLeafName = "default"
+
+	maxProcessCgroupAttempts = 16
+)
+
+// processControllers are delegated to per-process cgroups when available.
+var processControllers = []string{"cpu", "memory", "pids"}
+
+// enableProcessCgroups prepares parentPath for per-process cgroups. A cgroup
+// delegating controllers to its children can't hold processes itself, so a
+// default leaf is created for the processes started without their own cgroup.
+func enableProcessCgroups(logger *zerolog.Logger, parentPath string) (int, error) {
+	leafFD, err := createCgroup(logger, filepath.Join(parentPath, defaultLeafName), nil)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create default cgroup: %w", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(parentPath, "cgroup.controllers"))
+	if err != nil {
+		_ = unix.Close(leafFD)
+
+		return -1, fmt.Errorf("failed to read available controllers: %w", err)
+	}
+	available := strings.Fields(string(data))
+
+	for _, controller := range processControllers {
+		if !slices.Contains(available, controller) {
+			logger.Warn().Str("controller", controller).Str("path", parentPath).Msg("cgroup controller unavailable, skipping")
+
+			continue
+		}
+
+		// Fails with EBUSY when processes were already started directly in the parent,
+		// the per-process cgroups then only provide CPU accounting.
+		if err := writeCgroupProp(filepath.Join(parentPath, "cgroup.subtree_control"), "+"+controller); err != nil {
+			logger.Warn().Err(err).Str("controller", controller).Str("path", parentPath).Msg("failed to enable cgroup controller")
+		}
+	}
+
+	return leafFD, nil
+}
+
+type processCgroupState struct {
+	seq atomic.Uint64
+
+	mu sync.Mutex
+	// released are cgroups of exited processes kept alive by their background
+	// descendants, removed once those exit too.
+	released []string
+}
+
+func (s *processCgroupState) create(parentPath string, properties map[string]string) (*processCgroup, error) {
+	s.removeReleased()
+
+	for range maxProcessCgroupAttempts {
+		fullPath := filepath.Join(parentPath, fmt.Sprintf("process-%d", s.seq.Add(1)))
+
+		err := os.Mkdir(fullPath, 0o755)
+		if errors.Is(err, os.ErrExist) {
+			// Left behind by a previous envd instance.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create process cgroup: %w", err)
+		}
+
+		for name, value := range properties {
+			if err := writeCgroupProp(filepath.Join(fullPath, name), value); err != nil {
+				_ = unix.Rmdir(fullPath)
+
+				return nil, fmt.Errorf("failed to write cgroup property %q: %w", name, err)
+			}
+		}
+
+		fd, err := unix.Open(fullPath, unix.O_RDONLY, 0)
+		if err != nil {
+			_ = unix.Rmdir(fullPath)
+
+			return nil, fmt.Errorf("failed to open process cgroup: %w", err)
+		}
+
+		return &processCgroup{path: fullPath, fd: fd, state: s}, nil
+	}
+
+	return nil, fmt.Errorf("failed to find a free process cgroup name under %q", parentPath)
+}
+
+func (s *processCgroupState) release(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.released = append(s.released, path)
+}
+
+func (s *processCgroupState) removeReleased() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.released = slices.DeleteFunc(s.released, func(path string) bool {
+		return !errors.Is(unix.Rmdir(path), unix.EBUSY)
+	})
+}
+
+type processCgroup struct {
+	path  string
+	fd    int
+	state *processCgroupState
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ ProcessCgroup = (*processCgroup)(nil)
+
+func (p *processCgroup) GetFileDescriptor() int {
+	return p.fd
+}
+
+func (p *processCgroup) Stats() (Stats, error) {
+	// cpu.stat is always present, the other files only with their controller enabled.
+	usage, err := readCgroupKeyedUint(filepath.Join(p.path, "cpu.stat"), "usage_usec")
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{CPUUsage: time.Duration(usage) * time.Microsecond}
+
+	if stats.MemoryCurrent, err = ignoreNotExist(readCgroupUint(filepath.Join(p.path, "memory.current"))); err != nil {
+		return Stats{}, err
+	}
+
+	if stats.MemoryPeak, err = ignoreNotExist(readCgroupUint(filepath.Join(p.path, "memory.peak"))); err != nil {
+		return Stats{}, err
+	}
+
+	if stats.OOMKills, err = ignoreNotExist(readCgroupKeyedUint(filepath.Join(p.path, "memory.events"), "oom_kill")); err != nil {
+		return Stats{}, err
+	}
+
+	return stats, nil
+}
+
+// Close removes the cgroup. Processes left running in the background keep it,
+// and its limits, alive until they exit.
+func (p *processCgroup) Close() error {
+	p.closeOnce.Do(func() {
+		p.closeErr = unix.Close(p.fd)
+
+		if err := unix.Rmdir(p.path); errors.Is(err, unix.EBUSY) {
+			p.state.release(p.path)
+		} else if err != nil {
+			p.closeErr = errors.Join(p.closeErr, fmt.Errorf("failed to remove process cgroup: %w", err))
+		}
+	})
+
+	return p.closeErr
+}
+
+func readCgroupUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// readCgroupKeyedUint reads a value from a flat keyed file like cpu.stat.
+func readCgroupKeyedUint(path, key string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), " ")
+		if ok && name == key {
+			return strconv.ParseUint(value, 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("key %q not found in %s", key, path)
+}
+
+func ignoreNotExist(value uint64, err error) (uint64, error) {
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	return value, err
+}
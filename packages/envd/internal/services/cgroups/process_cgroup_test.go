@@ -0,0 +1,102 @@
+//go:build linux
+
+package cgroups
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessCgroup_Stats(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	p := &processCgroup{path: dir}
+
+	// cpu.stat is required.
+	_, err := p.Stats()
+	require.Error(t, err)
+
+	// Without the memory controller only CPU time is accounted.
+	write("cpu.stat", "usage_usec 1500\nuser_usec 1000\nsystem_usec 500\n")
+	stats, err := p.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, Stats{CPUUsage: 1500 * time.Microsecond}, stats)
+
+	write("memory.current", "4096\n")
+	write("memory.peak", "8192\n")
+	write("memory.events", "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\noom_group_kill 0\n")
+	stats, err = p.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, Stats{CPUUsage: 1500 * time.Microsecond, MemoryCurrent: 4096, MemoryPeak: 8192, OOMKills: 1}, stats)
+}
+
+func TestProcessCgroup_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	if os.Geteuid() != 0 {
+		t.Skip("must run as root")
+
+		return
+	}
+
+	cgroupPath := createCgroupPath(t, "process-cgroups")
+
+	m, err := NewCgroup2Manager(
+		WithCgroup2ProcessType(ProcessTypeUser, cgroupPath, map[string]string{}),
+		WithCgroup2ProcessCgroups(ProcessTypeUser),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, m.Close())
+		_ = os.Remove(filepath.Join(m.cgroupPaths[ProcessTypeUser], defaultLeafName))
+		_ = os.Remove(m.cgroupPaths[ProcessTypeUser])
+	})
+
+	// Processes without their own cgroup go to the default leaf.
+	_, ok := m.GetFileDescriptor(ProcessTypeUser)
+	require.True(t, ok)
+	assert.DirExists(t, filepath.Join(m.cgroupPaths[ProcessTypeUser], defaultLeafName))
+
+	_, err = m.CreateProcessCgroup(ProcessTypePTY, nil)
+	require.ErrorIs(t, err, ErrProcessCgroupsNotSupported)
+
+	cgroup, err := m.CreateProcessCgroup(ProcessTypeUser, map[string]string{
+		"memory.max": strconv.Itoa(16 * megabyte),
+	})
+	require.NoError(t, err)
+
+	pc, ok := cgroup.(*processCgroup)
+	require.True(t, ok)
+	assert.Equal(t, m.cgroupPaths[ProcessTypeUser], filepath.Dir(pc.path))
+
+	memoryMax, err := os.ReadFile(filepath.Join(pc.path, "memory.max"))
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(16*megabyte)+"\n", string(memoryMax))
+
+	// Allocating above the limit gets the process OOM killed.
+	cmd := exec.CommandContext(t.Context(), "bash", "-c", `exec perl -e 'my $x = "A" x (64*1024*1024); sleep 1'`)
+	cmd.SysProcAttr = &syscall.SysProcAttr{UseCgroupFD: true, CgroupFD: cgroup.GetFileDescriptor()}
+	require.Error(t, cmd.Run())
+
+	stats, err := cgroup.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.OOMKills)
+	assert.NotZero(t, stats.MemoryPeak)
+
+	require.NoError(t, cgroup.Close())
+	assert.NoDirExists(t, pc.path)
+}
@@ -0,0 +1,273 @@
+package filesystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+const (
+	// maxChecksumFiles caps the files a single Checksum or DiffManifest call hashes.
+	maxChecksumFiles = 100_000
+
+	maxChecksumCacheEntries = 100_000
+	// checksumCacheMinAge is how old a modification has to be for its checksum
+	// to be cached, so a write within the mtime granularity isn't missed.
+	checksumCacheMinAge = time.Second
+)
+
+// checksumKey identifies a version of a file without reading it.
+type checksumKey struct {
+	dev, ino uint64
+	size     int64
+	mtime    int64
+}
+
+// checksumCache caches the checksums of files by their inode and modification time.
+type checksumCache struct {
+	mu      sync.Mutex
+	entries map[checksumKey]string
+}
+
+func newChecksumCache() *checksumCache {
+	return &checksumCache{entries: make(map[checksumKey]string)}
+}
+
+func newChecksumKey(info os.FileInfo) (checksumKey, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return checksumKey{}, false
+	}
+
+	return checksumKey{
+		dev:   uint64(stat.Dev), //nolint:unconvert // int32 on darwin
+		ino:   stat.Ino,
+		size:  info.Size(),
+		mtime: info.ModTime().UnixNano(),
+	}, true
+}
+
+func (c *checksumCache) get(key checksumKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sum, ok := c.entries[key]
+
+	return sum, ok
+}
+
+func (c *checksumCache) put(key checksumKey, sum string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxChecksumCacheEntries {
+		// Evict an arbitrary entry, the map iteration order is random.
+		for k := range c.entries {
+			delete(c.entries, k)
+
+			break
+		}
+	}
+
+	c.entries[key] = sum
+}
+
+// file returns the hex-encoded SHA-256 of the regular file and its info.
+func (c *checksumCache) file(path string) (string, os.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", nil, fmt.Errorf("not a regular file: %s", path)
+	}
+
+	key, cacheable := newChecksumKey(info)
+	if cacheable {
+		if sum, ok := c.get(key); ok {
+			return sum, info, nil
+		}
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", nil, err
+	}
+
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	// Don't cache files that changed while being read or could still change within the same mtime.
+	after, err := f.Stat()
+	if err == nil && cacheable && time.Since(info.ModTime()) > checksumCacheMinAge {
+		if afterKey, ok := newChecksumKey(after); ok && afterKey == key {
+			c.put(key, sum)
+		}
+	}
+
+	return sum, info, nil
+}
+
+// checksumFiles hashes the files in parallel. Files that don't exist anymore are
+// returned as nil.
+func (c *checksumCache) checksumFiles(ctx context.Context, paths []string) ([]*rpc.FileChecksum, error) {
+	checksums := make([]*rpc.FileChecksum, len(paths))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
+
+	for i, path := range paths {
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			sum, info, err := c.file(path)
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("error computing checksum of %s: %w", path, err)
+			}
+
+			checksums[i] = &rpc.FileChecksum{
+				Size:         info.Size(),
+				ModifiedTime: toTimestamp(info.ModTime()),
+				Sha256:       sum,
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+func (s Service) Checksum(ctx context.Context, req *connect.Request[rpc.ChecksumRequest]) (*connect.Response[rpc.ChecksumResponse], error) {
+	u, err := permissions.GetAuthUser(ctx, s.defaults.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateGlobs(req.Msg.GetExclude()...); err != nil {
+		return nil, err
+	}
+
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u, s.defaults.Workdir)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolvedPath, err := followSymlink(requestedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(resolvedPath)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	var paths, rels []string
+	var truncated bool
+
+	switch {
+	case info.IsDir():
+		walker := &searchWalker{
+			root:             resolvedPath,
+			maxDepth:         int(req.Msg.GetMaxDepth()),
+			respectGitignore: req.Msg.GetRespectGitignore(),
+		}
+
+		paths, rels, err = walkFiles(ctx, walker, req.Msg.GetExclude(), nil)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error walking directory %s: %w", resolvedPath, err))
+		}
+
+		truncated = walker.truncated
+	case info.Mode().IsRegular():
+		paths, rels = []string{resolvedPath}, []string{filepath.Base(requestedPath)}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is neither a directory nor a regular file: %s", requestedPath))
+	}
+
+	checksums, err := s.checksums.checksumFiles(ctx, paths)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	files := make([]*rpc.FileChecksum, 0, len(checksums))
+	for i, checksum := range checksums {
+		if checksum == nil {
+			continue
+		}
+
+		checksum.Path = filepath.ToSlash(rels[i])
+		files = append(files, checksum)
+	}
+
+	return connect.NewResponse(&rpc.ChecksumResponse{
+		Files:     files,
+		Truncated: truncated,
+	}), nil
+}
+
+// walkFiles returns the absolute and relative paths of the regular files the
+// walker visits, skipping the excluded ones and the ones for which skip returns
+// true. It stops after maxChecksumFiles files.
+func walkFiles(ctx context.Context, walker *searchWalker, exclude []string, skip func(rel string) bool) (paths, rels []string, err error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, searchTimeout, errSearchTimeout)
+	defer cancel()
+
+	err = walker.walk(ctx, func(path, rel string, d fs.DirEntry) error {
+		if slices.ContainsFunc(exclude, func(glob string) bool { return matchGlob(glob, rel) }) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !d.Type().IsRegular() || (skip != nil && skip(rel)) {
+			return nil
+		}
+
+		if len(paths) == maxChecksumFiles {
+			return errStopSearch
+		}
+
+		paths = append(paths, path)
+		rels = append(rels, rel)
+
+		return nil
+	})
+
+	return paths, rels, err
+}
@@ -0,0 +1,149 @@
+package filesystem
+
+import (
+	"maps"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func checksumPaths(files []*filesystem.FileChecksum) map[string]string {
+	sums := make(map[string]string, len(files))
+	for _, file := range files {
+		sums[file.GetPath()] = file.GetSha256()
+	}
+
+	return sums
+}
+
+func TestChecksum(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	root := setupSearchTree(t)
+	svc := mockService()
+	ctx := authn.SetInfo(t.Context(), u)
+
+	resp, err := svc.Checksum(ctx, connect.NewRequest(&filesystem.ChecksumRequest{
+		Path:             root,
+		Exclude:          []string{"src/pkg"},
+		RespectGitignore: true,
+	}))
+	require.NoError(t, err)
+	assert.False(t, resp.Msg.GetTruncated())
+
+	readme, err := os.ReadFile(filepath.Join(root, "src", "README.md"))
+	require.NoError(t, err)
+
+	sums := checksumPaths(resp.Msg.GetFiles())
+	assert.ElementsMatch(t, []string{".gitignore", "main.go", "src/README.md"}, slices.Collect(maps.Keys(sums)))
+	assert.Equal(t, sha256Hex(readme), sums["src/README.md"])
+
+	resp, err = svc.Checksum(ctx, connect.NewRequest(&filesystem.ChecksumRequest{
+		Path: filepath.Join(root, "src", "README.md"),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetFiles(), 1)
+	assert.Equal(t, "README.md", resp.Msg.GetFiles()[0].GetPath())
+	assert.Equal(t, int64(len(readme)), resp.Msg.GetFiles()[0].GetSize())
+	assert.Equal(t, sha256Hex(readme), resp.Msg.GetFiles()[0].GetSha256())
+}
+
+func TestChecksumCache(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o644))
+
+	mtime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	cache := newChecksumCache()
+	sum, _, err := cache.file(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("old")), sum)
+	assert.Len(t, cache.entries, 1)
+
+	// Rewriting the file in place with the same size and mtime hits the cache.
+	require.NoError(t, os.WriteFile(path, []byte("new"), 0o644))
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	sum, _, err = cache.file(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("old")), sum)
+
+	// A changed mtime is a cache miss.
+	require.NoError(t, os.Chtimes(path, mtime.Add(time.Second), mtime.Add(time.Second)))
+
+	sum, _, err = cache.file(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("new")), sum)
+
+	// Recently modified files aren't cached.
+	require.NoError(t, os.WriteFile(path, []byte("newer"), 0o644))
+
+	_, _, err = cache.file(path)
+	require.NoError(t, err)
+	assert.Len(t, cache.entries, 2)
+}
+
+func TestDiffManifest(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	root := setupSearchTree(t)
+	svc := mockService()
+	ctx := authn.SetInfo(t.Context(), u)
+
+	mainGo, err := os.ReadFile(filepath.Join(root, "main.go"))
+	require.NoError(t, err)
+
+	resp, err := svc.DiffManifest(ctx, connect.NewRequest(&filesystem.DiffManifestRequest{
+		Path: root,
+		Entries: []*filesystem.ManifestEntry{
+			{Path: "main.go", Sha256: sha256Hex(mainGo)},
+			{Path: "src/README.md", Sha256: sha256Hex([]byte("outdated\n"))},
+			{Path: "src/pkg/lib.go", Sha256: sha256Hex([]byte("x")), Size: new(int64(1))},
+			{Path: "src", Sha256: sha256Hex([]byte("x"))},
+			{Path: "new/file.txt", Sha256: sha256Hex([]byte("x"))},
+			{Path: "main.go/nested", Sha256: sha256Hex([]byte("x"))},
+		},
+		Exclude:          []string{".gitignore"},
+		RespectGitignore: true,
+	}))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"new/file.txt", "main.go/nested"}, resp.Msg.GetMissing())
+	assert.ElementsMatch(t, []string{"src/README.md", "src/pkg/lib.go", "src"}, resp.Msg.GetChanged())
+	assert.Empty(t, resp.Msg.GetExtra())
+	assert.False(t, resp.Msg.GetTruncated())
+
+	resp, err = svc.DiffManifest(ctx, connect.NewRequest(&filesystem.DiffManifestRequest{
+		Path:    root,
+		Entries: []*filesystem.ManifestEntry{{Path: "main.go", Sha256: sha256Hex(mainGo)}},
+	}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.GetMissing())
+	assert.Empty(t, resp.Msg.GetChanged())
+	assert.ElementsMatch(t, []string{".gitignore", ".git/config", "build/out.go", "data.bin", "src/README.md", "src/pkg/lib.go"}, resp.Msg.GetExtra())
+
+	_, err = svc.DiffManifest(ctx, connect.NewRequest(&filesystem.DiffManifestRequest{
+		Path:    root,
+		Entries: []*filesystem.ManifestEntry{{Path: "../outside"}},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,239 @@
+package filesystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+	"github.com/e2b-dev/infra/packages/shared/pkg/filesystem"
+)
+
+// maxEditFileSize caps the files EditFile loads into memory.
+const maxEditFileSize = 100 << 20 // 100 MiB
+
+func (s Service) EditFile(ctx context.Context, req *connect.Request[rpc.EditFileRequest]) (*connect.Response[rpc.EditFileResponse], error) {
+	u, err := permissions.GetAuthUser(ctx, s.defaults.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.GetEdits()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no edits specified"))
+	}
+
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u, s.defaults.Workdir)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Edit the target of a symlink instead of replacing the symlink.
+	path, err := followSymlink(requestedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Edits are serialized, so the precondition holds until the edited file replaces the original.
+	s.editMu.Lock()
+	defer s.editMu.Unlock()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is not a regular file: %s", path))
+	}
+
+	if info.Size() > maxEditFileSize {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("file is larger than %d bytes", maxEditFileSize))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error reading file: %w", err))
+	}
+
+	if expected := req.Msg.GetExpectedModifiedTime(); expected != nil && !info.ModTime().Equal(expected.AsTime()) {
+		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("file was modified at %s, expected %s", info.ModTime().UTC(), expected.AsTime()))
+	}
+
+	if req.Msg.ExpectedSha256 != nil && !strings.EqualFold(sha256Hex(data), req.Msg.GetExpectedSha256()) {
+		return nil, connect.NewError(connect.CodeAborted, errors.New("file content doesn't match the expected sha256"))
+	}
+
+	content, err := applyTextEdits(string(data), req.Msg.GetEdits())
+	if err != nil {
+		return nil, err
+	}
+
+	err = replaceFile(path, info, []byte(content))
+	if err != nil {
+		if errors.Is(err, syscall.ENOSPC) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("not enough disk space available"))
+		}
+
+		if _, ok := errors.AsType[*connect.Error](err); ok {
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error writing file: %w", err))
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the requested path instead of the symlink-resolved path
+	entry.Path = requestedPath
+
+	return connect.NewResponse(&rpc.EditFileResponse{
+		Entry:  entry,
+		Sha256: sha256Hex([]byte(content)),
+	}), nil
+}
+
+// replaceFile atomically replaces the file with the content, keeping its
+// mode, ownership and metadata. It fails if the file changed since original
+// was stat-ed.
+func replaceFile(path string, original os.FileInfo, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".e2b-edit-*")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+	defer tmp.Close()
+
+	if _, err := tmp.Write(content); err != nil {
+		return err
+	}
+
+	if err := tmp.Chmod(original.Mode().Perm()); err != nil {
+		return err
+	}
+
+	if stat, ok := original.Sys().(*syscall.Stat_t); ok {
+		if err := tmp.Chown(int(stat.Uid), int(stat.Gid)); err != nil {
+			return err
+		}
+	}
+
+	metadata, err := filesystem.ReadMetadata(path)
+	if err != nil {
+		return fmt.Errorf("error reading file metadata: %w", err)
+	}
+
+	if metadata != nil {
+		if err := filesystem.WriteMetadata(tmp, metadata); err != nil && !filesystem.IsXattrUnsupported(err) {
+			return fmt.Errorf("error writing file metadata: %w", err)
+		}
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// Catch writes from outside of EditFile, e.g. uploads or processes in the sandbox.
+	current, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !os.SameFile(original, current) || !current.ModTime().Equal(original.ModTime()) || current.Size() != original.Size() {
+		return connect.NewError(connect.CodeAborted, errors.New("file was modified during the edit"))
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// applyTextEdits applies the edits in order, each to the result of the previous one.
+func applyTextEdits(content string, edits []*rpc.TextEdit) (string, error) {
+	for i, edit := range edits {
+		var err error
+
+		switch e := edit.GetEdit().(type) {
+		case *rpc.TextEdit_SearchReplace_:
+			content, err = applySearchReplace(content, e.SearchReplace)
+		case *rpc.TextEdit_LineRange:
+			content, err = applyLineRange(content, e.LineRange)
+		default:
+			err = connect.NewError(connect.CodeInvalidArgument, errors.New("edit type not specified"))
+		}
+
+		if err != nil {
+			if connectErr, ok := errors.AsType[*connect.Error](err); ok {
+				return "", connect.NewError(connectErr.Code(), fmt.Errorf("edit %d: %w", i, connectErr.Unwrap()))
+			}
+
+			return "", err
+		}
+	}
+
+	return content, nil
+}
+
+func applySearchReplace(content string, edit *rpc.TextEdit_SearchReplace) (string, error) {
+	if edit.GetSearch() == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("search text is empty"))
+	}
+
+	count := strings.Count(content, edit.GetSearch())
+	switch {
+	case count == 0:
+		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("search text not found"))
+	case count > 1 && !edit.GetReplaceAll():
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("search text found %d times, expected exactly once", count))
+	}
+
+	return strings.ReplaceAll(content, edit.GetSearch(), edit.GetReplace()), nil
+}
+
+func applyLineRange(content string, edit *rpc.TextEdit_LineRangeReplace) (string, error) {
+	start, end := int(edit.GetStartLine()), int(edit.GetEndLine())
+	if start < 1 || end < start-1 {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid line range %d-%d", start, end))
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if start > len(lines)+1 || end > len(lines) {
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("line range %d-%d is outside of the file with %d lines", start, end, len(lines)))
+	}
+
+	text := edit.GetText()
+	if text != "" {
+		// Keep the lines separated, and the file's trailing newline.
+		if !strings.HasSuffix(text, "\n") && (end < len(lines) || strings.HasSuffix(content, "\n")) {
+			text += "\n"
+		}
+
+		// Appending after a last line without a newline.
+		if start > 1 && !strings.HasSuffix(lines[start-2], "\n") {
+			text = "\n" + text
+		}
+	}
+
+	return strings.Join(lines[:start-1], "") + text + strings.Join(lines[end:], ""), nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,195 @@
+package filesystem
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func searchReplace(search, replace string, all bool) *filesystem.TextEdit {
+	return &filesystem.TextEdit{Edit: &filesystem.TextEdit_SearchReplace_{
+		SearchReplace: &filesystem.TextEdit_SearchReplace{Search: search, Replace: replace, ReplaceAll: all},
+	}}
+}
+
+func lineRange(start, end uint32, text string) *filesystem.TextEdit {
+	return &filesystem.TextEdit{Edit: &filesystem.TextEdit_LineRange{
+		LineRange: &filesystem.TextEdit_LineRangeReplace{StartLine: start, EndLine: end, Text: text},
+	}}
+}
+
+func TestApplyTextEdits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		edits    []*filesystem.TextEdit
+		expected string
+		code     connect.Code
+	}{
+		{
+			name:     "search replace",
+			content:  "foo bar\n",
+			edits:    []*filesystem.TextEdit{searchReplace("bar", "baz", false)},
+			expected: "foo baz\n",
+		},
+		{
+			name:    "ambiguous search",
+			content: "a a\n",
+			edits:   []*filesystem.TextEdit{searchReplace("a", "b", false)},
+			code:    connect.CodeFailedPrecondition,
+		},
+		{
+			name:     "replace all",
+			content:  "a a\n",
+			edits:    []*filesystem.TextEdit{searchReplace("a", "b", true)},
+			expected: "b b\n",
+		},
+		{
+			name:    "search not found",
+			content: "a\n",
+			edits:   []*filesystem.TextEdit{searchReplace("x", "y", false)},
+			code:    connect.CodeFailedPrecondition,
+		},
+		{
+			name:     "replace lines",
+			content:  "1\n2\n3\n4\n",
+			edits:    []*filesystem.TextEdit{lineRange(2, 3, "two and three")},
+			expected: "1\ntwo and three\n4\n",
+		},
+		{
+			name:     "delete lines",
+			content:  "1\n2\n3\n",
+			edits:    []*filesystem.TextEdit{lineRange(2, 2, "")},
+			expected: "1\n3\n",
+		},
+		{
+			name:     "insert before line",
+			content:  "1\n2\n",
+			edits:    []*filesystem.TextEdit{lineRange(2, 1, "1.5\n")},
+			expected: "1\n1.5\n2\n",
+		},
+		{
+			name:     "append after last line without newline",
+			content:  "1\n2",
+			edits:    []*filesystem.TextEdit{lineRange(3, 2, "3\n")},
+			expected: "1\n2\n3\n",
+		},
+		{
+			name:    "line range outside of the file",
+			content: "1\n",
+			edits:   []*filesystem.TextEdit{lineRange(2, 3, "x")},
+			code:    connect.CodeFailedPrecondition,
+		},
+		{
+			name:    "invalid line range",
+			content: "1\n",
+			edits:   []*filesystem.TextEdit{lineRange(0, 1, "x")},
+			code:    connect.CodeInvalidArgument,
+		},
+		{
+			name:     "edits apply in order",
+			content:  "a\nb\n",
+			edits:    []*filesystem.TextEdit{searchReplace("a", "c", false), lineRange(2, 2, "c")},
+			expected: "c\nc\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := applyTextEdits(tt.content, tt.edits)
+			if tt.code != 0 {
+				require.Error(t, err)
+				assert.Equal(t, tt.code, connect.CodeOf(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEditFile(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	svc := mockService()
+	ctx := authn.SetInfo(t.Context(), u)
+
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello world\n"), 0o640))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	resp, err := svc.EditFile(ctx, connect.NewRequest(&filesystem.EditFileRequest{
+		Path:                 path,
+		Edits:                []*filesystem.TextEdit{searchReplace("world", "there", false)},
+		ExpectedModifiedTime: timestamppb.New(info.ModTime()),
+		ExpectedSha256:       new(sha256Hex([]byte("hello world\n"))),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("hello there\n")), resp.Msg.GetSha256())
+	assert.Equal(t, path, resp.Msg.GetEntry().GetPath())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello there\n", string(data))
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+
+	// A stale hash is rejected.
+	_, err = svc.EditFile(ctx, connect.NewRequest(&filesystem.EditFileRequest{
+		Path:           path,
+		Edits:          []*filesystem.TextEdit{searchReplace("there", "again", false)},
+		ExpectedSha256: new(sha256Hex([]byte("hello world\n"))),
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+	// A stale modified time is rejected.
+	_, err = svc.EditFile(ctx, connect.NewRequest(&filesystem.EditFileRequest{
+		Path:                 path,
+		Edits:                []*filesystem.TextEdit{searchReplace("there", "again", false)},
+		ExpectedModifiedTime: timestamppb.New(info.ModTime().Add(-time.Second)),
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+	// A failed edit leaves the file unchanged.
+	_, err = svc.EditFile(ctx, connect.NewRequest(&filesystem.EditFileRequest{
+		Path: path,
+		Edits: []*filesystem.TextEdit{
+			searchReplace("hello", "bye", false),
+			searchReplace("missing", "x", false),
+		},
+	}))
+	require.Error(t, err)
+
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello there\n", string(data))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files must be cleaned up")
+}
@@ -0,0 +1,146 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func (s Service) Find(ctx context.Context, req *connect.Request[rpc.FindRequest]) (*connect.Response[rpc.FindResponse], error) {
+	u, err := permissions.GetAuthUser(ctx, s.defaults.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateGlobs(req.Msg.GetPattern()); err != nil {
+		return nil, err
+	}
+
+	// Expand the path so we can return absolute paths in the response.
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u, s.defaults.Workdir)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolvedPath, err := followSymlink(requestedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkIfDirectory(resolvedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, searchTimeout, errSearchTimeout)
+	defer cancel()
+
+	limit := searchLimit(req.Msg.GetLimit())
+	walker := &searchWalker{
+		root:             resolvedPath,
+		maxDepth:         int(req.Msg.GetMaxDepth()),
+		respectGitignore: req.Msg.GetRespectGitignore(),
+	}
+
+	var entries []*rpc.EntryInfo
+	err = walker.walk(ctx, func(path, rel string, d fs.DirEntry) error {
+		matched, err := findMatches(req.Msg, rel, d)
+		if err != nil || !matched {
+			return err
+		}
+
+		if len(entries) == limit {
+			return errStopSearch
+		}
+
+		entry, err := entryInfo(path)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
+				// Skip entries that don't exist anymore
+				return nil
+			}
+
+			return err
+		}
+
+		// Return the requested path as the base path instead of the symlink-resolved path
+		entry.Path = filepath.Join(requestedPath, rel)
+		entries = append(entries, entry)
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := errors.AsType[*connect.Error](err); ok {
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error searching directory %s: %w", resolvedPath, err))
+	}
+
+	return connect.NewResponse(&rpc.FindResponse{
+		Entries:   entries,
+		Truncated: walker.truncated,
+	}), nil
+}
+
+// findMatches reports whether the entry passes the filters of the request.
+func findMatches(req *rpc.FindRequest, rel string, d fs.DirEntry) (bool, error) {
+	if req.Type != nil && dirEntryType(d) != req.GetType() {
+		return false, nil
+	}
+
+	if req.GetPattern() != "" && !matchGlob(req.GetPattern(), rel) {
+		return false, nil
+	}
+
+	if req.MinSize == nil && req.MaxSize == nil && req.GetModifiedAfter() == nil && req.GetModifiedBefore() == nil {
+		return true, nil
+	}
+
+	info, err := d.Info()
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if req.MinSize != nil && info.Size() < req.GetMinSize() {
+		return false, nil
+	}
+
+	if req.MaxSize != nil && info.Size() > req.GetMaxSize() {
+		return false, nil
+	}
+
+	if req.GetModifiedAfter() != nil && !info.ModTime().After(req.GetModifiedAfter().AsTime()) {
+		return false, nil
+	}
+
+	if req.GetModifiedBefore() != nil && !info.ModTime().Before(req.GetModifiedBefore().AsTime()) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func dirEntryType(d fs.DirEntry) rpc.FileType {
+	switch {
+	case d.Type()&fs.ModeSymlink != 0:
+		return rpc.FileType_FILE_TYPE_SYMLINK
+	case d.IsDir():
+		return rpc.FileType_FILE_TYPE_DIRECTORY
+	case d.Type().IsRegular():
+		return rpc.FileType_FILE_TYPE_FILE
+	default:
+		return rpc.FileType_FILE_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,183 @@
+package filesystem
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func setupSearchTree(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src", "pkg"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "build"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "pkg", "lib.go"), []byte("package pkg\n\n// Hello says hello.\nfunc Hello() {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "README.md"), []byte("hello docs\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "build", "out.go"), []byte("package out // hello\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".git", "config"), []byte("hello\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "data.bin"), []byte("hello\x00world"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gitignore"), []byte("build/\n*.bin\n"), 0o644))
+	require.NoError(t, os.Symlink("main.go", filepath.Join(root, "link.go")))
+
+	return root
+}
+
+func findPaths(entries []*filesystem.EntryInfo, root string) []string {
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		rel, _ := filepath.Rel(root, entry.GetPath())
+		paths = append(paths, rel)
+	}
+
+	return paths
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	root := setupSearchTree(t)
+	svc := mockService()
+
+	tests := []struct {
+		name     string
+		req      *filesystem.FindRequest
+		expected []string
+	}{
+		{
+			name:     "name glob at any depth",
+			req:      &filesystem.FindRequest{Pattern: "*.go"},
+			expected: []string{"build/out.go", "link.go", "main.go", "src/pkg/lib.go"},
+		},
+		{
+			name:     "path glob",
+			req:      &filesystem.FindRequest{Pattern: "src/**/*.go"},
+			expected: []string{"src/pkg/lib.go"},
+		},
+		{
+			name:     "type filter",
+			req:      &filesystem.FindRequest{Pattern: "*.go", Type: filesystem.FileType_FILE_TYPE_SYMLINK.Enum()},
+			expected: []string{"link.go"},
+		},
+		{
+			name:     "gitignore",
+			req:      &filesystem.FindRequest{Type: filesystem.FileType_FILE_TYPE_FILE.Enum(), RespectGitignore: true},
+			expected: []string{".gitignore", "main.go", "src/README.md", "src/pkg/lib.go"},
+		},
+		{
+			name:     "max depth",
+			req:      &filesystem.FindRequest{Pattern: "*.go", MaxDepth: 1},
+			expected: []string{"link.go", "main.go"},
+		},
+		{
+			name:     "size filter",
+			req:      &filesystem.FindRequest{Pattern: "*.go", MinSize: new(int64(30))},
+			expected: []string{"main.go", "src/pkg/lib.go"},
+		},
+		{
+			name:     "modified after",
+			req:      &filesystem.FindRequest{ModifiedAfter: timestamppb.New(time.Now().Add(time.Hour))},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.req.Path = root
+			resp, err := svc.Find(authn.SetInfo(t.Context(), u), connect.NewRequest(tt.req))
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, tt.expected, findPaths(resp.Msg.GetEntries(), root))
+			assert.False(t, resp.Msg.GetTruncated())
+		})
+	}
+}
+
+func TestFindLimit(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	root := setupSearchTree(t)
+	svc := mockService()
+
+	resp, err := svc.Find(authn.SetInfo(t.Context(), u), connect.NewRequest(&filesystem.FindRequest{
+		Path:    root,
+		Pattern: "*.go",
+		Limit:   2,
+	}))
+	require.NoError(t, err)
+
+	assert.Len(t, resp.Msg.GetEntries(), 2)
+	assert.True(t, resp.Msg.GetTruncated())
+}
+
+func TestFindInvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	svc := mockService()
+
+	_, err = svc.Find(authn.SetInfo(t.Context(), u), connect.NewRequest(&filesystem.FindRequest{
+		Path:    t.TempDir(),
+		Pattern: "[",
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGitignoreRules(t *testing.T) {
+	t.Parallel()
+
+	g := newGitignore()
+	for _, line := range []string{"*.log", "!keep.log", "/root-only", "docs/*.md", "tmp/"} {
+		rule, ok := parseGitignoreLine(line)
+		require.True(t, ok)
+		g.rules[""] = append(g.rules[""], rule)
+	}
+	rule, ok := parseGitignoreLine("*.txt")
+	require.True(t, ok)
+	g.rules["sub"] = []gitignoreRule{rule}
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{path: "app.log", ignored: true},
+		{path: "a/b/app.log", ignored: true},
+		{path: "keep.log", ignored: false},
+		{path: "root-only", ignored: true},
+		{path: "a/root-only", ignored: false},
+		{path: "docs/index.md", ignored: true},
+		{path: "a/docs/index.md", ignored: false},
+		{path: "tmp", isDir: true, ignored: true},
+		{path: "tmp", isDir: false, ignored: false},
+		{path: "sub/notes.txt", ignored: true},
+		{path: "notes.txt", ignored: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.ignored, g.ignored(tt.path, tt.isDir), tt.path)
+	}
+}
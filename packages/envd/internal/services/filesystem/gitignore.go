@@ -0,0 +1,129 @@
+package filesystem
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+type gitignoreRule struct {
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// gitignore matches paths against the .gitignore files found in the searched
+// tree. Paths are relative to the search root, rules of each .gitignore apply
+// to the paths under its directory.
+type gitignore struct {
+	rules map[string][]gitignoreRule
+}
+
+func newGitignore() *gitignore {
+	return &gitignore{rules: make(map[string][]gitignoreRule)}
+}
+
+// load reads the .gitignore in the directory, if there is one.
+func (g *gitignore) load(dir, rel string) {
+	file, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	var rules []gitignoreRule
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		rule, ok := parseGitignoreLine(scanner.Text())
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(rules) > 0 {
+		g.rules[filepath.ToSlash(rel)] = rules
+	}
+}
+
+func parseGitignoreLine(line string) (gitignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return gitignoreRule{}, false
+	}
+
+	var rule gitignoreRule
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+
+	// A leading backslash escapes "#" and "!".
+	line = strings.TrimPrefix(line, `\`)
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+
+	if line == "" || !doublestar.ValidatePattern(line) {
+		return gitignoreRule{}, false
+	}
+
+	rule.pattern = line
+
+	return rule, true
+}
+
+// ignored reports whether the path is ignored. Like git, the last matching rule
+// wins and the rules of deeper .gitignore files take precedence.
+func (g *gitignore) ignored(rel string, isDir bool) bool {
+	rel = filepath.ToSlash(rel)
+
+	ignored := false
+	for _, dir := range gitignoreDirs(rel) {
+		sub := rel
+		if dir != "" {
+			sub = strings.TrimPrefix(rel, dir+"/")
+		}
+
+		for _, rule := range g.rules[dir] {
+			if rule.dirOnly && !isDir {
+				continue
+			}
+
+			target := sub
+			if !rule.anchored {
+				target = path.Base(sub)
+			}
+
+			if matched, _ := doublestar.Match(rule.pattern, target); matched {
+				ignored = !rule.negate
+			}
+		}
+	}
+
+	return ignored
+}
+
+// gitignoreDirs returns the directories whose .gitignore applies to the path, outermost first.
+func gitignoreDirs(rel string) []string {
+	dirs := []string{""}
+
+	parts := strings.Split(rel, "/")
+	for i := 1; i < len(parts); i++ {
+		dirs = append(dirs, strings.Join(parts[:i], "/"))
+	}
+
+	return dirs
+}
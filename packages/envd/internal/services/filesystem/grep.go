@@ -0,0 +1,309 @@
+package filesystem
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+const (
+	defaultGrepMaxFileSize = 10 << 20  // 10 MiB
+	maxGrepMaxFileSize     = 100 << 20 // 100 MiB
+	maxGrepContextLines    = 20
+	// maxGrepLineLength caps the returned text of matched and context lines.
+	maxGrepLineLength = 1024
+	// binaryDetectionLength is how much of a file is checked for NUL bytes, like git does.
+	binaryDetectionLength = 8000
+	// maxGrepScanLineLength bounds the memory used for a single line while scanning.
+	maxGrepScanLineLength = 1 << 20 // 1 MiB
+)
+
+func (s Service) Grep(ctx context.Context, req *connect.Request[rpc.GrepRequest], stream *connect.ServerStream[rpc.GrepResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.grepHandler)
+}
+
+type grepSearch struct {
+	re           *regexp.Regexp
+	include      []string
+	exclude      []string
+	contextLines int
+	maxFileSize  int64
+	maxMatches   int
+
+	stream        *connect.ServerStream[rpc.GrepResponse]
+	filesSearched uint32
+	matches       int
+}
+
+func (s Service) grepHandler(ctx context.Context, req *connect.Request[rpc.GrepRequest], stream *connect.ServerStream[rpc.GrepResponse]) error {
+	u, err := permissions.GetAuthUser(ctx, s.defaults.User)
+	if err != nil {
+		return err
+	}
+
+	search, err := newGrepSearch(req.Msg, stream)
+	if err != nil {
+		return err
+	}
+
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u, s.defaults.Workdir)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolvedPath, err := followSymlink(requestedPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(resolvedPath)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, searchTimeout, errSearchTimeout)
+	defer cancel()
+
+	var truncated bool
+	if info.IsDir() {
+		walker := &searchWalker{
+			root:             resolvedPath,
+			maxDepth:         int(req.Msg.GetMaxDepth()),
+			respectGitignore: req.Msg.GetRespectGitignore(),
+		}
+
+		err = walker.walk(ctx, func(path, rel string, d fs.DirEntry) error {
+			if slices.ContainsFunc(search.exclude, func(glob string) bool { return matchGlob(glob, rel) }) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			if len(search.include) > 0 && !slices.ContainsFunc(search.include, func(glob string) bool { return matchGlob(glob, rel) }) {
+				return nil
+			}
+
+			return search.file(path, filepath.Join(requestedPath, rel))
+		})
+		truncated = walker.truncated
+	} else {
+		err = search.file(resolvedPath, requestedPath)
+	}
+
+	if errors.Is(err, errStopSearch) {
+		err = nil
+		truncated = true
+	}
+
+	if err != nil {
+		if _, ok := errors.AsType[*connect.Error](err); ok {
+			return err
+		}
+
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error searching %s: %w", resolvedPath, err))
+	}
+
+	err = stream.Send(&rpc.GrepResponse{
+		Event: &rpc.GrepResponse_Done{
+			Done: &rpc.GrepResponse_DoneEvent{
+				FilesSearched: search.filesSearched,
+				Matches:       uint32(search.matches),
+				Truncated:     truncated,
+			},
+		},
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending done event: %w", err))
+	}
+
+	return nil
+}
+
+func newGrepSearch(req *rpc.GrepRequest, stream *connect.ServerStream[rpc.GrepResponse]) (*grepSearch, error) {
+	if req.GetPattern() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("pattern is required"))
+	}
+
+	pattern := req.GetPattern()
+	if req.GetCaseInsensitive() {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid pattern: %w", err))
+	}
+
+	if err := validateGlobs(req.GetInclude()...); err != nil {
+		return nil, err
+	}
+
+	if err := validateGlobs(req.GetExclude()...); err != nil {
+		return nil, err
+	}
+
+	maxFileSize := req.GetMaxFileSize()
+	switch {
+	case maxFileSize <= 0:
+		maxFileSize = defaultGrepMaxFileSize
+	case maxFileSize > maxGrepMaxFileSize:
+		maxFileSize = maxGrepMaxFileSize
+	}
+
+	return &grepSearch{
+		re:           re,
+		include:      req.GetInclude(),
+		exclude:      req.GetExclude(),
+		contextLines: min(int(req.GetContextLines()), maxGrepContextLines),
+		maxFileSize:  maxFileSize,
+		maxMatches:   searchLimit(req.GetMaxMatches()),
+		stream:       stream,
+	}, nil
+}
+
+// file searches the file line by line and streams its matches, skipping binary and
+// too large files. Files with a line longer than maxGrepScanLineLength are searched
+// up to that line. It returns errStopSearch once the limit of matches is reached.
+func (g *grepSearch) file(path, displayPath string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil //nolint:nilerr // files that can't be read are skipped instead of failing the whole search
+	}
+
+	if !info.Mode().IsRegular() || info.Size() > g.maxFileSize {
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil //nolint:nilerr // files that can't be read are skipped instead of failing the whole search
+	}
+	defer f.Close()
+
+	r := bufio.NewReaderSize(f, binaryDetectionLength)
+	head, err := r.Peek(binaryDetectionLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil //nolint:nilerr // files that can't be read are skipped instead of failing the whole search
+	}
+
+	if bytes.IndexByte(head, 0) >= 0 {
+		return nil
+	}
+
+	g.filesSearched++
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxGrepScanLineLength)
+
+	var (
+		// before holds the last lines for the context of the next match.
+		before []string
+		// pending are the matches still collecting lines after them.
+		pending []*rpc.GrepMatch
+		stop    bool
+	)
+
+	flush := func(all bool) error {
+		for len(pending) > 0 && (all || len(pending[0].GetAfter()) == g.contextLines) {
+			err := g.stream.Send(&rpc.GrepResponse{
+				Event: &rpc.GrepResponse_Match{Match: pending[0]},
+			})
+			if err != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending match: %w", err))
+			}
+
+			pending = pending[1:]
+		}
+
+		return nil
+	}
+
+	for lineNumber := 1; !stop && scanner.Scan(); lineNumber++ {
+		line := scanner.Bytes()
+		text := grepLineText(line)
+
+		for _, m := range pending {
+			m.After = append(m.After, text)
+		}
+
+		if err := flush(false); err != nil {
+			return err
+		}
+
+		if loc := g.re.FindIndex(bytes.TrimSuffix(line, []byte("\r"))); loc != nil {
+			if g.matches == g.maxMatches {
+				// The matches found so far still get their context lines.
+				stop = true
+			} else {
+				g.matches++
+
+				pending = append(pending, &rpc.GrepMatch{
+					Path:   displayPath,
+					Line:   uint32(lineNumber),
+					Column: uint32(loc[0] + 1),
+					Text:   text,
+					Before: slices.Clone(before),
+				})
+			}
+		}
+
+		if g.contextLines > 0 {
+			before = append(before, text)
+			if len(before) > g.contextLines {
+				before = before[1:]
+			}
+		}
+	}
+
+	for stop && len(pending) > 0 && scanner.Scan() {
+		text := grepLineText(scanner.Bytes())
+		for _, m := range pending {
+			m.After = append(m.After, text)
+		}
+
+		if err := flush(false); err != nil {
+			return err
+		}
+	}
+
+	if err := flush(true); err != nil {
+		return err
+	}
+
+	if stop {
+		return errStopSearch
+	}
+
+	return nil
+}
+
+// grepLineText returns the line as a valid UTF-8 string, truncated to maxGrepLineLength.
+func grepLineText(line []byte) string {
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if len(line) > maxGrepLineLength {
+		line = line[:maxGrepLineLength]
+	}
+
+	return strings.ToValidUTF8(string(line), "�")
+}
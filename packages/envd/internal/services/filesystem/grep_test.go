@@ -0,0 +1,191 @@
+package filesystem
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem/filesystemconnect"
+)
+
+func newGrepClient(t *testing.T) filesystemconnect.FilesystemClient {
+	t.Helper()
+
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	svc := mockService()
+	svc.defaults.User = u.Username
+
+	mux := http.NewServeMux()
+	mux.Handle(filesystemconnect.NewFilesystemHandler(svc))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return filesystemconnect.NewFilesystemClient(server.Client(), server.URL)
+}
+
+func grep(t *testing.T, client filesystemconnect.FilesystemClient, req *filesystem.GrepRequest) ([]*filesystem.GrepMatch, *filesystem.GrepResponse_DoneEvent) {
+	t.Helper()
+
+	stream, err := client.Grep(t.Context(), connect.NewRequest(req))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var matches []*filesystem.GrepMatch
+	var done *filesystem.GrepResponse_DoneEvent
+	for stream.Receive() {
+		switch event := stream.Msg().GetEvent().(type) {
+		case *filesystem.GrepResponse_Match:
+			matches = append(matches, event.Match)
+		case *filesystem.GrepResponse_Done:
+			done = event.Done
+		}
+	}
+	require.NoError(t, stream.Err())
+	require.NotNil(t, done)
+
+	return matches, done
+}
+
+func TestGrep(t *testing.T) {
+	t.Parallel()
+
+	root := setupSearchTree(t)
+	client := newGrepClient(t)
+
+	t.Run("respects gitignore and skips binary files", func(t *testing.T) {
+		t.Parallel()
+
+		matches, done := grep(t, client, &filesystem.GrepRequest{
+			Path:             root,
+			Pattern:          "hello",
+			CaseInsensitive:  true,
+			RespectGitignore: true,
+		})
+
+		var paths []string
+		for _, m := range matches {
+			paths = append(paths, m.GetPath())
+		}
+
+		assert.ElementsMatch(t, []string{
+			filepath.Join(root, "main.go"),
+			filepath.Join(root, "src", "pkg", "lib.go"),
+			filepath.Join(root, "src", "pkg", "lib.go"),
+			filepath.Join(root, "src", "README.md"),
+		}, paths)
+		assert.Equal(t, uint32(4), done.GetMatches())
+		assert.False(t, done.GetTruncated())
+	})
+
+	t.Run("include glob and context", func(t *testing.T) {
+		t.Parallel()
+
+		matches, _ := grep(t, client, &filesystem.GrepRequest{
+			Path:         root,
+			Pattern:      `println\(`,
+			Include:      []string{"*.go"},
+			Exclude:      []string{"build"},
+			ContextLines: 1,
+		})
+		require.Len(t, matches, 1)
+
+		m := matches[0]
+		assert.Equal(t, filepath.Join(root, "main.go"), m.GetPath())
+		assert.Equal(t, uint32(4), m.GetLine())
+		assert.Equal(t, uint32(2), m.GetColumn())
+		assert.Equal(t, "\tprintln(\"hello\")", m.GetText())
+		assert.Equal(t, []string{"func main() {"}, m.GetBefore())
+		assert.Equal(t, []string{"}"}, m.GetAfter())
+	})
+
+	t.Run("max matches", func(t *testing.T) {
+		t.Parallel()
+
+		matches, done := grep(t, client, &filesystem.GrepRequest{
+			Path:       root,
+			Pattern:    "(?i)hello",
+			MaxMatches: 2,
+		})
+
+		assert.Len(t, matches, 2)
+		assert.True(t, done.GetTruncated())
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		t.Parallel()
+
+		matches, done := grep(t, client, &filesystem.GrepRequest{
+			Path:    filepath.Join(root, "src", "README.md"),
+			Pattern: "docs",
+		})
+
+		require.Len(t, matches, 1)
+		assert.Equal(t, uint32(1), done.GetFilesSearched())
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		t.Parallel()
+
+		stream, err := client.Grep(t.Context(), connect.NewRequest(&filesystem.GrepRequest{
+			Path:    root,
+			Pattern: "(",
+		}))
+		require.NoError(t, err)
+		defer stream.Close()
+
+		for stream.Receive() {
+		}
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+	})
+}
+
+func TestGrep_Streaming(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	client := newGrepClient(t)
+
+	path := filepath.Join(root, "log.txt")
+	content := "a\nmatch 1\nb\nmatch 2\nc\n" + strings.Repeat("x", maxGrepScanLineLength+1) + "\nmatch 3\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	matches, done := grep(t, client, &filesystem.GrepRequest{
+		Path:         path,
+		Pattern:      "match",
+		ContextLines: 2,
+	})
+
+	// Adjacent matches share context lines, the search stops at the over-long line.
+	require.Len(t, matches, 2)
+	assert.Equal(t, uint32(2), matches[0].GetLine())
+	assert.Equal(t, []string{"a"}, matches[0].GetBefore())
+	assert.Equal(t, []string{"b", "match 2"}, matches[0].GetAfter())
+	assert.Equal(t, uint32(4), matches[1].GetLine())
+	assert.Equal(t, []string{"match 1", "b"}, matches[1].GetBefore())
+	assert.Equal(t, []string{"c"}, matches[1].GetAfter())
+	assert.False(t, done.GetTruncated())
+
+	// The matches before the limit still get the lines after them.
+	matches, done = grep(t, client, &filesystem.GrepRequest{
+		Path:         path,
+		Pattern:      "match",
+		ContextLines: 1,
+		MaxMatches:   1,
+	})
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, []string{"b"}, matches[0].GetAfter())
+	assert.True(t, done.GetTruncated())
+}
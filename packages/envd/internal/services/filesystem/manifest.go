@@ -0,0 +1,116 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func (s Service) DiffManifest(ctx context.Context, req *connect.Request[rpc.DiffManifestRequest]) (*connect.Response[rpc.DiffManifestResponse], error) {
+	u, err := permissions.GetAuthUser(ctx, s.defaults.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.GetEntries()) > maxChecksumFiles {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("manifest has more than %d entries", maxChecksumFiles))
+	}
+
+	if err := validateGlobs(req.Msg.GetExclude()...); err != nil {
+		return nil, err
+	}
+
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u, s.defaults.Workdir)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolvedPath, err := followSymlink(requestedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkIfDirectory(resolvedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make(map[string]*rpc.ManifestEntry, len(req.Msg.GetEntries()))
+	for _, entry := range req.Msg.GetEntries() {
+		rel := filepath.FromSlash(entry.GetPath())
+		if !filepath.IsLocal(rel) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("manifest path must be relative and within the directory: %s", entry.GetPath()))
+		}
+
+		manifest[filepath.Clean(rel)] = entry
+	}
+
+	resp := &rpc.DiffManifestResponse{}
+
+	// Only regular files of the same size need to be hashed.
+	var hashPaths []string
+	var hashEntries []*rpc.ManifestEntry
+
+	for _, entry := range req.Msg.GetEntries() {
+		path := filepath.Join(resolvedPath, filepath.FromSlash(entry.GetPath()))
+
+		info, err := os.Lstat(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ENOTDIR):
+			resp.Missing = append(resp.Missing, entry.GetPath())
+		case err != nil:
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+		case !info.Mode().IsRegular(), entry.Size != nil && info.Size() != entry.GetSize():
+			resp.Changed = append(resp.Changed, entry.GetPath())
+		default:
+			hashPaths = append(hashPaths, path)
+			hashEntries = append(hashEntries, entry)
+		}
+	}
+
+	checksums, err := s.checksums.checksumFiles(ctx, hashPaths)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for i, checksum := range checksums {
+		switch {
+		case checksum == nil:
+			resp.Missing = append(resp.Missing, hashEntries[i].GetPath())
+		case !strings.EqualFold(checksum.GetSha256(), hashEntries[i].GetSha256()):
+			resp.Changed = append(resp.Changed, hashEntries[i].GetPath())
+		}
+	}
+
+	walker := &searchWalker{
+		root:             resolvedPath,
+		respectGitignore: req.Msg.GetRespectGitignore(),
+	}
+
+	_, extra, err := walkFiles(ctx, walker, req.Msg.GetExclude(), func(rel string) bool {
+		_, ok := manifest[rel]
+
+		return ok
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error walking directory %s: %w", resolvedPath, err))
+	}
+
+	for _, rel := range extra {
+		resp.Extra = append(resp.Extra, filepath.ToSlash(rel))
+	}
+
+	resp.Truncated = walker.truncated
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,159 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+const (
+	defaultSearchLimit = 1000
+	maxSearchLimit     = 10_000
+
+	// maxSearchVisited caps the entries a single search walks, so a huge tree
+	// can't keep envd busy indefinitely.
+	maxSearchVisited = 1_000_000
+	searchTimeout    = time.Minute
+)
+
+var (
+	errSearchTimeout = errors.New("search timeout exceeded")
+	errStopSearch    = errors.New("search stopped")
+)
+
+// searchLimit returns the requested limit of results, applying the default and the cap.
+func searchLimit(limit uint32) int {
+	switch {
+	case limit == 0:
+		return defaultSearchLimit
+	case limit > maxSearchLimit:
+		return maxSearchLimit
+	default:
+		return int(limit)
+	}
+}
+
+// validateGlobs returns an InvalidArgument error if any of the globs is malformed.
+func validateGlobs(globs ...string) error {
+	for _, glob := range globs {
+		if !doublestar.ValidatePattern(glob) {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid glob pattern: "+glob))
+		}
+	}
+
+	return nil
+}
+
+// matchGlob matches the path relative to the search root against the glob.
+// A glob without a slash is matched against the name only, at any depth.
+func matchGlob(glob, rel string) bool {
+	if !strings.Contains(glob, "/") {
+		rel = filepath.Base(rel)
+	}
+
+	// The globs are validated upfront.
+	matched, _ := doublestar.Match(glob, filepath.ToSlash(rel))
+
+	return matched
+}
+
+// searchWalker walks the tree under root for Find and Grep, applying the depth,
+// gitignore and resource limits.
+type searchWalker struct {
+	root             string
+	maxDepth         int
+	respectGitignore bool
+
+	visited   int
+	truncated bool
+	gitignore *gitignore
+}
+
+// walk calls fn for every entry under the root with its path relative to the
+// root. Unreadable directories are skipped. When fn returns errStopSearch or
+// the search limits are reached, it stops without an error and marks the
+// walker as truncated.
+func (w *searchWalker) walk(ctx context.Context, fn func(path, rel string, d fs.DirEntry) error) error {
+	if w.respectGitignore {
+		w.gitignore = newGitignore()
+		w.gitignore.load(w.root, "")
+	}
+
+	err := filepath.WalkDir(w.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == w.root {
+				return err
+			}
+
+			// Skip the entries that can't be read instead of failing the whole search.
+			return nil
+		}
+
+		if path == w.root {
+			return nil
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			if errors.Is(context.Cause(ctx), errSearchTimeout) {
+				return errStopSearch
+			}
+
+			return ctxErr
+		}
+
+		w.visited++
+		if w.visited > maxSearchVisited {
+			return errStopSearch
+		}
+
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return err
+		}
+
+		if w.gitignore != nil {
+			if d.IsDir() && d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			if w.gitignore.ignored(rel, d.IsDir()) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+		}
+
+		if err := fn(path, rel, d); err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if w.maxDepth > 0 && strings.Count(rel, string(filepath.Separator))+1 >= w.maxDepth {
+			return filepath.SkipDir
+		}
+
+		if w.gitignore != nil {
+			w.gitignore.load(path, rel)
+		}
+
+		return nil
+	})
+	if errors.Is(err, errStopSearch) {
+		w.truncated = true
+
+		return nil
+	}
+
+	return err
+}
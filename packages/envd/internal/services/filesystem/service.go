@@ -1,6 +1,8 @@
 package filesystem
 
 import (
+	"sync"
+
 	"connectrpc.com/connect"
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog"
@@ -13,16 +15,20 @@ import (
 )
 
 type Service struct {
-	logger   *zerolog.Logger
-	watchers *utils.Map[string, *FileWatcher]
-	defaults *execcontext.Defaults
+	logger    *zerolog.Logger
+	watchers  *utils.Map[string, *FileWatcher]
+	defaults  *execcontext.Defaults
+	editMu    *sync.Mutex
+	checksums *checksumCache
 }
 
 func Handle(server *chi.Mux, l *zerolog.Logger, defaults *execcontext.Defaults) {
 	service := Service{
-		logger:   l,
-		watchers: utils.NewMap[string, *FileWatcher](),
-		defaults: defaults,
+		logger:    l,
+		watchers:  utils.NewMap[string, *FileWatcher](),
+		defaults:  defaults,
+		editMu:    &sync.Mutex{},
+		checksums: newChecksumCache(),
 	}
 
 	interceptors := connect.WithInterceptors(
@@ -1,6 +1,8 @@
 package filesystem
 
 import (
+	"sync"
+
 	"github.com/rs/zerolog"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/execcontext"
@@ -16,5 +18,7 @@ func mockService() Service {
 		defaults: &execcontext.Defaults{
 			EnvVars: utils.NewEnvVars(),
 		},
+		editMu:    &sync.Mutex{},
+		checksums: newChecksumCache(),
 	}
 }
@@ -0,0 +1,148 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"connectrpc.com/connect"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/port"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/ports"
+	spec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/ports/portsconnect"
+)
+
+type Service struct {
+	logger    *zerolog.Logger
+	tracker   *tracker
+	forwarder *port.Forwarder
+}
+
+// Handle serves the ports service, tracking the listening ports from the scanner until ctx is done.
+func Handle(ctx context.Context, server *chi.Mux, l *zerolog.Logger, scanner *port.Scanner, forwarder *port.Forwarder) {
+	service := Service{
+		logger:    l,
+		tracker:   newTracker(l),
+		forwarder: forwarder,
+	}
+
+	sub := scanner.AddSubscriber(l, "ports-service", &port.ScannerFilter{State: "LISTEN"})
+	go service.tracker.run(ctx, sub)
+
+	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l))
+
+	path, handler := spec.NewPortsHandler(service, interceptors)
+
+	server.Mount(path, handler)
+}
+
+func (s Service) ListPorts(ctx context.Context, _ *connect.Request[rpc.ListPortsRequest]) (*connect.Response[rpc.ListPortsResponse], error) {
+	// Don't report no ports just because envd has started recently.
+	select {
+	case <-s.tracker.scanned:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	ports := s.tracker.list()
+	for i, p := range ports {
+		// The tracked ports are shared with the watchers.
+		ports[i] = proto.CloneOf(p)
+		ports[i].Forwarding = s.forwardStats(p)
+	}
+
+	return connect.NewResponse(&rpc.ListPortsResponse{
+		Ports: ports,
+	}), nil
+}
+
+func (s Service) forwardStats(p *rpc.Port) *rpc.ForwardStats {
+	if s.forwarder == nil {
+		return nil
+	}
+
+	if ip := net.ParseIP(p.GetAddress()); ip == nil || !ip.IsLoopback() {
+		return nil
+	}
+
+	stats, ok := s.forwarder.Stats(p.GetPort())
+	if !ok {
+		return nil
+	}
+
+	return &rpc.ForwardStats{
+		ActiveConnections: stats.ActiveConnections,
+		TotalConnections:  stats.TotalConnections,
+		BytesReceived:     stats.BytesReceived,
+		BytesSent:         stats.BytesSent,
+	}
+}
+
+func (s Service) WatchPorts(ctx context.Context, req *connect.Request[rpc.WatchPortsRequest], stream *connect.ServerStream[rpc.WatchPortsResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.watchHandler)
+}
+
+func (s Service) watchHandler(ctx context.Context, req *connect.Request[rpc.WatchPortsRequest], stream *connect.ServerStream[rpc.WatchPortsResponse]) error {
+	w, existing := s.tracker.watch()
+	defer s.tracker.remove(w)
+
+	err := stream.Send(&rpc.WatchPortsResponse{
+		Event: &rpc.WatchPortsResponse_Start{
+			Start: &rpc.WatchPortsResponse_StartEvent{},
+		},
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending start event: %w", err))
+	}
+
+	if req.Msg.GetIncludeExisting() {
+		for _, p := range existing {
+			err := stream.Send(&rpc.WatchPortsResponse{
+				Event: &rpc.WatchPortsResponse_Port{
+					Port: &rpc.PortEvent{Type: rpc.PortEventType_PORT_EVENT_TYPE_OPEN, Port: p},
+				},
+			})
+			if err != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending port event: %w", err))
+			}
+		}
+	}
+
+	keepaliveTicker, resetKeepalive := permissions.GetKeepAliveTicker(req)
+	defer keepaliveTicker.Stop()
+
+	for {
+		select {
+		case <-keepaliveTicker.C:
+			streamErr := stream.Send(&rpc.WatchPortsResponse{
+				Event: &rpc.WatchPortsResponse_Keepalive{
+					Keepalive: &rpc.WatchPortsResponse_KeepAlive{},
+				},
+			})
+			if streamErr != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending keepalive: %w", streamErr))
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-w.events:
+			if !ok {
+				return connect.NewError(connect.CodeResourceExhausted, errors.New("port watcher fell behind, watch again to resync"))
+			}
+
+			streamErr := stream.Send(&rpc.WatchPortsResponse{
+				Event: &rpc.WatchPortsResponse_Port{Port: event},
+			})
+			if streamErr != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending port event: %w", streamErr))
+			}
+
+			resetKeepalive()
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package ports
+
+import (
+	"cmp"
+	"context"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/shirou/gopsutil/v4/net"
+	"github.com/shirou/gopsutil/v4/process"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/port"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/ports"
+)
+
+// watcherBuffer is how many events a watcher can fall behind before it's dropped.
+const watcherBuffer = 256
+
+type portKey struct {
+	address string
+	port    uint32
+	pid     int32
+}
+
+// watcher receives the port events until its channel is closed, either when
+// it's removed or when it falls behind.
+type watcher struct {
+	events chan *rpc.PortEvent
+}
+
+// tracker keeps the listening ports up to date from the scan results and
+// notifies the watchers about the changes.
+type tracker struct {
+	logger *zerolog.Logger
+	// ownPid is excluded, so envd's own listeners aren't reported.
+	ownPid int32
+
+	mu       sync.Mutex
+	ports    map[portKey]*rpc.Port
+	watchers map[*watcher]struct{}
+
+	scanned     chan struct{}
+	scannedOnce sync.Once
+}
+
+func newTracker(logger *zerolog.Logger) *tracker {
+	return &tracker{
+		logger:   logger,
+		ownPid:   int32(os.Getpid()),
+		ports:    make(map[portKey]*rpc.Port),
+		watchers: make(map[*watcher]struct{}),
+		scanned:  make(chan struct{}),
+	}
+}
+
+func (t *tracker) run(ctx context.Context, sub *port.ScannerSubscriber) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case conns, ok := <-sub.Messages:
+			if !ok {
+				return
+			}
+
+			t.update(conns)
+		}
+	}
+}
+
+// update replaces the tracked ports with the listening sockets from a scan.
+func (t *tracker) update(conns []net.ConnectionStat) {
+	defer t.scannedOnce.Do(func() { close(t.scanned) })
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := make(map[portKey]struct{}, len(conns))
+	var events []*rpc.PortEvent
+
+	for _, conn := range conns {
+		// The listeners forwarding localhost ports would duplicate the forwarded ones.
+		if conn.Pid == t.ownPid || conn.Laddr.IP == port.DefaultGatewayIP.String() {
+			continue
+		}
+
+		key := portKey{address: conn.Laddr.IP, port: conn.Laddr.Port, pid: conn.Pid}
+		current[key] = struct{}{}
+
+		if _, ok := t.ports[key]; ok {
+			continue
+		}
+
+		p := newPort(key)
+		t.ports[key] = p
+		events = append(events, &rpc.PortEvent{Type: rpc.PortEventType_PORT_EVENT_TYPE_OPEN, Port: p})
+	}
+
+	for key, p := range t.ports {
+		if _, ok := current[key]; ok {
+			continue
+		}
+
+		delete(t.ports, key)
+		events = append(events, &rpc.PortEvent{Type: rpc.PortEventType_PORT_EVENT_TYPE_CLOSE, Port: p})
+	}
+
+	// Closes first, so a port that moved to another process is reported as closed before it's opened again.
+	slices.SortFunc(events, func(a, b *rpc.PortEvent) int {
+		return cmp.Or(
+			cmp.Compare(b.GetType(), a.GetType()),
+			comparePorts(a.GetPort(), b.GetPort()),
+		)
+	})
+
+	for _, event := range events {
+		t.logger.Debug().
+			Str("event_type", event.GetType().String()).
+			Str("address", event.GetPort().GetAddress()).
+			Uint32("port", event.GetPort().GetPort()).
+			Uint32("pid", event.GetPort().GetPid()).
+			Msg("Listening port changed")
+
+		for w := range t.watchers {
+			select {
+			case w.events <- event:
+			default:
+				t.logger.Warn().Msg("Port watcher fell behind, dropping it")
+				t.removeLocked(w)
+			}
+		}
+	}
+}
+
+// list returns the tracked ports sorted by port and address.
+func (t *tracker) list() []*rpc.Port {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.listLocked()
+}
+
+func (t *tracker) listLocked() []*rpc.Port {
+	ports := make([]*rpc.Port, 0, len(t.ports))
+	for _, p := range t.ports {
+		ports = append(ports, p)
+	}
+
+	slices.SortFunc(ports, comparePorts)
+
+	return ports
+}
+
+// watch adds a watcher and returns the ports open at the time it was added.
+func (t *tracker) watch() (*watcher, []*rpc.Port) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w := &watcher{events: make(chan *rpc.PortEvent, watcherBuffer)}
+	t.watchers[w] = struct{}{}
+
+	return w, t.listLocked()
+}
+
+func (t *tracker) remove(w *watcher) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.removeLocked(w)
+}
+
+func (t *tracker) removeLocked(w *watcher) {
+	if _, ok := t.watchers[w]; !ok {
+		return
+	}
+
+	delete(t.watchers, w)
+	close(w.events)
+}
+
+func newPort(key portKey) *rpc.Port {
+	p := &rpc.Port{
+		Port:    key.port,
+		Address: key.address,
+		Pid:     uint32(key.pid),
+	}
+
+	if key.pid <= 0 {
+		return p
+	}
+
+	proc, err := process.NewProcess(key.pid)
+	if err != nil {
+		return p
+	}
+
+	cmdline, err := proc.CmdlineSlice()
+	if err != nil || len(cmdline) == 0 {
+		return p
+	}
+
+	p.Cmd = cmdline[0]
+	p.Args = cmdline[1:]
+
+	return p
+}
+
+func comparePorts(a, b *rpc.Port) int {
+	return cmp.Or(
+		cmp.Compare(a.GetPort(), b.GetPort()),
+		cmp.Compare(a.GetAddress(), b.GetAddress()),
+		cmp.Compare(a.GetPid(), b.GetPid()),
+	)
+}
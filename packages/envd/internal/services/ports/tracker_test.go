@@ -0,0 +1,125 @@
+package ports
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/port"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/ports"
+)
+
+func listener(ip string, p uint32, pid int32) net.ConnectionStat {
+	return net.ConnectionStat{Laddr: net.Addr{IP: ip, Port: p}, Status: "LISTEN", Pid: pid}
+}
+
+func newTestTracker() *tracker {
+	logger := zerolog.Nop()
+
+	return newTracker(&logger)
+}
+
+func receive(t *testing.T, w *watcher) []string {
+	t.Helper()
+
+	var events []string
+	for {
+		select {
+		case event, ok := <-w.events:
+			require.True(t, ok)
+			events = append(events, event.GetType().String()+" "+event.GetPort().GetAddress())
+		default:
+			return events
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTracker()
+	w, existing := tr.watch()
+	assert.Empty(t, existing)
+
+	tr.update([]net.ConnectionStat{
+		listener("0.0.0.0", 3000, 100),
+		listener("127.0.0.1", 8080, 101),
+		// Forwarding listener for the port above.
+		listener(port.DefaultGatewayIP.String(), 8080, 102),
+		// envd itself.
+		listener("0.0.0.0", 49983, tr.ownPid),
+	})
+
+	select {
+	case <-tr.scanned:
+	default:
+		t.Fatal("tracker should be marked as scanned")
+	}
+
+	assert.Equal(t, []string{"PORT_EVENT_TYPE_OPEN 0.0.0.0", "PORT_EVENT_TYPE_OPEN 127.0.0.1"}, receive(t, w))
+
+	ports := tr.list()
+	require.Len(t, ports, 2)
+	assert.Equal(t, uint32(3000), ports[0].GetPort())
+	assert.Equal(t, uint32(100), ports[0].GetPid())
+	assert.Equal(t, uint32(8080), ports[1].GetPort())
+
+	// Unchanged ports don't emit events, the ones that are gone are closed first.
+	tr.update([]net.ConnectionStat{
+		listener("0.0.0.0", 3000, 100),
+		listener("::", 5173, 103),
+	})
+	assert.Equal(t, []string{"PORT_EVENT_TYPE_CLOSE 127.0.0.1", "PORT_EVENT_TYPE_OPEN ::"}, receive(t, w))
+
+	_, existing = tr.watch()
+	assert.Len(t, existing, 2)
+
+	tr.remove(w)
+	_, ok := <-w.events
+	assert.False(t, ok)
+}
+
+func TestTrackerDropsSlowWatcher(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTracker()
+	w, _ := tr.watch()
+
+	for i := range watcherBuffer + 1 {
+		tr.update([]net.ConnectionStat{listener("0.0.0.0", uint32(i+1), 100)})
+	}
+
+	assert.Empty(t, tr.watchers)
+
+	received := 0
+	for range w.events {
+		received++
+	}
+	assert.Equal(t, watcherBuffer, received)
+
+	// Removing a dropped watcher is a no-op.
+	tr.remove(w)
+}
+
+func TestNewPortProcess(t *testing.T) {
+	t.Parallel()
+
+	cmd := exec.CommandContext(t.Context(), "sleep", "30")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	p := newPort(portKey{address: "0.0.0.0", port: 3000, pid: int32(cmd.Process.Pid)})
+	assert.Equal(t, "sleep", p.GetCmd())
+	assert.Equal(t, []string{"30"}, p.GetArgs())
+
+	p = newPort(portKey{address: "0.0.0.0", port: 3000})
+	assert.Empty(t, p.GetCmd())
+	assert.Equal(t, &rpc.Port{Port: 3000, Address: "0.0.0.0"}, p)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 )
 
@@ -20,7 +21,7 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 	ctx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
 
-	proc, err := s.getProcess(req.Msg.GetProcess())
+	proc, err := s.getProcessOrExited(req.Msg.GetProcess())
 	if err != nil {
 		return err
 	}
@@ -33,6 +34,10 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 	end, endCancel := proc.EndEvent.Fork()
 	defer endCancel()
 
+	// Read the buffer only after subscribing, so no output falls in between.
+	// Live events already replayed are skipped by their offset.
+	replayed, liveOffset := replayOutput(proc.Output, req.Msg.GetReplay())
+
 	streamErr := stream.Send(&rpc.ConnectResponse{
 		Event: &rpc.ProcessEvent{
 			Event: &rpc.ProcessEvent_Start{
@@ -46,6 +51,17 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 		return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending start event: %w", streamErr))
 	}
 
+	for _, event := range replayed {
+		streamErr := stream.Send(&rpc.ConnectResponse{
+			Event: &rpc.ProcessEvent{
+				Event: &rpc.ProcessEvent_Data{Data: event},
+			},
+		})
+		if streamErr != nil {
+			return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending replayed data event: %w", streamErr))
+		}
+	}
+
 	go func() {
 		defer close(exitChan)
 
@@ -77,6 +93,10 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 					break dataLoop
 				}
 
+				if event.Data.GetOffset() < liveOffset {
+					continue
+				}
+
 				streamErr := stream.Send(&rpc.ConnectResponse{
 					Event: &rpc.ProcessEvent{
 						Event: &event,
@@ -99,9 +119,15 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 			return
 		case event, ok := <-end:
 			if !ok {
-				cancel(connect.NewError(connect.CodeUnknown, errors.New("end event channel closed before sending end event")))
+				// The process ended before subscribing.
+				endEvent := proc.End()
+				if endEvent == nil {
+					cancel(connect.NewError(connect.CodeUnknown, errors.New("end event channel closed before sending end event")))
 
-				return
+					return
+				}
+
+				event = rpc.ProcessEvent_End{End: endEvent}
 			}
 
 			streamErr := stream.Send(&rpc.ConnectResponse{
@@ -122,3 +148,17 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 
 	return ctx.Err()
 }
+
+// replayOutput returns the buffered output requested by the replay and the
+// offset from which the live output continues it. Without a replay only the
+// live output is streamed.
+func replayOutput(output *handler.OutputBuffer, replay *rpc.Replay) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	switch replay.GetFrom().(type) {
+	case *rpc.Replay_Offset:
+		return output.SinceOffset(replay.GetOffset())
+	case *rpc.Replay_Timestamp:
+		return output.SinceTime(replay.GetTimestamp().AsTime())
+	default:
+		return nil, 0
+	}
+}
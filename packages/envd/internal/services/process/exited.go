@@ -0,0 +1,78 @@
+package process
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	exitedProcessRetention = 10 * time.Minute
+	maxExitedProcesses     = 32
+)
+
+type exitedProcess struct {
+	pid      uint32
+	handler  *handler.Handler
+	exitedAt time.Time
+}
+
+// exitedProcesses keeps the recently exited processes, so clients reconnecting
+// later can still read their exit code and replay their output.
+type exitedProcesses struct {
+	mu    sync.Mutex
+	procs []exitedProcess // ordered by exit time
+}
+
+func (e *exitedProcesses) add(pid uint32, h *handler.Handler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.prune()
+
+	// The pid may have been reused since the previous process exited.
+	e.procs = slices.DeleteFunc(e.procs, func(p exitedProcess) bool { return p.pid == pid })
+	e.procs = append(e.procs, exitedProcess{pid: pid, handler: h, exitedAt: time.Now()})
+
+	if over := len(e.procs) - maxExitedProcesses; over > 0 {
+		e.procs = slices.Delete(e.procs, 0, over)
+	}
+}
+
+func (e *exitedProcesses) list() []exitedProcess {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.prune()
+
+	return slices.Clone(e.procs)
+}
+
+// get returns the most recently exited process matching the selector.
+func (e *exitedProcesses) get(selector *rpc.ProcessSelector) *handler.Handler {
+	procs := e.list()
+
+	for _, p := range slices.Backward(procs) {
+		switch selector.GetSelector().(type) {
+		case *rpc.ProcessSelector_Pid:
+			if p.pid == selector.GetPid() {
+				return p.handler
+			}
+		case *rpc.ProcessSelector_Tag:
+			if p.handler.Tag != nil && *p.handler.Tag == selector.GetTag() {
+				return p.handler
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *exitedProcesses) prune() {
+	e.procs = slices.DeleteFunc(e.procs, func(p exitedProcess) bool {
+		return time.Since(p.exitedAt) > exitedProcessRetention
+	})
+}
@@ -0,0 +1,45 @@
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func TestExitedProcesses(t *testing.T) {
+	t.Parallel()
+
+	e := &exitedProcesses{}
+
+	first := &handler.Handler{Tag: new("server")}
+	second := &handler.Handler{Tag: new("server")}
+	e.add(1, first)
+	e.add(2, second)
+
+	// The most recent process with the tag wins.
+	assert.Same(t, second, e.get(&rpc.ProcessSelector{Selector: &rpc.ProcessSelector_Tag{Tag: "server"}}))
+	assert.Same(t, first, e.get(&rpc.ProcessSelector{Selector: &rpc.ProcessSelector_Pid{Pid: 1}}))
+	assert.Nil(t, e.get(&rpc.ProcessSelector{Selector: &rpc.ProcessSelector_Pid{Pid: 3}}))
+
+	// A reused pid replaces the older process.
+	third := &handler.Handler{}
+	e.add(1, third)
+	procs := e.list()
+	require.Len(t, procs, 2)
+	assert.Same(t, second, procs[0].handler)
+	assert.Same(t, third, procs[1].handler)
+
+	// Expired processes are dropped.
+	e.procs[0].exitedAt = time.Now().Add(-exitedProcessRetention - time.Second)
+	assert.Len(t, e.list(), 1)
+
+	for pid := range uint32(maxExitedProcesses + 5) {
+		e.add(100+pid, &handler.Handler{})
+	}
+	assert.Len(t, e.list(), maxExitedProcesses)
+}
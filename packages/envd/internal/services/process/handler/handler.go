@@ -44,6 +44,7 @@ type ProcessExit struct {
 
 type Handler struct {
 	Config *rpc.ProcessConfig
+	Limits *rpc.ProcessLimits
 
 	logger *zerolog.Logger
 
@@ -51,6 +52,10 @@ type Handler struct {
 	cmd *exec.Cmd
 	tty *os.File
 
+	// cgroup is the process's own cgroup, nil when it runs in the shared one.
+	cgroup   cgroups.ProcessCgroup
+	timedOut func() bool
+
 	cancel context.CancelFunc
 
 	outCtx    context.Context //nolint:containedctx // todo: refactor so this can be removed
@@ -65,6 +70,12 @@ type Handler struct {
 
 	DataEvent *MultiplexedChannel[rpc.ProcessEvent_Data]
 	EndEvent  *MultiplexedChannel[rpc.ProcessEvent_End]
+
+	// Output keeps the most recent output for clients connecting later.
+	Output *OutputBuffer
+	// outputLog ships the output to the sandbox logs, nil when it's not enabled.
+	outputLog *outputLog
+	end       atomic.Pointer[rpc.ProcessEvent_EndEvent]
 }
 
 // This method must be called only after the process has been started
@@ -97,10 +108,25 @@ func New(
 	defaults *execcontext.Defaults,
 	cgroupManager cgroups.Manager,
 	cancel context.CancelFunc,
-) (*Handler, error) {
+) (h *Handler, err error) {
 	// User command string for logging (without the internal wrapper details).
 	userCmd := strings.Join(append([]string{req.GetProcess().GetCmd()}, req.GetProcess().GetArgs()...), " ")
 
+	timedOut := func() bool { return false }
+	if timeout := req.GetLimits().GetTimeoutMs(); timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, time.Duration(timeout)*time.Millisecond, errProcessTimeout)
+
+		procCancel := cancel
+		cancel = func() {
+			cancelTimeout()
+			procCancel()
+		}
+
+		timeoutCtx := ctx
+		timedOut = func() bool { return errors.Is(context.Cause(timeoutCtx), errProcessTimeout) }
+	}
+
 	// Wrap in a shell that resets oom_score_adj, ioprio (ionice best-effort/4), and nice.
 	niceDelta := defaultNice - currentNice()
 	oomWrapperScript := fmt.Sprintf(`echo %d > /proc/$$/oom_score_adj && exec /usr/bin/ionice -c 2 -n 4 /usr/bin/nice -n %d "${@}"`, defaultOomScore, niceDelta)
@@ -123,7 +149,22 @@ func New(
 		}
 	}
 
-	cgroupFD, ok := cgroupManager.GetFileDescriptor(getProcType(req))
+	procType := getProcType(req)
+	cgroupFD, ok := cgroupManager.GetFileDescriptor(procType)
+
+	processCgroup, err := newProcessCgroup(cgroupManager, procType, req.GetLimits(), logger)
+	if err != nil {
+		return nil, err
+	}
+	if processCgroup != nil {
+		cgroupFD, ok = processCgroup.GetFileDescriptor(), true
+
+		defer func() {
+			if h == nil {
+				processCgroup.Close()
+			}
+		}()
+	}
 
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		Credential: &syscall.Credential{
@@ -177,18 +218,26 @@ func New(
 	// Cancellation of the process via timeout will propagate and cancel this context too.
 	outCtx, outCancel := context.WithCancel(ctx)
 
-	h := &Handler{
+	h = &Handler{
 		Config:    req.GetProcess(),
+		Limits:    req.GetLimits(),
 		cmd:       cmd,
+		cgroup:    processCgroup,
+		timedOut:  timedOut,
 		Tag:       req.Tag,
 		DataEvent: outMultiplex,
 		cancel:    cancel,
 		outCtx:    outCtx,
 		outCancel: outCancel,
 		EndEvent:  NewMultiplexedChannel[rpc.ProcessEvent_End](0),
+		Output:    NewOutputBuffer(outputBufferLimit),
 		logger:    logger,
 	}
 
+	if shouldLogOutput(req, defaults.LogProcessOutput) {
+		h.outputLog = newOutputLog(logger, req.Tag) //nolint:protogetter // we need the nil check too
+	}
+
 	if req.GetPty() != nil {
 		// The pty should ideally start only in the Start method, but the package does not support that and we would have to code it manually.
 		// The output of the pty should correctly be passed though.
@@ -209,17 +258,9 @@ func New(
 				if n > 0 {
 					h.ptyBytes.Add(int64(n))
 
-					if outMultiplex.HasSubscribers() {
-						data := slices.Clone(readBuf[:n])
-
-						outMultiplex.Source <- rpc.ProcessEvent_Data{
-							Data: &rpc.ProcessEvent_DataEvent{
-								Output: &rpc.ProcessEvent_DataEvent_Pty{
-									Pty: data,
-								},
-							},
-						}
-					}
+					h.emit(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Pty{Pty: slices.Clone(readBuf[:n])},
+					})
 				}
 
 				if errors.Is(readErr, io.EOF) || errors.Is(readErr, syscall.EIO) {
@@ -235,6 +276,10 @@ func New(
 		})
 
 		h.tty = tty
+
+		if h.outputLog != nil {
+			h.outputLog.setPid(cmd.Process.Pid)
+		}
 	} else {
 		stdout, err := cmd.StdoutPipe()
 		if err != nil {
@@ -250,17 +295,9 @@ func New(
 				if n > 0 {
 					h.stdoutBytes.Add(int64(n))
 
-					if outMultiplex.HasSubscribers() {
-						data := slices.Clone(readBuf[:n])
-
-						outMultiplex.Source <- rpc.ProcessEvent_Data{
-							Data: &rpc.ProcessEvent_DataEvent{
-								Output: &rpc.ProcessEvent_DataEvent_Stdout{
-									Stdout: data,
-								},
-							},
-						}
-					}
+					h.emit(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Stdout{Stdout: slices.Clone(readBuf[:n])},
+					})
 				}
 
 				if errors.Is(readErr, io.EOF) {
@@ -289,17 +326,9 @@ func New(
 				if n > 0 {
 					h.stderrBytes.Add(int64(n))
 
-					if outMultiplex.HasSubscribers() {
-						data := slices.Clone(readBuf[:n])
-
-						outMultiplex.Source <- rpc.ProcessEvent_Data{
-							Data: &rpc.ProcessEvent_DataEvent{
-								Output: &rpc.ProcessEvent_DataEvent_Stderr{
-									Stderr: data,
-								},
-							},
-						}
-					}
+					h.emit(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Stderr{Stderr: slices.Clone(readBuf[:n])},
+					})
 				}
 
 				if errors.Is(readErr, io.EOF) {
@@ -326,9 +355,15 @@ func New(
 		}
 	}
 
+	outputLog := h.outputLog
+
 	go func() {
 		outWg.Wait()
 
+		if outputLog != nil {
+			outputLog.flush()
+		}
+
 		close(outMultiplex.Source)
 
 		outCancel()
@@ -337,6 +372,26 @@ func New(
 	return h, nil
 }
 
+// emit buffers the output and forwards it to the connected clients. The output
+// is buffered first, so a client that subscribes and then reads the buffer
+// can't miss it.
+func (p *Handler) emit(event *rpc.ProcessEvent_DataEvent) {
+	p.Output.Append(event)
+
+	if p.outputLog != nil {
+		p.outputLog.write(event)
+	}
+
+	if p.DataEvent.HasSubscribers() {
+		p.DataEvent.Source <- rpc.ProcessEvent_Data{Data: event}
+	}
+}
+
+// End returns the end event of the process, nil while it's running.
+func (p *Handler) End() *rpc.ProcessEvent_EndEvent {
+	return p.end.Load()
+}
+
 func getProcType(req *rpc.StartRequest) cgroups.ProcessType {
 	if req != nil && req.GetTag() == systemTag {
 		return cgroups.ProcessTypeSystem
@@ -429,8 +484,14 @@ func (p *Handler) Start(requestTimeout time.Duration) (uint32, error) {
 	if p.tty == nil {
 		err := p.cmd.Start()
 		if err != nil {
+			p.closeCgroup()
+
 			return 0, fmt.Errorf("error starting process '%s': %w", p.userCommand(), err)
 		}
+
+		if p.outputLog != nil {
+			p.outputLog.setPid(p.cmd.Process.Pid)
+		}
 	}
 
 	p.logger.
@@ -464,13 +525,22 @@ func (p *Handler) Wait() {
 		ExitCode: int32(p.cmd.ProcessState.ExitCode()),
 		Exited:   p.cmd.ProcessState.Exited(),
 		Status:   p.cmd.ProcessState.String(),
+		Usage:    p.endUsage(),
+		TimedOut: p.timedOut(),
 	}
 
+	p.closeCgroup()
+
+	// Stored before the event is sent, so clients subscribing after it was
+	// sent still find it once the channel is closed.
+	p.end.Store(endEvent)
+
 	event := rpc.ProcessEvent_End{
 		End: endEvent,
 	}
 
 	p.EndEvent.Source <- event
+	close(p.EndEvent.Source)
 
 	p.logger.
 		Info().
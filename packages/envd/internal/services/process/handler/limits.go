@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/cgroups"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	cpuMaxPeriodUsec = 100_000
+
+	// Below these the process can't even exec the command.
+	minMemoryLimitBytes  = 4 << 20 // 4 MiB
+	minCPULimitMillicore = 10      // cpu.max quota must be at least 1ms
+)
+
+var errProcessTimeout = errors.New("process timeout exceeded")
+
+// limitProperties converts the requested limits to the properties of the process cgroup.
+func limitProperties(limits *rpc.ProcessLimits) (map[string]string, error) {
+	properties := make(map[string]string)
+	if limits == nil {
+		return properties, nil
+	}
+
+	if limits.MemoryBytes != nil {
+		if limits.GetMemoryBytes() < minMemoryLimitBytes {
+			return nil, fmt.Errorf("memory limit must be at least %d bytes", minMemoryLimitBytes)
+		}
+
+		properties["memory.max"] = strconv.FormatUint(limits.GetMemoryBytes(), 10)
+	}
+
+	if limits.CpuMillicores != nil {
+		if limits.GetCpuMillicores() < minCPULimitMillicore {
+			return nil, fmt.Errorf("cpu limit must be at least %d millicores", minCPULimitMillicore)
+		}
+
+		quota := uint64(limits.GetCpuMillicores()) * cpuMaxPeriodUsec / 1000
+		properties["cpu.max"] = fmt.Sprintf("%d %d", quota, cpuMaxPeriodUsec)
+	}
+
+	if limits.Pids != nil {
+		if limits.GetPids() == 0 {
+			return nil, errors.New("pids limit must be at least 1")
+		}
+
+		properties["pids.max"] = strconv.FormatUint(uint64(limits.GetPids()), 10)
+	}
+
+	return properties, nil
+}
+
+// newProcessCgroup creates the process's own cgroup with the requested limits.
+// It returns nil when the process should run in the shared cgroup of its type.
+func newProcessCgroup(manager cgroups.Manager, procType cgroups.ProcessType, limits *rpc.ProcessLimits, logger *zerolog.Logger) (cgroups.ProcessCgroup, error) {
+	properties, err := limitProperties(limits)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if procType != cgroups.ProcessTypeUser {
+		if len(properties) > 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource limits are not supported for %s processes", procType))
+		}
+
+		return nil, nil
+	}
+
+	cgroup, err := manager.CreateProcessCgroup(procType, properties)
+	if err != nil {
+		if len(properties) > 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to apply resource limits: %w", err))
+		}
+
+		// Without its own cgroup the process is still accounted from its rusage.
+		if !errors.Is(err, cgroups.ErrProcessCgroupsNotSupported) {
+			logger.Warn().Err(err).Msg("failed to create process cgroup")
+		}
+
+		return nil, nil
+	}
+
+	return cgroup, nil
+}
+
+// Usage returns the current resource usage of the process and its descendants,
+// nil when the process doesn't have its own cgroup.
+func (p *Handler) Usage() *rpc.ProcessUsage {
+	if p.cgroup == nil {
+		return nil
+	}
+
+	stats, err := p.cgroup.Stats()
+	if err != nil {
+		return nil
+	}
+
+	return statsToUsage(stats)
+}
+
+// endUsage returns the usage of the exited process, preferring the cgroup
+// accounting which also covers descendants that weren't waited for.
+func (p *Handler) endUsage() *rpc.ProcessUsage {
+	if p.cgroup != nil {
+		stats, err := p.cgroup.Stats()
+		if err == nil {
+			return statsToUsage(stats)
+		}
+
+		p.logger.Warn().Err(err).Msg("failed to read process cgroup stats")
+	}
+
+	return rusageToUsage(p.cmd.ProcessState)
+}
+
+func (p *Handler) closeCgroup() {
+	if p.cgroup == nil {
+		return
+	}
+
+	if err := p.cgroup.Close(); err != nil {
+		p.logger.Warn().Err(err).Msg("failed to close process cgroup")
+	}
+}
+
+func statsToUsage(stats cgroups.Stats) *rpc.ProcessUsage {
+	return &rpc.ProcessUsage{
+		CpuUsageUsec:       uint64(stats.CPUUsage.Microseconds()),
+		MemoryCurrentBytes: stats.MemoryCurrent,
+		MemoryPeakBytes:    stats.MemoryPeak,
+		OomKills:           stats.OOMKills,
+	}
+}
+
+func rusageToUsage(state *os.ProcessState) *rpc.ProcessUsage {
+	if state == nil {
+		return nil
+	}
+
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return nil
+	}
+
+	return &rpc.ProcessUsage{
+		CpuUsageUsec: uint64((state.UserTime() + state.SystemTime()).Microseconds()),
+		// Linux reports the peak resident set size in kilobytes.
+		MemoryPeakBytes: uint64(rusage.Maxrss) * 1024,
+	}
+}
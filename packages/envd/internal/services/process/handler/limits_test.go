@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/cgroups"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func TestLimitProperties(t *testing.T) {
+	t.Parallel()
+
+	properties, err := limitProperties(nil)
+	require.NoError(t, err)
+	assert.Empty(t, properties)
+
+	properties, err = limitProperties(&rpc.ProcessLimits{
+		MemoryBytes:   new(uint64(256 << 20)),
+		CpuMillicores: new(uint32(500)),
+		Pids:          new(uint32(64)),
+		TimeoutMs:     new(uint32(1000)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"memory.max": "268435456",
+		"cpu.max":    "50000 100000",
+		"pids.max":   "64",
+	}, properties)
+
+	_, err = limitProperties(&rpc.ProcessLimits{MemoryBytes: new(uint64(1 << 20))})
+	require.Error(t, err)
+
+	_, err = limitProperties(&rpc.ProcessLimits{CpuMillicores: new(uint32(5))})
+	require.Error(t, err)
+
+	_, err = limitProperties(&rpc.ProcessLimits{Pids: new(uint32(0))})
+	require.Error(t, err)
+}
+
+func TestNewProcessCgroup(t *testing.T) {
+	t.Parallel()
+
+	logger := zerolog.Nop()
+	manager := cgroups.NewNoopManager()
+	limits := &rpc.ProcessLimits{MemoryBytes: new(uint64(64 << 20))}
+
+	// Without limits the process falls back to the shared cgroup.
+	cgroup, err := newProcessCgroup(manager, cgroups.ProcessTypeUser, nil, &logger)
+	require.NoError(t, err)
+	assert.Nil(t, cgroup)
+
+	// A timeout alone doesn't need a cgroup.
+	cgroup, err = newProcessCgroup(manager, cgroups.ProcessTypeUser, &rpc.ProcessLimits{TimeoutMs: new(uint32(10))}, &logger)
+	require.NoError(t, err)
+	assert.Nil(t, cgroup)
+
+	_, err = newProcessCgroup(manager, cgroups.ProcessTypeUser, limits, &logger)
+	require.Error(t, err)
+
+	_, err = newProcessCgroup(manager, cgroups.ProcessTypePTY, limits, &logger)
+	require.Error(t, err)
+}
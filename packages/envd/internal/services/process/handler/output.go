@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+// outputBufferLimit is the amount of the most recent output kept per process for replay.
+const outputBufferLimit = 1 << 20 // 1 MiB
+
+type outputChunk struct {
+	timestamp time.Time
+	event     *rpc.ProcessEvent_DataEvent
+}
+
+// OutputBuffer keeps the most recent output of a process so clients connecting
+// later can replay it. Each chunk is assigned its byte offset in the combined
+// stdout, stderr and pty output, which clients use to resume where they left off.
+type OutputBuffer struct {
+	mu     sync.Mutex
+	chunks []outputChunk
+	size   int
+	limit  int
+	end    uint64
+}
+
+func NewOutputBuffer(limit int) *OutputBuffer {
+	return &OutputBuffer{limit: limit}
+}
+
+// Append assigns the event its offset and stores it, evicting the oldest
+// chunks above the limit.
+func (b *OutputBuffer) Append(event *rpc.ProcessEvent_DataEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(dataBytes(event))
+	event.Offset = b.end
+	b.end += uint64(n)
+
+	b.chunks = append(b.chunks, outputChunk{timestamp: time.Now(), event: event})
+	b.size += n
+
+	evict := 0
+	for b.size > b.limit && evict < len(b.chunks) {
+		b.size -= len(dataBytes(b.chunks[evict].event))
+		evict++
+	}
+
+	// Clear the evicted chunks so their data can be collected.
+	clear(b.chunks[:evict])
+	b.chunks = b.chunks[evict:]
+}
+
+// SinceOffset returns the buffered output from the offset on, and the offset
+// following it. A chunk containing the offset is cut so no byte is repeated.
+func (b *OutputBuffer) SinceOffset(offset uint64) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []*rpc.ProcessEvent_DataEvent
+	for _, c := range b.chunks {
+		data := dataBytes(c.event)
+		chunkEnd := c.event.GetOffset() + uint64(len(data))
+
+		switch {
+		case chunkEnd <= offset:
+			continue
+		case c.event.GetOffset() < offset:
+			events = append(events, withData(c.event, data[offset-c.event.GetOffset():], offset))
+		default:
+			events = append(events, c.event)
+		}
+	}
+
+	return events, b.end
+}
+
+// SinceTime returns the buffered output produced at or after t, and the offset
+// following it.
+func (b *OutputBuffer) SinceTime(t time.Time) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []*rpc.ProcessEvent_DataEvent
+	for _, c := range b.chunks {
+		if !c.timestamp.Before(t) {
+			events = append(events, c.event)
+		}
+	}
+
+	return events, b.end
+}
+
+func dataBytes(event *rpc.ProcessEvent_DataEvent) []byte {
+	switch output := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		return output.Stdout
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		return output.Stderr
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		return output.Pty
+	default:
+		return nil
+	}
+}
+
+// withData returns a copy of the event with its data replaced.
+func withData(event *rpc.ProcessEvent_DataEvent, data []byte, offset uint64) *rpc.ProcessEvent_DataEvent {
+	result := &rpc.ProcessEvent_DataEvent{Offset: offset}
+
+	switch event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		result.Output = &rpc.ProcessEvent_DataEvent_Stdout{Stdout: data}
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		result.Output = &rpc.ProcessEvent_DataEvent_Stderr{Stderr: data}
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		result.Output = &rpc.ProcessEvent_DataEvent_Pty{Pty: data}
+	}
+
+	return result
+}
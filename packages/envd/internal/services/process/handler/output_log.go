@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs/ratelimit"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	// outputLogRate and outputLogBurst limit the lines shipped per process, so a
+	// chatty process can't flood the sandbox logs.
+	outputLogRate  = 100
+	outputLogBurst = 1000
+	// outputLogMaxLine is where longer lines are split, keeping the entries well under the exporter limit.
+	outputLogMaxLine = 16 << 10 // 16 KiB
+	// outputLogDroppedFloor is how often the dropped lines are reported.
+	outputLogDroppedFloor = 10 * time.Second
+)
+
+const (
+	streamStdout = "stdout"
+	streamStderr = "stderr"
+	streamPty    = "pty"
+)
+
+// outputLog ships the output of a process to the sandbox logs line by line.
+type outputLog struct {
+	logger zerolog.Logger
+	// pid is set before started is closed, the output can be read before that.
+	pid     uint32
+	started chan struct{}
+	bucket  *ratelimit.Bucket
+	dropped *ratelimit.Limiter
+
+	// The streams are each written only from their own reader.
+	stdout *outputLogStream
+	stderr *outputLogStream
+	pty    *outputLogStream
+}
+
+type outputLogStream struct {
+	log  *outputLog
+	name string
+	buf  []byte
+}
+
+func newOutputLog(logger *zerolog.Logger, tag *string) *outputLog {
+	ctx := logger.With().Str("logger", "process-output")
+	if tag != nil {
+		ctx = ctx.Str("process_tag", *tag)
+	}
+
+	l := &outputLog{
+		logger:  ctx.Logger(),
+		started: make(chan struct{}),
+		bucket:  ratelimit.NewBucket(outputLogRate, outputLogBurst),
+		dropped: ratelimit.New(outputLogDroppedFloor),
+	}
+	l.stdout = &outputLogStream{log: l, name: streamStdout}
+	l.stderr = &outputLogStream{log: l, name: streamStderr}
+	l.pty = &outputLogStream{log: l, name: streamPty}
+
+	return l
+}
+
+// shouldLogOutput resolves whether the output of the process is shipped, falling back to the sandbox-wide default.
+func shouldLogOutput(req *rpc.StartRequest, defaultLogOutput bool) bool {
+	if req.LogOutput != nil {
+		return req.GetLogOutput()
+	}
+
+	return defaultLogOutput
+}
+
+// setPid must be called once the process is started, the output isn't shipped before.
+func (l *outputLog) setPid(pid int) {
+	l.pid = uint32(pid)
+	close(l.started)
+}
+
+func (l *outputLog) write(event *rpc.ProcessEvent_DataEvent) {
+	<-l.started
+
+	switch out := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		l.stdout.write(out.Stdout)
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		l.stderr.write(out.Stderr)
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		l.pty.write(out.Pty)
+	}
+}
+
+// flush ships the last unterminated lines, it must be called only after the readers are done.
+func (l *outputLog) flush() {
+	for _, s := range []*outputLogStream{l.stdout, l.stderr, l.pty} {
+		if len(s.buf) > 0 {
+			s.emit(s.buf)
+			s.buf = nil
+		}
+	}
+}
+
+func (s *outputLogStream) write(data []byte) {
+	s.buf = append(s.buf, data...)
+
+	for {
+		i := bytes.IndexByte(s.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		s.emit(s.buf[:i])
+		s.buf = s.buf[i+1:]
+	}
+
+	for len(s.buf) >= outputLogMaxLine {
+		s.emit(s.buf[:outputLogMaxLine])
+		s.buf = s.buf[outputLogMaxLine:]
+	}
+
+	// Don't keep the consumed part of the buffer alive.
+	s.buf = bytes.Clone(s.buf)
+}
+
+func (s *outputLogStream) emit(line []byte) {
+	line = bytes.TrimSuffix(line, []byte("\r"))
+
+	for len(line) > outputLogMaxLine {
+		s.emit(line[:outputLogMaxLine])
+		line = line[outputLogMaxLine:]
+	}
+
+	if !s.log.bucket.Allow() {
+		if ok, suppressed := s.log.dropped.Allow(); ok {
+			s.log.logger.Warn().
+				Uint32("pid", s.log.pid).
+				Int64("dropped_lines", suppressed+1).
+				Msg("Process output is over the rate limit, dropping lines")
+		}
+
+		return
+	}
+
+	s.log.logger.Info().
+		Uint32("pid", s.log.pid).
+		Str("stream", s.name).
+		Msg(string(line))
+}
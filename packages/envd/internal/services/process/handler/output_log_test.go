@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+type outputLogEntry struct {
+	Level        string `json:"level"`
+	Message      string `json:"message"`
+	Pid          uint32 `json:"pid"`
+	Tag          string `json:"process_tag"`
+	Stream       string `json:"stream"`
+	DroppedLines int64  `json:"dropped_lines"`
+}
+
+func newTestOutputLog(t *testing.T) (*outputLog, func() []outputLogEntry) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	tag := "server"
+
+	l := newOutputLog(&logger, &tag)
+	l.setPid(42)
+
+	return l, func() []outputLogEntry {
+		var entries []outputLogEntry
+		for line := range strings.Lines(buf.String()) {
+			var entry outputLogEntry
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		buf.Reset()
+
+		return entries
+	}
+}
+
+func TestOutputLog(t *testing.T) {
+	t.Parallel()
+
+	l, entries := newTestOutputLog(t)
+
+	l.write(stdoutEvent("hello\nwor"))
+	l.write(&rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stderr{Stderr: []byte("oops\r\n")}})
+	l.write(stdoutEvent("ld\n"))
+	l.write(stdoutEvent("no newline"))
+	l.flush()
+
+	assert.Equal(t, []outputLogEntry{
+		{Level: "info", Message: "hello", Pid: 42, Tag: "server", Stream: streamStdout},
+		{Level: "info", Message: "oops", Pid: 42, Tag: "server", Stream: streamStderr},
+		{Level: "info", Message: "world", Pid: 42, Tag: "server", Stream: streamStdout},
+		{Level: "info", Message: "no newline", Pid: 42, Tag: "server", Stream: streamStdout},
+	}, entries())
+
+	// Long lines are split.
+	l.write(stdoutEvent(strings.Repeat("a", outputLogMaxLine+10) + "\n"))
+	got := entries()
+	require.Len(t, got, 2)
+	assert.Len(t, got[0].Message, outputLogMaxLine)
+	assert.Len(t, got[1].Message, 10)
+}
+
+func TestOutputLogRateLimit(t *testing.T) {
+	t.Parallel()
+
+	l, entries := newTestOutputLog(t)
+
+	l.write(stdoutEvent(strings.Repeat("line\n", outputLogBurst+5)))
+
+	got := entries()
+	// Only the first dropped line is reported, until the floor passes.
+	require.Len(t, got, outputLogBurst+1)
+	assert.Equal(t, "warn", got[outputLogBurst].Level)
+	assert.Equal(t, int64(1), got[outputLogBurst].DroppedLines)
+}
+
+func TestShouldLogOutput(t *testing.T) {
+	t.Parallel()
+
+	enabled, disabled := true, false
+
+	assert.True(t, shouldLogOutput(&rpc.StartRequest{}, true))
+	assert.False(t, shouldLogOutput(&rpc.StartRequest{}, false))
+	assert.True(t, shouldLogOutput(&rpc.StartRequest{LogOutput: &enabled}, false))
+	assert.False(t, shouldLogOutput(&rpc.StartRequest{LogOutput: &disabled}, true))
+}
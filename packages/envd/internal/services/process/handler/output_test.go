@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func stdoutEvent(data string) *rpc.ProcessEvent_DataEvent {
+	return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stdout{Stdout: []byte(data)}}
+}
+
+func TestOutputBuffer_SinceOffset(t *testing.T) {
+	t.Parallel()
+
+	b := NewOutputBuffer(1024)
+
+	first, second := stdoutEvent("hello "), &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stderr{Stderr: []byte("world")}}
+	b.Append(first)
+	b.Append(second)
+	assert.Equal(t, uint64(0), first.GetOffset())
+	assert.Equal(t, uint64(6), second.GetOffset())
+
+	events, end := b.SinceOffset(0)
+	assert.Equal(t, []*rpc.ProcessEvent_DataEvent{first, second}, events)
+	assert.Equal(t, uint64(11), end)
+
+	// A chunk containing the offset is cut.
+	events, _ = b.SinceOffset(8)
+	require.Len(t, events, 1)
+	assert.Equal(t, []byte("rld"), events[0].GetStderr())
+	assert.Equal(t, uint64(8), events[0].GetOffset())
+	assert.Equal(t, []byte("world"), second.GetStderr())
+
+	events, end = b.SinceOffset(11)
+	assert.Empty(t, events)
+	assert.Equal(t, uint64(11), end)
+}
+
+func TestOutputBuffer_Eviction(t *testing.T) {
+	t.Parallel()
+
+	b := NewOutputBuffer(8)
+
+	b.Append(stdoutEvent("aaaa"))
+	b.Append(stdoutEvent("bbbb"))
+	b.Append(stdoutEvent("cccc"))
+
+	// Offsets keep counting the evicted output.
+	events, end := b.SinceOffset(0)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(4), events[0].GetOffset())
+	assert.Equal(t, []byte("bbbb"), events[0].GetStdout())
+	assert.Equal(t, uint64(12), end)
+}
+
+func TestOutputBuffer_SinceTime(t *testing.T) {
+	t.Parallel()
+
+	b := NewOutputBuffer(1024)
+
+	b.Append(stdoutEvent("old"))
+	since := time.Now()
+	newer := stdoutEvent("new")
+	b.Append(newer)
+
+	events, end := b.SinceTime(since)
+	assert.Equal(t, []*rpc.ProcessEvent_DataEvent{newer}, events)
+	assert.Equal(t, uint64(6), end)
+
+	events, _ = b.SinceTime(time.Now().Add(time.Minute))
+	assert.Empty(t, events)
+}
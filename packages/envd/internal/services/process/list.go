@@ -9,20 +9,45 @@ import (
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 )
 
-func (s *Service) List(context.Context, *connect.Request[rpc.ListRequest]) (*connect.Response[rpc.ListResponse], error) {
+func (s *Service) List(_ context.Context, req *connect.Request[rpc.ListRequest]) (*connect.Response[rpc.ListResponse], error) {
 	processes := make([]*rpc.ProcessInfo, 0)
+	listed := make(map[*handler.Handler]struct{})
 
 	s.processes.Range(func(pid uint32, value *handler.Handler) bool {
-		processes = append(processes, &rpc.ProcessInfo{
-			Pid:    pid,
-			Tag:    value.Tag,
-			Config: value.Config,
-		})
+		// Processes that just ended are listed only with the exited ones.
+		if value.End() != nil && !req.Msg.GetIncludeExited() {
+			return true
+		}
+
+		processes = append(processes, processInfo(pid, value))
+		listed[value] = struct{}{}
 
 		return true
 	})
 
+	if req.Msg.GetIncludeExited() {
+		for _, p := range s.exited.list() {
+			// A process is briefly in both while it's being moved to the exited ones.
+			if _, ok := listed[p.handler]; ok {
+				continue
+			}
+
+			processes = append(processes, processInfo(p.pid, p.handler))
+		}
+	}
+
 	return connect.NewResponse(&rpc.ListResponse{
 		Processes: processes,
 	}), nil
 }
+
+func processInfo(pid uint32, h *handler.Handler) *rpc.ProcessInfo {
+	return &rpc.ProcessInfo{
+		Pid:    pid,
+		Tag:    h.Tag,
+		Config: h.Config,
+		Limits: h.Limits,
+		Usage:  h.Usage(),
+		End:    h.End(),
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 type Service struct {
 	processes     *utils.Map[uint32, *handler.Handler]
+	exited        *exitedProcesses
 	logger        *zerolog.Logger
 	defaults      *execcontext.Defaults
 	cgroupManager cgroups.Manager
@@ -27,6 +28,7 @@ func newService(l *zerolog.Logger, defaults *execcontext.Defaults, cgroupManager
 	return &Service{
 		logger:        l,
 		processes:     utils.NewMap[uint32, *handler.Handler](),
+		exited:        &exitedProcesses{},
 		defaults:      defaults,
 		cgroupManager: cgroupManager,
 	}
@@ -44,6 +46,18 @@ func Handle(server *chi.Mux, l *zerolog.Logger, defaults *execcontext.Defaults,
 	return service
 }
 
+// getProcessOrExited also finds the processes that exited within the retention window.
+func (s *Service) getProcessOrExited(selector *rpc.ProcessSelector) (*handler.Handler, error) {
+	proc, err := s.getProcess(selector)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		if exited := s.exited.get(selector); exited != nil {
+			return exited, nil
+		}
+	}
+
+	return proc, err
+}
+
 func (s *Service) getProcess(selector *rpc.ProcessSelector) (*handler.Handler, error) {
 	var proc *handler.Handler
 
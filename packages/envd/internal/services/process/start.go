@@ -177,6 +177,8 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 		defer s.processes.Delete(pid)
 
 		proc.Wait()
+
+		s.exited.add(pid, proc)
 	}()
 
 	// Wait for the sender goroutine; returning early panics envd.
@@ -187,3 +187,148 @@ func TestStart_ClientDisconnectMidStream(t *testing.T) {
 	}
 	_ = stream.Close()
 }
+
+// TestStart_LimitsTimeout verifies that the timeout in the limits kills the
+// process and is reported in the end event together with its usage.
+func TestStart_LimitsTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, cleanup := newTestService(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.Start(ctx, connect.NewRequest(&rpc.StartRequest{
+		Process: &rpc.ProcessConfig{
+			Cmd:  "sleep",
+			Args: []string{"30"},
+		},
+		Limits: &rpc.ProcessLimits{TimeoutMs: new(uint32(200))},
+	}))
+	require.NoError(t, err)
+
+	var end *rpc.ProcessEvent_EndEvent
+	for stream.Receive() {
+		if e := stream.Msg().GetEvent().GetEnd(); e != nil {
+			end = e
+		}
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+
+	require.NotNil(t, end, "expected an end event")
+	assert.True(t, end.GetTimedOut())
+	assert.False(t, end.GetExited())
+	assert.NotNil(t, end.GetUsage())
+}
+
+// TestStart_LimitsWithoutCgroups verifies that resource limits are refused
+// rather than silently ignored when per-process cgroups are unavailable.
+func TestStart_LimitsWithoutCgroups(t *testing.T) {
+	t.Parallel()
+
+	client, cleanup := newTestService(t)
+	t.Cleanup(cleanup)
+
+	tests := []struct {
+		name   string
+		limits *rpc.ProcessLimits
+		code   connect.Code
+	}{
+		{name: "memory", limits: &rpc.ProcessLimits{MemoryBytes: new(uint64(64 << 20))}, code: connect.CodeFailedPrecondition},
+		{name: "memory too low", limits: &rpc.ProcessLimits{MemoryBytes: new(uint64(1024))}, code: connect.CodeInvalidArgument},
+		{name: "zero pids", limits: &rpc.ProcessLimits{Pids: new(uint32(0))}, code: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.Start(t.Context(), connect.NewRequest(&rpc.StartRequest{
+				Process: &rpc.ProcessConfig{Cmd: "true"},
+				Limits:  tt.limits,
+			}))
+			require.NoError(t, err)
+
+			for stream.Receive() {
+			}
+			require.Error(t, stream.Err())
+			assert.Equal(t, tt.code, connect.CodeOf(stream.Err()))
+			_ = stream.Close()
+		})
+	}
+}
+
+// TestConnect_ReplayExited verifies that a client connecting after the process
+// exited gets its buffered output replayed and its end event.
+func TestConnect_ReplayExited(t *testing.T) {
+	t.Parallel()
+
+	client, cleanup := newTestService(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.Start(ctx, connect.NewRequest(&rpc.StartRequest{
+		Process: &rpc.ProcessConfig{
+			Cmd:  "/bin/sh",
+			Args: []string{"-c", "printf hello; printf world"},
+		},
+		Tag: new("replay"),
+	}))
+	require.NoError(t, err)
+
+	for stream.Receive() {
+	}
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+
+	selector := &rpc.ProcessSelector{Selector: &rpc.ProcessSelector_Tag{Tag: "replay"}}
+
+	// The exited process is only listed on request.
+	list, err := client.List(ctx, connect.NewRequest(&rpc.ListRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, list.Msg.GetProcesses())
+
+	list, err = client.List(ctx, connect.NewRequest(&rpc.ListRequest{IncludeExited: true}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetProcesses(), 1)
+	require.NotNil(t, list.Msg.GetProcesses()[0].GetEnd())
+	assert.True(t, list.Msg.GetProcesses()[0].GetEnd().GetExited())
+
+	replay := func(r *rpc.Replay) (string, *rpc.ProcessEvent_EndEvent) {
+		stream, err := client.Connect(ctx, connect.NewRequest(&rpc.ConnectRequest{Process: selector, Replay: r}))
+		require.NoError(t, err)
+
+		var (
+			output string
+			end    *rpc.ProcessEvent_EndEvent
+		)
+		for stream.Receive() {
+			event := stream.Msg().GetEvent()
+			output += string(event.GetData().GetStdout())
+			if event.GetEnd() != nil {
+				end = event.GetEnd()
+			}
+		}
+		require.NoError(t, stream.Err())
+		require.NoError(t, stream.Close())
+
+		return output, end
+	}
+
+	output, end := replay(&rpc.Replay{From: &rpc.Replay_Offset{Offset: 0}})
+	assert.Equal(t, "helloworld", output)
+	require.NotNil(t, end)
+	assert.Equal(t, int32(0), end.GetExitCode())
+
+	output, _ = replay(&rpc.Replay{From: &rpc.Replay_Offset{Offset: 5}})
+	assert.Equal(t, "world", output)
+
+	// Without a replay only the end event is left.
+	output, end = replay(nil)
+	assert.Empty(t, output)
+	assert.NotNil(t, end)
+}
@@ -39,6 +39,74 @@ func (_m *MockFilesystemHandler) EXPECT() *MockFilesystemHandler_Expecter {
 	return &MockFilesystemHandler_Expecter{mock: &_m.Mock}
 }
 
+// Checksum provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Checksum(context1 context.Context, request *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Checksum")
+	}
+
+	var r0 *connect.Response[filesystem.ChecksumResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChecksumRequest]) *connect.Response[filesystem.ChecksumResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.ChecksumResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.ChecksumRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Checksum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checksum'
+type MockFilesystemHandler_Checksum_Call struct {
+	*mock.Call
+}
+
+// Checksum is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.ChecksumRequest]
+func (_e *MockFilesystemHandler_Expecter) Checksum(context1 interface{}, request interface{}) *MockFilesystemHandler_Checksum_Call {
+	return &MockFilesystemHandler_Checksum_Call{Call: _e.mock.On("Checksum", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Checksum_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.ChecksumRequest])) *MockFilesystemHandler_Checksum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.ChecksumRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.ChecksumRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Checksum_Call) Return(response *connect.Response[filesystem.ChecksumResponse], err error) *MockFilesystemHandler_Checksum_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Checksum_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error)) *MockFilesystemHandler_Checksum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWatcher provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) CreateWatcher(context1 context.Context, request *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error) {
 	ret := _mock.Called(context1, request)
@@ -107,6 +175,210 @@ func (_c *MockFilesystemHandler_CreateWatcher_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// DiffManifest provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) DiffManifest(context1 context.Context, request *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffManifest")
+	}
+
+	var r0 *connect.Response[filesystem.DiffManifestResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.DiffManifestRequest]) *connect.Response[filesystem.DiffManifestResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.DiffManifestResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.DiffManifestRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_DiffManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffManifest'
+type MockFilesystemHandler_DiffManifest_Call struct {
+	*mock.Call
+}
+
+// DiffManifest is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.DiffManifestRequest]
+func (_e *MockFilesystemHandler_Expecter) DiffManifest(context1 interface{}, request interface{}) *MockFilesystemHandler_DiffManifest_Call {
+	return &MockFilesystemHandler_DiffManifest_Call{Call: _e.mock.On("DiffManifest", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_DiffManifest_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.DiffManifestRequest])) *MockFilesystemHandler_DiffManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.DiffManifestRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.DiffManifestRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_DiffManifest_Call) Return(response *connect.Response[filesystem.DiffManifestResponse], err error) *MockFilesystemHandler_DiffManifest_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_DiffManifest_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error)) *MockFilesystemHandler_DiffManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditFile provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) EditFile(context1 context.Context, request *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditFile")
+	}
+
+	var r0 *connect.Response[filesystem.EditFileResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.EditFileRequest]) *connect.Response[filesystem.EditFileResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.EditFileResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.EditFileRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_EditFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditFile'
+type MockFilesystemHandler_EditFile_Call struct {
+	*mock.Call
+}
+
+// EditFile is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.EditFileRequest]
+func (_e *MockFilesystemHandler_Expecter) EditFile(context1 interface{}, request interface{}) *MockFilesystemHandler_EditFile_Call {
+	return &MockFilesystemHandler_EditFile_Call{Call: _e.mock.On("EditFile", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_EditFile_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.EditFileRequest])) *MockFilesystemHandler_EditFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.EditFileRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.EditFileRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_EditFile_Call) Return(response *connect.Response[filesystem.EditFileResponse], err error) *MockFilesystemHandler_EditFile_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_EditFile_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error)) *MockFilesystemHandler_EditFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Find(context1 context.Context, request *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *connect.Response[filesystem.FindResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.FindRequest]) *connect.Response[filesystem.FindResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.FindResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.FindRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockFilesystemHandler_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.FindRequest]
+func (_e *MockFilesystemHandler_Expecter) Find(context1 interface{}, request interface{}) *MockFilesystemHandler_Find_Call {
+	return &MockFilesystemHandler_Find_Call{Call: _e.mock.On("Find", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Find_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.FindRequest])) *MockFilesystemHandler_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.FindRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.FindRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Find_Call) Return(response *connect.Response[filesystem.FindResponse], err error) *MockFilesystemHandler_Find_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Find_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error)) *MockFilesystemHandler_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWatcherEvents provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) GetWatcherEvents(context1 context.Context, request *connect.Request[filesystem.GetWatcherEventsRequest]) (*connect.Response[filesystem.GetWatcherEventsResponse], error) {
 	ret := _mock.Called(context1, request)
@@ -175,6 +447,69 @@ func (_c *MockFilesystemHandler_GetWatcherEvents_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// Grep provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Grep(context1 context.Context, request *connect.Request[filesystem.GrepRequest], serverStream *connect.ServerStream[filesystem.GrepResponse]) error {
+	ret := _mock.Called(context1, request, serverStream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Grep")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.GrepRequest], *connect.ServerStream[filesystem.GrepResponse]) error); ok {
+		r0 = returnFunc(context1, request, serverStream)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFilesystemHandler_Grep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Grep'
+type MockFilesystemHandler_Grep_Call struct {
+	*mock.Call
+}
+
+// Grep is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.GrepRequest]
+//   - serverStream *connect.ServerStream[filesystem.GrepResponse]
+func (_e *MockFilesystemHandler_Expecter) Grep(context1 interface{}, request interface{}, serverStream interface{}) *MockFilesystemHandler_Grep_Call {
+	return &MockFilesystemHandler_Grep_Call{Call: _e.mock.On("Grep", context1, request, serverStream)}
+}
+
+func (_c *MockFilesystemHandler_Grep_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.GrepRequest], serverStream *connect.ServerStream[filesystem.GrepResponse])) *MockFilesystemHandler_Grep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.GrepRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.GrepRequest])
+		}
+		var arg2 *connect.ServerStream[filesystem.GrepResponse]
+		if args[2] != nil {
+			arg2 = args[2].(*connect.ServerStream[filesystem.GrepResponse])
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Grep_Call) Return(err error) *MockFilesystemHandler_Grep_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Grep_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.GrepRequest], serverStream *connect.ServerStream[filesystem.GrepResponse]) error) *MockFilesystemHandler_Grep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDir provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) ListDir(context1 context.Context, request *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error) {
 	ret := _mock.Called(context1, request)
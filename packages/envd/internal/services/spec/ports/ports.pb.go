@@ -0,0 +1,860 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: ports/ports.proto
+
+package ports
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PortEventType int32
+
+const (
+	PortEventType_PORT_EVENT_TYPE_UNSPECIFIED PortEventType = 0
+	PortEventType_PORT_EVENT_TYPE_OPEN        PortEventType = 1
+	PortEventType_PORT_EVENT_TYPE_CLOSE       PortEventType = 2
+)
+
+// Enum value maps for PortEventType.
+var (
+	PortEventType_name = map[int32]string{
+		0: "PORT_EVENT_TYPE_UNSPECIFIED",
+		1: "PORT_EVENT_TYPE_OPEN",
+		2: "PORT_EVENT_TYPE_CLOSE",
+	}
+	PortEventType_value = map[string]int32{
+		"PORT_EVENT_TYPE_UNSPECIFIED": 0,
+		"PORT_EVENT_TYPE_OPEN":        1,
+		"PORT_EVENT_TYPE_CLOSE":       2,
+	}
+)
+
+func (x PortEventType) Enum() *PortEventType {
+	p := new(PortEventType)
+	*p = x
+	return p
+}
+
+func (x PortEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ports_ports_proto_enumTypes[0].Descriptor()
+}
+
+func (PortEventType) Type() protoreflect.EnumType {
+	return &file_ports_ports_proto_enumTypes[0]
+}
+
+func (x PortEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortEventType.Descriptor instead.
+func (PortEventType) EnumDescriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{0}
+}
+
+type Port struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// IP address the socket is bound to, e.g. "0.0.0.0", "127.0.0.1" or "::".
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Process owning the socket, 0 if it couldn't be determined.
+	Pid uint32 `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Command of the owning process, empty if it couldn't be determined.
+	Cmd  string   `protobuf:"bytes,4,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Args []string `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	// Connections forwarded from outside of the sandbox to a port listening on
+	// localhost. Only set by ListPorts.
+	Forwarding *ForwardStats `protobuf:"bytes,6,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Port) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Port) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Port) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Port) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *Port) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Port) GetForwarding() *ForwardStats {
+	if x != nil {
+		return x.Forwarding
+	}
+	return nil
+}
+
+type ForwardStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveConnections uint32 `protobuf:"varint,1,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	TotalConnections  uint64 `protobuf:"varint,2,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
+	// Bytes received from and sent to the connecting clients.
+	BytesReceived uint64 `protobuf:"varint,3,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	BytesSent     uint64 `protobuf:"varint,4,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+}
+
+func (x *ForwardStats) Reset() {
+	*x = ForwardStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardStats) ProtoMessage() {}
+
+func (x *ForwardStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardStats.ProtoReflect.Descriptor instead.
+func (*ForwardStats) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForwardStats) GetActiveConnections() uint32 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
+}
+
+func (x *ForwardStats) GetTotalConnections() uint64 {
+	if x != nil {
+		return x.TotalConnections
+	}
+	return 0
+}
+
+func (x *ForwardStats) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *ForwardStats) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+type ListPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPortsRequest) Reset() {
+	*x = ListPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsRequest) ProtoMessage() {}
+
+func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortsRequest) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{2}
+}
+
+type ListPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ports []*Port `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *ListPortsResponse) Reset() {
+	*x = ListPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsResponse) ProtoMessage() {}
+
+func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortsResponse) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPortsResponse) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type WatchPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, the ports already open are sent as open events after the start event.
+	IncludeExisting bool `protobuf:"varint,1,opt,name=include_existing,json=includeExisting,proto3" json:"include_existing,omitempty"`
+}
+
+func (x *WatchPortsRequest) Reset() {
+	*x = WatchPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortsRequest) ProtoMessage() {}
+
+func (x *WatchPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPortsRequest) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchPortsRequest) GetIncludeExisting() bool {
+	if x != nil {
+		return x.IncludeExisting
+	}
+	return false
+}
+
+type PortEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type PortEventType `protobuf:"varint,1,opt,name=type,proto3,enum=ports.PortEventType" json:"type,omitempty"`
+	Port *Port         `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *PortEvent) Reset() {
+	*x = PortEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortEvent) ProtoMessage() {}
+
+func (x *PortEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortEvent.ProtoReflect.Descriptor instead.
+func (*PortEvent) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PortEvent) GetType() PortEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PortEventType_PORT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PortEvent) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+type WatchPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*WatchPortsResponse_Start
+	//	*WatchPortsResponse_Port
+	//	*WatchPortsResponse_Keepalive
+	Event isWatchPortsResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *WatchPortsResponse) Reset() {
+	*x = WatchPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortsResponse) ProtoMessage() {}
+
+func (x *WatchPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPortsResponse) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *WatchPortsResponse) GetEvent() isWatchPortsResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *WatchPortsResponse) GetStart() *WatchPortsResponse_StartEvent {
+	if x, ok := x.GetEvent().(*WatchPortsResponse_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *WatchPortsResponse) GetPort() *PortEvent {
+	if x, ok := x.GetEvent().(*WatchPortsResponse_Port); ok {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *WatchPortsResponse) GetKeepalive() *WatchPortsResponse_KeepAlive {
+	if x, ok := x.GetEvent().(*WatchPortsResponse_Keepalive); ok {
+		return x.Keepalive
+	}
+	return nil
+}
+
+type isWatchPortsResponse_Event interface {
+	isWatchPortsResponse_Event()
+}
+
+type WatchPortsResponse_Start struct {
+	Start *WatchPortsResponse_StartEvent `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type WatchPortsResponse_Port struct {
+	Port *PortEvent `protobuf:"bytes,2,opt,name=port,proto3,oneof"`
+}
+
+type WatchPortsResponse_Keepalive struct {
+	Keepalive *WatchPortsResponse_KeepAlive `protobuf:"bytes,3,opt,name=keepalive,proto3,oneof"`
+}
+
+func (*WatchPortsResponse_Start) isWatchPortsResponse_Event() {}
+
+func (*WatchPortsResponse_Port) isWatchPortsResponse_Event() {}
+
+func (*WatchPortsResponse_Keepalive) isWatchPortsResponse_Event() {}
+
+type WatchPortsResponse_StartEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchPortsResponse_StartEvent) Reset() {
+	*x = WatchPortsResponse_StartEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPortsResponse_StartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortsResponse_StartEvent) ProtoMessage() {}
+
+func (x *WatchPortsResponse_StartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortsResponse_StartEvent.ProtoReflect.Descriptor instead.
+func (*WatchPortsResponse_StartEvent) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type WatchPortsResponse_KeepAlive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchPortsResponse_KeepAlive) Reset() {
+	*x = WatchPortsResponse_KeepAlive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ports_ports_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPortsResponse_KeepAlive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortsResponse_KeepAlive) ProtoMessage() {}
+
+func (x *WatchPortsResponse_KeepAlive) ProtoReflect() protoreflect.Message {
+	mi := &file_ports_ports_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortsResponse_KeepAlive.ProtoReflect.Descriptor instead.
+func (*WatchPortsResponse_KeepAlive) Descriptor() ([]byte, []int) {
+	return file_ports_ports_proto_rawDescGZIP(), []int{6, 1}
+}
+
+var File_ports_ports_proto protoreflect.FileDescriptor
+
+var file_ports_ports_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xb0,
+	0x01, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e,
+	0x74, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x3e, 0x0a,
+	0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x56, 0x0a,
+	0x09, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6b, 0x65,
+	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0x0c, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x0b, 0x0a, 0x09, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69,
+	0x76, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x65, 0x0a, 0x0d, 0x50,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x10, 0x02, 0x32, 0x8c, 0x01, 0x0a, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x42, 0x90, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42,
+	0x0a, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65,
+	0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x65, 0x6e, 0x76, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0xca, 0x02, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0xe2, 0x02, 0x11, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ports_ports_proto_rawDescOnce sync.Once
+	file_ports_ports_proto_rawDescData = file_ports_ports_proto_rawDesc
+)
+
+func file_ports_ports_proto_rawDescGZIP() []byte {
+	file_ports_ports_proto_rawDescOnce.Do(func() {
+		file_ports_ports_proto_rawDescData = protoimpl.X.CompressGZIP(file_ports_ports_proto_rawDescData)
+	})
+	return file_ports_ports_proto_rawDescData
+}
+
+var file_ports_ports_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ports_ports_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ports_ports_proto_goTypes = []interface{}{
+	(PortEventType)(0),                    // 0: ports.PortEventType
+	(*Port)(nil),                          // 1: ports.Port
+	(*ForwardStats)(nil),                  // 2: ports.ForwardStats
+	(*ListPortsRequest)(nil),              // 3: ports.ListPortsRequest
+	(*ListPortsResponse)(nil),             // 4: ports.ListPortsResponse
+	(*WatchPortsRequest)(nil),             // 5: ports.WatchPortsRequest
+	(*PortEvent)(nil),                     // 6: ports.PortEvent
+	(*WatchPortsResponse)(nil),            // 7: ports.WatchPortsResponse
+	(*WatchPortsResponse_StartEvent)(nil), // 8: ports.WatchPortsResponse.StartEvent
+	(*WatchPortsResponse_KeepAlive)(nil),  // 9: ports.WatchPortsResponse.KeepAlive
+}
+var file_ports_ports_proto_depIdxs = []int32{
+	2, // 0: ports.Port.forwarding:type_name -> ports.ForwardStats
+	1, // 1: ports.ListPortsResponse.ports:type_name -> ports.Port
+	0, // 2: ports.PortEvent.type:type_name -> ports.PortEventType
+	1, // 3: ports.PortEvent.port:type_name -> ports.Port
+	8, // 4: ports.WatchPortsResponse.start:type_name -> ports.WatchPortsResponse.StartEvent
+	6, // 5: ports.WatchPortsResponse.port:type_name -> ports.PortEvent
+	9, // 6: ports.WatchPortsResponse.keepalive:type_name -> ports.WatchPortsResponse.KeepAlive
+	3, // 7: ports.Ports.ListPorts:input_type -> ports.ListPortsRequest
+	5, // 8: ports.Ports.WatchPorts:input_type -> ports.WatchPortsRequest
+	4, // 9: ports.Ports.ListPorts:output_type -> ports.ListPortsResponse
+	7, // 10: ports.Ports.WatchPorts:output_type -> ports.WatchPortsResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_ports_ports_proto_init() }
+func file_ports_ports_proto_init() {
+	if File_ports_ports_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ports_ports_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Port); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPortsResponse_StartEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ports_ports_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPortsResponse_KeepAlive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ports_ports_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*WatchPortsResponse_Start)(nil),
+		(*WatchPortsResponse_Port)(nil),
+		(*WatchPortsResponse_Keepalive)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ports_ports_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ports_ports_proto_goTypes,
+		DependencyIndexes: file_ports_ports_proto_depIdxs,
+		EnumInfos:         file_ports_ports_proto_enumTypes,
+		MessageInfos:      file_ports_ports_proto_msgTypes,
+	}.Build()
+	File_ports_ports_proto = out.File
+	file_ports_ports_proto_rawDesc = nil
+	file_ports_ports_proto_goTypes = nil
+	file_ports_ports_proto_depIdxs = nil
+}
@@ -80,3 +80,21 @@ func (e *EnvVars) ReplaceUserVars(newVars map[string]string) {
 		e.m[k] = envVarEntry{value: v}
 	}
 }
+
+// UpdateUserVars sets and unsets user entries, keeping the other ones;
+// internal entries are left untouched even if they appear in set or unset.
+func (e *EnvVars) UpdateUserVars(set map[string]string, unset []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, k := range unset {
+		if existing, ok := e.m[k]; ok && !existing.internal {
+			delete(e.m, k)
+		}
+	}
+	for k, v := range set {
+		if existing, ok := e.m[k]; ok && existing.internal {
+			continue
+		}
+		e.m[k] = envVarEntry{value: v}
+	}
+}
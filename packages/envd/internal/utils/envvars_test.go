@@ -74,3 +74,20 @@ func TestEnvVars_ReplaceUserVarsClearsOmittedKeys(t *testing.T) {
 		"FOO":         "1",
 	}, e.All())
 }
+
+func TestEnvVars_UpdateUserVars(t *testing.T) {
+	t.Parallel()
+	e := NewEnvVars()
+
+	e.Store("E2B_SANDBOX", "true")
+	require.True(t, e.StoreUser("FOO", "1"))
+	require.True(t, e.StoreUser("BAR", "2"))
+
+	e.UpdateUserVars(map[string]string{"BAZ": "3", "E2B_SANDBOX": "spoofed"}, []string{"FOO", "E2B_SANDBOX", "MISSING"})
+
+	assert.Equal(t, map[string]string{
+		"E2B_SANDBOX": "true",
+		"BAR":         "2",
+		"BAZ":         "3",
+	}, e.All())
+}
@@ -15,6 +15,7 @@ import (
 	connectcors "connectrpc.com/cors"
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/cors"
+	"github.com/rs/zerolog"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/api"
 	"github.com/e2b-dev/infra/packages/envd/internal/execcontext"
@@ -24,6 +25,7 @@ import (
 	publicport "github.com/e2b-dev/infra/packages/envd/internal/port"
 	"github.com/e2b-dev/infra/packages/envd/internal/services/cgroups"
 	filesystemRpc "github.com/e2b-dev/infra/packages/envd/internal/services/filesystem"
+	portsRpc "github.com/e2b-dev/infra/packages/envd/internal/services/ports"
 	processRpc "github.com/e2b-dev/infra/packages/envd/internal/services/process"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 	"github.com/e2b-dev/infra/packages/envd/pkg"
@@ -188,7 +190,8 @@ func run() error {
 	fsLogger := l.With().Str("logger", "filesystem").Logger()
 	filesystemRpc.Handle(m, &fsLogger, defaults)
 
-	cgroupManager := createCgroupManager()
+	cgroupLogger := l.With().Str("logger", "cgroups").Logger()
+	cgroupManager := createCgroupManager(&cgroupLogger)
 	defer func() {
 		err := cgroupManager.Close()
 		if err != nil {
@@ -199,6 +202,17 @@ func run() error {
 	processLogger := l.With().Str("logger", "process").Logger()
 	processRpc.Handle(m, &processLogger, defaults, cgroupManager)
 
+	// The scanned listening ports are forwarded and reported by the ports service.
+	portScanner := publicport.NewScanner(portScannerInterval)
+	defer portScanner.Destroy()
+
+	// Bind all open ports on 127.0.0.1 and localhost to the eth0 interface
+	portLogger := l.With().Str("logger", "port-forwarder").Logger()
+	portForwarder := publicport.NewForwarder(&portLogger, portScanner)
+
+	portsLogger := l.With().Str("logger", "ports").Logger()
+	portsRpc.Handle(ctx, m, &portsLogger, portScanner, portForwarder)
+
 	service := api.New(&envLogger, defaults, mmdsChan, isNotFC, cgroupManager)
 	handler := api.HandlerFromMux(service, m)
 	middleware := authn.NewMiddleware(permissions.AuthenticateUsername)
@@ -216,12 +230,6 @@ func run() error {
 		IdleTimeout:  idleTimeout,
 	}
 
-	// Bind all open ports on 127.0.0.1 and localhost to the eth0 interface
-	portScanner := publicport.NewScanner(portScannerInterval)
-	defer portScanner.Destroy()
-
-	portLogger := l.With().Str("logger", "port-forwarder").Logger()
-	portForwarder := publicport.NewForwarder(&portLogger, portScanner, cgroupManager)
 	go portForwarder.StartForwarding(ctx)
 
 	go portScanner.ScanAndBroadcast()
@@ -234,7 +242,7 @@ func run() error {
 	return err
 }
 
-func createCgroupManager() (m cgroups.Manager) {
+func createCgroupManager(logger *zerolog.Logger) (m cgroups.Manager) {
 	defer func() {
 		if m == nil {
 			fmt.Fprintf(os.Stderr, "falling back to no-op cgroup manager\n")
@@ -270,18 +278,14 @@ func createCgroupManager() (m cgroups.Manager) {
 			"memory.high": fmt.Sprintf("%d", memoryHigh),
 			"memory.max":  fmt.Sprintf("%d", memoryMax),
 		}),
-		cgroups.WithCgroup2ProcessType(cgroups.ProcessTypeSocat, "socats", map[string]string{
-			"cpu.weight": "150",
-			"io.weight":  "default 50",
-			"memory.min": fmt.Sprintf("%d", 5*megabyte),
-			"memory.low": fmt.Sprintf("%d", 8*megabyte),
-		}),
 		cgroups.WithCgroup2ProcessType(cgroups.ProcessTypeUser, "user", map[string]string{
 			"memory.high": fmt.Sprintf("%d", memoryHigh),
 			"memory.max":  fmt.Sprintf("%d", memoryMax),
 			"cpu.weight":  "50",
 			"io.weight":   "default 10",
 		}),
+		cgroups.WithCgroup2ProcessCgroups(cgroups.ProcessTypeUser),
+		cgroups.WithCgroup2Logger(logger),
 	}
 	if cgroupRoot != "" {
 		opts = append(opts, cgroups.WithCgroup2RootSysFSPath(cgroupRoot))
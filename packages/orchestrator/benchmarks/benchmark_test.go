@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/metric/noop"
 
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/hoststats"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/cfg"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/proxy"
@@ -244,6 +245,7 @@ func BenchmarkBaseImageLaunch(b *testing.B) {
 		sandboxes,
 		noop.NewMeterProvider(),
 		featureFlags,
+		egresslogs.NewNoopDelivery(),
 	)
 	go func() {
 		err := tcpFirewall.Start(b.Context())
@@ -40,6 +40,7 @@ import (
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/hoststats"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/cfg"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/proxy"
@@ -307,7 +308,7 @@ func BenchmarkConcurrentResume(b *testing.B) {
 
 	var proxyPort uint16 = 5007
 
-	tcpFw := tcpfirewall.New(l, config.NetworkConfig, sandboxes, noop.NewMeterProvider(), featureFlags)
+	tcpFw := tcpfirewall.New(l, config.NetworkConfig, sandboxes, noop.NewMeterProvider(), featureFlags, egresslogs.NewNoopDelivery())
 	go func() { assert.NoError(b, tcpFw.Start(b.Context())) }()
 	b.Cleanup(func() {
 		ctx := context.WithoutCancel(b.Context())
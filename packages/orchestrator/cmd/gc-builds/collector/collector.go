@@ -0,0 +1,501 @@
+// Package collector finds builds in object storage that are no longer
+// referenced by the database or by any other build's header and deletes them.
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// registryBatchSize bounds the number of build IDs sent in one registry lookup.
+const registryBatchSize = 1000
+
+// Reason is why a build was kept, or ReasonUnreferenced when it was collected.
+type Reason string
+
+const (
+	ReasonUnreferenced Reason = "unreferenced" // collected
+	ReasonRegistered   Reason = "registered"   // still has an env_builds row
+	ReasonReferenced   Reason = "referenced"   // mapped by the header of a live build
+	ReasonRecent       Reason = "recent"       // modified within MinAge, possibly still uploading
+	ReasonSoftDeleted  Reason = "soft_deleted" // tombstoned; the storage index owns its deletion
+	ReasonOrigin       Reason = "origin"       // build origin not selected for collection
+	ReasonUnlabeled    Reason = "unlabeled"    // origin metadata missing or unreadable on this backend
+	ReasonError        Reason = "error"        // metadata could not be read, kept to be safe
+)
+
+// DefaultOrigins are the build origins collected unless configured otherwise.
+// Layer-cache builds are excluded: they are looked up by hash, not by ID, so
+// being unreferenced does not make them garbage.
+var DefaultOrigins = []storage.ObjectOrigin{
+	storage.ObjectOriginPause,
+	storage.ObjectOriginSnapshotTemplate,
+	storage.ObjectOriginTemplateBuild,
+}
+
+// Registry tells which builds the database still knows about.
+type Registry interface {
+	// ExistingBuilds returns the subset of ids that are still recorded.
+	ExistingBuilds(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+}
+
+type Options struct {
+	// MinAge protects builds whose newest object is younger than this, so
+	// in-progress uploads and builds not yet committed to the database are
+	// never collected.
+	MinAge time.Duration
+	// Origins are the build origins eligible for collection.
+	Origins []storage.ObjectOrigin
+	// IncludeUnlabeled also collects builds without origin metadata, e.g.
+	// ones uploaded before it was introduced or stored on a backend that
+	// cannot read custom metadata. Such builds cannot be checked for
+	// soft-delete tombstones either.
+	IncludeUnlabeled bool
+	// DryRun only reports the builds that would be deleted.
+	DryRun      bool
+	Concurrency int
+}
+
+// Build is a build directory found in storage.
+type Build struct {
+	ID       uuid.UUID `json:"build_id"`
+	Origin   string    `json:"origin,omitempty"`
+	Objects  int       `json:"objects"`
+	Size     int64     `json:"size_bytes"`
+	Modified time.Time `json:"last_modified"`
+	Reason   Reason    `json:"reason"`
+	// Marker is the soft-delete tombstone value, if any.
+	Marker string `json:"soft_delete_marker,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report summarizes a collection run.
+type Report struct {
+	DryRun  bool           `json:"dry_run"`
+	Scanned int            `json:"scanned"`
+	Kept    map[Reason]int `json:"kept"`
+	// Collected lists the unreferenced builds, deleted unless DryRun.
+	Collected      []Build `json:"collected"`
+	CollectedBytes int64   `json:"collected_bytes"`
+	// SoftDeleted lists tombstoned builds that would otherwise be collected.
+	SoftDeleted []Build `json:"soft_deleted,omitempty"`
+	Failed      int     `json:"failed"`
+}
+
+type Collector struct {
+	Options
+
+	storage  storage.StorageProvider
+	registry Registry
+	log      logger.Logger
+	now      func() time.Time
+}
+
+func New(opts Options, provider storage.StorageProvider, registry Registry, log logger.Logger) *Collector {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+
+	if opts.Origins == nil {
+		opts.Origins = DefaultOrigins
+	}
+
+	return &Collector{
+		Options:  opts,
+		storage:  provider,
+		registry: registry,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Run scans storage, decides which builds are garbage and deletes them.
+// Any error that could make a referenced build look unreferenced (listing,
+// registry lookups, unreadable headers of live builds) aborts the run
+// before anything is deleted.
+func (c *Collector) Run(ctx context.Context) (*Report, error) {
+	builds, err := c.scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Info(ctx, "scanned storage", zap.Int("builds", len(builds)), zap.String("storage", c.storage.GetDetails()))
+
+	registered, err := c.existing(ctx, slices.Collect(maps.Keys(builds)))
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := c.liveClosure(ctx, builds, registered)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{DryRun: c.DryRun, Scanned: len(builds), Kept: map[Reason]int{}}
+	var candidates []*Build
+	for id, b := range builds {
+		switch {
+		case registered[id]:
+			b.Reason = ReasonRegistered
+		case live[id]:
+			b.Reason = ReasonReferenced
+		case c.now().Sub(b.Modified) < c.MinAge:
+			b.Reason = ReasonRecent
+		default:
+			candidates = append(candidates, b)
+
+			continue
+		}
+
+		report.Kept[b.Reason]++
+	}
+
+	c.inspect(ctx, candidates)
+
+	var unreferenced []uuid.UUID
+	for _, b := range candidates {
+		if b.Reason == ReasonUnreferenced {
+			unreferenced = append(unreferenced, b.ID)
+		}
+	}
+
+	// Look the candidates up once more, a build registered while the run
+	// was in progress must not be deleted.
+	registered, err = c.existing(ctx, unreferenced)
+	if err != nil {
+		return nil, err
+	}
+
+	var collect []*Build
+	for _, b := range candidates {
+		if registered[b.ID] {
+			b.Reason = ReasonRegistered
+		}
+
+		switch b.Reason {
+		case ReasonUnreferenced:
+			collect = append(collect, b)
+		case ReasonSoftDeleted:
+			report.SoftDeleted = append(report.SoftDeleted, *b)
+			report.Kept[b.Reason]++
+		default:
+			report.Kept[b.Reason]++
+		}
+	}
+
+	if !c.DryRun {
+		report.Failed = c.delete(ctx, collect)
+	}
+
+	for _, b := range collect {
+		report.Collected = append(report.Collected, *b)
+		if b.Error == "" {
+			report.CollectedBytes += b.Size
+		}
+	}
+
+	slices.SortFunc(report.Collected, func(a, b Build) int { return a.Modified.Compare(b.Modified) })
+
+	return report, nil
+}
+
+// scan groups all objects in storage by their top-level build directory.
+// Objects outside UUID-named directories are not builds and are ignored.
+func (c *Collector) scan(ctx context.Context) (map[uuid.UUID]*Build, error) {
+	builds := make(map[uuid.UUID]*Build)
+
+	err := storage.ListObjects(ctx, c.storage, "", func(info storage.ObjectInfo) error {
+		dir, _, found := strings.Cut(info.Path, "/")
+		if !found {
+			return nil
+		}
+
+		id, ok := parseBuildID(dir)
+		if !ok {
+			return nil
+		}
+
+		b, ok := builds[id]
+		if !ok {
+			b = &Build{ID: id}
+			builds[id] = b
+		}
+
+		b.Objects++
+		b.Size += info.Size
+		if info.Modified.After(b.Modified) {
+			b.Modified = info.Modified
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list builds: %w", err)
+	}
+
+	return builds, nil
+}
+
+func (c *Collector) existing(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	existing := make(map[uuid.UUID]bool)
+
+	for batch := range slices.Chunk(ids, registryBatchSize) {
+		found, err := c.registry.ExistingBuilds(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up builds: %w", err)
+		}
+
+		for _, id := range found {
+			existing[id] = true
+		}
+	}
+
+	return existing, nil
+}
+
+// liveClosure returns every build reachable from the registered ones through
+// header mappings. Diff headers map blocks into their ancestors, so an
+// ancestor stays live as long as any registered descendant does, even after
+// its own database row is gone.
+func (c *Collector) liveClosure(ctx context.Context, builds map[uuid.UUID]*Build, registered map[uuid.UUID]bool) (map[uuid.UUID]bool, error) {
+	live := make(map[uuid.UUID]bool, len(registered))
+	var frontier []uuid.UUID
+	for id := range registered {
+		live[id] = true
+		frontier = append(frontier, id)
+	}
+
+	for len(frontier) > 0 {
+		var mu sync.Mutex
+		var next []uuid.UUID
+
+		eg, egCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(c.Concurrency)
+		for _, id := range frontier {
+			if _, stored := builds[id]; !stored {
+				continue
+			}
+
+			eg.Go(func() error {
+				refs, err := c.references(egCtx, id)
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, ref := range refs {
+					if !live[ref] {
+						live[ref] = true
+						next = append(next, ref)
+					}
+				}
+
+				return nil
+			})
+		}
+
+		if err := eg.Wait(); err != nil {
+			return nil, err
+		}
+
+		frontier = next
+	}
+
+	return live, nil
+}
+
+// references returns the builds the memfile and rootfs headers of a build map into.
+func (c *Collector) references(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	paths := storage.Paths{BuildID: id.String()}
+
+	var refs []uuid.UUID
+	for _, name := range []string{storage.MemfileName, storage.RootfsName} {
+		h, _, err := header.LoadHeader(ctx, c.storage, paths.HeaderFile(name))
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s header of build %s: %w", name, id, err)
+		}
+
+		refs = append(refs, h.Mapping.Builds()...)
+		for ref := range h.Builds {
+			refs = append(refs, ref)
+		}
+
+		if h.Metadata != nil {
+			refs = append(refs, h.Metadata.BaseBuildId)
+		}
+	}
+
+	return slices.DeleteFunc(refs, func(ref uuid.UUID) bool { return ref == uuid.Nil || ref == id }), nil
+}
+
+// inspect checks the candidates against the reference index, then reads the
+// custom metadata of every object of the rest and sets their Reason from the
+// origin and soft-delete tombstones. A candidate whose referrers or metadata
+// can't be read is kept with ReasonError.
+func (c *Collector) inspect(ctx context.Context, candidates []*Build) {
+	var eg errgroup.Group
+	eg.SetLimit(c.Concurrency)
+
+	for _, b := range candidates {
+		eg.Go(func() error {
+			if err := c.inspectBuild(ctx, b); err != nil {
+				b.Reason = ReasonError
+				b.Error = err.Error()
+			}
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+}
+
+func (c *Collector) inspectBuild(ctx context.Context, b *Build) error {
+	// The reference index also knows about referrers the closure can't reach,
+	// e.g. unregistered builds still uploading.
+	referrers, err := header.LiveReferrers(ctx, c.storage, b.ID)
+	if err != nil {
+		return fmt.Errorf("failed to look up referrers: %w", err)
+	}
+
+	if len(referrers) > 0 {
+		b.Reason = ReasonReferenced
+
+		return nil
+	}
+
+	var objects []string
+	err = storage.ListObjects(ctx, c.storage, b.ID.String()+"/", func(info storage.ObjectInfo) error {
+		objects = append(objects, info.Path)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	unlabeled := false
+	for _, path := range objects {
+		md, err := c.objectMetadata(ctx, path)
+		switch {
+		case errors.Is(err, storage.ErrObjectNotExist):
+			continue
+		case errors.Is(err, storage.ErrMetadataUnsupported):
+			unlabeled = true
+
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to read metadata of %s: %w", path, err)
+		}
+
+		if marker, ok := md[storage.ObjectMetadataSoftDeleted]; ok {
+			b.Reason = ReasonSoftDeleted
+			b.Marker = marker
+
+			return nil
+		}
+
+		if origin := md[storage.ObjectMetadataBuildOrigin]; origin != "" && b.Origin == "" {
+			b.Origin = origin
+		}
+	}
+
+	switch {
+	case b.Origin == "" || unlabeled:
+		if c.IncludeUnlabeled {
+			b.Reason = ReasonUnreferenced
+		} else {
+			b.Reason = ReasonUnlabeled
+		}
+	case slices.Contains(c.Origins, storage.ObjectOrigin(b.Origin)):
+		b.Reason = ReasonUnreferenced
+	default:
+		b.Reason = ReasonOrigin
+	}
+
+	return nil
+}
+
+func (c *Collector) objectMetadata(ctx context.Context, path string) (storage.ObjectMetadata, error) {
+	blob, err := c.storage.OpenBlob(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.BlobCustomMetadata(ctx, blob)
+}
+
+// delete removes the collected builds and returns how many failed.
+func (c *Collector) delete(ctx context.Context, collect []*Build) int {
+	var mu sync.Mutex
+	failed := 0
+
+	var eg errgroup.Group
+	eg.SetLimit(c.Concurrency)
+	for _, b := range collect {
+		eg.Go(func() error {
+			// The markers in the ancestors' index directories are found through the build's headers.
+			err := header.ForgetReferences(ctx, c.storage, b.ID)
+			if err == nil {
+				err = c.storage.DeleteObjectsWithPrefix(ctx, b.ID.String())
+			}
+
+			if err == nil {
+				// Whatever is left in the build's index directory is stale.
+				err = c.storage.DeleteObjectsWithPrefix(ctx, header.ReferencesDir(b.ID))
+			}
+
+			if err == nil {
+				c.log.Info(ctx, "deleted build", logger.WithBuildID(b.ID.String()), zap.String("origin", b.Origin), zap.Int64("size", b.Size))
+
+				return nil
+			}
+
+			// A failed build is reported, it does not stop the others.
+			c.log.Error(ctx, "failed to delete build", logger.WithBuildID(b.ID.String()), zap.Error(err))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			b.Error = err.Error()
+			failed++
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	return failed
+}
+
+// parseBuildID accepts only canonical UUIDs, the form build directories are
+// written and deleted under.
+func parseBuildID(dir string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(dir)
+	if err != nil || id.String() != dir {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,271 @@
+package collector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+type fakeRegistry map[uuid.UUID]bool
+
+func (r fakeRegistry) ExistingBuilds(_ context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	var found []uuid.UUID
+	for _, id := range ids {
+		if r[id] {
+			found = append(found, id)
+		}
+	}
+
+	return found, nil
+}
+
+// metadataStorage adds per-build custom metadata to the filesystem provider,
+// which can't store it.
+type metadataStorage struct {
+	storage.StorageProvider
+
+	metadata map[string]storage.ObjectMetadata
+}
+
+func (s *metadataStorage) ListObjects(ctx context.Context, prefix string, fn func(storage.ObjectInfo) error) error {
+	return storage.ListObjects(ctx, s.StorageProvider, prefix, fn)
+}
+
+func (s *metadataStorage) OpenBlob(ctx context.Context, path string) (storage.Blob, error) {
+	blob, err := s.StorageProvider.OpenBlob(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, _, _ := strings.Cut(path, "/")
+
+	return &metadataBlob{Blob: blob, metadata: s.metadata[dir]}, nil
+}
+
+type metadataBlob struct {
+	storage.Blob
+
+	metadata storage.ObjectMetadata
+}
+
+func (b *metadataBlob) Metadata(context.Context) (storage.ObjectMetadata, error) {
+	return b.metadata, nil
+}
+
+type testStore struct {
+	t        *testing.T
+	basePath string
+	provider *metadataStorage
+}
+
+func newTestStore(t *testing.T) *testStore {
+	t.Helper()
+
+	basePath := t.TempDir()
+	provider, err := storage.NewProvider(t.Context(), storage.Spec{Provider: storage.LocalStorageProvider, BasePath: basePath})
+	require.NoError(t, err)
+
+	return &testStore{
+		t:        t,
+		basePath: basePath,
+		provider: &metadataStorage{StorageProvider: provider, metadata: map[string]storage.ObjectMetadata{}},
+	}
+}
+
+// addBuild stores a build whose memfile header maps into parents, with all
+// objects last modified age ago.
+func (s *testStore) addBuild(id uuid.UUID, age time.Duration, metadata storage.ObjectMetadata, parents ...uuid.UUID) {
+	s.t.Helper()
+
+	mappings := []header.BuildMap{{Offset: 0, Length: header.PageSize, BuildId: id}}
+	for i, parent := range parents {
+		mappings = append(mappings, header.BuildMap{Offset: uint64(i+1) * header.PageSize, Length: header.PageSize, BuildId: parent})
+	}
+
+	h, err := header.NewHeader(header.NewTemplateMetadata(id, header.PageSize, uint64(len(mappings))*header.PageSize), mappings)
+	require.NoError(s.t, err)
+	require.NoError(s.t, header.RecordReferences(s.t.Context(), s.provider, h))
+	data, err := header.SerializeHeader(h)
+	require.NoError(s.t, err)
+
+	paths := storage.Paths{BuildID: id.String()}
+	s.put(paths.MemfileHeader(), data, age)
+	s.put(paths.Memfile(), []byte("memfile"), age)
+	s.put(paths.Snapfile(), []byte("snapfile"), age)
+
+	s.provider.metadata[id.String()] = metadata
+}
+
+func (s *testStore) put(path string, data []byte, age time.Duration) {
+	s.t.Helper()
+
+	blob, err := s.provider.OpenBlob(s.t.Context(), path)
+	require.NoError(s.t, err)
+	require.NoError(s.t, blob.Put(s.t.Context(), data))
+
+	modified := time.Now().Add(-age)
+	require.NoError(s.t, os.Chtimes(filepath.Join(s.basePath, path), modified, modified))
+}
+
+func (s *testStore) exists(id uuid.UUID) bool {
+	_, err := os.Stat(filepath.Join(s.basePath, id.String()))
+
+	return err == nil
+}
+
+func origin(o storage.ObjectOrigin) storage.ObjectMetadata {
+	return storage.ObjectMetadata{storage.ObjectMetadataBuildOrigin: string(o)}
+}
+
+func collectedIDs(report *Report) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(report.Collected))
+	for _, b := range report.Collected {
+		ids = append(ids, b.ID)
+	}
+
+	return ids
+}
+
+// scenario is a storage with a build of every kind the collector tells apart.
+type scenario struct {
+	store    *testStore
+	registry fakeRegistry
+
+	registered, parent, grandparent uuid.UUID
+	orphan, recent, tombstoned      uuid.UUID
+	layerCache, unlabeled           uuid.UUID
+	uploading, indexed              uuid.UUID
+}
+
+func newScenario(t *testing.T) *scenario {
+	t.Helper()
+
+	const old = 30 * 24 * time.Hour
+
+	s := &scenario{
+		store:       newTestStore(t),
+		registered:  uuid.New(),
+		parent:      uuid.New(),
+		grandparent: uuid.New(),
+		orphan:      uuid.New(),
+		recent:      uuid.New(),
+		tombstoned:  uuid.New(),
+		layerCache:  uuid.New(),
+		unlabeled:   uuid.New(),
+		uploading:   uuid.New(),
+		indexed:     uuid.New(),
+	}
+	s.registry = fakeRegistry{s.registered: true}
+
+	s.store.addBuild(s.grandparent, old, origin(storage.ObjectOriginTemplateBuild))
+	s.store.addBuild(s.parent, old, origin(storage.ObjectOriginPause), s.grandparent)
+	s.store.addBuild(s.registered, old, origin(storage.ObjectOriginPause), s.parent)
+	s.store.addBuild(s.orphan, old, origin(storage.ObjectOriginPause))
+	s.store.addBuild(s.recent, time.Minute, origin(storage.ObjectOriginPause))
+	s.store.addBuild(s.tombstoned, old, storage.ObjectMetadata{
+		storage.ObjectMetadataBuildOrigin: string(storage.ObjectOriginPause),
+		storage.ObjectMetadataSoftDeleted: "retention:action-1",
+	})
+	s.store.addBuild(s.layerCache, old, origin(storage.ObjectOriginTemplateBuildCache))
+	s.store.addBuild(s.unlabeled, old, nil)
+	// Only the reference index links the unregistered, still recent build
+	// to its parent.
+	s.store.addBuild(s.indexed, old, origin(storage.ObjectOriginPause))
+	s.store.addBuild(s.uploading, time.Minute, origin(storage.ObjectOriginPause), s.indexed)
+
+	// Not a build directory.
+	s.store.put("index/hash", []byte("build"), old)
+
+	return s
+}
+
+func TestCollectorRunDryRun(t *testing.T) {
+	t.Parallel()
+
+	s := newScenario(t)
+	c := New(Options{MinAge: time.Hour, DryRun: true}, s.store.provider, s.registry, logger.NewNopLogger())
+
+	report, err := c.Run(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, report.Scanned)
+	assert.Equal(t, []uuid.UUID{s.orphan}, collectedIDs(report))
+	assert.Equal(t, map[Reason]int{
+		ReasonRegistered:  1,
+		ReasonReferenced:  3,
+		ReasonRecent:      2,
+		ReasonSoftDeleted: 1,
+		ReasonOrigin:      1,
+		ReasonUnlabeled:   1,
+	}, report.Kept)
+	require.Len(t, report.SoftDeleted, 1)
+	assert.Equal(t, "retention:action-1", report.SoftDeleted[0].Marker)
+	assert.Positive(t, report.CollectedBytes)
+
+	assert.True(t, s.store.exists(s.orphan))
+}
+
+func TestCollectorRunDelete(t *testing.T) {
+	t.Parallel()
+
+	s := newScenario(t)
+	c := New(Options{MinAge: time.Hour, IncludeUnlabeled: true}, s.store.provider, s.registry, logger.NewNopLogger())
+
+	report, err := c.Run(t.Context())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []uuid.UUID{s.orphan, s.unlabeled}, collectedIDs(report))
+	assert.Zero(t, report.Failed)
+
+	assert.False(t, s.store.exists(s.orphan))
+	assert.False(t, s.store.exists(s.unlabeled))
+	for _, id := range []uuid.UUID{s.registered, s.parent, s.grandparent, s.recent, s.tombstoned, s.layerCache, s.uploading, s.indexed} {
+		assert.True(t, s.store.exists(id), id)
+	}
+}
+
+func TestCollectorUnverifiableMetadata(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	provider, err := storage.NewProvider(t.Context(), storage.Spec{Provider: storage.LocalStorageProvider, BasePath: basePath})
+	require.NoError(t, err)
+
+	id := uuid.New()
+	blob, err := provider.OpenBlob(t.Context(), storage.Paths{BuildID: id.String()}.Snapfile())
+	require.NoError(t, err)
+	require.NoError(t, blob.Put(t.Context(), []byte("snapfile")))
+
+	c := New(Options{}, provider, fakeRegistry{}, logger.NewNopLogger())
+	report, err := c.Run(t.Context())
+	require.NoError(t, err)
+
+	// The filesystem backend can't read metadata, so neither the origin nor
+	// a tombstone can be verified.
+	assert.Empty(t, report.Collected)
+	assert.Equal(t, map[Reason]int{ReasonUnlabeled: 1}, report.Kept)
+}
+
+func TestCollectorUnreadableHeader(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+
+	registered := uuid.New()
+	store.put(storage.Paths{BuildID: registered.String()}.MemfileHeader(), []byte("corrupted"), time.Hour)
+
+	c := New(Options{}, store.provider, fakeRegistry{registered: true}, logger.NewNopLogger())
+	_, err := c.Run(t.Context())
+	require.ErrorContains(t, err, registered.String())
+}
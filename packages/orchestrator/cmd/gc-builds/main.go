@@ -0,0 +1,186 @@
+// gc-builds deletes builds from template storage that are no longer referenced
+// by Postgres (env_builds of templates that aren't deleted) nor mapped by the
+// header of any referenced build or any live build in the reference index —
+// leftovers of crashed pauses, deleted teams and templates and superseded
+// snapshots.
+//
+// Usage:
+//
+//	POSTGRES_CONNECTION_STRING=... gc-builds -storage gs://bucket [-min-age 168h] [-deleted-template-grace 48h] [-dry-run=false] [-report report.json]
+//
+// It runs in dry-run mode by default and only writes the report.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/orchestrator/cmd/gc-builds/collector"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+type registry struct {
+	db *client.Client
+	// deletedTemplateGrace keeps the builds of a deleted template registered for a while,
+	// so sandboxes still running from it can pause.
+	deletedTemplateGrace time.Duration
+}
+
+func (r registry) ExistingBuilds(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	return r.db.GetExistingBuildIDs(ctx, queries.GetExistingBuildIDsParams{
+		BuildIds:      ids,
+		DeletedBefore: time.Now().Add(-r.deletedTemplateGrace),
+	})
+}
+
+func main() {
+	storageURL := flag.String("storage", "", "template storage URL: gs://bucket, s3://bucket?... or file:///path")
+	minAge := flag.Duration("min-age", 7*24*time.Hour, "never delete a build modified more recently than this")
+	deletedTemplateGrace := flag.Duration("deleted-template-grace", 48*time.Hour, "keep the builds of a deleted template for this long after its deletion")
+	origins := flag.String("origins", joinOrigins(collector.DefaultOrigins), "comma-separated build origins eligible for deletion")
+	includeUnlabeled := flag.Bool("include-unlabeled", false, "also delete builds without readable origin metadata (cannot be checked for soft-delete tombstones)")
+	dryRun := flag.Bool("dry-run", true, "only report the builds that would be deleted")
+	concurrency := flag.Int("concurrency", 16, "number of concurrent storage requests")
+	reportPath := flag.String("report", "-", "where to write the JSON report, - for stdout")
+
+	flag.Parse()
+
+	if *storageURL == "" {
+		log.Fatal("-storage is required")
+	}
+
+	dbURL := os.Getenv("POSTGRES_CONNECTION_STRING")
+	if dbURL == "" {
+		log.Fatal("POSTGRES_CONNECTION_STRING is required")
+	}
+
+	l, err := logger.NewLogger(logger.LoggerConfig{
+		ServiceName:   "gc-builds",
+		IsInternal:    true,
+		EnableConsole: true,
+	})
+	if err != nil {
+		log.Fatalf("failed to create logger: %s", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	opts := collector.Options{
+		MinAge:           *minAge,
+		Origins:          parseOrigins(*origins),
+		IncludeUnlabeled: *includeUnlabeled,
+		DryRun:           *dryRun,
+		Concurrency:      *concurrency,
+	}
+
+	err = run(ctx, l, *storageURL, dbURL, *deletedTemplateGrace, opts, *reportPath)
+	cancel()
+	if err != nil {
+		l.Error(ctx, "build garbage collection failed", zap.Error(err))
+	}
+
+	l.Sync()
+
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, l logger.Logger, storageURL, dbURL string, deletedTemplateGrace time.Duration, opts collector.Options, reportPath string) error {
+	spec, err := storage.ParseStorageURL(storageURL)
+	if err != nil {
+		return err
+	}
+
+	provider, err := storage.NewProvider(ctx, spec)
+	if err != nil {
+		return fmt.Errorf("failed to create storage provider: %w", err)
+	}
+
+	db, err := client.NewClient(ctx, dbURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	report, err := collector.New(opts, provider, registry{db: db, deletedTemplateGrace: deletedTemplateGrace}, l).Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	l.Info(ctx, "build garbage collection finished",
+		zap.Bool("dry_run", report.DryRun),
+		zap.Int("scanned", report.Scanned),
+		zap.Int("collected", len(report.Collected)),
+		zap.Int64("collected_bytes", report.CollectedBytes),
+		zap.Int("soft_deleted", len(report.SoftDeleted)),
+		zap.Int("failed", report.Failed),
+	)
+
+	if err := writeReport(reportPath, report); err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("failed to delete %d builds", report.Failed)
+	}
+
+	return nil
+}
+
+func writeReport(path string, report *collector.Report) (err error) {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create report file: %w", err)
+		}
+		defer func() { err = errors.Join(err, f.Close()) }()
+
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+func parseOrigins(value string) []storage.ObjectOrigin {
+	var origins []storage.ObjectOrigin
+	for origin := range strings.SplitSeq(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, storage.ObjectOrigin(origin))
+		}
+	}
+
+	return origins
+}
+
+func joinOrigins(origins []storage.ObjectOrigin) string {
+	values := make([]string, len(origins))
+	for i, origin := range origins {
+		values[i] = string(origin)
+	}
+
+	return strings.Join(values, ",")
+}
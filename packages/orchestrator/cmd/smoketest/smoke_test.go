@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/metric/noop"
 
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/clickhouse/pkg/hoststats"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/cfg"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/proxy"
@@ -203,7 +204,7 @@ func newTestInfra(t *testing.T, ctx context.Context) *testInfra {
 	// Sandbox proxy + TCP firewall
 	sandboxes := sandbox.NewSandboxesMap()
 
-	tcpFw := tcpfirewall.New(l, networkConfig, sandboxes, noop.NewMeterProvider(), flags)
+	tcpFw := tcpfirewall.New(l, networkConfig, sandboxes, noop.NewMeterProvider(), flags, egresslogs.NewNoopDelivery())
 	go tcpFw.Start(ctx)
 	ti.closers = append(ti.closers, func(ctx context.Context) { tcpFw.Close(ctx) })
 
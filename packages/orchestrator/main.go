@@ -46,6 +46,7 @@ func defaultEgressFactory(_ context.Context, deps *factories.Deps) (*factories.E
 		deps.Sandboxes,
 		deps.MeterProvider,
 		deps.FeatureFlags,
+		deps.EgressLogs,
 	)
 
 	return &factories.EgressSetup{
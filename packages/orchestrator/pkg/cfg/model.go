@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/willscott/go-nfs"
@@ -79,6 +80,8 @@ func makePathsAbsolute(c *BuilderConfig) error {
 type Config struct {
 	BuilderConfig
 
+	// APIAddress is where the hyperloop server forwards the actions sandboxes take on themselves.
+	APIAddress                  string            `env:"API_ADDRESS"`
 	ClickhouseConnectionString  string            `env:"CLICKHOUSE_CONNECTION_STRING"`
 	ClickhouseConnectionStrings []string          `env:"CLICKHOUSE_CONNECTION_STRINGS" envSeparator:";"`
 	DisableStartupReclaim       bool              `env:"DISABLE_STARTUP_RECLAIM"`
@@ -104,6 +107,12 @@ type Config struct {
 	NBDPoolSize                 int               `env:"NBD_POOL_SIZE"                 envDefault:"64"`
 	Services                    []string          `env:"ORCHESTRATOR_SERVICES"         envDefault:"orchestrator"`
 	PersistentVolumeMounts      map[string]string `env:"PERSISTENT_VOLUME_MOUNTS"`
+
+	// The identity tokens of sandboxes are signed with the base64-encoded PEM key, disabled when empty.
+	// The issuer must be the API URL, where the public keys are published.
+	SandboxIdentityIssuer           string        `env:"SANDBOX_IDENTITY_ISSUER"`
+	SandboxIdentitySigningKeyBase64 string        `env:"SANDBOX_IDENTITY_SIGNING_KEY_BASE64"`
+	SandboxIdentityTokenTTL         time.Duration `env:"SANDBOX_IDENTITY_TOKEN_TTL"          envDefault:"10m"`
 }
 
 // AdditionalClickhouseEndpoints returns the non-blank entries from
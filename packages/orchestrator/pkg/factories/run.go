@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	clickhouseegresslogs "github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	clickhouseevents "github.com/e2b-dev/infra/packages/clickhouse/pkg/events"
 	clickhousehoststats "github.com/e2b-dev/infra/packages/clickhouse/pkg/hoststats"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/cfg"
@@ -68,6 +69,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/limit"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/sandboxidentity"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -82,6 +84,7 @@ type Deps struct {
 	Logger        logger.Logger
 	Sandboxes     *sandbox.Map
 	FeatureFlags  *featureflags.Client
+	EgressLogs    clickhouseegresslogs.Delivery
 }
 
 // EgressSetup is returned by EgressFactory with the proxy implementation
@@ -481,6 +484,7 @@ func run(config cfg.Config, opts Options) (success bool) {
 
 	sbxEventsDeliveryTargets := make([]event.Delivery[event.SandboxEvent], 0)
 	hostStatsTargets := make([]clickhousehoststats.Delivery, 0, 1+len(config.ClickhouseConnectionStrings))
+	egressLogsTargets := make([]clickhouseegresslogs.Delivery, 0, 1+len(config.ClickhouseConnectionStrings))
 
 	// Legacy singular ClickHouse delivery path. Fatal on init error and uses
 	// the unsuffixed default batcher names to preserve pre-multi-endpoint
@@ -515,6 +519,17 @@ func run(config cfg.Config, opts Options) (success bool) {
 			logger.L().Fatal(ctx, "failed to create clickhouse host stats delivery", zap.Error(err))
 		}
 		hostStatsTargets = append(hostStatsTargets, hostStatsDeliveryClickhouse)
+
+		egressLogsDeliveryClickhouse, err := clickhouseegresslogs.NewDefaultClickhouseEgressLogsDelivery(
+			ctx,
+			clickhouseConn,
+			featureFlags,
+			clickhouseegresslogs.DefaultBatcherName,
+		)
+		if err != nil {
+			logger.L().Fatal(ctx, "failed to create clickhouse egress logs delivery", zap.Error(err))
+		}
+		egressLogsTargets = append(egressLogsTargets, egressLogsDeliveryClickhouse)
 	}
 
 	// Additional ClickHouse delivery endpoints are best-effort. One driver +
@@ -600,16 +615,45 @@ func run(config cfg.Config, opts Options) (success bool) {
 			}
 			hostStatsGatedDeliveryClickhouse := clickhousehoststats.NewGatedDelivery(hostStatsDeliveryClickhouse, featureFlags)
 
+			egressLogsDeliveryClickhouse, err := clickhouseegresslogs.NewDefaultClickhouseEgressLogsDelivery(
+				ctx,
+				clickhouseConn,
+				featureFlags,
+				clickhouseegresslogs.DefaultBatcherName+":"+label,
+			)
+			if err != nil {
+				logger.L().Error(ctx, "failed to create clickhouse egress logs delivery, skipping endpoint",
+					zap.String("endpoint", label),
+					zap.Error(err),
+				)
+				logClose(
+					"clickhouse host stats delivery after egress logs delivery failure",
+					label,
+					func() error { return hostStatsDeliveryClickhouse.Close(ctx) },
+				)
+				logClose(
+					"clickhouse events delivery after egress logs delivery failure",
+					label,
+					func() error { return sbxEventsDeliveryClickhouse.Close(ctx) },
+				)
+				logClose("clickhouse connection after egress logs delivery failure", label, clickhouseConn.Close)
+
+				continue
+			}
+			egressLogsGatedDeliveryClickhouse := clickhouseegresslogs.NewGatedDelivery(egressLogsDeliveryClickhouse, featureFlags)
+
 			sbxEventsDeliveryTargets = append(sbxEventsDeliveryTargets, sbxGatedEventsDeliveryClickhouse)
 			closers = append(closers, closer{"clickhouse connection " + label, func(context.Context) error {
 				return clickhouseConn.Close()
 			}})
 
 			hostStatsTargets = append(hostStatsTargets, hostStatsGatedDeliveryClickhouse)
+			egressLogsTargets = append(egressLogsTargets, egressLogsGatedDeliveryClickhouse)
 		}
 	}
 
 	hostStatsDelivery := clickhousehoststats.NewMultiDelivery(hostStatsTargets...)
+	egressLogsDelivery := clickhouseegresslogs.NewMultiDelivery(egressLogsTargets...)
 
 	// cgroup manager for resource accounting
 	cgroupManager, err := cgroup.NewManager()
@@ -632,6 +676,7 @@ func run(config cfg.Config, opts Options) (success bool) {
 	// Wrapper closers run before per-driver closers (deliveries write through the drivers).
 	eventsService := events.NewEventsService(sbxEventsDeliveryTargets)
 	closers = append(closers, closer{"sandbox host stats deliveries (all)", hostStatsDelivery.Close})
+	closers = append(closers, closer{"sandbox egress logs deliveries (all)", egressLogsDelivery.Close})
 	closers = append(closers, closer{"sandbox events deliveries (all)", eventsService.Close})
 
 	// sandbox observer
@@ -672,6 +717,7 @@ func run(config cfg.Config, opts Options) (success bool) {
 		Logger:        globalLogger,
 		Sandboxes:     sandboxes,
 		FeatureFlags:  featureFlags,
+		EgressLogs:    egressLogsDelivery,
 	}
 
 	egressSetup, err := opts.EgressFactory(ctx, deps)
@@ -799,7 +845,15 @@ func run(config cfg.Config, opts Options) (success bool) {
 	}
 
 	// hyperloop server
-	hyperloopSrv, err := hyperloopserver.NewHyperloopServer(ctx, config.NetworkConfig.HyperloopProxyPort, globalLogger, sandboxes, featureFlags)
+	var identitySigner *sandboxidentity.Signer
+	if config.SandboxIdentitySigningKeyBase64 != "" {
+		identitySigner, err = sandboxidentity.NewSigner(config.SandboxIdentityIssuer, config.SandboxIdentitySigningKeyBase64, config.SandboxIdentityTokenTTL)
+		if err != nil {
+			logger.L().Fatal(ctx, "failed to create sandbox identity signer", zap.Error(err))
+		}
+	}
+
+	hyperloopSrv, err := hyperloopserver.NewHyperloopServer(ctx, config.NetworkConfig.HyperloopProxyPort, globalLogger, sandboxes, config.APIAddress, identitySigner, featureFlags)
 	if err != nil {
 		logger.L().Fatal(ctx, "failed to create hyperloop server", zap.Error(err))
 	}
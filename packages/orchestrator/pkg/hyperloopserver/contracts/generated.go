@@ -8,12 +8,15 @@ import (
 	"compress/flate"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
 )
 
 // Error defines model for Error.
@@ -25,26 +28,77 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// IdentityToken defines model for IdentityToken.
+type IdentityToken struct {
+	// ExpiresAt Time when the token expires
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Token Signed OIDC token identifying the sandbox
+	Token string `json:"token"`
+}
+
 // Me defines model for Me.
 type Me struct {
 	// SandboxID Sandbox ID
 	SandboxID string `json:"sandboxID"`
 }
 
+// SandboxTimeout defines model for SandboxTimeout.
+type SandboxTimeout struct {
+	// Timeout Timeout in seconds from the current time after which the sandbox should expire
+	Timeout int32 `json:"timeout"`
+}
+
 // N400 defines model for 400.
 type N400 = Error
 
+// N403 defines model for 403.
+type N403 = Error
+
+// N404 defines model for 404.
+type N404 = Error
+
+// N409 defines model for 409.
+type N409 = Error
+
 // N500 defines model for 500.
 type N500 = Error
 
+// IdentityTokenParams defines parameters for IdentityToken.
+type IdentityTokenParams struct {
+	// Audience Audience the token is issued for, usually the URL of the service receiving it
+	Audience string `form:"audience" json:"audience"`
+}
+
+// CreateSandboxJSONBody defines parameters for CreateSandbox.
+type CreateSandboxJSONBody map[string]interface{}
+
+// SetSandboxTimeoutJSONRequestBody defines body for SetSandboxTimeout for application/json ContentType.
+type SetSandboxTimeoutJSONRequestBody = SandboxTimeout
+
+// CreateSandboxJSONRequestBody defines body for CreateSandbox for application/json ContentType.
+type CreateSandboxJSONRequestBody CreateSandboxJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Sandbox identity token
+	// (GET /identity/token)
+	IdentityToken(c *gin.Context, params IdentityTokenParams)
 	// Submit sandbox logs
 	// (POST /logs)
 	Logs(c *gin.Context)
 	// Sandbox information
 	// (GET /me)
 	Me(c *gin.Context)
+	// Pause sandbox
+	// (POST /sandbox/pause)
+	PauseSandbox(c *gin.Context)
+	// Set sandbox timeout
+	// (POST /sandbox/timeout)
+	SetSandboxTimeout(c *gin.Context)
+	// Create sandbox
+	// (POST /sandboxes)
+	CreateSandbox(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -56,6 +110,33 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// IdentityToken operation middleware
+func (siw *ServerInterfaceWrapper) IdentityToken(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IdentityTokenParams
+
+	// ------------- Required query parameter "audience" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "audience", c.Request.URL.Query(), &params.Audience, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter audience: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.IdentityToken(c, params)
+}
+
 // Logs operation middleware
 func (siw *ServerInterfaceWrapper) Logs(c *gin.Context) {
 
@@ -82,6 +163,45 @@ func (siw *ServerInterfaceWrapper) Me(c *gin.Context) {
 	siw.Handler.Me(c)
 }
 
+// PauseSandbox operation middleware
+func (siw *ServerInterfaceWrapper) PauseSandbox(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PauseSandbox(c)
+}
+
+// SetSandboxTimeout operation middleware
+func (siw *ServerInterfaceWrapper) SetSandboxTimeout(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetSandboxTimeout(c)
+}
+
+// CreateSandbox operation middleware
+func (siw *ServerInterfaceWrapper) CreateSandbox(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateSandbox(c)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -109,8 +229,12 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/identity/token", wrapper.IdentityToken)
 	router.POST(options.BaseURL+"/logs", wrapper.Logs)
 	router.GET(options.BaseURL+"/me", wrapper.Me)
+	router.POST(options.BaseURL+"/sandbox/pause", wrapper.PauseSandbox)
+	router.POST(options.BaseURL+"/sandbox/timeout", wrapper.SetSandboxTimeout)
+	router.POST(options.BaseURL+"/sandboxes", wrapper.CreateSandbox)
 }
 
 // Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
@@ -118,14 +242,26 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"vJNBj9NMDIb/ysjfd4ya0IVLjqtdiSIKEj2iPUwTJzsoGQ/2pFBV+e/IkyxRaQsXxCmT2H5sv3nnBBX1",
-	"gTz6KFCegFECecH08roo9FGRj+ijHm0InatsdOTzL0Jev0n1jL3V0/+MDZTwX74w8ykq+SMzMYzjmEGN",
-	"UrELCoES7m1tGL8OKBHGDN78i5475AOywTmezby09FRUniAwBeToJi0qqlGf56CUbFIsg4a4txFKcD7e",
-	"rSGDeAw4vWKLrNv1KGLbW6ClRCI736bRVBvHWEP5GeZGL5SnMYMtXs4q1td7+r55uOyzm0Jm8/DHZgvl",
-	"KUnofEMKjC52Wva4vjdvjwG5IwqQwQFZpibF6tWq0HUpoLfBQQl3q2JVQAbBxuc0Y95Rmw6BJF7O+Qkr",
-	"dAcU82738YPZW8HaaIWZdxdIdE622NRQwnvlZecGXk9m+hWdzGa+WTEyVBWKNEOn485+v2apn9hckxaf",
-	"/j5Xk5K9hr63fFT9h33vopm1TSuljLxP/7HFq1rEgb0Y/QHqMEfe2D0NC8ZFwa65kGSLNwT5K7dri9eu",
-	"1i11z1V4mXvZSFnjjwAAAP//",
+	"1Fffj9s2DP5XCG1Pg5ukd7eH+q3tdViG/kKve+oKVLHoRK0tuSJ1d0GR/32QZCeOnWvaWzNsT1EiiR/5",
+	"8RPJfBGFrRtr0DCJ/ItwSI01hPHLxWwWPgprGA2HpWyaSheStTXTj2RN+I2KFdYyrH52WIpc/DTd2Zym",
+	"XZo+c846sdlsMqGQCqebYETk4olU4PCzR2KxycTF7Pz0mL9Zt9BKoUmIF6dHfGkZSuuNSoiPTo/41Jqy",
+	"0kUk9dd/I5FX6K7RAbb7WWsvKildyr+IxtkGHesksMIqDJ/7huJhiHuZKK2rJYtcaMPnZyITvG4wfcUl",
+	"uhBdjURyeZeh3RVip80yuhYEpx0qkb8TLVBn5f0mE3OFhjWv39pPaMZu422jHdJjHkO+1TXCzQoN8AqB",
+	"w31oj/eDUZLxAesax95lgjvUAb96aVDBq/nl09awjm6Wa22WEY6kUQt7ezTiBJD14ghBv8BxpK3F+eUB",
+	"d9IWzC+P4u2sBJz2YmDKeh5j8m5jzK31DNoAYWGNIiidrWPohXcODUO4DLJkdHCz0sWqzwvQyvpKtfk4",
+	"pK1aG137WuSzsc6GHLZevo8vQZvSJt+5CreenT2B39cNusraRmTiGh2lIGaTh5NZyLJt0MhGi1ycT2aT",
+	"mchEI3kVGZjqVn/TrRSWeICQN8jeGQIZInP8oNLX3ySQDK7R6VLLRYVwozmx9AnXBI1fVJpWqGCxjr9q",
+	"Io9uAuF16wIJrGfSCiGEWEgD7DwxaAZeOeuXKyhRoYuVZQJve+wXK2sJKVqVXmk0BWZAFmrJXaL84iMW",
+	"DGxtHkxqgg+Mss7/8rPZeRGW88u4xrw1225tJZZ2P2QgjUq+dcEftfTLB2gclvoWKl1rTp6m8Nj2+Qss",
+	"lGANQrAzETGZKeS5EvmgfITEOlkjoyORvxsm8XFLRa9gaEq0Kyity8CTl1WV0vHnm+cBOywpZQQcFqiv",
+	"Q5SaRZCiyMVnj24tMmFkjSIXHd2ir2F2HrNe0a+1eY5mySuRPxy/6PfZ/mxw9gNbyj5fB1rLmzQfwI0k",
+	"IF8USFT6KrXS2V3Wt+5Ow6Fdoz929qLXMb9+NhyKjc7XtXTrXlHsHnBKaDw0rewyMtdYOviWQxaR4I+r",
+	"Vy9hIQkVhBvQ9iUaqex5sHc4K6dg776M+EWteVsEIgmRjhqP1jVtUoXW1oBcWL8zo5mwKkeUvEBxQpm+",
+	"wO/R5mFd7CJKLLQBTRvpCe9Wx+uwTXvNLFHQ9rqw0cUdygeh4Qzat57uRQQIRDhbQWMrXaxHBEacq+0Y",
+	"MaDy7EBP7ntEsMBQhyKU+v7nef4tZ8+/9ym30/axs4/+gcgjbR0P+4ntTTOHU3uFXadJJ7flfS/Pg2Ti",
+	"LaNR3UB0JKlXyIOZK7UBJH5i1fqHvY8ByGazGbabzUhSFwcI2T6iag2EvMdGR+d/RFv3LYrI44h6qsGv",
+	"NIqnDiVjHPq2RSXpB2U9EM9ANdTIG3PVQQxkk4a1VhdxetoWlIVVOiC6NKRYkwagsH78er4b8BymsW+k",
+	"wORzv67cT31SKR22ZPW695chDTLtwGLjBPlt4nt4SvC762ToFJErVL2OUa3/56JOSe5Vwc3m7wEA",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -0,0 +1,131 @@
+//go:build linux
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const (
+	headerSandboxControlToken = "X-Sandbox-Control-Token"
+	headerTeamID              = "X-Team-ID"
+
+	// maxControlRequestSize bounds the request body the sandbox sends.
+	maxControlRequestSize = 1 << 20
+	// maxControlResponseSize bounds the API response passed back to the sandbox.
+	maxControlResponseSize = 1 << 20
+)
+
+func (h *APIStore) SetSandboxTimeout(c *gin.Context) {
+	h.forwardControl(c, "timeout")
+}
+
+func (h *APIStore) PauseSandbox(c *gin.Context) {
+	h.forwardControl(c, "pause")
+}
+
+func (h *APIStore) CreateSandbox(c *gin.Context) {
+	h.forwardControl(c, "sandboxes")
+}
+
+// forwardControl forwards the action the sandbox takes on itself to the API on
+// behalf of the sandbox, which is identified by the source address. The API
+// checks the control policy, its response is passed back as is.
+func (h *APIStore) forwardControl(c *gin.Context, action string) {
+	ctx := c.Request.Context()
+	sbx, err := h.sandboxes.GetByHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Error when finding source sandbox")
+		ip, _, _ := net.SplitHostPort(c.Request.RemoteAddr)
+		h.logger.Error(ctx, "error finding sandbox for source addr", logger.WithSandboxIP(ip), zap.Error(err))
+
+		return
+	}
+
+	sbxID := sbx.Runtime.SandboxID
+
+	token := sbx.APIStoredConfig.GetControlAccessToken()
+	if token == "" {
+		h.sendAPIStoreError(c, http.StatusForbidden, "The sandbox doesn't have a control policy")
+
+		return
+	}
+
+	if h.apiAddress == "" {
+		h.sendAPIStoreError(c, http.StatusInternalServerError, "Sandbox control is not configured")
+		h.logger.Error(ctx, "sandbox control request without the API address configured", logger.WithSandboxID(sbxID))
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxControlRequestSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.sendAPIStoreError(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("Request body can't be larger than %d bytes", maxBytesErr.Limit))
+
+			return
+		}
+
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Error when reading request body")
+
+		return
+	}
+
+	status, contentType, respBody, err := h.forwardControlRequest(ctx, sbxID, sbx.Runtime.TeamID, token, action, body)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusInternalServerError, "Error when forwarding sandbox control request")
+		h.logger.Error(ctx, "error when forwarding sandbox control request", zap.Error(err), logger.WithSandboxID(sbxID), zap.String("action", action))
+
+		return
+	}
+
+	if len(respBody) == 0 {
+		c.Status(status)
+
+		return
+	}
+
+	c.Data(status, contentType, respBody)
+}
+
+// forwardControlRequest POSTs body to the control endpoint of the API for action.
+func (h *APIStore) forwardControlRequest(ctx context.Context, sandboxID, teamID, token, action string, body []byte) (int, string, []byte, error) {
+	url := fmt.Sprintf("%s/sandboxes/%s/control/%s", strings.TrimSuffix(h.apiAddress, "/"), sandboxID, action)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("error creating sandbox control request: %w", err)
+	}
+
+	if len(body) > 0 {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set(headerSandboxControlToken, token)
+	request.Header.Set(headerTeamID, teamID)
+
+	response, err := h.apiClient.Do(request)
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("error sending sandbox control request: %w", err)
+	}
+	defer response.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(response.Body, maxControlResponseSize))
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("error reading sandbox control response: %w", err)
+	}
+
+	return response.StatusCode, response.Header.Get("Content-Type"), respBody, nil
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardControlRequest(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/sandboxes/sbx-1/control/sandboxes", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get(headerSandboxControlToken))
+		assert.Equal(t, "team-1", r.Header.Get(headerTeamID))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"templateID":"base"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"code":403,"message":"The sandbox is not allowed to create sandboxes"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	store := &APIStore{apiAddress: server.URL + "/", apiClient: *server.Client()}
+
+	status, contentType, body, err := store.forwardControlRequest(t.Context(), "sbx-1", "team-1", "token", "sandboxes", []byte(`{"templateID":"base"}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Equal(t, "application/json", contentType)
+	assert.JSONEq(t, `{"code":403,"message":"The sandbox is not allowed to create sandboxes"}`, string(body))
+}
+
+func TestForwardControlRequestWithoutBody(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sandboxes/sbx-1/control/pause", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Content-Type"))
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(server.Close)
+
+	store := &APIStore{apiAddress: server.URL, apiClient: *server.Client()}
+
+	status, _, body, err := store.forwardControlRequest(t.Context(), "sbx-1", "team-1", "token", "pause", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Empty(t, body)
+}
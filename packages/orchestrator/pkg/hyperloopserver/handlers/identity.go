@@ -0,0 +1,52 @@
+//go:build linux
+
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/hyperloopserver/contracts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/sandboxidentity"
+)
+
+func (h *APIStore) IdentityToken(c *gin.Context, params contracts.IdentityTokenParams) {
+	ctx := c.Request.Context()
+
+	if h.identitySigner == nil {
+		h.sendAPIStoreError(c, http.StatusNotFound, "Sandbox identity tokens are not enabled")
+
+		return
+	}
+
+	sbx, err := h.sandboxes.GetByHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Error when finding source sandbox")
+		ip, _, _ := net.SplitHostPort(c.Request.RemoteAddr)
+		h.logger.Error(ctx, "error finding sandbox for source addr", logger.WithSandboxIP(ip), zap.Error(err))
+
+		return
+	}
+
+	identity := sandboxidentity.Identity{
+		SandboxID:  sbx.Runtime.SandboxID,
+		TemplateID: sbx.Runtime.TemplateID,
+		TeamID:     sbx.Runtime.TeamID,
+		Metadata:   sbx.APIStoredConfig.GetMetadata(),
+	}
+
+	token, expiresAt, err := h.identitySigner.Sign(identity, params.Audience, time.Now())
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusInternalServerError, "Error when signing identity token")
+		h.logger.Error(ctx, "error when signing sandbox identity token", zap.Error(err), logger.WithSandboxID(identity.SandboxID))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &contracts.IdentityToken{Token: token, ExpiresAt: expiresAt})
+}
@@ -13,10 +13,14 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/apierrors"
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/sandboxidentity"
 )
 
 const CollectorExporterTimeout = 10 * time.Second
 
+// APIControlTimeout bounds the forwarded control requests, creating a sandbox can take a while.
+const APIControlTimeout = 60 * time.Second
+
 const defaultMaxInflightShadowWrites = 1024
 
 type APIStore struct {
@@ -26,12 +30,18 @@ type APIStore struct {
 	collectorClient http.Client
 	logWriteConfig  *featureflags.LogWriteConfigResolver
 
+	apiAddress string
+	apiClient  http.Client
+
+	// identitySigner mints the identity tokens of sandboxes, nil when disabled.
+	identitySigner *sandboxidentity.Signer
+
 	// shadowInflight bounds concurrent shadow forwards (best-effort, non-blocking
 	// acquire; dropped when the resolved route limit is reached).
 	shadowInflight atomic.Int64
 }
 
-func NewHyperloopStore(logger logger.Logger, sandboxes *sandbox.Map, sandboxCollectorAddr string, featureFlags *featureflags.Client) *APIStore {
+func NewHyperloopStore(logger logger.Logger, sandboxes *sandbox.Map, sandboxCollectorAddr string, apiAddress string, identitySigner *sandboxidentity.Signer, featureFlags *featureflags.Client) *APIStore {
 	return &APIStore{
 		logger:    logger,
 		sandboxes: sandboxes,
@@ -40,6 +50,13 @@ func NewHyperloopStore(logger logger.Logger, sandboxes *sandbox.Map, sandboxColl
 			Timeout: CollectorExporterTimeout,
 		},
 		logWriteConfig: featureflags.NewLogWriteConfigResolver(featureFlags, sandboxCollectorAddr),
+
+		apiAddress: apiAddress,
+		apiClient: http.Client{
+			Timeout: APIControlTimeout,
+		},
+
+		identitySigner: identitySigner,
 	}
 }
 
@@ -19,13 +19,14 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/httpserver"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/sandboxidentity"
 )
 
 const maxUploadLimit = 1 << 28 // 256 MiB
 
-func NewHyperloopServer(ctx context.Context, port uint16, logger logger.Logger, sandboxes *sandbox.Map, featureFlags *featureflags.Client) (*http.Server, error) {
+func NewHyperloopServer(ctx context.Context, port uint16, logger logger.Logger, sandboxes *sandbox.Map, apiAddress string, identitySigner *sandboxidentity.Signer, featureFlags *featureflags.Client) (*http.Server, error) {
 	sandboxCollectorAddr := env.LogsCollectorAddress()
-	store := handlers.NewHyperloopStore(logger, sandboxes, sandboxCollectorAddr, featureFlags)
+	store := handlers.NewHyperloopStore(logger, sandboxes, sandboxCollectorAddr, apiAddress, identitySigner, featureFlags)
 	swagger, err := contracts.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("error getting swagger spec: %w", err)
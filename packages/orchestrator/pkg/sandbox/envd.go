@@ -27,6 +27,9 @@ import (
 
 const (
 	loopDelay = 5 * time.Millisecond
+
+	// envdEnvsTimeout bounds replacing the env vars of a running sandbox.
+	envdEnvsTimeout = 10 * time.Second
 )
 
 // envdInitExitType classifies the outcome of an envd init call.
@@ -78,14 +81,15 @@ func (s *Sandbox) doRequestWithInfiniteRetries(
 	requestCount := int64(0)
 
 	jsonBody := &envd.PostInitJSONBody{
-		LifecycleID:    s.LifecycleID,
-		EnvVars:        s.Config.Envd.Vars,
-		HyperloopIP:    s.config.NetworkConfig.OrchestratorInSandboxIPAddress,
-		AccessToken:    utils.DerefOrDefault(s.Config.Envd.AccessToken, ""),
-		DefaultUser:    utils.DerefOrDefault(s.Config.Envd.DefaultUser, ""),
-		DefaultWorkdir: utils.DerefOrDefault(s.Config.Envd.DefaultWorkdir, ""),
-		VolumeMounts:   s.convertMounts(s.Config.VolumeMounts),
-		CaBundle:       s.CABundle,
+		LifecycleID:      s.LifecycleID,
+		EnvVars:          s.Config.GetEnvdVars(),
+		HyperloopIP:      s.config.NetworkConfig.OrchestratorInSandboxIPAddress,
+		AccessToken:      utils.DerefOrDefault(s.Config.Envd.AccessToken, ""),
+		DefaultUser:      utils.DerefOrDefault(s.Config.Envd.DefaultUser, ""),
+		DefaultWorkdir:   utils.DerefOrDefault(s.Config.Envd.DefaultWorkdir, ""),
+		VolumeMounts:     s.convertMounts(s.Config.VolumeMounts),
+		CaBundle:         s.CABundle,
+		LogProcessOutput: s.Config.Envd.LogProcessOutput,
 	}
 
 	for {
@@ -226,11 +230,19 @@ func (s *Sandbox) envdServerURL() string {
 // deadline must live on ctx (callers set it via context.WithTimeout) so it
 // stays in force while the caller reads the body.
 func (s *Sandbox) doEnvdPost(ctx context.Context, path string) (*http.Response, error) {
+	return s.doEnvdRequest(ctx, http.MethodPost, path, nil)
+}
+
+// doEnvdRequest builds and sends an authenticated request to envd's /<path> endpoint.
+func (s *Sandbox) doEnvdRequest(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
 	address := s.envdServerURL() + "/" + path
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, nil)
+	req, err := http.NewRequestWithContext(ctx, method, address, body)
 	if err != nil {
 		return nil, fmt.Errorf("build %s request: %w", path, err)
 	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	if s.Config.Envd.AccessToken != nil {
 		req.Header.Set("X-Access-Token", *s.Config.Envd.AccessToken)
 	}
@@ -243,6 +255,33 @@ func (s *Sandbox) doEnvdPost(ctx context.Context, path string) (*http.Response,
 	return resp, nil
 }
 
+// UpdateEnvVars sets and unsets default env vars envd uses for new processes.
+func (s *Sandbox) UpdateEnvVars(ctx context.Context, set map[string]string, unset []string) error {
+	body, err := json.Marshal(envd.PatchEnvsJSONRequestBody{Set: set, Unset: unset})
+	if err != nil {
+		return fmt.Errorf("encode env vars: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, envdEnvsTimeout)
+	defer cancel()
+
+	resp, err := s.doEnvdRequest(ctx, http.MethodPatch, "envs", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		respBody, _ := io.ReadAll(resp.Body)
+
+		return fmt.Errorf("envs returned %d: %s", resp.StatusCode, utils.Truncate(string(respBody), 100))
+	}
+
+	s.Config.UpdateEnvdVars(set, unset)
+
+	return nil
+}
+
 func (s *Sandbox) convertMounts(mounts []VolumeMountConfig) []envd.VolumeMount {
 	results := make([]envd.VolumeMount, 0, len(mounts))
 
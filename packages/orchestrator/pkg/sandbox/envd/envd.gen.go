@@ -15,12 +15,15 @@ const (
 
 // Defines values for EntryInfoType.
 const (
-	File EntryInfoType = "file"
+	Directory EntryInfoType = "directory"
+	File      EntryInfoType = "file"
 )
 
 // Valid indicates whether the value is a known member of the EntryInfoType enum.
 func (e EntryInfoType) Valid() bool {
 	switch e {
+	case Directory:
+		return true
 	case File:
 		return true
 	default:
@@ -28,6 +31,81 @@ func (e EntryInfoType) Valid() bool {
 	}
 }
 
+// Defines values for ArchiveFormat.
+const (
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+	ArchiveFormatZip   ArchiveFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the ArchiveFormat enum.
+func (e ArchiveFormat) Valid() bool {
+	switch e {
+	case ArchiveFormatTarGz:
+		return true
+	case ArchiveFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesParamsMode.
+const (
+	Append    PostFilesParamsMode = "append"
+	Overwrite PostFilesParamsMode = "overwrite"
+	Write     PostFilesParamsMode = "write"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesParamsMode enum.
+func (e PostFilesParamsMode) Valid() bool {
+	switch e {
+	case Append:
+		return true
+	case Overwrite:
+		return true
+	case Write:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetFilesArchiveParamsFormat.
+const (
+	GetFilesArchiveParamsFormatTarGz GetFilesArchiveParamsFormat = "tar.gz"
+	GetFilesArchiveParamsFormatZip   GetFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the GetFilesArchiveParamsFormat enum.
+func (e GetFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case GetFilesArchiveParamsFormatTarGz:
+		return true
+	case GetFilesArchiveParamsFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesArchiveParamsFormat.
+const (
+	TarGz PostFilesArchiveParamsFormat = "tar.gz"
+	Zip   PostFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesArchiveParamsFormat enum.
+func (e PostFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case TarGz:
+		return true
+	case Zip:
+		return true
+	default:
+		return false
+	}
+}
+
 // CollapseResult Per-call statistics from a heap collapse
 type CollapseResult struct {
 	// AlreadyHuge Chunks MADV_COLLAPSE accepted but were already hugepages (no work)
@@ -82,6 +160,15 @@ type EntryInfoType string
 // EnvVars Environment variables to set
 type EnvVars map[string]string
 
+// EnvVarsUpdate Environment variables to set and unset, other environment variables are kept
+type EnvVarsUpdate struct {
+	// Set Environment variables to set
+	Set EnvVars `json:"set,omitempty"`
+
+	// Unset Names of the environment variables to unset
+	Unset []string `json:"unset,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -133,6 +220,9 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// ArchiveFormat defines model for ArchiveFormat.
+type ArchiveFormat string
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -202,8 +292,61 @@ type PostFilesParams struct {
 
 	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
 	SignatureExpiration SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Mode How the uploaded content is written: `overwrite` replaces the file,
+	// `append` adds the content to its end, and `write` writes it at
+	// `offset`, keeping the rest of the file.
+	Mode PostFilesParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Offset Byte offset to write the content at. Required with the `write` mode and not allowed with the other modes.
+	Offset int64 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostFilesParamsMode defines parameters for PostFiles.
+type PostFilesParamsMode string
+
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format GetFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
 }
 
+// GetFilesArchiveParamsFormat defines parameters for GetFilesArchive.
+type GetFilesArchiveParamsFormat string
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format PostFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PostFilesArchiveParamsFormat defines parameters for PostFilesArchive.
+type PostFilesArchiveParamsFormat string
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// AccessToken Access token for secure access to envd service
@@ -227,11 +370,17 @@ type PostInitJSONBody struct {
 	// LifecycleID Lifecycle ID of the sandbox
 	LifecycleID string `json:"lifecycleID,omitempty"`
 
+	// LogProcessOutput Ship the output of started processes to the sandbox logs unless a process sets it explicitly
+	LogProcessOutput bool `json:"logProcessOutput,omitempty"`
+
 	// Timestamp The current timestamp in RFC3339 format
 	Timestamp    time.Time     `json:"timestamp,omitempty"`
 	VolumeMounts []VolumeMount `json:"volumeMounts,omitempty"`
 }
 
+// PatchEnvsJSONRequestBody defines body for PatchEnvs for application/json ContentType.
+type PatchEnvsJSONRequestBody = EnvVarsUpdate
+
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
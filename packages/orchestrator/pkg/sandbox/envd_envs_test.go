@@ -0,0 +1,68 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/envd"
+)
+
+func TestUpdateEnvVars(t *testing.T) {
+	t.Parallel()
+
+	var received envd.EnvVarsUpdate
+	fail := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/envs" || r.Header.Get("X-Access-Token") != "test-token" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+
+			return
+		}
+
+		if fail {
+			http.Error(w, "invalid env var", http.StatusBadRequest)
+
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	token := "test-token"
+	s := &Sandbox{Metadata: &Metadata{
+		Config: NewConfig(Config{
+			Envd: EnvdMetadata{
+				AccessToken: &token,
+				Vars:        map[string]string{"OLD": "1", "KEPT": "2"},
+			},
+		}),
+	}}
+	s.internalConfig.envdServerURLOverride = srv.URL
+
+	set := map[string]string{"API_KEY": "secret"}
+	require.NoError(t, s.UpdateEnvVars(t.Context(), set, []string{"OLD"}))
+	assert.Equal(t, envd.EnvVars(set), received.Set)
+	assert.Equal(t, []string{"OLD"}, received.Unset)
+
+	vars := map[string]string{"API_KEY": "secret", "KEPT": "2"}
+	assert.Equal(t, vars, s.Config.GetEnvdVars())
+
+	// A rejected update keeps the current env vars.
+	fail = true
+	require.Error(t, s.UpdateEnvVars(t.Context(), map[string]string{"A=B": "x"}, nil))
+	assert.Equal(t, vars, s.Config.GetEnvdVars())
+}
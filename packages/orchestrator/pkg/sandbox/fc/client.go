@@ -462,6 +462,92 @@ func (c *apiClient) installBalloon(ctx context.Context, freePageReporting, freeP
 	return nil
 }
 
+// setBalloonAmount inflates or deflates the balloon to amountMib, taking the
+// memory away from the guest.
+func (c *apiClient) setBalloonAmount(ctx context.Context, amountMib int64) error {
+	params := operations.PatchBalloonParams{
+		Context: ctx,
+		Body:    &models.BalloonUpdate{AmountMib: &amountMib},
+	}
+
+	_, err := c.client.Operations.PatchBalloon(&params)
+	if err != nil {
+		return fmt.Errorf("error setting balloon amount: %w", err)
+	}
+
+	return nil
+}
+
+// balloonAmount returns the target size of the balloon, errors when the VM has no balloon device.
+func (c *apiClient) balloonAmount(ctx context.Context) (int64, error) {
+	res, err := c.client.Operations.DescribeBalloonConfig(&operations.DescribeBalloonConfigParams{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("error getting balloon config: %w", err)
+	}
+
+	if res.Payload.AmountMib == nil {
+		return 0, nil
+	}
+
+	return *res.Payload.AmountMib, nil
+}
+
+// installMemoryHotplug attaches a virtio-mem region of totalSizeMib that
+// starts unplugged. Pre-boot only.
+func (c *apiClient) installMemoryHotplug(ctx context.Context, totalSizeMib int64) error {
+	params := operations.PutMemoryHotplugParams{
+		Context: ctx,
+		Body:    &models.MemoryHotplugConfig{TotalSizeMib: totalSizeMib},
+	}
+
+	_, err := c.client.Operations.PutMemoryHotplug(&params)
+	if err != nil {
+		return fmt.Errorf("error installing memory hotplug device: %w", err)
+	}
+
+	return nil
+}
+
+// setHotplugMemory asks the guest to plug or unplug memory of the virtio-mem
+// region until requestedSizeMib is plugged.
+func (c *apiClient) setHotplugMemory(ctx context.Context, requestedSizeMib int64) error {
+	params := operations.PatchMemoryHotplugParams{
+		Context: ctx,
+		Body:    &models.MemoryHotplugSizeUpdate{RequestedSizeMib: requestedSizeMib},
+	}
+
+	_, err := c.client.Operations.PatchMemoryHotplug(&params)
+	if err != nil {
+		return fmt.Errorf("error setting hotplug memory: %w", err)
+	}
+
+	return nil
+}
+
+// hotplugMemorySize returns the size of the virtio-mem region, 0 when the VM has none.
+func (c *apiClient) hotplugMemorySize(ctx context.Context) (int64, error) {
+	res, err := c.client.Operations.GetMemoryHotplug(&operations.GetMemoryHotplugParams{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("error getting memory hotplug status: %w", err)
+	}
+
+	return res.Payload.TotalSizeMib, nil
+}
+
+// bootMemorySize returns the memory the VM was booted with, without the hotplugged memory.
+func (c *apiClient) bootMemorySize(ctx context.Context) (int64, error) {
+	res, err := c.client.Operations.GetMachineConfiguration(&operations.GetMachineConfigurationParams{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("error getting fc machine config: %w", err)
+	}
+
+	if res.Payload.MemSizeMib == nil {
+		return 0, errors.New("fc machine config has no memory size")
+	}
+
+	return *res.Payload.MemSizeMib, nil
+}
+
 func (c *apiClient) startBalloonHinting(ctx context.Context, acknowledgeOnStop bool) error {
 	params := operations.StartBalloonHintingParams{
 		Context: ctx,
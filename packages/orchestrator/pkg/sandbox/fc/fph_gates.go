@@ -27,3 +27,14 @@ func FCSupportsMemfd(fcVersion string) bool {
 
 	return info.HasMemfd()
 }
+
+// FCSupportsMemoryHotplug reports whether the FC version exposes the virtio-mem
+// hotplug API used to grow the memory of running sandboxes.
+func FCSupportsMemoryHotplug(fcVersion string) bool {
+	info, err := fcversion.New(fcVersion)
+	if err != nil {
+		return false
+	}
+
+	return info.HasMemoryHotplug()
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/RoaringBitmap/roaring/v2"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/block"
@@ -155,3 +156,91 @@ func (p *Process) ExportMemory(
 
 	return dedupCache, nil
 }
+
+// ErrMemoryResizeUnsupported is returned when the VM lacks the device needed to resize its memory to the requested size.
+var ErrMemoryResizeUnsupported = errors.New("memory resize not supported by the sandbox")
+
+type memoryResize struct {
+	hotplugMib int64
+	balloonMib int64
+}
+
+// planMemoryResize returns how much memory has to be plugged into the virtio-mem region
+// and how much has to be taken by the balloon for the guest to have memoryMib.
+// Memory above the boot memory is hotplugged, memory below it is taken by the balloon.
+func planMemoryResize(bootMib, hotplugSizeMib, memoryMib int64) (memoryResize, error) {
+	if memoryMib <= 0 {
+		return memoryResize{}, fmt.Errorf("invalid memory size %d MiB", memoryMib)
+	}
+
+	if memoryMib <= bootMib {
+		return memoryResize{balloonMib: bootMib - memoryMib}, nil
+	}
+
+	if memoryMib-bootMib > hotplugSizeMib {
+		return memoryResize{}, fmt.Errorf("%w: %d MiB is more than the %d MiB boot memory and the %d MiB hotplug region",
+			ErrMemoryResizeUnsupported, memoryMib, bootMib, hotplugSizeMib)
+	}
+
+	return memoryResize{hotplugMib: memoryMib - bootMib}, nil
+}
+
+// ResizeMemory grows or shrinks the memory of the running guest to memoryMib.
+// Growing plugs memory of the virtio-mem region, shrinking inflates the balloon.
+// The guest applies both asynchronously.
+func (p *Process) ResizeMemory(ctx context.Context, memoryMib int64) error {
+	ctx, span := tracer.Start(ctx, "resize-memory-fc")
+	defer span.End()
+
+	bootMib, err := p.client.bootMemorySize(ctx)
+	if err != nil {
+		return err
+	}
+
+	// FC errors on both when the VM was booted without the device.
+	hotplugSizeMib, hotplugErr := p.client.hotplugMemorySize(ctx)
+	if hotplugErr != nil {
+		hotplugSizeMib = 0
+	}
+	currentBalloonMib, balloonErr := p.client.balloonAmount(ctx)
+	hasBalloon := balloonErr == nil
+
+	plan, err := planMemoryResize(bootMib, hotplugSizeMib, memoryMib)
+	if err != nil {
+		return err
+	}
+
+	if plan.balloonMib > 0 && !hasBalloon {
+		return fmt.Errorf("%w: shrinking the memory requires a balloon device", ErrMemoryResizeUnsupported)
+	}
+
+	span.SetAttributes(
+		attribute.Int64("memory.boot_mib", bootMib),
+		attribute.Int64("memory.hotplug_mib", plan.hotplugMib),
+		attribute.Int64("memory.balloon_mib", plan.balloonMib),
+	)
+
+	// Change the devices in the direction of the resize, so the guest memory
+	// moves from the old to the new size without overshooting either.
+	if plan.hotplugMib > 0 {
+		if hasBalloon && currentBalloonMib != 0 {
+			if err := p.client.setBalloonAmount(ctx, 0); err != nil {
+				return err
+			}
+		}
+
+		return p.client.setHotplugMemory(ctx, plan.hotplugMib)
+	}
+
+	if hotplugSizeMib > 0 {
+		if err := p.client.setHotplugMemory(ctx, 0); err != nil {
+			return err
+		}
+	}
+
+	if hasBalloon && currentBalloonMib != plan.balloonMib {
+		return p.client.setBalloonAmount(ctx, plan.balloonMib)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+//go:build linux
+
+package fc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanMemoryResize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		hotplugSizeMib int64
+		memoryMib      int64
+		want           memoryResize
+		wantErr        error
+	}{
+		{
+			name:           "boot memory",
+			hotplugSizeMib: 4096,
+			memoryMib:      1024,
+			want:           memoryResize{},
+		},
+		{
+			name:           "grow plugs the memory above the boot memory",
+			hotplugSizeMib: 4096,
+			memoryMib:      3072,
+			want:           memoryResize{hotplugMib: 2048},
+		},
+		{
+			name:           "grow up to the hotplug region",
+			hotplugSizeMib: 4096,
+			memoryMib:      5120,
+			want:           memoryResize{hotplugMib: 4096},
+		},
+		{
+			name:           "shrink inflates the balloon",
+			hotplugSizeMib: 4096,
+			memoryMib:      512,
+			want:           memoryResize{balloonMib: 512},
+		},
+		{
+			name:      "shrink without a hotplug region",
+			memoryMib: 768,
+			want:      memoryResize{balloonMib: 256},
+		},
+		{
+			name:           "grow beyond the hotplug region",
+			hotplugSizeMib: 1024,
+			memoryMib:      4096,
+			wantErr:        ErrMemoryResizeUnsupported,
+		},
+		{
+			name:      "grow without a hotplug region",
+			memoryMib: 2048,
+			wantErr:   ErrMemoryResizeUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := planMemoryResize(1024, tt.hotplugSizeMib, tt.memoryMib)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -324,6 +324,7 @@ func (p *Process) Create(
 	hugePages bool,
 	freePageReporting bool,
 	freePageHinting bool,
+	memoryHotplugMB int64,
 	options ProcessOptions,
 	txRateLimit RateLimiterConfig,
 	driveRateLimit RateLimiterConfig,
@@ -477,6 +478,17 @@ func (p *Process) Create(
 		)
 	}
 
+	if memoryHotplugMB > 0 {
+		if err := p.client.installMemoryHotplug(ctx, memoryHotplugMB); err != nil {
+			fcStopErr := p.Stop(ctx)
+
+			return errors.Join(fmt.Errorf("error installing memory hotplug device: %w", err), fcStopErr)
+		}
+		telemetry.ReportEvent(ctx, "installed memory hotplug device",
+			attribute.Int64("memory_hotplug.size_mb", memoryHotplugMB),
+		)
+	}
+
 	// Write MMDS metadata before boot when an access token is provided (the
 	// cold-boot/reboot user path) so the guest envd can authenticate /init the
 	// same way it does after a memory resume. The MMDS transport is already
@@ -666,6 +678,19 @@ func (p *Process) Resume(
 	return nil
 }
 
+// SetRateLimits replaces the rate limits of the network interface and the
+// rootfs drive of the running VM.
+func (p *Process) SetRateLimits(ctx context.Context, txRateLimit RateLimiterConfig, driveRateLimit RateLimiterConfig) error {
+	ctx, childSpan := tracer.Start(ctx, "set-rate-limits-fc")
+	defer childSpan.End()
+
+	if err := p.client.setTxRateLimit(ctx, p.slot.VpeerName(), txRateLimit); err != nil {
+		return err
+	}
+
+	return p.client.setDriveRateLimit(ctx, rootfsDriveID, driveRateLimit)
+}
+
 func (p *Process) Pid() (int, error) {
 	if p.cmd.Process == nil {
 		return 0, errors.New("fc process not started")
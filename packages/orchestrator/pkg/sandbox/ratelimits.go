@@ -0,0 +1,88 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const (
+	bytesPerMegabit  = 1_000_000 / 8
+	bytesPerMegabyte = 1_000_000
+
+	// bandwidthRefillTimeMs makes the bucket size of a sandbox limit equal to its rate per second.
+	bandwidthRefillTimeMs = 1000
+)
+
+// nodeRateLimits returns the node-wide network and drive rate limits from the feature flags.
+func nodeRateLimits(ctx context.Context, ff *featureflags.Client) (fc.RateLimiterConfig, fc.RateLimiterConfig) {
+	txThrottleConfig := featureflags.GetTCPFirewallEgressThrottleConfig(ctx, ff)
+	driveThrottleConfig := featureflags.GetBlockDriveThrottleConfig(ctx, ff)
+
+	tx := fc.RateLimiterConfig{
+		Ops:       fc.TokenBucketConfig(txThrottleConfig.Ops),
+		Bandwidth: fc.TokenBucketConfig(txThrottleConfig.Bandwidth),
+	}
+	drive := fc.RateLimiterConfig{
+		Ops:       fc.TokenBucketConfig(driveThrottleConfig.Ops),
+		Bandwidth: fc.TokenBucketConfig(driveThrottleConfig.Bandwidth),
+	}
+
+	return tx, drive
+}
+
+// applyBandwidthLimits replaces the buckets of the node-wide rate limits for
+// which the sandbox has a lower limit set, a sandbox can't exceed the node limits.
+func applyBandwidthLimits(limits *orchestrator.SandboxBandwidthLimits, tx fc.RateLimiterConfig, drive fc.RateLimiterConfig) (fc.RateLimiterConfig, fc.RateLimiterConfig) {
+	if mbps := limits.GetNetworkMbps(); mbps > 0 {
+		tx.Bandwidth = lowerBucket(tx.Bandwidth, perSecondBucket(int64(mbps)*bytesPerMegabit))
+	}
+
+	if mbPerSecond := limits.GetDiskMbPerSecond(); mbPerSecond > 0 {
+		drive.Bandwidth = lowerBucket(drive.Bandwidth, perSecondBucket(int64(mbPerSecond)*bytesPerMegabyte))
+	}
+
+	if iops := limits.GetDiskIops(); iops > 0 {
+		drive.Ops = lowerBucket(drive.Ops, perSecondBucket(int64(iops)))
+	}
+
+	return tx, drive
+}
+
+// lowerBucket returns the bucket with the lower rate, a disabled node bucket doesn't limit the rate.
+func lowerBucket(node, sandbox fc.TokenBucketConfig) fc.TokenBucketConfig {
+	if node.BucketSize <= 0 || node.RefillTimeMs <= 0 {
+		return sandbox
+	}
+
+	// Compares BucketSize / RefillTimeMs without the integer division.
+	if node.BucketSize*sandbox.RefillTimeMs <= sandbox.BucketSize*node.RefillTimeMs {
+		return node
+	}
+
+	return sandbox
+}
+
+func perSecondBucket(rate int64) fc.TokenBucketConfig {
+	return fc.TokenBucketConfig{
+		BucketSize:   rate,
+		RefillTimeMs: bandwidthRefillTimeMs,
+	}
+}
+
+// UpdateBandwidthLimits replaces the bandwidth limits of the running sandbox.
+// Limits that aren't set fall back to the node-wide ones.
+func (s *Sandbox) UpdateBandwidthLimits(ctx context.Context, limits *orchestrator.SandboxBandwidthLimits) error {
+	tx, drive := applyBandwidthLimits(limits, s.nodeTxRateLimit, s.nodeDriveRateLimit)
+
+	if err := s.process.SetRateLimits(ctx, tx, drive); err != nil {
+		return fmt.Errorf("failed to set rate limits: %w", err)
+	}
+
+	s.Config.SetBandwidthLimits(limits)
+
+	return nil
+}
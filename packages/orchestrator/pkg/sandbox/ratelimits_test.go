@@ -0,0 +1,68 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestApplyBandwidthLimits(t *testing.T) {
+	t.Parallel()
+
+	disabled := fc.TokenBucketConfig{BucketSize: -1, RefillTimeMs: 1000}
+	nodeTx := fc.RateLimiterConfig{Ops: disabled, Bandwidth: fc.TokenBucketConfig{BucketSize: 1 << 20, RefillTimeMs: 100}}
+	nodeDrive := fc.RateLimiterConfig{Ops: disabled, Bandwidth: disabled}
+
+	tests := []struct {
+		name      string
+		limits    *orchestrator.SandboxBandwidthLimits
+		wantTx    fc.RateLimiterConfig
+		wantDrive fc.RateLimiterConfig
+	}{
+		{
+			name:      "no limits keep the node defaults",
+			limits:    nil,
+			wantTx:    nodeTx,
+			wantDrive: nodeDrive,
+		},
+		{
+			name:   "lower network limit replaces only the bandwidth bucket",
+			limits: &orchestrator.SandboxBandwidthLimits{NetworkMbps: 50},
+			wantTx: fc.RateLimiterConfig{
+				Ops:       disabled,
+				Bandwidth: fc.TokenBucketConfig{BucketSize: 6_250_000, RefillTimeMs: 1000},
+			},
+			wantDrive: nodeDrive,
+		},
+		{
+			name:      "network limit above the node limit keeps the node limit",
+			limits:    &orchestrator.SandboxBandwidthLimits{NetworkMbps: 1000},
+			wantTx:    nodeTx,
+			wantDrive: nodeDrive,
+		},
+		{
+			name:   "disk limits",
+			limits: &orchestrator.SandboxBandwidthLimits{DiskMbPerSecond: 50, DiskIops: 3000},
+			wantTx: nodeTx,
+			wantDrive: fc.RateLimiterConfig{
+				Ops:       fc.TokenBucketConfig{BucketSize: 3000, RefillTimeMs: 1000},
+				Bandwidth: fc.TokenBucketConfig{BucketSize: 50_000_000, RefillTimeMs: 1000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx, drive := applyBandwidthLimits(tt.limits, nodeTx, nodeDrive)
+			assert.Equal(t, tt.wantTx, tx)
+			assert.Equal(t, tt.wantDrive, drive)
+		})
+	}
+}
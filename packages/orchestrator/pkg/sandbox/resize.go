@@ -0,0 +1,19 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResizeMemory grows or shrinks the memory of the running sandbox to ramMB.
+func (s *Sandbox) ResizeMemory(ctx context.Context, ramMB int64) error {
+	if err := s.process.ResizeMemory(ctx, ramMB); err != nil {
+		return fmt.Errorf("failed to resize memory: %w", err)
+	}
+
+	s.Config.SetRamMB(ramMB)
+
+	return nil
+}
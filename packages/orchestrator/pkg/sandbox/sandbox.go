@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"sync"
 	"time"
@@ -114,10 +115,12 @@ type Config struct {
 
 	MaxSandboxLengthHours int64
 
-	// mu protects mutable sub-fields of Network (Egress, Ingress).
+	// mu protects mutable sub-fields of Network (Egress, Ingress), Envd.Vars, BandwidthLimits and RamMB.
 	// The Network pointer itself is set once at construction and never replaced.
 	mu      *sync.RWMutex
 	Network *orchestrator.SandboxNetworkConfig
+
+	BandwidthLimits *orchestrator.SandboxBandwidthLimits
 }
 
 // NewConfig creates a Config, normalizing a nil Network to an empty config
@@ -148,6 +151,64 @@ func (c *Config) SetNetworkEgress(egress *orchestrator.SandboxNetworkEgressConfi
 	c.Network.Egress = egress
 }
 
+// GetEnvdVars returns the default env vars of envd in a thread-safe manner.
+func (c *Config) GetEnvdVars() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.Envd.Vars
+}
+
+// UpdateEnvdVars sets and unsets default env vars of envd in a thread-safe manner.
+func (c *Config) UpdateEnvdVars(set map[string]string, unset []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vars := maps.Clone(c.Envd.Vars)
+	if vars == nil {
+		vars = make(map[string]string, len(set))
+	}
+
+	for _, key := range unset {
+		delete(vars, key)
+	}
+	maps.Copy(vars, set)
+
+	c.Envd.Vars = vars
+}
+
+// GetBandwidthLimits returns the bandwidth limits in a thread-safe manner.
+func (c *Config) GetBandwidthLimits() *orchestrator.SandboxBandwidthLimits {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.BandwidthLimits
+}
+
+// SetBandwidthLimits updates the bandwidth limits in a thread-safe manner.
+func (c *Config) SetBandwidthLimits(limits *orchestrator.SandboxBandwidthLimits) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.BandwidthLimits = limits
+}
+
+// GetRamMB returns the current memory size in a thread-safe manner.
+func (c *Config) GetRamMB() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.RamMB
+}
+
+// SetRamMB updates the current memory size in a thread-safe manner.
+func (c *Config) SetRamMB(ramMB int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.RamMB = ramMB
+}
+
 // GetNetworkIngress returns the ingress config in a thread-safe manner.
 func (c *Config) GetNetworkIngress() *orchestrator.SandboxNetworkIngressConfig {
 	c.mu.RLock()
@@ -169,6 +230,8 @@ type EnvdMetadata struct {
 	DefaultWorkdir *string
 	AccessToken    *string
 	Version        string
+	// LogProcessOutput is the sandbox-wide default for shipping the process output to the sandbox logs.
+	LogProcessOutput bool
 }
 
 // SandboxType distinguishes build sandboxes from regular sandboxes.
@@ -284,6 +347,11 @@ type Sandbox struct {
 	process      *fc.Process
 	cgroupHandle *cgroup.CgroupHandle
 
+	// nodeTxRateLimit and nodeDriveRateLimit are the node-wide rate limits the
+	// sandbox limits are applied over.
+	nodeTxRateLimit    fc.RateLimiterConfig
+	nodeDriveRateLimit fc.RateLimiterConfig
+
 	Template template.Template
 
 	Checks *Checks
@@ -574,8 +642,8 @@ func (f *Factory) CreateSandbox(
 		return nil, fmt.Errorf("failed to init FC: %w", err)
 	}
 
-	throttleConfig := featureflags.GetTCPFirewallEgressThrottleConfig(ctx, f.featureFlags)
-	driveThrottleConfig := featureflags.GetBlockDriveThrottleConfig(ctx, f.featureFlags)
+	nodeTxRateLimit, nodeDriveRateLimit := nodeRateLimits(ctx, f.featureFlags)
+	txRateLimit, driveRateLimit := applyBandwidthLimits(config.GetBandwidthLimits(), nodeTxRateLimit, nodeDriveRateLimit)
 
 	telemetry.ReportEvent(ctx, "created fc client")
 
@@ -615,6 +683,9 @@ func (f *Factory) CreateSandbox(
 		process:   fcHandle,
 		sandboxes: f.Sandboxes,
 
+		nodeTxRateLimit:    nodeTxRateLimit,
+		nodeDriveRateLimit: nodeDriveRateLimit,
+
 		cleanup:      cleanup,
 		featureFlags: f.featureFlags,
 
@@ -650,6 +721,13 @@ func (f *Factory) CreateSandbox(
 
 	freePageHinting := fc.FCSupportsFreePageHinting(config.FirecrackerConfig.FirecrackerVersion) && config.FreePageHinting
 
+	// Only template builds attach the virtio-mem region, the sandboxes resumed
+	// from their snapshots inherit it.
+	var memoryHotplugMB int64
+	if createOpts.networkAssignReason == NetworkAssignReasonCreate && fc.FCSupportsMemoryHotplug(config.FirecrackerConfig.FirecrackerVersion) {
+		memoryHotplugMB = int64(f.featureFlags.IntFlag(ctx, featureflags.MemoryHotplugSizeMB))
+	}
+
 	err = fcHandle.Create(
 		ctx,
 		sbxlogger.SandboxMetadata{
@@ -662,15 +740,10 @@ func (f *Factory) CreateSandbox(
 		config.HugePages,
 		config.FreePageReporting,
 		freePageHinting,
+		memoryHotplugMB,
 		processOptions,
-		fc.RateLimiterConfig{
-			Ops:       fc.TokenBucketConfig(throttleConfig.Ops),
-			Bandwidth: fc.TokenBucketConfig(throttleConfig.Bandwidth),
-		},
-		fc.RateLimiterConfig{
-			Ops:       fc.TokenBucketConfig(driveThrottleConfig.Ops),
-			Bandwidth: fc.TokenBucketConfig(driveThrottleConfig.Bandwidth),
-		},
+		txRateLimit,
+		driveRateLimit,
 		cgroupFD,
 	)
 	if err != nil {
@@ -977,8 +1050,8 @@ func (f *Factory) ResumeSandbox(
 		return nil, fmt.Errorf("failed to create FC: %w", fcErr)
 	}
 
-	resumeThrottleConfig := featureflags.GetTCPFirewallEgressThrottleConfig(ctx, f.featureFlags)
-	resumeDriveThrottleConfig := featureflags.GetBlockDriveThrottleConfig(ctx, f.featureFlags)
+	nodeTxRateLimit, nodeDriveRateLimit := nodeRateLimits(ctx, f.featureFlags)
+	txRateLimit, driveRateLimit := applyBandwidthLimits(config.GetBandwidthLimits(), nodeTxRateLimit, nodeDriveRateLimit)
 
 	telemetry.ReportEvent(ctx, "created FC process")
 
@@ -1027,6 +1100,9 @@ func (f *Factory) ResumeSandbox(
 		process:   fcHandle,
 		sandboxes: f.Sandboxes,
 
+		nodeTxRateLimit:    nodeTxRateLimit,
+		nodeDriveRateLimit: nodeDriveRateLimit,
+
 		cleanup:      cleanup,
 		featureFlags: f.featureFlags,
 
@@ -1107,14 +1183,8 @@ func (f *Factory) ResumeSandbox(
 		config.Envd.AccessToken,
 		cgroupFD,
 		useMemfd,
-		fc.RateLimiterConfig{
-			Ops:       fc.TokenBucketConfig(resumeThrottleConfig.Ops),
-			Bandwidth: fc.TokenBucketConfig(resumeThrottleConfig.Bandwidth),
-		},
-		fc.RateLimiterConfig{
-			Ops:       fc.TokenBucketConfig(resumeDriveThrottleConfig.Ops),
-			Bandwidth: fc.TokenBucketConfig(resumeDriveThrottleConfig.Bandwidth),
-		},
+		txRateLimit,
+		driveRateLimit,
 	)
 
 	if fcStartErr != nil {
@@ -53,7 +53,13 @@ func uploadRatio(compressed, uncompressed int64) float64 {
 	return float64(compressed) / float64(uncompressed)
 }
 
+// storeHeaderWithMetrics records the header's ancestors in the reference index
+// before uploading it, so deletion paths never miss a stored header.
 func storeHeaderWithMetrics(ctx context.Context, store storage.StorageProvider, path, fileType string, h *headers.Header, opts ...storage.PutOption) error {
+	if err := headers.RecordReferences(ctx, store, h); err != nil {
+		return fmt.Errorf("record %s references: %w", fileType, err)
+	}
+
 	cfg, stored, uncompressed, err := headers.StoreHeader(ctx, store, path, h, opts...)
 	if err != nil {
 		return err
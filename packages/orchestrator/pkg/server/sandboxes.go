@@ -195,6 +195,8 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			Version:     req.GetSandbox().GetEnvdVersion(),
 			AccessToken: req.GetSandbox().EnvdAccessToken,
 			Vars:        req.GetSandbox().GetEnvVars(),
+
+			LogProcessOutput: req.GetSandbox().GetLogProcessOutput(),
 		},
 
 		FirecrackerConfig: fc.Config{
@@ -204,6 +206,8 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 
 		VolumeMounts:          volumeMounts,
 		MaxSandboxLengthHours: req.GetSandbox().GetMaxSandboxLength(),
+
+		BandwidthLimits: req.GetSandbox().GetBandwidthLimits(),
 	})
 	childSpan.SetAttributes(
 		telemetry.WithFirecrackerVersion(config.FirecrackerConfig.FirecrackerVersion),
@@ -280,6 +284,13 @@ func (s *Server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		schedulingMetadata = provider.SchedulingMetadata(ctx)
 	}
 
+	if req.GetMigration() {
+		return &orchestrator.SandboxCreateResponse{
+			ClientId:           s.info.ClientId,
+			SchedulingMetadata: schedulingMetadata,
+		}, nil
+	}
+
 	eventType := events.SandboxCreatedEventPair
 	if req.GetSandbox().GetSnapshot() {
 		eventType = events.SandboxResumedEventPair
@@ -381,6 +392,16 @@ func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		}
 	}
 
+	if req.RamMb != nil {
+		if req.GetRamMb() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "memory size must be positive")
+		}
+		if !fc.FCSupportsMemoryHotplug(sbx.Config.FirecrackerConfig.FirecrackerVersion) {
+			return nil, status.Error(codes.FailedPrecondition,
+				"memory resize is not supported by the sandbox firecracker version")
+		}
+	}
+
 	var updates []utils.UpdateFunc
 
 	if req.GetEndTime() != nil {
@@ -414,8 +435,65 @@ func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		})
 	}
 
+	if envVars := req.GetEnvVars(); envVars != nil {
+		updates = append(updates, func(ctx context.Context) (func(context.Context), error) {
+			oldVars := sbx.Config.GetEnvdVars()
+
+			if err := sbx.UpdateEnvVars(ctx, envVars.GetSet(), envVars.GetUnset()); err != nil {
+				return nil, fmt.Errorf("failed to update sandbox env vars: %w", err)
+			}
+
+			return func(ctx context.Context) {
+				// Restore the previous values of the changed keys.
+				restore := make(map[string]string)
+				var unset []string
+				for _, key := range slices.Concat(slices.Collect(maps.Keys(envVars.GetSet())), envVars.GetUnset()) {
+					if value, ok := oldVars[key]; ok {
+						restore[key] = value
+					} else {
+						unset = append(unset, key)
+					}
+				}
+
+				_ = sbx.UpdateEnvVars(ctx, restore, unset)
+			}, nil
+		})
+	}
+
+	if req.GetBandwidthLimits() != nil {
+		updates = append(updates, func(ctx context.Context) (func(context.Context), error) {
+			oldLimits := sbx.Config.GetBandwidthLimits()
+
+			if err := sbx.UpdateBandwidthLimits(ctx, req.GetBandwidthLimits()); err != nil {
+				return nil, fmt.Errorf("failed to update sandbox bandwidth limits: %w", err)
+			}
+
+			return func(ctx context.Context) {
+				_ = sbx.UpdateBandwidthLimits(ctx, oldLimits)
+			}, nil
+		})
+	}
+
+	if req.RamMb != nil {
+		updates = append(updates, func(ctx context.Context) (func(context.Context), error) {
+			oldRamMB := sbx.Config.GetRamMB()
+
+			if err := sbx.ResizeMemory(ctx, req.GetRamMb()); err != nil {
+				return nil, fmt.Errorf("failed to resize sandbox memory: %w", err)
+			}
+
+			return func(ctx context.Context) {
+				_ = sbx.ResizeMemory(ctx, oldRamMB)
+			}, nil
+		})
+	}
+
 	err := sbx.RunUpdate(func() error {
 		if err := utils.ApplyAllOrNone(ctx, updates); err != nil {
+			if errors.Is(err, fc.ErrMemoryResizeUnsupported) {
+				return status.Errorf(codes.FailedPrecondition, "failed to update sandbox: %s", err)
+			}
+
 			telemetry.ReportCriticalError(ctx, "failed to update sandbox", err)
 
 			return status.Errorf(codes.Internal, "failed to update sandbox: %s", err)
@@ -434,6 +512,21 @@ func (s *Server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 					"allowed_domains": egress.GetAllowedDomains(),
 				}
 			}
+			if envVars := req.GetEnvVars(); envVars != nil {
+				// Only the keys, the values can be secrets.
+				eventData["env_vars"] = slices.Sorted(maps.Keys(envVars.GetSet()))
+				eventData["unset_env_vars"] = envVars.GetUnset()
+			}
+			if limits := req.GetBandwidthLimits(); limits != nil {
+				eventData["bandwidth_limits"] = map[string]any{
+					"network_mbps":       limits.GetNetworkMbps(),
+					"disk_mb_per_second": limits.GetDiskMbPerSecond(),
+					"disk_iops":          limits.GetDiskIops(),
+				}
+			}
+			if req.RamMb != nil {
+				eventData["ram_mb"] = req.GetRamMb()
+			}
 
 			go s.sbxEventsService.Publish(
 				context.WithoutCancel(ctx),
@@ -862,6 +955,108 @@ func (s *Server) Checkpoint(ctx context.Context, in *orchestrator.SandboxCheckpo
 	}, nil
 }
 
+func (s *Server) Migrate(ctx context.Context, in *orchestrator.SandboxMigrateRequest) (*orchestrator.SandboxMigrateResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-migrate")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.GetSandboxId()),
+		telemetry.WithTemplateID(in.GetTemplateId()),
+		telemetry.WithBuildID(in.GetBuildId()),
+	)
+
+	sbx, ok := s.sandboxFactory.Sandboxes.Get(in.GetSandboxId())
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil, telemetry.WithSandboxID(in.GetSandboxId()))
+
+		return nil, status.Errorf(codes.NotFound, "sandbox '%s' not found", in.GetSandboxId())
+	}
+
+	ctx = featureflags.AddToContext(
+		ctx,
+		ldcontext.NewBuilder(in.GetSandboxId()).
+			Kind(featureflags.SandboxKind).
+			SetString(featureflags.SandboxTemplateAttribute, sbx.Runtime.TemplateID).
+			SetString(featureflags.SandboxKernelVersionAttribute, sbx.Config.FirecrackerConfig.KernelVersion).
+			SetString(featureflags.SandboxFirecrackerVersionAttribute, sbx.Config.FirecrackerConfig.FirecrackerVersion).
+			SetString(featureflags.SandboxEnvdVersionAttribute, sbx.Config.Envd.Version).
+			Build(),
+	)
+
+	childSpan.SetAttributes(
+		telemetry.WithTeamID(sbx.Runtime.TeamID),
+		telemetry.WithFirecrackerVersion(sbx.Config.FirecrackerConfig.FirecrackerVersion),
+		telemetry.WithKernelVersion(sbx.Config.FirecrackerConfig.KernelVersion),
+		telemetry.WithEnvdVersion(sbx.Config.Envd.Version),
+	)
+
+	// The target node faults the memory in from this node while the snapshot is
+	// uploaded, without the peer transfer it would have to wait for the upload.
+	// Everything is checked before the sandbox is paused, so it keeps running here on rejection.
+	if !s.featureFlags.BoolFlag(ctx, featureflags.PeerToPeerChunkTransferFlag) {
+		return nil, status.Error(codes.FailedPrecondition, "live migration requires the peer-to-peer chunk transfer")
+	}
+
+	if err := utils.CheckEnvdVersionForSnapshot(sbx.Config.Envd.Version); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+
+	if sbx.APIStoredConfig == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "sandbox '%s' has no stored config to migrate with", in.GetSandboxId())
+	}
+
+	config := liveSandboxConfig(sbx)
+
+	marked := s.sandboxFactory.Sandboxes.MarkStopping(ctx, sbx.Runtime.SandboxID, sbx.LifecycleID)
+	if !marked {
+		telemetry.ReportCriticalError(ctx, "failed to mark sandbox as stopping", nil, telemetry.WithSandboxID(in.GetSandboxId()))
+
+		return nil, status.Errorf(codes.Internal, "failed to migrate sandbox '%s'", in.GetSandboxId())
+	}
+
+	// The sandbox continues on the target node, the local one is stopped in any case.
+	defer s.stopSandboxAsync(context.WithoutCancel(ctx), sbx)
+
+	sbxlogger.E(sbx).Info(ctx, "Migrating sandbox")
+
+	// Stop-and-copy: the sandbox is paused only while its dirty memory is copied
+	// into the local cache, the rest is served to the target node on demand.
+	stopStart := time.Now()
+	res, err := s.snapshotAndCacheSandbox(ctx, sbx, in.GetBuildId(), map[string]string{storage.ObjectMetadataTemplateID: in.GetTemplateId()}, storage.ObjectOriginPause, false)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox for migration", err, telemetry.WithSandboxID(in.GetSandboxId()))
+
+		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.GetSandboxId(), err)
+	}
+
+	s.uploadSnapshotAsync(ctx, sbx, res)
+
+	sbxlogger.E(sbx).Info(ctx, "Sandbox snapshotted for migration", zap.Duration("stop_and_copy_duration", time.Since(stopStart)))
+	telemetry.ReportEvent(ctx, "Snapshotted sandbox for migration")
+
+	return &orchestrator.SandboxMigrateResponse{
+		Config:             config,
+		SchedulingMetadata: res.schedulingMetadata,
+	}, nil
+}
+
+// liveSandboxConfig returns the config the sandbox was created with, updated
+// with the changes applied to the running sandbox since.
+func liveSandboxConfig(sbx *sandbox.Sandbox) *orchestrator.SandboxConfig {
+	config := proto.CloneOf(sbx.APIStoredConfig)
+	config.EnvVars = maps.Clone(sbx.Config.GetEnvdVars())
+	config.BandwidthLimits = proto.CloneOf(sbx.Config.GetBandwidthLimits())
+	config.RamMb = sbx.Config.GetRamMB()
+
+	if config.GetNetwork() == nil {
+		config.Network = &orchestrator.SandboxNetworkConfig{}
+	}
+	config.Network.Egress = proto.CloneOf(sbx.Config.GetNetworkEgress())
+	config.Network.Ingress = proto.CloneOf(sbx.Config.GetNetworkIngress())
+
+	return config
+}
+
 // Extracts common data needed for sandbox events
 func (s *Server) prepareSandboxEventData(ctx context.Context, sbx *sandbox.Sandbox) (uuid.UUID, string, int64, map[string]any) {
 	teamID, err := uuid.Parse(sbx.Runtime.TeamID)
@@ -890,7 +1085,7 @@ func (s *Server) getSandboxExecutionData(sbx *sandbox.Sandbox) map[string]any {
 	return map[string]any{
 		"started_at":     startedAt.UTC().Format(time.RFC3339),
 		"vcpu_count":     sbx.Config.Vcpu,
-		"memory_mb":      sbx.Config.RamMB,
+		"memory_mb":      sbx.Config.GetRamMB(),
 		"execution_time": time.Since(startedAt).Milliseconds(),
 	}
 }
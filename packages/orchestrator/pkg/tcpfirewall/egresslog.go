@@ -0,0 +1,167 @@
+//go:build linux
+
+package tcpfirewall
+
+import (
+	"bytes"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inetaf/tcpproxy"
+
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox"
+)
+
+// maxHTTPMethodLength bounds the request-line token accepted as an HTTP method.
+const maxHTTPMethodLength = 16
+
+// egressRecord collects the audit log entry for a single sandbox connection.
+// The handler fills in the decision; the proxy fills in the upstream details
+// and byte counts, which are updated concurrently by both copy directions.
+type egressRecord struct {
+	log   egresslogs.SandboxEgressLog
+	start time.Time
+
+	resolvedIP    atomic.Pointer[string]
+	errMsg        atomic.Pointer[string]
+	bytesSent     atomic.Uint64
+	bytesReceived atomic.Uint64
+}
+
+func newEgressRecord(sbx *sandbox.Sandbox, protocol Protocol, dstIP net.IP, dstPort int) *egressRecord {
+	// TeamID is best-effort runtime metadata; unparsable values are stored as the nil UUID.
+	teamID, _ := uuid.Parse(sbx.Runtime.TeamID)
+	start := time.Now()
+
+	return &egressRecord{
+		start: start,
+		log: egresslogs.SandboxEgressLog{
+			Timestamp:          start,
+			TeamID:             teamID,
+			SandboxID:          sbx.Runtime.SandboxID,
+			SandboxExecutionID: sbx.Runtime.ExecutionID,
+			TemplateID:         sbx.Runtime.TemplateID,
+			BuildID:            sbx.Runtime.BuildID,
+			Protocol:           string(protocol),
+			DestinationIP:      dstIP.String(),
+			DestinationPort:    uint16(dstPort),
+			Decision:           string(DecisionBlocked),
+			MatchType:          string(MatchTypeNone),
+		},
+	}
+}
+
+// setRequest records the hostname and, for plain HTTP, the request method
+// from the bytes tcpproxy peeked while routing the connection.
+func (r *egressRecord) setRequest(conn net.Conn, hostname string) {
+	r.log.Hostname = hostname
+
+	if tc, ok := conn.(*tcpproxy.Conn); ok && r.log.Protocol == string(ProtocolHTTP) {
+		r.log.Method = httpMethod(tc.Peeked)
+	}
+}
+
+func (r *egressRecord) setDecision(decision Decision, matchType MatchType) {
+	r.log.Decision = string(decision)
+	r.log.MatchType = string(matchType)
+}
+
+func (r *egressRecord) setError(err error) {
+	r.errMsg.Store(new(err.Error()))
+}
+
+// trackUpstream wraps the dialed upstream connection so the bytes flowing in
+// both directions are counted, and records the IP the proxy connected to.
+func (r *egressRecord) trackUpstream(conn net.Conn) net.Conn {
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		r.resolvedIP.Store(new(addr.IP.String()))
+	}
+
+	return &countingConn{Conn: conn, record: r}
+}
+
+// finish returns the completed log entry.
+func (r *egressRecord) finish() egresslogs.SandboxEgressLog {
+	l := r.log
+	l.DurationMs = uint64(time.Since(r.start).Milliseconds())
+	l.BytesSent = r.bytesSent.Load()
+	l.BytesReceived = r.bytesReceived.Load()
+
+	if ip := r.resolvedIP.Load(); ip != nil {
+		l.ResolvedIP = *ip
+	}
+	if err := r.errMsg.Load(); err != nil {
+		l.Error = *err
+	}
+
+	return l
+}
+
+// httpMethod extracts the method token from the start of an HTTP request line.
+func httpMethod(peeked []byte) string {
+	idx := bytes.IndexByte(peeked, ' ')
+	if idx <= 0 || idx > maxHTTPMethodLength {
+		return ""
+	}
+
+	method := peeked[:idx]
+	for _, c := range method {
+		if c < 'A' || c > 'Z' {
+			return ""
+		}
+	}
+
+	return string(method)
+}
+
+var (
+	_ closeWriter = (*countingConn)(nil)
+	_ closeReader = (*countingConn)(nil)
+)
+
+type (
+	closeWriter interface{ CloseWrite() error }
+	closeReader interface{ CloseRead() error }
+)
+
+// countingConn counts the bytes written to (sent) and read from (received)
+// the upstream connection. It forwards half-closes so tcpproxy can still
+// propagate EOF in each direction independently.
+type countingConn struct {
+	net.Conn
+
+	record *egressRecord
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.record.bytesReceived.Add(uint64(n))
+
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.record.bytesSent.Add(uint64(n))
+
+	return n, err
+}
+
+func (c *countingConn) CloseWrite() error {
+	if cw, ok := c.Conn.(closeWriter); ok {
+		return cw.CloseWrite()
+	}
+
+	return nil
+}
+
+func (c *countingConn) CloseRead() error {
+	if cr, ok := c.Conn.(closeReader); ok {
+		return cr.CloseRead()
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+//go:build linux
+
+package tcpfirewall
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/inetaf/tcpproxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox"
+)
+
+func TestHTTPMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		peeked string
+		want   string
+	}{
+		{name: "GET", peeked: "GET /repos HTTP/1.1\r\nHost: api.github.com\r\n", want: "GET"},
+		{name: "POST", peeked: "POST / HTTP/1.1\r\n", want: "POST"},
+		{name: "empty", peeked: "", want: ""},
+		{name: "no space", peeked: "GET", want: ""},
+		{name: "leading space", peeked: " GET / HTTP/1.1", want: ""},
+		{name: "lowercase token", peeked: "get / HTTP/1.1", want: ""},
+		{name: "binary", peeked: "\x16\x03\x01 \x00", want: ""},
+		{name: "too long", peeked: "ABCDEFGHIJKLMNOPQ / HTTP/1.1", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, httpMethod([]byte(tt.peeked)))
+		})
+	}
+}
+
+func newTestRecord(protocol Protocol) *egressRecord {
+	sbx := &sandbox.Sandbox{
+		Metadata: &sandbox.Metadata{
+			Runtime: sandbox.RuntimeMetadata{
+				SandboxID:   "sbx-1",
+				ExecutionID: "exec-1",
+				TemplateID:  "tmpl-1",
+				BuildID:     "build-1",
+				TeamID:      "7b8e0a55-9a57-4c9f-9d1c-2f4a1c1c3b1e",
+			},
+		},
+	}
+
+	return newEgressRecord(sbx, protocol, net.ParseIP("93.184.216.34"), 80)
+}
+
+func TestEgressRecord_Defaults(t *testing.T) {
+	t.Parallel()
+
+	l := newTestRecord(ProtocolOther).finish()
+
+	assert.Equal(t, uuid.MustParse("7b8e0a55-9a57-4c9f-9d1c-2f4a1c1c3b1e"), l.TeamID)
+	assert.Equal(t, "sbx-1", l.SandboxID)
+	assert.Equal(t, "exec-1", l.SandboxExecutionID)
+	assert.Equal(t, "93.184.216.34", l.DestinationIP)
+	assert.Equal(t, uint16(80), l.DestinationPort)
+	// Connections are blocked until a handler explicitly allows them.
+	assert.Equal(t, string(DecisionBlocked), l.Decision)
+	assert.Equal(t, string(MatchTypeNone), l.MatchType)
+	assert.Empty(t, l.ResolvedIP)
+	assert.Zero(t, l.BytesSent)
+	assert.Zero(t, l.BytesReceived)
+}
+
+func TestEgressRecord_SetRequest(t *testing.T) {
+	t.Parallel()
+
+	conn := &tcpproxy.Conn{HostName: "example.com", Peeked: []byte("PUT /upload HTTP/1.1\r\n")}
+
+	httpRecord := newTestRecord(ProtocolHTTP)
+	httpRecord.setRequest(conn, conn.HostName)
+	l := httpRecord.finish()
+	assert.Equal(t, "example.com", l.Hostname)
+	assert.Equal(t, "PUT", l.Method)
+
+	// The method is only parsed for plain HTTP.
+	tlsRecord := newTestRecord(ProtocolTLS)
+	tlsRecord.setRequest(conn, conn.HostName)
+	l = tlsRecord.finish()
+	assert.Equal(t, "example.com", l.Hostname)
+	assert.Empty(t, l.Method)
+}
+
+func TestEgressRecord_DecisionAndError(t *testing.T) {
+	t.Parallel()
+
+	r := newTestRecord(ProtocolTLS)
+	r.setDecision(DecisionAllowed, MatchTypeDomain)
+	r.setError(errors.New("dial tcp: connection refused"))
+
+	l := r.finish()
+	assert.Equal(t, string(DecisionAllowed), l.Decision)
+	assert.Equal(t, string(MatchTypeDomain), l.MatchType)
+	assert.Equal(t, "dial tcp: connection refused", l.Error)
+}
+
+func TestEgressRecord_TrackUpstreamCountsBytes(t *testing.T) {
+	t.Parallel()
+
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	serverDone := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverDone <- err
+
+			return
+		}
+		defer conn.Close()
+
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			serverDone <- err
+
+			return
+		}
+
+		_, err = conn.Write([]byte("response"))
+		serverDone <- err
+	}()
+
+	upstream, err := (&net.Dialer{}).DialContext(t.Context(), "tcp", ln.Addr().String())
+	require.NoError(t, err)
+
+	r := newTestRecord(ProtocolOther)
+	conn := r.trackUpstream(upstream)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	buf := make([]byte, len("response"))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.NoError(t, <-serverDone)
+
+	// Half-close must reach the underlying TCP connection.
+	cw, ok := conn.(closeWriter)
+	require.True(t, ok)
+	require.NoError(t, cw.CloseWrite())
+
+	l := r.finish()
+	assert.Equal(t, "127.0.0.1", l.ResolvedIP)
+	assert.Equal(t, uint64(5), l.BytesSent)
+	assert.Equal(t, uint64(8), l.BytesReceived)
+}
@@ -4,10 +4,10 @@ package tcpfirewall
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
-	"strings"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -56,18 +56,20 @@ func markDSCP(c syscall.RawConn) error {
 }
 
 // domainHandler handles connections with hostname information (HTTP Host header or TLS SNI).
-func domainHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol) {
+func domainHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol, record *egressRecord) {
 	// Get hostname from tcpproxy's wrapped connection (HTTP Host or TLS SNI).
 	// Hostname can be empty, e.g. for https://1.1.1.1 like requests.
 	var hostname string
 	if tc, ok := conn.(*tcpproxy.Conn); ok {
 		hostname = tc.HostName
 	}
+	record.setRequest(conn, hostname)
 
 	allowed, matchType, err := isEgressAllowed(sbx, hostname, dstIP)
 	if err != nil {
 		logger.Error(ctx, "Egress check failed", zap.Error(err))
 		metrics.RecordError(ctx, ErrorTypeEgressCheck, protocol)
+		record.setError(err)
 		conn.Close()
 
 		return
@@ -75,37 +77,79 @@ func domainHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int
 
 	if !allowed {
 		metrics.RecordDecision(ctx, DecisionBlocked, protocol, matchType)
+		record.setDecision(DecisionBlocked, matchType)
 		conn.Close()
 
 		return
 	}
 
-	metrics.RecordDecision(ctx, DecisionAllowed, protocol, matchType)
+	// Access rules need the request method and path and transform headers can only be
+	// added to requests, which only plain HTTP exposes. Fail closed for TLS rather than
+	// letting the whole domain through or sending requests without the headers.
+	// Transform headers are also never sent to an upstream allowed only by CIDR, since the
+	// sandbox picks that IP and could pair it with a matching Host header to receive them.
+	egress := sbx.Config.GetNetworkEgress()
+	policy := newRequestPolicy(egress, hostname)
+	if (!policy.empty() && protocol != ProtocolHTTP) || (len(policy.headers) > 0 && matchType == MatchTypeCIDR) {
+		metrics.RecordDecision(ctx, DecisionBlocked, protocol, MatchTypeRule)
+		record.setDecision(DecisionBlocked, MatchTypeRule)
+		conn.Close()
+
+		return
+	}
 
 	// When allowed by domain match, dial the hostname directly (not the sandbox's resolved IP).
 	// This prevents DNS spoofing attacks where the sandbox modifies /etc/hosts to redirect
 	// an allowed domain to an arbitrary IP. We use Go's net.Dialer which provides built-in
 	// Happy Eyeballs (RFC 8305) for multi-IP fallback when some IPs are unreachable.
 	// After connecting, we verify the connected IP is not internal/private.
-	if matchType == MatchTypeDomain {
-		upstreamAddr := net.JoinHostPort(hostname, fmt.Sprintf("%d", dstPort))
-		proxyWithIPVerification(ctx, conn, upstreamAddr, logger, metrics, protocol)
+	var upstreamAddr string
+	var dialer *net.Dialer
+	var upstreamTLS *tls.Config
+	switch {
+	case len(policy.headers) > 0:
+		// Transform headers usually carry secrets, so they are only sent over TLS the proxy
+		// originates to the hostname itself. Plain HTTP port 80 is forwarded to HTTPS.
+		host := normalizeHost(hostname)
+		upstreamAddr = net.JoinHostPort(host, fmt.Sprintf("%d", upstreamTLSPort(dstPort)))
+		upstreamTLS = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+
+		// Without a domain match the connection was only allowed because the sandbox's
+		// resolved IP isn't denied, which must then hold for the proxy's resolution too.
+		var deniedCIDRs []string
+		if matchType == MatchTypeNone {
+			deniedCIDRs = egress.GetDeniedCidrs()
+		}
+		dialer = newVerifyingDialer(ctx, upstreamAddr, deniedCIDRs, logger, metrics, protocol)
+	case matchType == MatchTypeDomain:
+		upstreamAddr = net.JoinHostPort(hostname, fmt.Sprintf("%d", dstPort))
+		dialer = newVerifyingDialer(ctx, upstreamAddr, nil, logger, metrics, protocol)
+	default:
+		// For non-domain matches, use the original destination IP
+		upstreamAddr = net.JoinHostPort(dstIP.String(), fmt.Sprintf("%d", dstPort))
+		dialer = newDialer()
+	}
+
+	if protocol == ProtocolHTTP && !policy.empty() {
+		proxyHTTPRequests(ctx, conn, hostname, upstreamAddr, dialer, upstreamTLS, policy, matchType, metrics, protocol, record)
 
 		return
 	}
 
-	// For non-domain matches, use the original destination IP
-	upstreamAddr := net.JoinHostPort(dstIP.String(), fmt.Sprintf("%d", dstPort))
-	proxy(ctx, conn, upstreamAddr, metrics, protocol)
+	metrics.RecordDecision(ctx, DecisionAllowed, protocol, matchType)
+	record.setDecision(DecisionAllowed, matchType)
+
+	proxy(ctx, conn, upstreamAddr, dialer, metrics, protocol, record)
 }
 
 // cidrOnlyHandler handles connections without hostname information.
-func cidrOnlyHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol) {
+func cidrOnlyHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol, record *egressRecord) {
 	// No hostname available for CIDR-only handler
 	allowed, matchType, err := isEgressAllowed(sbx, noHostnameValue, dstIP)
 	if err != nil {
 		logger.Error(ctx, "Egress check failed", zap.Error(err))
 		metrics.RecordError(ctx, ErrorTypeEgressCheck, protocol)
+		record.setError(err)
 		conn.Close()
 
 		return
@@ -113,20 +157,22 @@ func cidrOnlyHandler(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort i
 
 	if !allowed {
 		metrics.RecordDecision(ctx, DecisionBlocked, protocol, matchType)
+		record.setDecision(DecisionBlocked, matchType)
 		conn.Close()
 
 		return
 	}
 
 	metrics.RecordDecision(ctx, DecisionAllowed, protocol, matchType)
+	record.setDecision(DecisionAllowed, matchType)
 
 	upstreamAddr := net.JoinHostPort(dstIP.String(), fmt.Sprintf("%d", dstPort))
 
-	proxy(ctx, conn, upstreamAddr, metrics, protocol)
+	proxy(ctx, conn, upstreamAddr, newDialer(), metrics, protocol, record)
 }
 
 // proxy proxies the connection to the upstream address.
-func proxy(ctx context.Context, conn net.Conn, upstreamAddr string, metrics *Metrics, protocol Protocol) {
+func proxy(ctx context.Context, conn net.Conn, upstreamAddr string, dialer *net.Dialer, metrics *Metrics, protocol Protocol, record *egressRecord) {
 	tracker := metrics.TrackConnection(protocol)
 	defer tracker.Close(ctx)
 
@@ -134,70 +180,95 @@ func proxy(ctx context.Context, conn net.Conn, upstreamAddr string, metrics *Met
 		Addr:        upstreamAddr,
 		DialTimeout: upstreamDialTimeout,
 		DialContext: func(dialCtx context.Context, network, addr string) (net.Conn, error) {
-			dialer := &net.Dialer{
-				Timeout: upstreamDialTimeout,
-				Control: func(_, _ string, c syscall.RawConn) error {
-					return markDSCP(c)
-				},
-			}
-
-			return dialer.DialContext(dialCtx, network, addr)
+			return dialUpstream(dialCtx, dialer, network, addr, record)
 		},
 	}
 	dp.HandleConn(conn)
 }
 
-// proxyWithIPVerification dials the upstream hostname using Go's net.Dialer (which provides
+// newDialer returns the dialer used for connections to the original destination IP.
+func newDialer() *net.Dialer {
+	return &net.Dialer{
+		Timeout: upstreamDialTimeout,
+		Control: func(_, _ string, c syscall.RawConn) error {
+			return markDSCP(c)
+		},
+	}
+}
+
+// newVerifyingDialer returns a dialer for the upstream hostname using Go's net.Dialer (which provides
 // built-in Happy Eyeballs / RFC 8305 for multi-IP fallback), and verifies the resolved IP
 // is not internal/private BEFORE connecting. This prevents DNS rebinding attacks while
 // preserving multi-IP reliability.
 //
 // The ControlContext callback is called after DNS resolution but before the TCP connect()
-// syscall, so no TCP handshake occurs to internal IPs.
-func proxyWithIPVerification(ctx context.Context, conn net.Conn, upstreamAddr string, logger logger.Logger, metrics *Metrics, protocol Protocol) {
-	tracker := metrics.TrackConnection(protocol)
-	defer tracker.Close(ctx)
+// syscall, so no TCP handshake occurs to internal IPs. The resolved IP must also not be
+// in deniedCIDRs.
+func newVerifyingDialer(ctx context.Context, upstreamAddr string, deniedCIDRs []string, logger logger.Logger, metrics *Metrics, protocol Protocol) *net.Dialer {
+	return &net.Dialer{
+		Timeout: upstreamDialTimeout,
+		// ControlContext is called after DNS resolution but BEFORE the TCP connect() syscall.
+		// The 'address' parameter contains the resolved IP:port, allowing us to block
+		// connections to internal IPs before any TCP handshake occurs.
+		ControlContext: func(_ context.Context, _, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("failed to parse resolved address %q: %w", address, err)
+			}
 
-	// Use tcpproxy.DialProxy with a custom DialContext that verifies resolved IPs
-	dp := &tcpproxy.DialProxy{
-		Addr:        upstreamAddr,
-		DialTimeout: upstreamDialTimeout,
-		DialContext: func(dialCtx context.Context, network, addr string) (net.Conn, error) {
-			// Use Go's net.Dialer which has built-in Happy Eyeballs (RFC 8305)
-			// This automatically tries multiple IPs with proper fallback
-			dialer := &net.Dialer{
-				Timeout: upstreamDialTimeout,
-				// ControlContext is called after DNS resolution but BEFORE the TCP connect() syscall.
-				// The 'address' parameter contains the resolved IP:port, allowing us to block
-				// connections to internal IPs before any TCP handshake occurs.
-				ControlContext: func(_ context.Context, _, address string, c syscall.RawConn) error {
-					host, _, err := net.SplitHostPort(address)
-					if err != nil {
-						return fmt.Errorf("failed to parse resolved address %q: %w", address, err)
-					}
-
-					resolvedIP := net.ParseIP(host)
-					if resolvedIP == nil {
-						return fmt.Errorf("failed to parse IP from resolved address %q", host)
-					}
-
-					if isIPInAlwaysDeniedCIDRs(resolvedIP) {
-						logger.Warn(ctx, "Blocked connection to internal IP via hostname",
-							zap.String("upstream_addr", addr),
-							zap.String("resolved_ip", resolvedIP.String()))
-						metrics.RecordError(ctx, ErrorTypeResolvedIPBlocked, protocol)
-
-						return fmt.Errorf("hostname resolved to internal IP %s", resolvedIP)
-					}
-
-					return markDSCP(c)
-				},
+			resolvedIP := net.ParseIP(host)
+			if resolvedIP == nil {
+				return fmt.Errorf("failed to parse IP from resolved address %q", host)
 			}
 
-			return dialer.DialContext(dialCtx, network, addr)
+			if isIPInAlwaysDeniedCIDRs(resolvedIP) {
+				logger.Warn(ctx, "Blocked connection to internal IP via hostname",
+					zap.String("upstream_addr", upstreamAddr),
+					zap.String("resolved_ip", resolvedIP.String()))
+				metrics.RecordError(ctx, ErrorTypeResolvedIPBlocked, protocol)
+
+				return fmt.Errorf("hostname resolved to internal IP %s", resolvedIP)
+			}
+
+			for _, cidr := range deniedCIDRs {
+				_, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					return fmt.Errorf("invalid denied CIDR %q: %w", cidr, err)
+				}
+
+				if ipNet.Contains(resolvedIP) {
+					metrics.RecordError(ctx, ErrorTypeResolvedIPBlocked, protocol)
+
+					return fmt.Errorf("hostname resolved to denied IP %s", resolvedIP)
+				}
+			}
+
+			return markDSCP(c)
 		},
 	}
-	dp.HandleConn(conn)
+}
+
+// upstreamTLSPort maps the port of a plain HTTP connection to the port the proxy
+// originates TLS to. Plain HTTP on the default port goes to the default HTTPS port,
+// other ports are kept.
+func upstreamTLSPort(dstPort int) int {
+	if dstPort == 80 {
+		return 443
+	}
+
+	return dstPort
+}
+
+// dialUpstream dials the upstream and attaches the connection to the egress record.
+func dialUpstream(ctx context.Context, dialer *net.Dialer, network, addr string, record *egressRecord) (net.Conn, error) {
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		record.setError(err)
+
+		return nil, err
+	}
+
+	return record.trackUpstream(conn), nil
 }
 
 // isEgressAllowed checks if egress is allowed based on domain and CIDR rules.
@@ -216,7 +287,7 @@ func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, Ma
 	// Priority 1: Check allowed domains
 	if hostname != noHostnameValue {
 		for _, domain := range egress.GetAllowedDomains() {
-			if matchDomain(hostname, domain) {
+			if sandbox_network.MatchDomain(hostname, domain) {
 				return true, MatchTypeDomain, nil // Explicitly allowed by domain
 			}
 		}
@@ -250,27 +321,6 @@ func isEgressAllowed(sbx *sandbox.Sandbox, hostname string, ip net.IP) (bool, Ma
 	return true, MatchTypeNone, nil
 }
 
-// matchDomain checks if a hostname matches a domain pattern.
-// Patterns can be exact matches, wildcards (*), or suffix wildcards (*.example.com).
-func matchDomain(hostname, pattern string) bool {
-	switch {
-	case pattern == "":
-		// Empty pattern should never match
-		return false
-	case strings.EqualFold(pattern, hostname):
-		return true
-	case strings.EqualFold(pattern, "*"):
-		return true
-	case strings.HasPrefix(pattern, "*."):
-		suffix := pattern[1:]
-		if strings.HasSuffix(strings.ToLower(hostname), strings.ToLower(suffix)) {
-			return true
-		}
-	}
-
-	return false
-}
-
 // isIPInAlwaysDeniedCIDRs checks if an IP is within the denied sandbox CIDRs (internal/private ranges).
 func isIPInAlwaysDeniedCIDRs(ip net.IP) bool {
 	for _, cidr := range sandbox_network.DeniedSandboxCIDRs {
@@ -37,6 +37,7 @@ type MatchType string
 const (
 	MatchTypeDomain MatchType = "domain"
 	MatchTypeCIDR   MatchType = "cidr"
+	MatchTypeRule   MatchType = "rule"
 	MatchTypeNone   MatchType = "none"
 )
 
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/egresslogs"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/network"
 	"github.com/e2b-dev/infra/packages/shared/pkg/connlimit"
@@ -31,6 +32,7 @@ type Proxy struct {
 	metrics      *Metrics
 	limiter      *connlimit.ConnectionLimiter
 	featureFlags *featureflags.Client
+	egressLogs   egresslogs.Delivery
 
 	// Separate ports for different traffic types to avoid protocol detection blocking
 	// on server-first protocols like SSH.
@@ -42,7 +44,7 @@ type Proxy struct {
 	proxy      *tcpproxy.Proxy
 }
 
-func New(logger logger.Logger, networkConfig network.Config, sandboxes *sandbox.Map, meterProvider metric.MeterProvider, featureFlags *featureflags.Client) *Proxy {
+func New(logger logger.Logger, networkConfig network.Config, sandboxes *sandbox.Map, meterProvider metric.MeterProvider, featureFlags *featureflags.Client, egressLogs egresslogs.Delivery) *Proxy {
 	sandboxEgressTOS.Store(uint32(networkConfig.SandboxEgressDSCP) << 2)
 
 	p := &Proxy{
@@ -54,6 +56,7 @@ func New(logger logger.Logger, networkConfig network.Config, sandboxes *sandbox.
 		metrics:      NewMetrics(meterProvider),
 		limiter:      connlimit.NewConnectionLimiter(),
 		featureFlags: featureFlags,
+		egressLogs:   egressLogs,
 	}
 
 	p.proxyRules = []proxyRule{
@@ -100,16 +103,16 @@ func (p *Proxy) Start(ctx context.Context) error {
 	otherAddr := fmt.Sprintf("0.0.0.0:%d", p.otherPort)
 
 	// HTTP listener (port 80 traffic): inspect Host header for domain allowlist
-	p.proxy.AddHTTPHostMatchRoute(httpAddr, func(_ context.Context, _ string) bool { return true }, newConnectionHandler(ctx, domainHandler, ProtocolHTTP, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags))
-	p.proxy.AddRoute(httpAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolHTTP, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags))
+	p.proxy.AddHTTPHostMatchRoute(httpAddr, func(_ context.Context, _ string) bool { return true }, newConnectionHandler(ctx, domainHandler, ProtocolHTTP, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags, p.egressLogs))
+	p.proxy.AddRoute(httpAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolHTTP, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags, p.egressLogs))
 
 	// TLS listener (port 443 traffic): inspect SNI for domain allowlist
-	p.proxy.AddSNIMatchRoute(tlsAddr, func(_ context.Context, _ string) bool { return true }, newConnectionHandler(ctx, domainHandler, ProtocolTLS, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags))
-	p.proxy.AddRoute(tlsAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolTLS, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags))
+	p.proxy.AddSNIMatchRoute(tlsAddr, func(_ context.Context, _ string) bool { return true }, newConnectionHandler(ctx, domainHandler, ProtocolTLS, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags, p.egressLogs))
+	p.proxy.AddRoute(tlsAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolTLS, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags, p.egressLogs))
 
 	// Other listener (all other ports): CIDR-only check, no protocol inspection
 	// This prevents blocking on server-first protocols like SSH
-	p.proxy.AddRoute(otherAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolOther, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags))
+	p.proxy.AddRoute(otherAddr, newConnectionHandler(ctx, cidrOnlyHandler, ProtocolOther, p.metrics, p.limiter, p.logger, p.sandboxes, p.featureFlags, p.egressLogs))
 
 	p.logger.Info(ctx, "TCP firewall proxy started",
 		zap.Uint16("http_port", p.httpPort),
@@ -188,7 +191,7 @@ func (p *Proxy) SupportsBYOP() bool {
 }
 
 // handlerFunc is the signature for connection handlers.
-type handlerFunc func(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol)
+type handlerFunc func(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, logger logger.Logger, metrics *Metrics, protocol Protocol, record *egressRecord)
 
 var _ tcpproxy.Target = (*connectionHandler)(nil)
 
@@ -203,9 +206,10 @@ type connectionHandler struct {
 	logger       logger.Logger
 	sandboxes    *sandbox.Map
 	featureFlags *featureflags.Client
+	egressLogs   egresslogs.Delivery
 }
 
-func newConnectionHandler(ctx context.Context, handler handlerFunc, protocol Protocol, metrics *Metrics, limiter *connlimit.ConnectionLimiter, logger logger.Logger, sandboxes *sandbox.Map, featureFlags *featureflags.Client) *connectionHandler {
+func newConnectionHandler(ctx context.Context, handler handlerFunc, protocol Protocol, metrics *Metrics, limiter *connlimit.ConnectionLimiter, logger logger.Logger, sandboxes *sandbox.Map, featureFlags *featureflags.Client, egressLogs egresslogs.Delivery) *connectionHandler {
 	return &connectionHandler{
 		ctx:          ctx,
 		handler:      handler,
@@ -215,6 +219,7 @@ func newConnectionHandler(ctx context.Context, handler handlerFunc, protocol Pro
 		logger:       logger,
 		sandboxes:    sandboxes,
 		featureFlags: featureFlags,
+		egressLogs:   egressLogs,
 	}
 }
 
@@ -271,11 +276,20 @@ func (t *connectionHandler) HandleConn(conn net.Conn) {
 	t.metrics.RecordConnectionsPerSandbox(ctx, count)
 	t.metrics.RecordConnection(ctx, t.protocol)
 
-	// Wrap the handler to release the connection slot when done
-	wrappedHandler := func(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, l logger.Logger, metrics *Metrics, protocol Protocol) {
+	record := newEgressRecord(sbx, t.protocol, ip, port)
+
+	// Wrap the handler to release the connection slot and ship the egress log when done
+	wrappedHandler := func(ctx context.Context, conn net.Conn, dstIP net.IP, dstPort int, sbx *sandbox.Sandbox, l logger.Logger, metrics *Metrics, protocol Protocol, record *egressRecord) {
 		defer t.limiter.Release(limiterKey)
-		t.handler(ctx, conn, dstIP, dstPort, sbx, l, metrics, protocol)
+		defer t.pushEgressLog(ctx, l, record)
+		t.handler(ctx, conn, dstIP, dstPort, sbx, l, metrics, protocol, record)
 	}
 
-	wrappedHandler(ctx, conn, ip, port, sbx, sbxLogger, t.metrics, t.protocol)
+	wrappedHandler(ctx, conn, ip, port, sbx, sbxLogger, t.metrics, t.protocol, record)
+}
+
+func (t *connectionHandler) pushEgressLog(ctx context.Context, l logger.Logger, record *egressRecord) {
+	if err := t.egressLogs.Push(record.finish()); err != nil {
+		l.Warn(ctx, "failed to push egress log", zap.Error(err))
+	}
 }
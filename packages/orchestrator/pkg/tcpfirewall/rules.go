@@ -0,0 +1,263 @@
+//go:build linux
+
+package tcpfirewall
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/http/httpguts"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sandbox_network "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-network"
+)
+
+// requestPolicy is what the proxy enforces on every plain HTTP request of a connection.
+type requestPolicy struct {
+	rules   []*orchestrator.SandboxNetworkRule // access rules
+	headers map[string]string                  // transform headers with secrets substituted
+}
+
+// newRequestPolicy collects the rules of every rule domain matching the hostname.
+func newRequestPolicy(egress *orchestrator.SandboxNetworkEgressConfig, hostname string) requestPolicy {
+	var policy requestPolicy
+
+	host := normalizeHost(hostname)
+	if host == noHostnameValue {
+		return policy
+	}
+
+	for domain, domainRules := range egress.GetRules() {
+		if !sandbox_network.MatchDomain(host, domain) {
+			continue
+		}
+
+		for _, r := range domainRules.GetRules() {
+			if r.GetAction() != orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_UNSPECIFIED {
+				policy.rules = append(policy.rules, r)
+
+				continue
+			}
+
+			for name, value := range r.GetTransform().GetHeaders() {
+				// Headers referencing a secret the API didn't resolve are not sent.
+				resolved, ok := sandbox_network.ReplaceSecretPlaceholders(value, egress.GetSecrets())
+				if !ok {
+					continue
+				}
+
+				if policy.headers == nil {
+					policy.headers = make(map[string]string)
+				}
+				policy.headers[name] = resolved
+			}
+		}
+	}
+
+	return policy
+}
+
+func (p requestPolicy) empty() bool {
+	return len(p.rules) == 0 && len(p.headers) == 0
+}
+
+// isRequestAllowed evaluates the access rules for a single HTTP request.
+// A matching deny rule always blocks; when any allow rules exist, the request
+// must match at least one of them.
+func isRequestAllowed(rules []*orchestrator.SandboxNetworkRule, method, requestPath string) bool {
+	requestPath = cleanRequestPath(requestPath)
+
+	var hasAllow, allowed bool
+	for _, r := range rules {
+		matches := matchMethod(r.GetMethods(), method) && matchPath(r.GetPath(), requestPath)
+
+		switch r.GetAction() {
+		case orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_DENY:
+			if matches {
+				return false
+			}
+		case orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW:
+			hasAllow = true
+			allowed = allowed || matches
+		}
+	}
+
+	return !hasAllow || allowed
+}
+
+// matchMethod checks the request method against the rule methods. Empty matches any method.
+func matchMethod(methods []string, method string) bool {
+	return len(methods) == 0 || slices.Contains(methods, method)
+}
+
+// matchPath checks a cleaned request path against a rule path pattern.
+// Patterns ending with "*" match by prefix, others must match exactly. Empty matches any path.
+func matchPath(pattern, requestPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(requestPath, prefix)
+	}
+
+	return pattern == "" || pattern == requestPath
+}
+
+// cleanRequestPath resolves dot segments so "/repos/../admin" can't slip past a
+// "/repos/*" rule, keeping a trailing slash since rules may rely on it.
+func cleanRequestPath(requestPath string) string {
+	cleaned := path.Clean("/" + requestPath)
+	if strings.HasSuffix(requestPath, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+
+	return cleaned
+}
+
+// normalizeHost strips the port and trailing dot from an HTTP Host header value.
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.TrimSuffix(host, ".")
+}
+
+// proxyHTTPRequests proxies a plain HTTP connection request by request, checking
+// each one against the access rules and injecting the transform headers before
+// forwarding it upstream. The first request is checked before dialing, so a
+// blocked connection never reaches the upstream. The connection is closed on the
+// first blocked request; after a protocol upgrade the remaining bytes are copied
+// without inspection. With upstreamTLS set, the requests are forwarded over TLS
+// the proxy originates.
+func proxyHTTPRequests(ctx context.Context, conn net.Conn, hostname, upstreamAddr string, dialer *net.Dialer, upstreamTLS *tls.Config, policy requestPolicy, matchType MatchType, metrics *Metrics, protocol Protocol, record *egressRecord) {
+	tracker := metrics.TrackConnection(protocol)
+	defer tracker.Close(ctx)
+	defer conn.Close()
+
+	// With access rules the decision is made per request rather than by the hostname.
+	if len(policy.rules) > 0 {
+		matchType = MatchTypeRule
+	}
+
+	block := func() {
+		metrics.RecordDecision(ctx, DecisionBlocked, protocol, matchType)
+		record.setDecision(DecisionBlocked, matchType)
+	}
+
+	// tcpproxy.Conn replays the peeked bytes, so the first request is read in full.
+	br := bufio.NewReader(conn)
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		record.setError(err)
+		block()
+
+		return
+	}
+
+	if !isHTTPRequestAllowed(req, hostname, policy.rules) {
+		block()
+
+		return
+	}
+
+	metrics.RecordDecision(ctx, DecisionAllowed, protocol, matchType)
+	record.setDecision(DecisionAllowed, matchType)
+
+	dialCtx, cancel := context.WithTimeout(ctx, upstreamDialTimeout)
+	upstream, err := dialUpstream(dialCtx, dialer, "tcp", upstreamAddr, record)
+	if err == nil && upstreamTLS != nil {
+		upstream, err = handshakeUpstream(dialCtx, upstream, upstreamTLS, record)
+	}
+	cancel()
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	// Responses are copied back unmodified. Once the upstream is done no
+	// further request can be answered, so the client connection is closed too.
+	responsesDone := make(chan struct{})
+	go func() {
+		defer close(responsesDone)
+
+		_, _ = io.Copy(conn, upstream)
+		conn.Close()
+	}()
+
+	for {
+		for name, value := range policy.headers {
+			req.Header.Set(name, value)
+		}
+
+		if err := writeRequest(upstream, req); err != nil {
+			break
+		}
+
+		if httpguts.HeaderValuesContainsToken(req.Header["Connection"], "Upgrade") {
+			_, _ = io.Copy(upstream, br)
+
+			break
+		}
+
+		req, err = http.ReadRequest(br)
+		if err != nil {
+			break
+		}
+
+		if !isHTTPRequestAllowed(req, hostname, policy.rules) {
+			block()
+			upstream.Close()
+			<-responsesDone
+
+			return
+		}
+	}
+
+	// The client is done sending; let the upstream finish its responses.
+	if cw, ok := upstream.(closeWriter); ok {
+		_ = cw.CloseWrite()
+	} else {
+		upstream.Close()
+	}
+	<-responsesDone
+}
+
+// handshakeUpstream originates TLS over the upstream connection, verifying the
+// upstream's certificate against the configured server name.
+func handshakeUpstream(ctx context.Context, upstream net.Conn, config *tls.Config, record *egressRecord) (net.Conn, error) {
+	tlsConn := tls.Client(upstream, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		record.setError(err)
+		upstream.Close()
+
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// isHTTPRequestAllowed checks a request read from the connection. Requests for a
+// different host than the one the connection was routed by are blocked, since
+// the rules and headers were selected for that host.
+func isHTTPRequestAllowed(req *http.Request, hostname string, rules []*orchestrator.SandboxNetworkRule) bool {
+	if !strings.EqualFold(normalizeHost(req.Host), normalizeHost(hostname)) {
+		return false
+	}
+
+	return isRequestAllowed(rules, req.Method, req.URL.Path)
+}
+
+// writeRequest forwards a request read by http.ReadRequest upstream.
+func writeRequest(upstream io.Writer, req *http.Request) error {
+	if _, ok := req.Header["User-Agent"]; !ok {
+		// Keep Request.Write from adding Go's default User-Agent.
+		req.Header["User-Agent"] = []string{""}
+	}
+
+	return req.Write(upstream)
+}
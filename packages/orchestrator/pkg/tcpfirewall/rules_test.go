@@ -0,0 +1,265 @@
+//go:build linux
+
+package tcpfirewall
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func allowRule(path string, methods ...string) *orchestrator.SandboxNetworkRule {
+	return &orchestrator.SandboxNetworkRule{
+		Action:  orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_ALLOW,
+		Methods: methods,
+		Path:    path,
+	}
+}
+
+func denyRule(path string, methods ...string) *orchestrator.SandboxNetworkRule {
+	return &orchestrator.SandboxNetworkRule{
+		Action:  orchestrator.SandboxNetworkRuleAction_SANDBOX_NETWORK_RULE_ACTION_DENY,
+		Methods: methods,
+		Path:    path,
+	}
+}
+
+func TestNewRequestPolicy(t *testing.T) {
+	t.Parallel()
+
+	transform := &orchestrator.SandboxNetworkRule{
+		Transform: &orchestrator.SandboxNetworkTransform{Headers: map[string]string{
+			"Authorization": "Bearer {{secret:OPENAI_KEY}}",
+			"X-Missing":     "{{secret:MISSING}}",
+			"X-Plain":       "value",
+		}},
+	}
+	egress := &orchestrator.SandboxNetworkEgressConfig{
+		Rules: map[string]*orchestrator.SandboxNetworkDomainRules{
+			"api.github.com": {Rules: []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}},
+			"api.openai.com": {Rules: []*orchestrator.SandboxNetworkRule{transform}},
+		},
+		Secrets: map[string]string{"OPENAI_KEY": "sk-123"},
+	}
+
+	policy := newRequestPolicy(egress, "api.github.com")
+	assert.Len(t, policy.rules, 1)
+	assert.Empty(t, policy.headers)
+
+	// Host header values may carry a port, a trailing dot or different case.
+	policy = newRequestPolicy(egress, "API.github.com.:80")
+	assert.Len(t, policy.rules, 1)
+
+	// Headers referencing a missing secret are dropped.
+	policy = newRequestPolicy(egress, "api.openai.com")
+	assert.Empty(t, policy.rules)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer sk-123", "X-Plain": "value"}, policy.headers)
+
+	assert.True(t, newRequestPolicy(egress, "github.com").empty())
+	assert.True(t, newRequestPolicy(egress, noHostnameValue).empty())
+	assert.True(t, newRequestPolicy(nil, "api.github.com").empty())
+}
+
+func TestIsRequestAllowed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		rules  []*orchestrator.SandboxNetworkRule
+		method string
+		path   string
+		want   bool
+	}{
+		{name: "no rules allows", method: http.MethodPost, path: "/", want: true},
+		{name: "allow prefix matches", rules: []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}, method: http.MethodGet, path: "/repos/e2b-dev/infra", want: true},
+		{name: "allow prefix wrong method", rules: []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}, method: http.MethodPost, path: "/repos/e2b-dev/infra", want: false},
+		{name: "allow prefix other path", rules: []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}, method: http.MethodGet, path: "/user", want: false},
+		{name: "dot segments are resolved", rules: []*orchestrator.SandboxNetworkRule{allowRule("/repos/*")}, method: http.MethodGet, path: "/repos/../admin", want: false},
+		{name: "exact path", rules: []*orchestrator.SandboxNetworkRule{allowRule("/user")}, method: http.MethodGet, path: "/user", want: true},
+		{name: "exact path does not match prefix", rules: []*orchestrator.SandboxNetworkRule{allowRule("/user")}, method: http.MethodGet, path: "/user/repos", want: false},
+		{name: "empty path matches any", rules: []*orchestrator.SandboxNetworkRule{allowRule("", "GET", "HEAD")}, method: http.MethodHead, path: "/anything", want: true},
+		{name: "deny only allows the rest", rules: []*orchestrator.SandboxNetworkRule{denyRule("/admin/*")}, method: http.MethodGet, path: "/repos", want: true},
+		{name: "deny wins over allow", rules: []*orchestrator.SandboxNetworkRule{allowRule("/*"), denyRule("/admin/*", "DELETE")}, method: http.MethodDelete, path: "/admin/users", want: false},
+		{name: "deny with other method", rules: []*orchestrator.SandboxNetworkRule{allowRule("/*"), denyRule("/admin/*", "DELETE")}, method: http.MethodGet, path: "/admin/users", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isRequestAllowed(tt.rules, tt.method, tt.path))
+		})
+	}
+}
+
+func TestProxyHTTPRequests(t *testing.T) {
+	t.Parallel()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s %q", r.Method, r.URL.Path, r.UserAgent())
+	}))
+	defer upstream.Close()
+
+	rules := []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}
+	record := newTestRecord(ProtocolHTTP)
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		proxyHTTPRequests(t.Context(), server, "api.github.com", upstream.Listener.Addr().String(), newDialer(), nil, requestPolicy{rules: rules}, MatchTypeDomain, NewMetrics(noop.NewMeterProvider()), ProtocolHTTP, record)
+	}()
+
+	br := bufio.NewReader(client)
+
+	_, err := io.WriteString(client, "GET /repos/e2b-dev/infra HTTP/1.1\r\nHost: api.github.com\r\n\r\n")
+	require.NoError(t, err)
+
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	// The request is forwarded without Go's default User-Agent.
+	assert.Equal(t, `GET /repos/e2b-dev/infra ""`, string(body))
+
+	// A request not matching the allow rule closes the connection.
+	_, err = io.WriteString(client, "POST /repos/e2b-dev/infra HTTP/1.1\r\nHost: api.github.com\r\nContent-Length: 0\r\n\r\n")
+	require.NoError(t, err)
+
+	rest, err := io.ReadAll(br)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	<-done
+
+	l := record.finish()
+	assert.Equal(t, string(DecisionBlocked), l.Decision)
+	assert.Equal(t, string(MatchTypeRule), l.MatchType)
+	assert.NotZero(t, l.BytesSent)
+	assert.NotZero(t, l.BytesReceived)
+}
+
+func TestProxyHTTPRequests_BlockedBeforeDial(t *testing.T) {
+	t.Parallel()
+
+	rules := []*orchestrator.SandboxNetworkRule{allowRule("/repos/*", "GET")}
+	record := newTestRecord(ProtocolHTTP)
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// The upstream address is never dialed for a blocked first request.
+		proxyHTTPRequests(t.Context(), server, "api.github.com", "127.0.0.1:1", newDialer(), nil, requestPolicy{rules: rules}, MatchTypeDomain, NewMetrics(noop.NewMeterProvider()), ProtocolHTTP, record)
+	}()
+
+	// Requests for another host than the connection was routed by are blocked too.
+	_, err := io.WriteString(client, "GET /repos/e2b-dev/infra HTTP/1.1\r\nHost: evil.example.com\r\n\r\n")
+	require.NoError(t, err)
+	<-done
+
+	l := record.finish()
+	assert.Equal(t, string(DecisionBlocked), l.Decision)
+	assert.Equal(t, string(MatchTypeRule), l.MatchType)
+	assert.Empty(t, l.ResolvedIP)
+	assert.Empty(t, l.Error)
+}
+
+func TestProxyHTTPRequests_InjectsHeaders(t *testing.T) {
+	t.Parallel()
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	}))
+	defer upstream.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(upstream.Certificate())
+	// The test server's certificate is issued for example.com.
+	upstreamTLS := &tls.Config{ServerName: "example.com", RootCAs: roots, MinVersion: tls.VersionTLS12}
+
+	policy := requestPolicy{headers: map[string]string{"Authorization": "Bearer sk-123"}}
+	record := newTestRecord(ProtocolHTTP)
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		proxyHTTPRequests(t.Context(), server, "api.openai.com", upstream.Listener.Addr().String(), newDialer(), upstreamTLS, policy, MatchTypeDomain, NewMetrics(noop.NewMeterProvider()), ProtocolHTTP, record)
+	}()
+
+	br := bufio.NewReader(client)
+
+	// An Authorization header set by the sandbox is replaced.
+	_, err := io.WriteString(client, "GET /v1/models HTTP/1.1\r\nHost: api.openai.com\r\nAuthorization: Bearer {{secret:OPENAI_KEY}}\r\n\r\n")
+	require.NoError(t, err)
+
+	resp, err := http.ReadResponse(br, nil)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "Bearer sk-123", string(body))
+
+	client.Close()
+	<-done
+
+	l := record.finish()
+	// Without access rules the hostname decides the connection.
+	assert.Equal(t, string(DecisionAllowed), l.Decision)
+	assert.Equal(t, string(MatchTypeDomain), l.MatchType)
+}
+
+func TestProxyHTTPRequests_RejectsUnverifiedUpstream(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests.Add(1)
+	}))
+	defer upstream.Close()
+
+	policy := requestPolicy{headers: map[string]string{"Authorization": "Bearer sk-123"}}
+	record := newTestRecord(ProtocolHTTP)
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// The test server's certificate isn't trusted by the system roots.
+		upstreamTLS := &tls.Config{ServerName: "api.openai.com", MinVersion: tls.VersionTLS12}
+		proxyHTTPRequests(t.Context(), server, "api.openai.com", upstream.Listener.Addr().String(), newDialer(), upstreamTLS, policy, MatchTypeDomain, NewMetrics(noop.NewMeterProvider()), ProtocolHTTP, record)
+	}()
+
+	_, err := io.WriteString(client, "GET /v1/models HTTP/1.1\r\nHost: api.openai.com\r\n\r\n")
+	require.NoError(t, err)
+
+	rest, err := io.ReadAll(client)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	<-done
+
+	assert.Zero(t, requests.Load())
+	assert.NotEmpty(t, record.finish().Error)
+}
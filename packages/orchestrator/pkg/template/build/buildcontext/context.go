@@ -3,10 +3,13 @@
 package buildcontext
 
 import (
+	"fmt"
+
 	"golang.org/x/sync/errgroup"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/cfg"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/config"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
@@ -19,4 +22,29 @@ type BuildContext struct {
 	CacheScope     string
 	IsV1Build      bool
 	Version        string
+
+	// Stage is the name of the build stage being built, empty when building
+	// the template itself.
+	Stage string
+}
+
+// ForStage returns the build context for building the stage. The stage is
+// built with the template's configuration, from its own source and steps.
+func (bc BuildContext) ForStage(stage *templatemanager.TemplateStage) BuildContext {
+	bc.Stage = stage.GetName()
+	bc.Config.FromImage = stage.GetFromImage()
+	bc.Config.FromTemplate = stage.GetFromTemplate()
+	bc.Config.Steps = stage.GetSteps()
+	bc.Config.Stages = nil
+
+	return bc
+}
+
+// StagePrefix prefixes the log prefix of a phase with the stage being built.
+func (bc BuildContext) StagePrefix(prefix string) string {
+	if bc.Stage == "" {
+		return prefix
+	}
+
+	return fmt.Sprintf("stage %s: %s", bc.Stage, prefix)
 }
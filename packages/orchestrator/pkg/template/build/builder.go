@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -32,6 +33,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/ensurefreedisk"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/finalize"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/optimize"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/stage"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/steps"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/user"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/storage/cache"
@@ -40,6 +42,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/dockerhub"
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
 	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -117,7 +120,7 @@ type Result struct {
 // 3. Extract ext4 filesystem
 // 4. Start FC VM with BusyBox init that runs just the provisioning script, wait for exit. This will install systemd, that is later used for proper VM boot.
 // 5. Start the FC VM (using systemd) and wait for Envd
-// 6. Build the template steps/layers
+// 6. Build the stages the template copies files from (if any), then the template steps/layers
 // 7. Restart the sandbox and run two additional commands:
 //   - configuration script (enable swap, create user, change folder permissions, etc.)
 //   - start command (if defined), together with the ready command (always with default value if not defined)
@@ -215,7 +218,21 @@ func (b *Builder) Build(ctx context.Context, paths storage.Paths, cfg config.Tem
 			return
 		}
 
-		// Remove build files if build fails
+		// Remove build files if build fails. The reference markers are found
+		// through the build's headers, so they go first.
+		buildID, parseErr := uuid.Parse(paths.BuildID)
+		if parseErr != nil {
+			e = errors.Join(e, fmt.Errorf("invalid build id '%s': %w", paths.BuildID, parseErr))
+
+			return
+		}
+
+		if forgetErr := header.ForgetReferences(ctx, b.templateStorage, buildID); forgetErr != nil {
+			e = errors.Join(e, fmt.Errorf("error removing build references: %w", forgetErr))
+
+			return
+		}
+
 		removeErr := b.templateStorage.DeleteObjectsWithPrefix(ctx, paths.BuildID)
 		if removeErr != nil {
 			e = errors.Join(e, fmt.Errorf("error removing build files: %w", removeErr))
@@ -316,52 +333,21 @@ func runBuild(
 		builder.featureFlags,
 	)
 
-	baseBuilder := base.New(
-		bc,
-		builder.featureFlags,
-		builder.logger,
-		builder.proxy,
-		templateStorage,
-		builder.artifactRegistry,
-		builder.dockerhubRepository,
-		layerExecutor,
-		index,
-		builder.metrics,
-		builder.sandboxFactory,
-		builder.sandboxes,
-	)
-
 	commandExecutor := commands.NewCommandExecutor(
 		bc,
 		builder.buildStorage,
 		builder.proxy,
 	)
 
-	userBuilder := user.New(
-		bc,
-		builder.sandboxFactory,
-		builder.logger,
-		builder.proxy,
-		layerExecutor,
-		commandExecutor,
-		index,
-		builder.metrics,
-		builder.featureFlags,
-		config.TemplateDefaultUser,
-		bc.Config.Force,
-	)
+	err := buildStages(ctx, userLogger, bc, builder, templateStorage, layerExecutor, commandExecutor, index)
+	if err != nil {
+		return nil, err
+	}
 
-	stepBuilders := steps.CreateStepPhases(
-		bc,
-		builder.sandboxFactory,
-		builder.logger,
-		builder.proxy,
-		layerExecutor,
-		commandExecutor,
-		index,
-		builder.metrics,
-		builder.featureFlags,
-	)
+	builders, err := sourcePhases(bc, builder, templateStorage, layerExecutor, commandExecutor, index)
+	if err != nil {
+		return nil, err
+	}
 
 	postProcessingBuilder := finalize.New(
 		bc,
@@ -384,19 +370,6 @@ func runBuild(
 		builder.logger,
 	)
 
-	// Construct the phases/steps to run
-	builders := []phases.BuilderPhase{
-		baseBuilder,
-	}
-	// Default user is only set for version TemplateDefaultUserVersion
-	ok, err := utils.IsGTEVersion(bc.Version, templates.TemplateV2ReleaseVersion)
-	if err != nil {
-		return nil, fmt.Errorf("error checking build version: %w", err)
-	}
-	if ok {
-		builders = append(builders, userBuilder)
-	}
-	builders = append(builders, stepBuilders...)
 	// Grow the quiescent rootfs before finalize cold-boots it.
 	if builder.featureFlags.BoolFlag(ctx, featureflags.BuildEnsureFreeDiskSpace) {
 		builders = append(builders, ensurefreedisk.New(
@@ -439,6 +412,164 @@ func runBuild(
 	}, nil
 }
 
+// sourcePhases returns the phases building the base of the template or stage
+// and its steps.
+func sourcePhases(
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	templateStorage storage.StorageProvider,
+	layerExecutor *layer.LayerExecutor,
+	commandExecutor *commands.CommandExecutor,
+	index cache.Index,
+) ([]phases.BuilderPhase, error) {
+	baseBuilder := base.New(
+		bc,
+		builder.featureFlags,
+		builder.logger,
+		builder.proxy,
+		templateStorage,
+		builder.artifactRegistry,
+		builder.dockerhubRepository,
+		layerExecutor,
+		index,
+		builder.metrics,
+		builder.sandboxFactory,
+		builder.sandboxes,
+	)
+
+	userBuilder := user.New(
+		bc,
+		builder.sandboxFactory,
+		builder.logger,
+		builder.proxy,
+		layerExecutor,
+		commandExecutor,
+		index,
+		builder.metrics,
+		builder.featureFlags,
+		config.TemplateDefaultUser,
+		bc.Config.Force,
+	)
+
+	stepBuilders := steps.CreateStepPhases(
+		bc,
+		builder.sandboxFactory,
+		builder.logger,
+		builder.proxy,
+		layerExecutor,
+		commandExecutor,
+		index,
+		builder.metrics,
+		builder.featureFlags,
+	)
+
+	// Construct the phases/steps to run
+	builders := []phases.BuilderPhase{
+		baseBuilder,
+	}
+	// Default user is only set for version TemplateDefaultUserVersion
+	ok, err := utils.IsGTEVersion(bc.Version, templates.TemplateV2ReleaseVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error checking build version: %w", err)
+	}
+	if ok {
+		builders = append(builders, userBuilder)
+	}
+
+	return append(builders, stepBuilders...), nil
+}
+
+// buildStages builds the stages the template copies files from and exports
+// the copied files. The COPY steps copying from a stage are pointed to its
+// export. Stages whose files are exported already aren't built at all.
+func buildStages(
+	ctx context.Context,
+	userLogger logger.Logger,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	templateStorage storage.StorageProvider,
+	layerExecutor *layer.LayerExecutor,
+	commandExecutor *commands.CommandExecutor,
+	index cache.Index,
+) error {
+	stages := bc.Config.Stages
+
+	exports := make(map[string]string, len(stages))
+	resolve := func(steps []*templatemanager.TemplateStep) error {
+		for _, step := range steps {
+			if step.FromStage == nil {
+				continue
+			}
+
+			hash, ok := exports[step.GetFromStage()]
+			if !ok {
+				return fmt.Errorf("stage '%s' is not built before it's copied from", step.GetFromStage())
+			}
+
+			step.FilesHash = &hash
+		}
+
+		return nil
+	}
+
+	for i, stg := range stages {
+		later := make([][]*templatemanager.TemplateStep, 0, len(stages)-i)
+		for _, next := range stages[i+1:] {
+			later = append(later, next.GetSteps())
+		}
+		later = append(later, bc.Config.Steps)
+
+		sources := stage.Sources(stg.GetName(), later...)
+		if len(sources) == 0 {
+			// Nothing is copied from the stage, so it doesn't need to be built.
+			continue
+		}
+
+		stageBC := bc.ForStage(stg)
+		if err := resolve(stageBC.Config.Steps); err != nil {
+			return err
+		}
+
+		builders, err := sourcePhases(stageBC, builder, templateStorage, layerExecutor, commandExecutor, index)
+		if err != nil {
+			return err
+		}
+
+		export := stage.New(stageBC, sources, builder.proxy, builder.sandboxFactory, layerExecutor, builder.buildStorage, builder.featureFlags)
+		builders = append(builders, export)
+
+		hash, err := phases.Hash(ctx, builders)
+		if err != nil {
+			return fmt.Errorf("error hashing stage %s: %w", stg.GetName(), err)
+		}
+
+		exported, err := export.Exported(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("error checking stage %s export: %w", stg.GetName(), err)
+		}
+
+		if exported {
+			source, err := export.String(ctx)
+			if err != nil {
+				return err
+			}
+
+			userLogger.Info(ctx, fmt.Sprintf("CACHED [%s] %s [%s]", export.Prefix(), source, hash))
+		} else {
+			res, err := phases.Run(ctx, builder.logger, userLogger, stageBC, builder.metrics, builders)
+			if err != nil {
+				return err
+			}
+
+			hash = res.Hash
+		}
+
+		exports[stg.GetName()] = hash
+	}
+
+	return resolve(bc.Config.Steps)
+}
+
 func templateSchedulingMetadata(ctx context.Context, cache *sbxtemplate.Cache, buildID string) *orchestratorgrpc.SchedulingMetadata {
 	// Use GetTemplate (not GetCachedTemplate): the optimize phase invalidates
 	// the final build from the cache, so re-fetch to resolve its headers.
@@ -456,15 +587,36 @@ func templateSchedulingMetadata(ctx context.Context, cache *sbxtemplate.Cache, b
 	return provider.SchedulingMetadata(ctx)
 }
 
-// forceSteps sets force for all steps after the first encounter.
+// forceSteps sets force for all steps after the first encounter. Steps copying
+// files from a stage with forced steps are forced too, as the stage's files
+// are exported again.
 func forceSteps(template config.TemplateConfig) config.TemplateConfig {
-	shouldRebuild := template.Force != nil && *template.Force
-	for _, step := range template.Steps {
+	forcedStages := make(map[string]bool)
+	for _, stage := range template.Stages {
+		if forceStepsFrom(template.Force, stage.GetSteps(), forcedStages) {
+			forcedStages[stage.GetName()] = true
+		}
+	}
+
+	forceStepsFrom(template.Force, template.Steps, forcedStages)
+
+	return template
+}
+
+// forceStepsFrom forces the steps from the first forced one and reports
+// whether any step is forced.
+func forceStepsFrom(force *bool, steps []*templatemanager.TemplateStep, forcedStages map[string]bool) bool {
+	shouldRebuild := force != nil && *force
+	for _, step := range steps {
 		// Force rebuild if the step has a Force flag set to true
 		if step.Force != nil && step.GetForce() {
 			shouldRebuild = true
 		}
 
+		if step.FromStage != nil && forcedStages[step.GetFromStage()] {
+			shouldRebuild = true
+		}
+
 		if !shouldRebuild {
 			continue
 		}
@@ -473,7 +625,7 @@ func forceSteps(template config.TemplateConfig) config.TemplateConfig {
 		step.Force = &force
 	}
 
-	return template
+	return shouldRebuild
 }
 
 func getRootfsSize(
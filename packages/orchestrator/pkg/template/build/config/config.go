@@ -67,6 +67,16 @@ type TemplateConfig struct {
 	// Steps to build the template.
 	Steps []*templatemanager.TemplateStep
 
+	// Stages to build before the template, in order. COPY steps of the
+	// template and of the later stages can copy files from them.
+	Stages []*templatemanager.TemplateStage
+
+	// Labels declared by the template's Dockerfile.
+	Labels map[string]string
+
+	// ExposedPorts declared by the template's Dockerfile.
+	ExposedPorts []string
+
 	// Firecracker version to use
 	FirecrackerVersion string
 
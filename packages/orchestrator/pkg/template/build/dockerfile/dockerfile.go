@@ -0,0 +1,813 @@
+// Package dockerfile translates a Dockerfile into template build steps, so a
+// template can be built from a raw Dockerfile instead of steps described by
+// the SDK.
+package dockerfile
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+type Options struct {
+	// BuildArgs override the default values of the ARG instructions.
+	BuildArgs map[string]string
+
+	// FilesHash is the hash of the build context uploaded with
+	// InitLayerFileUpload. COPY and ADD read their sources from it.
+	FilesHash string
+}
+
+// Template is the build configuration described by a Dockerfile. It is
+// built from the last stage of the Dockerfile.
+type Template struct {
+	FromImage string
+	Steps     []*templatemanager.TemplateStep
+
+	// Stages are the earlier stages the template copies files from, directly
+	// or through other stages, in the order they have to be built.
+	Stages []*templatemanager.TemplateStage
+
+	// StartCmd is translated from ENTRYPOINT and CMD.
+	StartCmd string
+	// ReadyCmd is translated from HEALTHCHECK.
+	ReadyCmd string
+
+	// Labels are collected from LABEL.
+	Labels map[string]string
+	// ExposedPorts are collected from EXPOSE, as "port/protocol".
+	ExposedPorts []string
+}
+
+// Parse translates the Dockerfile into a template. Instructions that have no
+// equivalent in template builds are rejected with an error naming the line.
+func Parse(content string, opts Options) (*Template, error) {
+	instructions, escape, err := split(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instructions) == 0 {
+		return nil, errors.New("the Dockerfile has no instructions")
+	}
+
+	t := &translator{
+		opts:       opts,
+		escape:     escape,
+		globalArgs: make(map[string]string),
+	}
+
+	for _, in := range instructions {
+		if err := t.apply(in); err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", in.line, in.cmd, err)
+		}
+	}
+
+	if t.current == nil {
+		return nil, errors.New("the Dockerfile has no FROM instruction")
+	}
+
+	return t.template(), nil
+}
+
+// template converts the last stage into the template, together with the
+// stages it depends on.
+func (t *translator) template() *Template {
+	final := t.current
+
+	needed := make(map[string]bool)
+	queue := slices.Clone(final.copiesFrom)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if needed[name] {
+			continue
+		}
+
+		needed[name] = true
+		queue = append(queue, t.stage(name).copiesFrom...)
+	}
+
+	var stages []*templatemanager.TemplateStage
+	for _, s := range t.stages {
+		if !needed[s.name] {
+			continue
+		}
+
+		stages = append(stages, &templatemanager.TemplateStage{
+			Name:   s.name,
+			Source: &templatemanager.TemplateStage_FromImage{FromImage: s.fromImage},
+			Steps:  s.steps,
+		})
+	}
+
+	return &Template{
+		FromImage:    final.fromImage,
+		Steps:        final.steps,
+		Stages:       stages,
+		StartCmd:     startCommand(final.entrypoint, final.cmd),
+		ReadyCmd:     final.readyCmd,
+		Labels:       final.labels,
+		ExposedPorts: final.exposedPorts,
+	}
+}
+
+// command is a CMD, ENTRYPOINT or HEALTHCHECK command in either form.
+type command struct {
+	exec  []string
+	shell string
+}
+
+func (c *command) String() string {
+	if c == nil {
+		return ""
+	}
+
+	if c.exec != nil {
+		return shellJoin(c.exec)
+	}
+
+	return c.shell
+}
+
+// stage is a build stage, started by a FROM instruction.
+type stage struct {
+	// name is the stage's AS name, or its index for unnamed stages.
+	name  string
+	alias bool
+
+	fromImage string
+	steps     []*templatemanager.TemplateStep
+
+	// envs are the ENV values of the stage, inherited by the stages built
+	// from it.
+	envs map[string]string
+	// vars are the ARG and ENV values of the stage, used for substitution.
+	vars map[string]string
+
+	entrypoint   *command
+	cmd          *command
+	readyCmd     string
+	labels       map[string]string
+	exposedPorts []string
+
+	// copiesFrom are the names of the stages the stage copies files from.
+	copiesFrom []string
+}
+
+// child returns a new stage built from s, inheriting its image configuration
+// but not its ARGs.
+func (s *stage) child(name string, alias bool) *stage {
+	return &stage{
+		name:         name,
+		alias:        alias,
+		fromImage:    s.fromImage,
+		steps:        slices.Clone(s.steps),
+		envs:         maps.Clone(s.envs),
+		vars:         maps.Clone(s.envs),
+		entrypoint:   s.entrypoint,
+		cmd:          s.cmd,
+		readyCmd:     s.readyCmd,
+		labels:       maps.Clone(s.labels),
+		exposedPorts: slices.Clone(s.exposedPorts),
+		copiesFrom:   slices.Clone(s.copiesFrom),
+	}
+}
+
+type translator struct {
+	opts   Options
+	escape rune
+
+	// globalArgs are the ARGs declared before FROM. They are only visible in
+	// FROM, or in the stage after being redeclared there without a value.
+	globalArgs map[string]string
+
+	stages  []*stage
+	current *stage
+}
+
+func (t *translator) lexer() lexer {
+	return lexer{escape: t.escape, vars: t.current.vars}
+}
+
+// stage returns the previous stage with the name, or nil.
+func (t *translator) stage(name string) *stage {
+	for _, s := range t.stages {
+		if s.name == strings.ToLower(name) {
+			return s
+		}
+	}
+
+	return nil
+}
+
+func (t *translator) apply(in instruction) error {
+	if t.current == nil && in.cmd != "FROM" && in.cmd != "ARG" {
+		return errors.New("only ARG can precede the first FROM instruction")
+	}
+
+	if err := checkFlags(in); err != nil {
+		return err
+	}
+
+	switch in.cmd {
+	case "FROM":
+		return t.from(in)
+	case "ARG":
+		return t.arg(in)
+	case "ENV":
+		return t.env(in)
+	case "LABEL":
+		return t.label(in)
+	case "MAINTAINER":
+		// Deprecated in favor of the maintainer label, which Docker sets too.
+		t.current.labels["maintainer"] = in.args
+
+		return nil
+	case "EXPOSE":
+		return t.expose(in)
+	case "RUN":
+		return t.run(in)
+	case "COPY", "ADD":
+		return t.copy(in)
+	case "WORKDIR":
+		return t.workdir(in)
+	case "USER":
+		return t.user(in)
+	case "CMD":
+		t.current.cmd = t.command(in)
+
+		return nil
+	case "ENTRYPOINT":
+		t.current.entrypoint = t.command(in)
+
+		return nil
+	case "HEALTHCHECK":
+		return t.healthcheck(in)
+	case "SHELL", "VOLUME", "STOPSIGNAL", "ONBUILD":
+		return errors.New("instruction is not supported in template builds")
+	default:
+		return errors.New("unknown instruction")
+	}
+}
+
+// supportedFlags lists the options each instruction accepts. Options without
+// an effect in template builds (e.g. the HEALTHCHECK timings, whose ready
+// command is polled by the build itself) are accepted and ignored.
+var supportedFlags = map[string][]string{
+	"COPY":        {"chown", "chmod", "link", "from"},
+	"ADD":         {"chown", "chmod", "link"},
+	"HEALTHCHECK": {"interval", "timeout", "start-period", "start-interval", "retries"},
+}
+
+func checkFlags(in instruction) error {
+	for _, f := range in.flags {
+		if !slices.Contains(supportedFlags[in.cmd], f.name) {
+			return fmt.Errorf("option --%s is not supported", f.name)
+		}
+	}
+
+	return nil
+}
+
+func (t *translator) flagValue(in instruction, name string) (string, error) {
+	for _, f := range slices.Backward(in.flags) {
+		if f.name == name {
+			return t.lexer().word(f.value)
+		}
+	}
+
+	return "", nil
+}
+
+func (t *translator) from(in instruction) error {
+	words, err := lexer{escape: t.escape, vars: t.globalArgs}.words(in.args)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(words) == 1:
+	case len(words) == 3 && strings.EqualFold(words[1], "AS"):
+	default:
+		return errors.New("expected an image optionally followed by 'AS name'")
+	}
+
+	if strings.Contains(words[0], "$") {
+		return fmt.Errorf("image '%s' references an undefined ARG", words[0])
+	}
+
+	name, alias := strconv.Itoa(len(t.stages)), false
+	if len(words) == 3 {
+		name, alias = strings.ToLower(words[2]), true
+
+		if _, err := strconv.Atoi(name); err == nil || !isStageName(name) {
+			return fmt.Errorf("invalid stage name '%s'", words[2])
+		}
+
+		if t.stage(name) != nil {
+			return fmt.Errorf("duplicate stage name '%s'", words[2])
+		}
+	}
+
+	// Stages can be built from the earlier stages by their name, but not by
+	// their index.
+	if parent := t.stage(words[0]); parent != nil && parent.alias {
+		t.current = parent.child(name, alias)
+	} else {
+		t.current = &stage{
+			name:      name,
+			alias:     alias,
+			fromImage: words[0],
+			envs:      make(map[string]string),
+			vars:      make(map[string]string),
+			labels:    make(map[string]string),
+		}
+	}
+
+	t.stages = append(t.stages, t.current)
+
+	return nil
+}
+
+func isStageName(s string) bool {
+	for i, r := range s {
+		if !isNameRune(r, i == 0) && (i == 0 || !strings.ContainsRune(".-", r)) {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func (t *translator) arg(in instruction) error {
+	words, err := lexer{escape: t.escape}.words(in.args)
+	if err != nil {
+		return err
+	}
+
+	if len(words) == 0 {
+		return errors.New("requires at least one argument")
+	}
+
+	for _, word := range words {
+		name, defaultValue, hasDefault := strings.Cut(word, "=")
+		if !isName(name) {
+			return fmt.Errorf("invalid argument name '%s'", name)
+		}
+
+		value, ok := t.opts.BuildArgs[name]
+		switch {
+		case ok:
+		case hasDefault:
+			value = defaultValue
+			ok = true
+		case t.current != nil:
+			value, ok = t.globalArgs[name]
+		}
+
+		if t.current == nil {
+			if ok {
+				expanded, err := lexer{escape: t.escape, vars: t.globalArgs}.expand(value)
+				if err != nil {
+					return err
+				}
+
+				t.globalArgs[name] = expanded
+			}
+
+			continue
+		}
+
+		// An ARG without any value stays unset.
+		if !ok {
+			continue
+		}
+
+		if err := t.setVar(in.cmd, name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *translator) env(in instruction) error {
+	pairs, err := t.pairs(in.args)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pairs {
+		if err := t.setVar(in.cmd, p[0], p[1]); err != nil {
+			return err
+		}
+
+		t.current.envs[p[0]] = t.current.vars[p[0]]
+	}
+
+	return nil
+}
+
+// setVar adds an ENV or ARG step. The value is passed on unexpanded, as the
+// step expands it in the sandbox, where the base image's environment is
+// known. The locally expanded value is kept for substitutions in the later
+// instructions.
+func (t *translator) setVar(cmd, name, value string) error {
+	expanded, err := t.lexer().expand(value)
+	if err != nil {
+		return err
+	}
+
+	t.current.vars[name] = expanded
+	t.addStep(cmd, name, value)
+
+	return nil
+}
+
+// pairs parses the key=value pairs of ENV, quotes removed and variables left
+// verbatim. The legacy "ENV key value" form is accepted too.
+func (t *translator) pairs(args string) ([][2]string, error) {
+	raw := lexer{escape: t.escape}
+
+	first, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	if !strings.Contains(first, "=") {
+		if strings.TrimSpace(rest) == "" {
+			return nil, fmt.Errorf("missing value for '%s'", first)
+		}
+
+		key, err := raw.word(first)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := raw.word(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, err
+		}
+
+		return [][2]string{{key, value}}, nil
+	}
+
+	words, err := raw.words(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("requires at least one key=value pair")
+	}
+
+	pairs := make([][2]string, 0, len(words))
+	for _, word := range words {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got '%s'", word)
+		}
+
+		pairs = append(pairs, [2]string{key, value})
+	}
+
+	return pairs, nil
+}
+
+func (t *translator) label(in instruction) error {
+	words, err := t.lexer().words(in.args)
+	if err != nil {
+		return err
+	}
+
+	if len(words) == 0 {
+		return errors.New("requires at least one key=value pair")
+	}
+
+	for _, word := range words {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("expected key=value, got '%s'", word)
+		}
+
+		t.current.labels[key] = value
+	}
+
+	return nil
+}
+
+func (t *translator) expose(in instruction) error {
+	words, err := t.lexer().words(in.args)
+	if err != nil {
+		return err
+	}
+
+	if len(words) == 0 {
+		return errors.New("requires at least one port")
+	}
+
+	for _, word := range words {
+		port, err := parsePort(word)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(t.current.exposedPorts, port) {
+			t.current.exposedPorts = append(t.current.exposedPorts, port)
+		}
+	}
+
+	return nil
+}
+
+// parsePort normalizes "port[/protocol]" or "start-end[/protocol]" to a
+// lower-case protocol, defaulting to tcp.
+func parsePort(s string) (string, error) {
+	ports, protocol, hasProtocol := strings.Cut(s, "/")
+	protocol = strings.ToLower(protocol)
+	if !hasProtocol {
+		protocol = "tcp"
+	}
+
+	if protocol != "tcp" && protocol != "udp" && protocol != "sctp" {
+		return "", fmt.Errorf("invalid protocol in port '%s'", s)
+	}
+
+	start, end, isRange := strings.Cut(ports, "-")
+
+	first, err := parsePortNumber(start)
+	if err != nil {
+		return "", fmt.Errorf("invalid port '%s'", s)
+	}
+
+	if isRange {
+		last, err := parsePortNumber(end)
+		if err != nil || last < first {
+			return "", fmt.Errorf("invalid port range '%s'", s)
+		}
+
+		return fmt.Sprintf("%d-%d/%s", first, last, protocol), nil
+	}
+
+	return fmt.Sprintf("%d/%s", first, protocol), nil
+}
+
+func parsePortNumber(s string) (uint64, error) {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid port number '%s'", s)
+	}
+
+	return port, nil
+}
+
+func (t *translator) run(in instruction) error {
+	command := in.args
+	if args, ok := in.execForm(); ok {
+		command = shellJoin(args)
+	}
+
+	if strings.TrimSpace(command) == "" {
+		return errors.New("requires a command")
+	}
+
+	t.addStep(in.cmd, command)
+
+	return nil
+}
+
+func (t *translator) copy(in instruction) error {
+	from, err := t.flagValue(in, "from")
+	if err != nil {
+		return err
+	}
+
+	var source *stage
+	switch {
+	case from != "":
+		source = t.stage(from)
+		if index, err := strconv.Atoi(from); err == nil && index >= 0 && index < len(t.stages) {
+			source = t.stages[index]
+		}
+
+		if source == nil || source == t.current {
+			return fmt.Errorf("--from must name a previous build stage, got '%s'", from)
+		}
+	case t.opts.FilesHash == "":
+		return errors.New("requires a build context to be uploaded")
+	}
+
+	owner, err := t.flagValue(in, "chown")
+	if err != nil {
+		return err
+	}
+
+	permissions, err := t.flagValue(in, "chmod")
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	if exec, ok := in.execForm(); ok {
+		for _, arg := range exec {
+			expanded, err := t.lexer().word(arg)
+			if err != nil {
+				return err
+			}
+
+			args = append(args, expanded)
+		}
+	} else {
+		args, err = t.lexer().words(in.args)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(args) < 2 {
+		return errors.New("requires at least one source and a destination")
+	}
+
+	sources, dest := args[:len(args)-1], args[len(args)-1]
+	if len(sources) > 1 && !strings.HasSuffix(dest, "/") {
+		return errors.New("the destination must end with '/' when copying multiple sources")
+	}
+
+	if source != nil {
+		for _, src := range sources {
+			if err := checkStageSource(src); err != nil {
+				return err
+			}
+
+			t.addStageCopyStep(source.name, src, dest, owner, permissions)
+		}
+
+		if !slices.Contains(t.current.copiesFrom, source.name) {
+			t.current.copiesFrom = append(t.current.copiesFrom, source.name)
+		}
+
+		return nil
+	}
+
+	for _, src := range sources {
+		if err := checkSource(in.cmd, src); err != nil {
+			return err
+		}
+
+		t.addCopyStep(in.cmd, src, dest, owner, permissions)
+	}
+
+	return nil
+}
+
+// archiveExtensions are the local archives Docker's ADD extracts instead of copying.
+var archiveExtensions = []string{".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tbz", ".tbz2", ".tar.xz", ".txz", ".tar.zst", ".tzst"}
+
+// checkSource rejects the sources a COPY step can't copy as Docker would. ADD
+// is translated to a plain copy, so the sources it would download or extract
+// are rejected instead of being copied silently. Docker detects archives by
+// their content; an archive without a known extension is copied as is.
+func checkSource(cmd, source string) error {
+	switch {
+	case strings.Contains(source, "://") || strings.HasPrefix(source, "git@"):
+		return fmt.Errorf("remote source '%s' is not supported", source)
+	case strings.ContainsAny(source, "*?["):
+		return fmt.Errorf("wildcards in source '%s' are not supported", source)
+	case cmd == "ADD" && isArchive(source):
+		return fmt.Errorf("extracting archive '%s' is not supported, COPY it and extract it in a RUN instead", source)
+	}
+
+	if cleaned := path.Clean(source); cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return fmt.Errorf("%s source '%s' is outside of the build context", cmd, source)
+	}
+
+	return nil
+}
+
+func isArchive(source string) bool {
+	lower := strings.ToLower(source)
+
+	return slices.ContainsFunc(archiveExtensions, func(ext string) bool { return strings.HasSuffix(lower, ext) })
+}
+
+func checkStageSource(source string) error {
+	if strings.ContainsAny(source, "*?[") {
+		return fmt.Errorf("wildcards in source '%s' are not supported", source)
+	}
+
+	if path.Clean("/"+source) == "/" {
+		return errors.New("copying the whole root of a stage is not supported")
+	}
+
+	return nil
+}
+
+func (t *translator) workdir(in instruction) error {
+	dir, err := t.lexer().word(in.args)
+	if err != nil {
+		return err
+	}
+
+	if dir == "" {
+		return errors.New("requires a path")
+	}
+
+	t.addStep(in.cmd, dir)
+
+	return nil
+}
+
+func (t *translator) user(in instruction) error {
+	user, err := t.lexer().word(in.args)
+	if err != nil {
+		return err
+	}
+
+	// The group is ignored, the user's primary group is used.
+	user, _, _ = strings.Cut(user, ":")
+	if user == "" {
+		return errors.New("requires a user")
+	}
+
+	t.addStep(in.cmd, user)
+
+	return nil
+}
+
+func (t *translator) command(in instruction) *command {
+	if args, ok := in.execForm(); ok {
+		return &command{exec: args}
+	}
+
+	return &command{shell: in.args}
+}
+
+func (t *translator) healthcheck(in instruction) error {
+	keyword, rest, _ := strings.Cut(in.args, " ")
+
+	switch strings.ToUpper(keyword) {
+	case "NONE":
+		if strings.TrimSpace(rest) != "" || len(in.flags) > 0 {
+			return errors.New("NONE takes no arguments")
+		}
+
+		t.current.readyCmd = ""
+
+		return nil
+	case "CMD":
+		c := t.command(instruction{args: strings.TrimSpace(rest)})
+		if c.String() == "" {
+			return errors.New("CMD requires a command")
+		}
+
+		t.current.readyCmd = c.String()
+
+		return nil
+	default:
+		return errors.New("expected NONE or CMD")
+	}
+}
+
+func (t *translator) addStep(cmd string, args ...string) {
+	t.current.steps = append(t.current.steps, &templatemanager.TemplateStep{
+		Type: cmd,
+		Args: args,
+	})
+}
+
+func (t *translator) addCopyStep(cmd, source, dest, owner, permissions string) {
+	t.current.steps = append(t.current.steps, &templatemanager.TemplateStep{
+		Type:      cmd,
+		Args:      []string{source, dest, owner, permissions},
+		FilesHash: &t.opts.FilesHash,
+	})
+}
+
+// addStageCopyStep adds a COPY of the source from the stage's filesystem.
+// Relative sources are relative to the stage's root.
+func (t *translator) addStageCopyStep(stageName, source, dest, owner, permissions string) {
+	t.current.steps = append(t.current.steps, &templatemanager.TemplateStep{
+		Type:      "COPY",
+		Args:      []string{path.Join("/", source), dest, owner, permissions},
+		FromStage: &stageName,
+	})
+}
+
+// startCommand combines ENTRYPOINT and CMD like Docker does: CMD is appended
+// to an exec form ENTRYPOINT as its arguments, and ignored by a shell form one.
+func startCommand(entrypoint, cmd *command) string {
+	switch {
+	case entrypoint == nil:
+		return cmd.String()
+	case entrypoint.exec == nil || cmd == nil:
+		return entrypoint.String()
+	case cmd.exec != nil:
+		return shellJoin(append(slices.Clone(entrypoint.exec), cmd.exec...))
+	default:
+		return shellJoin(append(slices.Clone(entrypoint.exec), "/bin/sh", "-c", cmd.shell))
+	}
+}
@@ -0,0 +1,265 @@
+package dockerfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+const filesHash = "context-hash"
+
+func step(cmd string, args ...string) *templatemanager.TemplateStep {
+	return &templatemanager.TemplateStep{Type: cmd, Args: args}
+}
+
+func copyStep(cmd string, args ...string) *templatemanager.TemplateStep {
+	return &templatemanager.TemplateStep{Type: cmd, Args: args, FilesHash: new(filesHash)}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	content := `# syntax=docker/dockerfile:1
+ARG VERSION=3.12
+FROM python:${VERSION}-slim AS app
+
+ARG APP_DIR=/app
+ENV PYTHONUNBUFFERED=1 \
+    GREETING="hello world"
+ENV LEGACY value with spaces
+LABEL org.opencontainers.image.title="My app" version=$VERSION
+EXPOSE 8000 53/UDP 9000-9002
+
+# install the dependencies
+RUN apt-get update && \
+    apt-get install -y curl
+RUN ["pip", "install", "flask gunicorn"]
+WORKDIR $APP_DIR
+COPY --chown=user:user --chmod=755 requirements.txt ./src/ $APP_DIR/
+ADD data /data
+USER user:staff
+
+HEALTHCHECK --interval=5s --retries=3 CMD curl -f http://localhost:8000/health
+ENTRYPOINT ["gunicorn"]
+CMD ["--bind", "0.0.0.0:8000", "app:app"]
+`
+
+	tmpl, err := Parse(content, Options{FilesHash: filesHash, BuildArgs: map[string]string{"APP_DIR": "/srv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "python:3.12-slim", tmpl.FromImage)
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ARG", "APP_DIR", "/srv"),
+		step("ENV", "PYTHONUNBUFFERED", "1"),
+		step("ENV", "GREETING", "hello world"),
+		step("ENV", "LEGACY", "value with spaces"),
+		step("RUN", "apt-get update &&     apt-get install -y curl"),
+		step("RUN", "pip install 'flask gunicorn'"),
+		step("WORKDIR", "/srv"),
+		copyStep("COPY", "requirements.txt", "/srv/", "user:user", "755"),
+		copyStep("COPY", "./src/", "/srv/", "user:user", "755"),
+		copyStep("ADD", "data", "/data", "", ""),
+		step("USER", "user"),
+	}, tmpl.Steps)
+
+	// VERSION was declared before FROM only, so it isn't visible in the stage.
+	assert.Equal(t, map[string]string{"org.opencontainers.image.title": "My app", "version": "$VERSION"}, tmpl.Labels)
+	assert.Equal(t, []string{"8000/tcp", "53/udp", "9000-9002/tcp"}, tmpl.ExposedPorts)
+	assert.Equal(t, "gunicorn --bind 0.0.0.0:8000 app:app", tmpl.StartCmd)
+	assert.Equal(t, "curl -f http://localhost:8000/health", tmpl.ReadyCmd)
+}
+
+func TestParse_StartCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "none", content: "FROM ubuntu", expected: ""},
+		{name: "shell cmd", content: "FROM ubuntu\nCMD node server.js", expected: "node server.js"},
+		{name: "exec cmd", content: `FROM ubuntu` + "\n" + `CMD ["echo", "it's"]`, expected: `echo 'it'\''s'`},
+		{name: "shell entrypoint ignores cmd", content: "FROM ubuntu\nENTRYPOINT ./run.sh\nCMD [\"--fast\"]", expected: "./run.sh"},
+		{name: "exec entrypoint with shell cmd", content: "FROM ubuntu\nENTRYPOINT [\"tini\", \"--\"]\nCMD npm start", expected: "tini -- /bin/sh -c 'npm start'"},
+		{name: "last cmd wins", content: "FROM ubuntu\nCMD first\nCMD second", expected: "second"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := Parse(tc.content, Options{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, tmpl.StartCmd)
+		})
+	}
+}
+
+func TestParse_Args(t *testing.T) {
+	t.Parallel()
+
+	content := "ARG BASE=ubuntu\nFROM $BASE\nARG BASE\nARG UNSET\nARG WITH_DEFAULT=default\nRUN echo $BASE"
+
+	tmpl, err := Parse(content, Options{BuildArgs: map[string]string{"BASE": "debian"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "debian", tmpl.FromImage)
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ARG", "BASE", "debian"),
+		step("ARG", "WITH_DEFAULT", "default"),
+		step("RUN", "echo $BASE"),
+	}, tmpl.Steps)
+}
+
+func TestParse_EscapeDirective(t *testing.T) {
+	t.Parallel()
+
+	content := "# escape=`\nFROM mcr.microsoft.com/windows\nRUN echo a `\n  && echo b\nWORKDIR C:\\app"
+
+	tmpl, err := Parse(content, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("RUN", "echo a   && echo b"),
+		step("WORKDIR", `C:\app`),
+	}, tmpl.Steps)
+}
+
+func stageCopyStep(stage string, args ...string) *templatemanager.TemplateStep {
+	return &templatemanager.TemplateStep{Type: "COPY", Args: args, FromStage: new(stage)}
+}
+
+func TestParse_MultiStage(t *testing.T) {
+	t.Parallel()
+
+	content := `ARG GO_VERSION=1.24
+FROM golang:${GO_VERSION} AS build
+WORKDIR /src
+COPY . .
+RUN go build -o /out/server .
+
+FROM node:22 AS Assets
+ENV NODE_ENV=production
+RUN npm run build
+
+FROM alpine AS release
+RUN echo release
+
+# unused by the final stage, so it isn't built
+FROM busybox AS unused
+RUN echo unused
+
+FROM assets AS web
+RUN echo $NODE_ENV
+
+FROM debian:bookworm-slim
+COPY --from=build out/server /usr/local/bin/
+COPY --from=2 /etc/os-release /tmp/
+COPY --from=WEB --chown=user /app/dist /srv/www
+CMD ["server"]
+`
+
+	tmpl, err := Parse(content, Options{FilesHash: filesHash})
+	require.NoError(t, err)
+
+	assert.Equal(t, "debian:bookworm-slim", tmpl.FromImage)
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		stageCopyStep("build", "/out/server", "/usr/local/bin/", "", ""),
+		stageCopyStep("release", "/etc/os-release", "/tmp/", "", ""),
+		stageCopyStep("web", "/app/dist", "/srv/www", "user", ""),
+	}, tmpl.Steps)
+	assert.Equal(t, "server", tmpl.StartCmd)
+
+	require.Len(t, tmpl.Stages, 3)
+
+	assert.Equal(t, "build", tmpl.Stages[0].GetName())
+	assert.Equal(t, "golang:1.24", tmpl.Stages[0].GetFromImage())
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("WORKDIR", "/src"),
+		copyStep("COPY", ".", ".", "", ""),
+		step("RUN", "go build -o /out/server ."),
+	}, tmpl.Stages[0].GetSteps())
+
+	assert.Equal(t, "release", tmpl.Stages[1].GetName())
+	assert.Equal(t, "alpine", tmpl.Stages[1].GetFromImage())
+
+	// Stages built from another stage repeat its steps, and inherit its ENV.
+	assert.Equal(t, "web", tmpl.Stages[2].GetName())
+	assert.Equal(t, "node:22", tmpl.Stages[2].GetFromImage())
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ENV", "NODE_ENV", "production"),
+		step("RUN", "npm run build"),
+		step("RUN", "echo $NODE_ENV"),
+	}, tmpl.Stages[2].GetSteps())
+}
+
+func TestParse_HealthcheckNone(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := Parse("FROM ubuntu\nHEALTHCHECK CMD [\"true\"]\nHEALTHCHECK NONE", Options{})
+	require.NoError(t, err)
+	assert.Empty(t, tmpl.ReadyCmd)
+}
+
+func TestParse_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		opts    Options
+		err     string
+	}{
+		{name: "empty", content: "# only a comment\n", err: "no instructions"},
+		{name: "missing from", content: "ARG A=1", err: "no FROM instruction"},
+		{name: "instruction before from", content: "RUN ls\nFROM ubuntu", err: "line 1: RUN: only ARG can precede"},
+		{name: "duplicate stage", content: "FROM golang AS build\nFROM alpine AS Build", err: "line 2: FROM: duplicate stage name 'Build'"},
+		{name: "numeric stage name", content: "FROM golang AS 1", err: "invalid stage name '1'"},
+		{name: "undefined arg in from", content: "FROM $IMAGE", err: "undefined ARG"},
+		{name: "unsupported instruction", content: "FROM ubuntu\nVOLUME /data", err: "line 2: VOLUME: instruction is not supported"},
+		{name: "unknown instruction", content: "FROM ubuntu\nFOO bar", err: "line 2: FOO: unknown instruction"},
+		{name: "unsupported flag", content: "FROM ubuntu\nRUN --mount=type=cache,target=/root/.cache pip install x", err: "option --mount is not supported"},
+		{name: "copy from image", content: "FROM ubuntu\nCOPY --from=nginx:latest /etc/nginx /etc/nginx", err: "--from must name a previous build stage"},
+		{name: "copy from current stage", content: "FROM ubuntu AS app\nCOPY --from=app /a /b", err: "--from must name a previous build stage"},
+		{name: "copy from later stage", content: "FROM ubuntu\nCOPY --from=build /a /b\nFROM golang AS build", err: "--from must name a previous build stage"},
+		{name: "copy stage root", content: "FROM golang AS build\nFROM ubuntu\nCOPY --from=build / /b", err: "whole root of a stage"},
+		{name: "add from stage", content: "FROM golang AS build\nFROM ubuntu\nADD --from=build /a /b", err: "option --from is not supported"},
+		{name: "copy without context", content: "FROM ubuntu\nCOPY a /a", err: "requires a build context"},
+		{name: "copy wildcard", content: "FROM ubuntu\nCOPY *.txt /a/", opts: Options{FilesHash: filesHash}, err: "wildcards"},
+		{name: "copy outside context", content: "FROM ubuntu\nCOPY ../secret /a", opts: Options{FilesHash: filesHash}, err: "outside of the build context"},
+		{name: "copy multiple to file", content: "FROM ubuntu\nCOPY a b /dest", opts: Options{FilesHash: filesHash}, err: "must end with '/'"},
+		{name: "add url", content: "FROM ubuntu\nADD https://example.com/a.tar.gz /a", opts: Options{FilesHash: filesHash}, err: "remote source"},
+		{name: "add archive", content: "FROM ubuntu\nADD app.TAR.gz /app", opts: Options{FilesHash: filesHash}, err: "extracting archive 'app.TAR.gz' is not supported"},
+		{name: "heredoc", content: "FROM ubuntu\nRUN <<EOF\necho hi\nEOF", err: "heredocs are not supported"},
+		{name: "invalid port", content: "FROM ubuntu\nEXPOSE 70000", err: "invalid port"},
+		{name: "invalid label", content: "FROM ubuntu\nLABEL novalue", err: "expected key=value"},
+		{name: "unterminated quote", content: "FROM ubuntu\nENV A=\"b", err: "unterminated double quote"},
+		{name: "healthcheck without cmd", content: "FROM ubuntu\nHEALTHCHECK curl localhost", err: "expected NONE or CMD"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tc.content, tc.opts)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestLexer(t *testing.T) {
+	t.Parallel()
+
+	l := lexer{escape: defaultEscape, vars: map[string]string{"A": "x", "EMPTY": ""}}
+
+	words, err := l.words(`$A ${A}y "$A z" '$A' \$A ${EMPTY:-def} ${A:+alt} ${MISSING} $HOME/dir`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x", "xy", "x z", "$A", "$A", "def", "alt", "${MISSING}", "$HOME/dir"}, words)
+
+	_, err = l.words(`${A:?required}`)
+	require.ErrorContains(t, err, "unsupported variable substitution")
+}
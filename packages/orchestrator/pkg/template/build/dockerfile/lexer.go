@@ -0,0 +1,285 @@
+package dockerfile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const defaultEscape = '\\'
+
+// lexer processes instruction arguments the way Docker does: whitespace
+// separates words, quotes group them and are removed, the escape character
+// escapes the next character, and variables are substituted outside of single
+// quotes.
+type lexer struct {
+	escape rune
+
+	// vars resolves variable references. References to variables missing from
+	// vars (e.g. the base image's environment, which is only known in the
+	// sandbox) are kept verbatim, so the sandbox shell can expand them. A nil
+	// map keeps every reference verbatim.
+	vars map[string]string
+}
+
+// words splits s into words.
+func (l lexer) words(s string) ([]string, error) {
+	return l.process(s, true)
+}
+
+// word processes s as a single word, keeping its whitespace.
+func (l lexer) word(s string) (string, error) {
+	words, err := l.process(s, false)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(words, ""), nil
+}
+
+// expand substitutes the variables in s, which already had its quotes
+// removed.
+func (l lexer) expand(s string) (string, error) {
+	var out strings.Builder
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '$' {
+			out.WriteRune(runes[i])
+
+			continue
+		}
+
+		end, err := l.variable(runes, i, &out)
+		if err != nil {
+			return "", err
+		}
+
+		i = end
+	}
+
+	return out.String(), nil
+}
+
+func (l lexer) process(s string, split bool) ([]string, error) {
+	var (
+		words   []string
+		current strings.Builder
+		inWord  bool
+	)
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case split && unicode.IsSpace(r):
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		case r == l.escape:
+			inWord = true
+			if i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+			}
+		case r == '\'':
+			inWord = true
+			end := indexRune(runes, i+1, '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated single quote")
+			}
+
+			current.WriteString(string(runes[i+1 : end]))
+			i = end
+		case r == '"':
+			inWord = true
+			end, err := l.doubleQuoted(runes, i+1, &current)
+			if err != nil {
+				return nil, err
+			}
+
+			i = end
+		case r == '$':
+			inWord = true
+			end, err := l.variable(runes, i, &current)
+			if err != nil {
+				return nil, err
+			}
+
+			i = end
+		default:
+			inWord = true
+			current.WriteRune(r)
+		}
+	}
+
+	if inWord || !split {
+		words = append(words, current.String())
+	}
+
+	return words, nil
+}
+
+// doubleQuoted writes the double-quoted string starting at runes[start] and
+// returns the index of its closing quote. Inside double quotes the escape
+// character only escapes quotes, dollars and itself.
+func (l lexer) doubleQuoted(runes []rune, start int, out *strings.Builder) (int, error) {
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '"':
+			return i, nil
+		case r == l.escape && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '$' || runes[i+1] == l.escape):
+			i++
+			out.WriteRune(runes[i])
+		case r == '$':
+			end, err := l.variable(runes, i, out)
+			if err != nil {
+				return 0, err
+			}
+
+			i = end
+		default:
+			out.WriteRune(r)
+		}
+	}
+
+	return 0, errors.New("unterminated double quote")
+}
+
+// variable writes the substitution of the variable reference starting at
+// runes[start] (the dollar sign) and returns the index of its last rune.
+func (l lexer) variable(runes []rune, start int, out *strings.Builder) (int, error) {
+	if start+1 >= len(runes) {
+		out.WriteRune('$')
+
+		return start, nil
+	}
+
+	if runes[start+1] != '{' {
+		end := start + 1
+		for end < len(runes) && isNameRune(runes[end], end == start+1) {
+			end++
+		}
+
+		if end == start+1 {
+			out.WriteRune('$')
+
+			return start, nil
+		}
+
+		name := string(runes[start+1 : end])
+		if value, ok := l.vars[name]; ok {
+			out.WriteString(value)
+		} else {
+			out.WriteString(string(runes[start:end]))
+		}
+
+		return end - 1, nil
+	}
+
+	end := indexRune(runes, start+2, '}')
+	if end < 0 {
+		return 0, errors.New("unterminated variable reference")
+	}
+
+	expr := string(runes[start+2 : end])
+	name, modifier, word := expr, "", ""
+	if idx := strings.IndexByte(expr, ':'); idx >= 0 {
+		name, modifier = expr[:idx], expr[idx:]
+		if len(modifier) < 2 || (modifier[1] != '-' && modifier[1] != '+') {
+			return 0, fmt.Errorf("unsupported variable substitution '${%s}'", expr)
+		}
+
+		modifier, word = modifier[:2], modifier[2:]
+	}
+
+	if name == "" || !isName(name) {
+		return 0, fmt.Errorf("invalid variable reference '${%s}'", expr)
+	}
+
+	value, ok := l.vars[name]
+	switch {
+	case modifier == ":-" && (!ok || value == ""):
+		expanded, err := l.word(word)
+		if err != nil {
+			return 0, err
+		}
+
+		out.WriteString(expanded)
+	case modifier == ":+":
+		if ok && value != "" {
+			expanded, err := l.word(word)
+			if err != nil {
+				return 0, err
+			}
+
+			out.WriteString(expanded)
+		}
+	case ok:
+		out.WriteString(value)
+	default:
+		out.WriteString(string(runes[start : end+1]))
+	}
+
+	return end, nil
+}
+
+func indexRune(runes []rune, start int, r rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isNameRune(r rune, first bool) bool {
+	if r == '_' || unicode.IsLetter(r) {
+		return true
+	}
+
+	return !first && unicode.IsDigit(r)
+}
+
+func isName(s string) bool {
+	for i, r := range s {
+		if !isNameRune(r, i == 0) {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// shellJoin joins exec form arguments into a shell command, quoting the
+// arguments the shell would otherwise split or interpret.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	safe := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_@%+=:,./-", r)
+	}) < 0
+	if safe {
+		return s
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,179 @@
+package dockerfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// heredocPattern matches a BuildKit heredoc marker such as <<EOF or <<-"EOF",
+// but not the <<< here-string.
+var heredocPattern = regexp.MustCompile(`(^|\s)<<-?["']?[A-Za-z_]`)
+
+type instruction struct {
+	// line is the 1-based line the instruction starts on.
+	line int
+	// cmd is the upper-cased instruction keyword.
+	cmd string
+	// flags are the leading --name=value options, in order.
+	flags []flag
+	// args is the rest of the instruction after the keyword and flags.
+	args string
+}
+
+type flag struct {
+	name  string
+	value string
+}
+
+// execForm returns the arguments of a JSON (exec) form instruction. ok is
+// false for the shell form.
+func (in instruction) execForm() (args []string, ok bool) {
+	if !strings.HasPrefix(in.args, "[") {
+		return nil, false
+	}
+
+	if err := json.Unmarshal([]byte(in.args), &args); err != nil {
+		// Like Docker, anything that isn't a valid JSON array of strings is
+		// treated as the shell form.
+		return nil, false
+	}
+
+	return args, true
+}
+
+// instructionsWithFlags lists the instructions whose leading --options are
+// split off into flags.
+var instructionsWithFlags = map[string]bool{
+	"FROM":        true,
+	"RUN":         true,
+	"COPY":        true,
+	"ADD":         true,
+	"HEALTHCHECK": true,
+}
+
+// split splits the Dockerfile into instructions, handling the escape parser
+// directive, comments and line continuations. It returns the escape character
+// in effect.
+func split(content string) ([]instruction, rune, error) {
+	escape := defaultEscape
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	// Parser directives are only recognized at the very top of the file.
+	directives := true
+
+	var (
+		instructions []instruction
+		current      strings.Builder
+		startLine    int
+	)
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if directives {
+			if name, value, ok := parseDirective(trimmed); ok {
+				if name == "escape" {
+					if value != `\` && value != "`" {
+						return nil, 0, fmt.Errorf("line %d: invalid escape directive '%s'", i+1, value)
+					}
+
+					escape = []rune(value)[0]
+				}
+
+				continue
+			}
+
+			directives = false
+		}
+
+		// Comments and empty lines are skipped, also within continuations.
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if current.Len() == 0 {
+			startLine = i + 1
+		}
+
+		continued := strings.HasSuffix(strings.TrimRightFunc(line, unicode.IsSpace), string(escape))
+		if continued {
+			line = strings.TrimRightFunc(line, unicode.IsSpace)
+			current.WriteString(line[:len(line)-len(string(escape))])
+
+			continue
+		}
+
+		current.WriteString(line)
+
+		in, err := parseInstruction(startLine, current.String())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		instructions = append(instructions, in)
+		current.Reset()
+	}
+
+	if current.Len() > 0 {
+		in, err := parseInstruction(startLine, current.String())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		instructions = append(instructions, in)
+	}
+
+	return instructions, escape, nil
+}
+
+// parseDirective parses a "# name=value" parser directive line.
+func parseDirective(line string) (name, value string, ok bool) {
+	rest, found := strings.CutPrefix(line, "#")
+	if !found {
+		return "", "", false
+	}
+
+	name, value, found = strings.Cut(strings.TrimSpace(rest), "=")
+	if !found || strings.ContainsFunc(name, unicode.IsSpace) {
+		return "", "", false
+	}
+
+	return strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value), true
+}
+
+func parseInstruction(line int, text string) (instruction, error) {
+	text = strings.TrimSpace(text)
+
+	keyword, rest, _ := strings.Cut(text, " ")
+	if tab := strings.IndexByte(keyword, '\t'); tab >= 0 {
+		keyword, rest = keyword[:tab], keyword[tab+1:]+" "+rest
+	}
+
+	in := instruction{
+		line: line,
+		cmd:  strings.ToUpper(keyword),
+		args: strings.TrimSpace(rest),
+	}
+
+	if instructionsWithFlags[in.cmd] {
+		for strings.HasPrefix(in.args, "--") {
+			option, remaining, _ := strings.Cut(in.args, " ")
+			name, value, _ := strings.Cut(strings.TrimPrefix(option, "--"), "=")
+
+			in.flags = append(in.flags, flag{name: strings.ToLower(name), value: value})
+			in.args = strings.TrimSpace(remaining)
+		}
+	}
+
+	switch in.cmd {
+	case "RUN", "COPY", "ADD":
+		if heredocPattern.MatchString(in.args) {
+			return instruction{}, fmt.Errorf("line %d: %s: heredocs are not supported", line, in.cmd)
+		}
+	}
+
+	return in, nil
+}
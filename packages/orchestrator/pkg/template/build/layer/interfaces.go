@@ -47,3 +47,14 @@ type LayerBuildCommand struct {
 	// template_build for the final layer, template_build_cache for intermediates.
 	BuildOrigin storage.ObjectOrigin
 }
+
+// LayerRunCommand encapsulates all parameters needed for running an action in
+// a layer's sandbox without building a new layer
+type LayerRunCommand struct {
+	SourceTemplate SourceTemplateProvider
+	// Layer is the metadata of the source layer, passed to the action.
+	Layer          metadata.Template
+	UpdateEnvd     bool
+	SandboxCreator SandboxCreator
+	ActionExecutor ActionExecutor
+}
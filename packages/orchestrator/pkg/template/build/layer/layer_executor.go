@@ -84,19 +84,70 @@ func (lb *LayerExecutor) BuildLayer(
 	ctx, childSpan := tracer.Start(ctx, "run-in-sandbox")
 	defer childSpan.End()
 
-	localTemplate, err := cmd.SourceTemplate.Get(ctx, lb.templateCache)
+	sbx, closeSandbox, err := lb.startSandbox(ctx, userLogger, cmd.SourceTemplate, cmd.SandboxCreator, cmd.UpdateEnvd)
 	if err != nil {
-		return metadata.Template{}, fmt.Errorf("get template snapshot: %w", err)
+		return metadata.Template{}, err
 	}
+	defer closeSandbox()
 
-	// Create or resume sandbox
-	sbx, err := cmd.SandboxCreator.Sandbox(ctx, lb, localTemplate)
+	// Execute the action using the executor
+	meta, err := cmd.ActionExecutor.Execute(ctx, sbx, cmd.CurrentLayer)
 	if err != nil {
+		lb.logger.Error(
+			ctx,
+			"error executing action",
+			logger.WithSandboxID(sbx.Runtime.SandboxID),
+			logger.WithExecutionID(sbx.Runtime.ExecutionID),
+			zap.Error(err),
+		)
+
 		return metadata.Template{}, err
 	}
-	defer sbx.Close(ctx)
 
-	defer func() {
+	// Prepare metadata
+	meta = meta.NewVersionTemplate(metadata.TemplateMetadata{
+		BuildID:            cmd.CurrentLayer.Template.BuildID,
+		KernelVersion:      sbx.Config.FirecrackerConfig.KernelVersion,
+		FirecrackerVersion: sbx.Config.FirecrackerConfig.FirecrackerVersion,
+	})
+	err = lb.PauseAndUpload(
+		ctx,
+		userLogger,
+		sbx,
+		cmd.Hash,
+		meta,
+		cmd.BuildOrigin,
+	)
+	if err != nil {
+		return metadata.Template{}, fmt.Errorf("pause and upload: %w", err)
+	}
+
+	return meta, nil
+}
+
+// startSandbox creates or resumes the sandbox for running a layer's action.
+// The returned function closes the sandbox.
+func (lb *LayerExecutor) startSandbox(
+	ctx context.Context,
+	userLogger logger.Logger,
+	sourceTemplate SourceTemplateProvider,
+	sandboxCreator SandboxCreator,
+	updateEnvd bool,
+) (*sandbox.Sandbox, func(), error) {
+	localTemplate, err := sourceTemplate.Get(ctx, lb.templateCache)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get template snapshot: %w", err)
+	}
+
+	// Create or resume sandbox
+	sbx, err := sandboxCreator.Sandbox(ctx, lb, localTemplate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeSandbox := func() {
+		defer sbx.Close(ctx)
+
 		closeErr := lb.proxy.RemoveFromPool(sbx.LifecycleID)
 		if closeErr != nil {
 			// Errors here will be from forcefully closing the connections, so we can ignore them—they will at worst timeout on their own.
@@ -109,10 +160,10 @@ func (lb *LayerExecutor) BuildLayer(
 				logger.WithExecutionID(sbx.Runtime.ExecutionID),
 			)
 		}
-	}()
+	}
 
 	// Update envd binary to the latest version
-	if cmd.UpdateEnvd {
+	if updateEnvd {
 		err = lb.updateEnvdInSandbox(ctx, userLogger, sbx)
 		if err != nil {
 			lb.logger.Error(
@@ -123,43 +174,34 @@ func (lb *LayerExecutor) BuildLayer(
 				zap.Error(err),
 			)
 
-			return metadata.Template{}, fmt.Errorf("update envd: %w", err)
+			closeSandbox()
+
+			return nil, nil, fmt.Errorf("update envd: %w", err)
 		}
 	}
 
-	// Execute the action using the executor
-	meta, err := cmd.ActionExecutor.Execute(ctx, sbx, cmd.CurrentLayer)
-	if err != nil {
-		lb.logger.Error(
-			ctx,
-			"error executing action",
-			logger.WithSandboxID(sbx.Runtime.SandboxID),
-			logger.WithExecutionID(sbx.Runtime.ExecutionID),
-			zap.Error(err),
-		)
+	return sbx, closeSandbox, nil
+}
 
-		return metadata.Template{}, err
-	}
+// RunInSandbox runs the action in a sandbox started from the source template,
+// without building a new layer. The sandbox is discarded afterwards.
+func (lb *LayerExecutor) RunInSandbox(
+	ctx context.Context,
+	userLogger logger.Logger,
+	cmd LayerRunCommand,
+) error {
+	ctx, childSpan := tracer.Start(ctx, "run-in-sandbox-without-layer")
+	defer childSpan.End()
 
-	// Prepare metadata
-	meta = meta.NewVersionTemplate(metadata.TemplateMetadata{
-		BuildID:            cmd.CurrentLayer.Template.BuildID,
-		KernelVersion:      sbx.Config.FirecrackerConfig.KernelVersion,
-		FirecrackerVersion: sbx.Config.FirecrackerConfig.FirecrackerVersion,
-	})
-	err = lb.PauseAndUpload(
-		ctx,
-		userLogger,
-		sbx,
-		cmd.Hash,
-		meta,
-		cmd.BuildOrigin,
-	)
+	sbx, closeSandbox, err := lb.startSandbox(ctx, userLogger, cmd.SourceTemplate, cmd.SandboxCreator, cmd.UpdateEnvd)
 	if err != nil {
-		return metadata.Template{}, fmt.Errorf("pause and upload: %w", err)
+		return err
 	}
+	defer closeSandbox()
 
-	return meta, nil
+	_, err = cmd.ActionExecutor.Execute(ctx, sbx, cmd.Layer)
+
+	return err
 }
 
 // updateEnvdInSandbox updates the envd binary in the sandbox to the latest version.
@@ -15,11 +15,12 @@ import (
 type Phase string
 
 const (
-	PhaseBase       Phase = "base"
-	PhaseSteps      Phase = "steps"
-	PhaseResizeDisk Phase = "resize-disk"
-	PhaseFinalize   Phase = "finalize"
-	PhaseOptimize   Phase = "optimize"
+	PhaseBase        Phase = "base"
+	PhaseSteps       Phase = "steps"
+	PhaseStageExport Phase = "stage-export"
+	PhaseResizeDisk  Phase = "resize-disk"
+	PhaseFinalize    Phase = "finalize"
+	PhaseOptimize    Phase = "optimize"
 )
 
 // BuildResultType represents the type of build result
@@ -100,7 +100,7 @@ func New(
 }
 
 func (bb *BaseBuilder) Prefix() string {
-	return "base"
+	return bb.StagePrefix("base")
 }
 
 func (bb *BaseBuilder) String(ctx context.Context) (string, error) {
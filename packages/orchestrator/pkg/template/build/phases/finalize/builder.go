@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -111,6 +113,11 @@ func (ppb *PostProcessingBuilder) Layer(
 		}
 	}
 
+	// Labels and exposed ports add to the ones of the template it is built from.
+	if len(ppb.Config.Labels) > 0 || len(ppb.Config.ExposedPorts) > 0 {
+		result.Image = mergeImage(result.Image, ppb.Config.Labels, ppb.Config.ExposedPorts)
+	}
+
 	// The final template is the one from the configuration
 	result.Template = metadata.TemplateMetadata{
 		BuildID:            ppb.Template.BuildID,
@@ -348,3 +355,20 @@ func (ppb *PostProcessingBuilder) postProcessingFn(userLogger logger.Logger) lay
 		return meta, nil
 	}
 }
+
+func mergeImage(base *metadata.Image, labels map[string]string, exposedPorts []string) *metadata.Image {
+	merged := &metadata.Image{Labels: make(map[string]string)}
+	if base != nil {
+		maps.Copy(merged.Labels, base.Labels)
+		merged.ExposedPorts = slices.Clone(base.ExposedPorts)
+	}
+
+	maps.Copy(merged.Labels, labels)
+	for _, port := range exposedPorts {
+		if !slices.Contains(merged.ExposedPorts, port) {
+			merged.ExposedPorts = append(merged.ExposedPorts, port)
+		}
+	}
+
+	return merged
+}
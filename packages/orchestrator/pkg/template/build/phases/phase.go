@@ -88,6 +88,23 @@ func Run(
 	return sourceLayer, nil
 }
 
+// Hash returns the hash of the last layer the builders would produce, without
+// building any of them. The phase hashes only depend on the hash of their
+// source layer, so they can be chained ahead of the build.
+func Hash(ctx context.Context, builders []BuilderPhase) (string, error) {
+	var hash string
+	for _, builder := range builders {
+		var err error
+
+		hash, err = builder.Hash(ctx, LayerResult{Hash: hash})
+		if err != nil {
+			return "", fmt.Errorf("getting hash: %w", err)
+		}
+	}
+
+	return hash, nil
+}
+
 // runPhase executes a single build phase (hash, cache lookup, and build) within
 // its own span so every phase, including cache hits, produces one span.
 func runPhase(
@@ -0,0 +1,290 @@
+//go:build linux
+
+// Package stage exports the files of a build stage, so the template and the
+// later stages can copy them with COPY --from.
+package stage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/layer"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/metrics"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/storage/cache"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/storage/paths"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	exportName    = "export"
+	exportTimeout = time.Hour
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/phases/stage")
+
+// ExportBuilder archives the sources the COPY --from steps copy out of the
+// stage. The archive is stored like the files uploaded for COPY, under the
+// phase's hash, so the steps copy it with the hash as their files hash.
+type ExportBuilder struct {
+	buildcontext.BuildContext
+
+	sources []string
+
+	proxy          *proxy.SandboxProxy
+	sandboxFactory *sandbox.Factory
+	layerExecutor  *layer.LayerExecutor
+	buildStorage   storage.StorageProvider
+	featureFlags   *featureflags.Client
+}
+
+func New(
+	buildContext buildcontext.BuildContext,
+	sources []string,
+	proxy *proxy.SandboxProxy,
+	sandboxFactory *sandbox.Factory,
+	layerExecutor *layer.LayerExecutor,
+	buildStorage storage.StorageProvider,
+	featureFlags *featureflags.Client,
+) *ExportBuilder {
+	return &ExportBuilder{
+		BuildContext: buildContext,
+
+		sources: sources,
+
+		proxy:          proxy,
+		sandboxFactory: sandboxFactory,
+		layerExecutor:  layerExecutor,
+		buildStorage:   buildStorage,
+		featureFlags:   featureFlags,
+	}
+}
+
+// Sources returns the sources the steps copy from the stage, in order.
+func Sources(stageName string, steps ...[]*templatemanager.TemplateStep) []string {
+	var sources []string
+	for _, step := range slices.Concat(steps...) {
+		if step.GetFromStage() != stageName || len(step.GetArgs()) == 0 {
+			continue
+		}
+
+		if source := step.GetArgs()[0]; !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+func (eb *ExportBuilder) Prefix() string {
+	return eb.StagePrefix(exportName)
+}
+
+func (eb *ExportBuilder) String(context.Context) (string, error) {
+	return fmt.Sprintf("EXPORT %s", strings.Join(eb.sources, " ")), nil
+}
+
+func (eb *ExportBuilder) Metadata() phases.PhaseMeta {
+	return phases.PhaseMeta{
+		Phase:    metrics.PhaseStageExport,
+		StepType: exportName,
+	}
+}
+
+func (eb *ExportBuilder) Hash(_ context.Context, sourceLayer phases.LayerResult) (string, error) {
+	return cache.HashKeys(
+		sourceLayer.Hash,
+		exportName,
+		strings.Join(eb.sources, " "),
+	), nil
+}
+
+// Exported reports whether the files of the export with the hash are stored
+// already. The stage doesn't have to be built then.
+func (eb *ExportBuilder) Exported(ctx context.Context, hash string) (bool, error) {
+	if eb.forced() {
+		return false, nil
+	}
+
+	obj, err := eb.buildStorage.OpenBlob(ctx, paths.GetLayerFilesCachePath(eb.CacheScope, hash))
+	if err != nil {
+		return false, fmt.Errorf("error opening exported files object: %w", err)
+	}
+
+	return obj.Exists(ctx)
+}
+
+// forced reports whether the stage is rebuilt regardless of the cache, in
+// which case its files are exported again too.
+func (eb *ExportBuilder) forced() bool {
+	if eb.Config.Force != nil && *eb.Config.Force {
+		return true
+	}
+
+	return slices.ContainsFunc(eb.Config.Steps, (*templatemanager.TemplateStep).GetForce)
+}
+
+func (eb *ExportBuilder) Layer(
+	ctx context.Context,
+	sourceLayer phases.LayerResult,
+	hash string,
+) (phases.LayerResult, error) {
+	exported, err := eb.Exported(ctx, hash)
+	if err != nil {
+		return phases.LayerResult{}, err
+	}
+
+	// The export doesn't change the stage, the layer stays the same.
+	return phases.LayerResult{
+		Metadata: sourceLayer.Metadata,
+		Cached:   exported,
+		Hash:     hash,
+	}, nil
+}
+
+func (eb *ExportBuilder) Build(
+	ctx context.Context,
+	userLogger logger.Logger,
+	_ string,
+	sourceLayer phases.LayerResult,
+	currentLayer phases.LayerResult,
+) (phases.LayerResult, error) {
+	ctx, span := tracer.Start(ctx, "export stage", trace.WithAttributes(
+		attribute.String("stage", eb.Stage),
+		attribute.String("hash", currentLayer.Hash),
+	))
+	defer span.End()
+
+	sbxConfig := sandbox.NewConfig(sandbox.Config{
+		Vcpu:              eb.Config.VCpuCount,
+		RamMB:             eb.Config.MemoryMB,
+		HugePages:         eb.Config.HugePages,
+		FreePageReporting: eb.Config.FreePageReporting,
+		FreePageHinting:   eb.Config.FreePageHinting,
+
+		Envd: sandbox.EnvdMetadata{
+			Version: eb.EnvdVersion,
+		},
+
+		FirecrackerConfig: fc.Config{
+			KernelVersion:      eb.Config.KernelVersion,
+			FirecrackerVersion: eb.Config.FirecrackerVersion,
+		},
+	})
+
+	var sandboxCreator layer.SandboxCreator
+	if sourceLayer.Cached {
+		sandboxCreator = layer.NewCreateSandbox(
+			sbxConfig,
+			eb.sandboxFactory,
+			exportTimeout,
+			layer.ReservedBlocksOptions(ctx, eb.featureFlags, eb.Config.RootfsBlockSize())...,
+		)
+	} else {
+		sandboxCreator = layer.NewResumeSandbox(sbxConfig, eb.sandboxFactory, exportTimeout)
+	}
+
+	actionExecutor := layer.NewFunctionAction(func(ctx context.Context, sbx *sandbox.Sandbox, meta metadata.Template) (metadata.Template, error) {
+		err := eb.export(ctx, userLogger, sbx, meta, currentLayer.Hash)
+		if err != nil {
+			return metadata.Template{}, err
+		}
+
+		return meta, nil
+	})
+
+	err := eb.layerExecutor.RunInSandbox(
+		ctx,
+		userLogger,
+		layer.LayerRunCommand{
+			SourceTemplate: layer.NewCacheSourceTemplateProvider(sourceLayer.Metadata.Template.BuildID),
+			Layer:          sourceLayer.Metadata,
+			UpdateEnvd:     sourceLayer.Cached,
+			SandboxCreator: sandboxCreator,
+			ActionExecutor: actionExecutor,
+		},
+	)
+	if err != nil {
+		return phases.LayerResult{}, fmt.Errorf("error exporting stage %s: %w", eb.Stage, err)
+	}
+
+	return phases.LayerResult{
+		Metadata: sourceLayer.Metadata,
+		Cached:   false,
+		Hash:     currentLayer.Hash,
+	}, nil
+}
+
+// export archives the sources in the sandbox, relative to the root, the same
+// way the files for COPY are archived by the SDKs.
+func (eb *ExportBuilder) export(
+	ctx context.Context,
+	userLogger logger.Logger,
+	sbx *sandbox.Sandbox,
+	meta metadata.Template,
+	hash string,
+) error {
+	sources := make([]string, len(eb.sources))
+	for i, source := range eb.sources {
+		sources[i] = fmt.Sprintf(`"%s"`, strings.TrimPrefix(filepath.Clean("/"+source), "/"))
+	}
+
+	sbxArchivePath := filepath.Join("/tmp", fmt.Sprintf("%s.tar.gz", hash))
+	err := sandboxtools.RunCommandWithLogger(
+		ctx,
+		eb.proxy,
+		userLogger,
+		zapcore.DebugLevel,
+		exportName,
+		sbx.Runtime.SandboxID,
+		fmt.Sprintf(`tar -czf "%s" -C / -- %s`, sbxArchivePath, strings.Join(sources, " ")),
+		meta.Context.WithUser("root"),
+	)
+	if err != nil {
+		return phases.NewPhaseBuildError(eb.Metadata(), fmt.Errorf("error archiving the files copied from the stage: %w", err))
+	}
+
+	archive, err := os.CreateTemp("", "stage-export-*.tar.gz")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for the stage export: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	archive.Close()
+
+	err = sandboxtools.DownloadFile(ctx, eb.proxy, sbx.Runtime.SandboxID, "root", sbxArchivePath, archive.Name())
+	if err != nil {
+		return fmt.Errorf("failed to download the stage export: %w", err)
+	}
+
+	err = storage.UploadBlob(
+		ctx,
+		eb.buildStorage,
+		paths.GetLayerFilesCachePath(eb.CacheScope, hash),
+		archive.Name(),
+		storage.WithMetadata(eb.Config.ObjectMetadata(storage.ObjectOriginTemplateBuildCache)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload the stage export: %w", err)
+	}
+
+	return nil
+}
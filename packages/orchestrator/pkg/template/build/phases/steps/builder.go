@@ -88,11 +88,16 @@ func New(
 }
 
 func (sb *StepBuilder) Prefix() string {
-	return fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps))
+	return sb.StagePrefix(fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps)))
 }
 
 func (sb *StepBuilder) String(context.Context) (string, error) {
-	return fmt.Sprintf("%s %s", strings.ToUpper(sb.step.GetType()), strings.Join(sb.step.GetArgs(), " ")), nil
+	cmd := strings.ToUpper(sb.step.GetType())
+	if sb.step.FromStage != nil {
+		cmd += " --from=" + sb.step.GetFromStage()
+	}
+
+	return fmt.Sprintf("%s %s", cmd, strings.Join(sb.step.GetArgs(), " ")), nil
 }
 
 func (sb *StepBuilder) Metadata() phases.PhaseMeta {
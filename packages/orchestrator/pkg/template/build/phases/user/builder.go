@@ -66,7 +66,7 @@ func New(
 }
 
 func (ub *UserBuilder) Prefix() string {
-	return "base"
+	return ub.StagePrefix("base")
 }
 
 func (ub *UserBuilder) String(_ context.Context) (string, error) {
@@ -75,6 +75,15 @@ type Start struct {
 	Context  Context `json:"context"`
 }
 
+// Image is the image configuration declared by the Dockerfile the template was
+// built from.
+type Image struct {
+	Labels map[string]string `json:"labels,omitempty"`
+	// ExposedPorts are hints of the ports the template listens on, as
+	// "port/protocol" or "start-end/protocol".
+	ExposedPorts []string `json:"exposed_ports,omitempty"`
+}
+
 type TemplateMetadata struct {
 	BuildID            string `json:"build_id"`
 	KernelVersion      string `json:"kernel_version"`
@@ -115,6 +124,7 @@ type Template struct {
 	Template     TemplateMetadata `json:"template"`
 	Context      Context          `json:"context"`
 	Start        *Start           `json:"start,omitempty"`
+	Image        *Image           `json:"image,omitempty"`
 	FromImage    *string          `json:"from_image,omitempty"`
 	FromTemplate *FromTemplate    `json:"from_template,omitempty"`
 	Prefetch     *Prefetch        `json:"prefetch,omitempty"`
@@ -167,6 +177,7 @@ func (t Template) BasedOn(
 		Template:     t.Template,
 		Context:      t.Context,
 		Start:        t.Start,
+		Image:        t.Image,
 		FromTemplate: &ft,
 		FromImage:    nil,
 	}
@@ -178,6 +189,7 @@ func (t Template) NewVersionTemplate(metadata TemplateMetadata) Template {
 		Template:     metadata,
 		Context:      t.Context,
 		Start:        t.Start,
+		Image:        t.Image,
 		FromTemplate: t.FromTemplate,
 		FromImage:    t.FromImage,
 	}
@@ -189,6 +201,7 @@ func (t Template) SameVersionTemplate(metadata TemplateMetadata) Template {
 		Template:     metadata,
 		Context:      t.Context,
 		Start:        t.Start,
+		Image:        t.Image,
 		FromTemplate: t.FromTemplate,
 		FromImage:    t.FromImage,
 	}
@@ -201,6 +214,7 @@ func (t Template) WithPrefetch(prefetch *Prefetch) Template {
 		Template:     t.Template,
 		Context:      t.Context,
 		Start:        t.Start,
+		Image:        t.Image,
 		FromTemplate: t.FromTemplate,
 		FromImage:    t.FromImage,
 		Prefetch:     prefetch,
@@ -4,18 +4,23 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/builderrors"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/buildlogger"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/core/oci/auth"
+	"github.com/e2b-dev/infra/packages/orchestrator/pkg/template/build/dockerfile"
 	"github.com/e2b-dev/infra/packages/shared/pkg/fcversion"
 	"github.com/e2b-dev/infra/packages/shared/pkg/featureflags"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
@@ -30,6 +35,11 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 
 	cfg := templateRequest.GetTemplate()
 
+	source, err := resolveTemplateSource(cfg)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	metadata := storage.Paths{
 		BuildID: cfg.GetBuildID(),
 	}
@@ -46,7 +56,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 	// TODO: Remove, temporary handling when version is not sent from the API
 	version := templateRequest.GetVersion()
 	if version == "" {
-		if cfg.GetFromImage() == "" && cfg.GetFromTemplate() == nil {
+		if source.fromImage == "" && cfg.GetFromTemplate() == nil {
 			version = templates.TemplateV1Version
 		} else {
 			version = templates.TemplateV2BetaVersion
@@ -75,7 +85,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		telemetry.WithBuildID(cfg.GetBuildID()),
 		telemetry.WithKernelVersion(kernelVersion),
 		telemetry.WithFirecrackerVersion(firecrackerVersion),
-		attribute.String("env.start_cmd", cfg.GetStartCommand()),
+		attribute.String("env.start_cmd", source.startCmd),
 		attribute.Int64("env.memory_mb", int64(cfg.GetMemoryMB())),
 		attribute.Int64("env.vcpu_count", int64(cfg.GetVCpuCount())),
 		attribute.Bool("env.huge_pages", hugePages),
@@ -90,17 +100,20 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		CacheScope:           cacheScope,
 		VCpuCount:            int64(cfg.GetVCpuCount()),
 		MemoryMB:             int64(cfg.GetMemoryMB()),
-		StartCmd:             cfg.GetStartCommand(),
-		ReadyCmd:             cfg.GetReadyCommand(),
+		StartCmd:             source.startCmd,
+		ReadyCmd:             source.readyCmd,
 		DiskSizeMB:           int64(cfg.GetDiskSizeMB()),
 		HugePages:            hugePages,
 		FreePageReporting:    freePageReporting,
 		FreePageHinting:      freePageHinting,
-		FromImage:            cfg.GetFromImage(),
+		FromImage:            source.fromImage,
 		FromTemplate:         cfg.GetFromTemplate(),
 		RegistryAuthProvider: authProvider,
 		Force:                cfg.Force,
-		Steps:                cfg.GetSteps(),
+		Steps:                source.steps,
+		Stages:               source.stages,
+		Labels:               source.labels,
+		ExposedPorts:         source.exposedPorts,
 		KernelVersion:        kernelVersion,
 		FirecrackerVersion:   firecrackerVersion,
 	}
@@ -190,3 +203,107 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 
 	return nil, nil
 }
+
+// templateSource is what the template is built from. A Dockerfile is
+// translated into the base image, steps and commands it describes.
+type templateSource struct {
+	fromImage    string
+	fromTemplate *templatemanager.FromTemplateConfig
+	steps        []*templatemanager.TemplateStep
+	stages       []*templatemanager.TemplateStage
+	startCmd     string
+	readyCmd     string
+	labels       map[string]string
+	exposedPorts []string
+}
+
+func resolveTemplateSource(cfg *templatemanager.TemplateConfig) (templateSource, error) {
+	source := templateSource{
+		fromImage:    cfg.GetFromImage(),
+		fromTemplate: cfg.GetFromTemplate(),
+		steps:        cfg.GetSteps(),
+		stages:       cfg.GetStages(),
+		startCmd:     cfg.GetStartCommand(),
+		readyCmd:     cfg.GetReadyCommand(),
+	}
+
+	df := cfg.GetDockerfile()
+	if df == nil {
+		return source, validateStages(source)
+	}
+
+	if len(source.steps) > 0 || len(source.stages) > 0 {
+		return templateSource{}, errors.New("steps and stages cannot be combined with a Dockerfile")
+	}
+
+	parsed, err := dockerfile.Parse(df.GetContent(), dockerfile.Options{
+		BuildArgs: df.GetBuildArgs(),
+		FilesHash: df.GetFilesHash(),
+	})
+	if err != nil {
+		return templateSource{}, fmt.Errorf("invalid Dockerfile: %w", err)
+	}
+
+	source.fromImage = parsed.FromImage
+	source.steps = parsed.Steps
+	source.stages = parsed.Stages
+	source.labels = parsed.Labels
+	source.exposedPorts = parsed.ExposedPorts
+
+	// Explicitly set commands take precedence over the Dockerfile.
+	if source.startCmd == "" {
+		source.startCmd = parsed.StartCmd
+	}
+
+	if source.readyCmd == "" {
+		source.readyCmd = parsed.ReadyCmd
+	}
+
+	return source, validateStages(source)
+}
+
+// validateStages checks that the stages have a source, and that COPY steps
+// only copy from the stages built before them.
+func validateStages(source templateSource) error {
+	if len(source.stages) > 0 && source.fromImage == "" && source.fromTemplate == nil {
+		return errors.New("stages require the template to be built from an image or a template")
+	}
+
+	built := make(map[string]bool, len(source.stages))
+	checkSteps := func(steps []*templatemanager.TemplateStep) error {
+		for _, step := range steps {
+			if step.FromStage == nil {
+				continue
+			}
+
+			if !strings.EqualFold(step.GetType(), "COPY") {
+				return fmt.Errorf("only COPY can copy from stage '%s'", step.GetFromStage())
+			}
+
+			if !built[step.GetFromStage()] {
+				return fmt.Errorf("stage '%s' must be defined before it's copied from", step.GetFromStage())
+			}
+		}
+
+		return nil
+	}
+
+	for _, stage := range source.stages {
+		switch {
+		case stage.GetName() == "":
+			return errors.New("stage name is required")
+		case built[stage.GetName()]:
+			return fmt.Errorf("duplicate stage '%s'", stage.GetName())
+		case stage.GetFromImage() == "" && stage.GetFromTemplate() == nil:
+			return fmt.Errorf("stage '%s' requires an image or a template to be built from", stage.GetName())
+		}
+
+		if err := checkSteps(stage.GetSteps()); err != nil {
+			return err
+		}
+
+		built[stage.GetName()] = true
+	}
+
+	return checkSteps(source.steps)
+}
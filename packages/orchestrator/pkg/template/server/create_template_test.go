@@ -0,0 +1,110 @@
+//go:build linux
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func TestResolveTemplateSource_DockerfileStages(t *testing.T) {
+	t.Parallel()
+
+	source, err := resolveTemplateSource(&template_manager.TemplateConfig{
+		Source: &template_manager.TemplateConfig_Dockerfile{
+			Dockerfile: &template_manager.DockerfileConfig{
+				Content: "FROM golang AS build\nRUN go build -o /out/app .\nFROM debian\nCOPY --from=build /out/app /usr/bin/app",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "debian", source.fromImage)
+	require.Len(t, source.stages, 1)
+	assert.Equal(t, "build", source.stages[0].GetName())
+	require.Len(t, source.steps, 1)
+	assert.Equal(t, "build", source.steps[0].GetFromStage())
+}
+
+func TestResolveTemplateSource_InvalidStages(t *testing.T) {
+	t.Parallel()
+
+	copyFrom := func(stage string) *template_manager.TemplateStep {
+		return &template_manager.TemplateStep{Type: "COPY", Args: []string{"/out", "/out"}, FromStage: &stage}
+	}
+	stage := func(name string, steps ...*template_manager.TemplateStep) *template_manager.TemplateStage {
+		return &template_manager.TemplateStage{
+			Name:   name,
+			Source: &template_manager.TemplateStage_FromImage{FromImage: "golang"},
+			Steps:  steps,
+		}
+	}
+
+	tests := []struct {
+		name string
+		cfg  *template_manager.TemplateConfig
+		err  string
+	}{
+		{
+			name: "unknown stage",
+			cfg: &template_manager.TemplateConfig{
+				Source: &template_manager.TemplateConfig_FromImage{FromImage: "debian"},
+				Steps:  []*template_manager.TemplateStep{copyFrom("build")},
+			},
+			err: "stage 'build' must be defined before it's copied from",
+		},
+		{
+			name: "later stage",
+			cfg: &template_manager.TemplateConfig{
+				Source: &template_manager.TemplateConfig_FromImage{FromImage: "debian"},
+				Stages: []*template_manager.TemplateStage{stage("a", copyFrom("b")), stage("b")},
+			},
+			err: "stage 'b' must be defined before it's copied from",
+		},
+		{
+			name: "duplicate stage",
+			cfg: &template_manager.TemplateConfig{
+				Source: &template_manager.TemplateConfig_FromImage{FromImage: "debian"},
+				Stages: []*template_manager.TemplateStage{stage("a"), stage("a")},
+			},
+			err: "duplicate stage 'a'",
+		},
+		{
+			name: "stage without source",
+			cfg: &template_manager.TemplateConfig{
+				Source: &template_manager.TemplateConfig_FromImage{FromImage: "debian"},
+				Stages: []*template_manager.TemplateStage{{Name: "a"}},
+			},
+			err: "stage 'a' requires an image or a template",
+		},
+		{
+			name: "v1 template",
+			cfg: &template_manager.TemplateConfig{
+				Stages: []*template_manager.TemplateStage{stage("a")},
+			},
+			err: "stages require the template to be built from an image or a template",
+		},
+		{
+			name: "run from stage",
+			cfg: &template_manager.TemplateConfig{
+				Source: &template_manager.TemplateConfig_FromImage{FromImage: "debian"},
+				Stages: []*template_manager.TemplateStage{stage("a")},
+				Steps:  []*template_manager.TemplateStep{{Type: "RUN", Args: []string{"ls"}, FromStage: new("a")}},
+			},
+			err: "only COPY can copy from stage 'a'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := resolveTemplateSource(tc.cfg)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+//go:build linux
+
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func (s *ServerStore) TemplateBuildReferences(ctx context.Context, in *templatemanager.TemplateBuildReferencesRequest) (*templatemanager.TemplateBuildReferencesResponse, error) {
+	ctx, span := tracer.Start(ctx, "template-build-references-request")
+	defer span.End()
+
+	references := make([]*templatemanager.TemplateBuildReference, 0, len(in.GetBuildIDs()))
+	for _, buildID := range in.GetBuildIDs() {
+		id, err := uuid.Parse(buildID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid build id '%s': %s", buildID, err)
+		}
+
+		live, err := header.LiveReferrers(ctx, s.templateStorage, id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to look up references of build '%s': %s", buildID, err)
+		}
+
+		referrers := make([]string, len(live))
+		for i, referrer := range live {
+			referrers[i] = referrer.String()
+		}
+
+		references = append(references, &templatemanager.TemplateBuildReference{
+			BuildID:          buildID,
+			ReferrerBuildIDs: referrers,
+		})
+	}
+
+	return &templatemanager.TemplateBuildReferencesResponse{References: references}, nil
+}
@@ -5,24 +5,51 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
 var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/pkg/template/template")
 
+// Delete removes the build's objects and image. It refuses with
+// header.ErrBuildReferenced while a live header of another build still maps
+// into this one.
 func Delete(ctx context.Context, artifactRegistry artifactsregistry.ArtifactsRegistry, templateStorage storage.StorageProvider, templateId string, buildId string) error {
 	childCtx, childSpan := tracer.Start(ctx, "delete-template")
 	defer childSpan.End()
 
-	err := templateStorage.DeleteObjectsWithPrefix(ctx, buildId)
+	id, err := uuid.Parse(buildId)
+	if err != nil {
+		return fmt.Errorf("invalid build id '%s': %w", buildId, err)
+	}
+
+	err = header.CheckUnreferenced(childCtx, templateStorage, id)
+	if err != nil {
+		return err
+	}
+
+	// The markers are found through the build's headers, so they go first.
+	err = header.ForgetReferences(childCtx, templateStorage, id)
+	if err != nil {
+		return fmt.Errorf("error when deleting references of the template: %w", err)
+	}
+
+	err = templateStorage.DeleteObjectsWithPrefix(ctx, buildId)
 	if err != nil {
 		return fmt.Errorf("error when deleting template objects: %w", err)
 	}
 
+	// Every remaining referrer is stale now, drop the build's index directory.
+	err = templateStorage.DeleteObjectsWithPrefix(ctx, header.ReferencesDir(id))
+	if err != nil {
+		return fmt.Errorf("error when deleting template references: %w", err)
+	}
+
 	err = artifactRegistry.Delete(childCtx, templateId, buildId)
 	if err != nil {
 		// snapshot build are not stored in docker repository
@@ -3,7 +3,7 @@ package consts
 const (
 	DefaultEnvdServerPort int64 = 49983
 
-	// SystemTag opts a process into envd's root cgroup (no user/pty/socat).
+	// SystemTag opts a process into envd's root cgroup (no user/pty).
 	// Used for maintenance commands that must outlive cgroup freezing.
 	SystemTag = "_system"
 )
@@ -301,6 +301,11 @@ var (
 	// timeout from guest RAM; a positive value pins it.
 	GuestSyncTimeoutMs            = NewIntFlag("guest-sync-timeout-milliseconds", 0)
 	MaxCacheWriterConcurrencyFlag = NewIntFlag("max-cache-writer-concurrency", 10)
+	// MemoryHotplugSizeMB is the size of the virtio-mem region attached to the
+	// VMs of new template builds, bounding how much running sandboxes can grow
+	// their memory. 0 (default) builds templates without the region, so their
+	// sandboxes can only shrink their memory or grow it back to the template size.
+	MemoryHotplugSizeMB = NewIntFlag("memory-hotplug-size-mb", 0)
 
 	// BuildCacheMaxUsagePercentage the maximum percentage of the cache disk storage
 	// that can be used before the cache starts evicting items.
@@ -43,6 +43,16 @@ const (
 	FilesystemListDirProcedure = "/filesystem.Filesystem/ListDir"
 	// FilesystemRemoveProcedure is the fully-qualified name of the Filesystem's Remove RPC.
 	FilesystemRemoveProcedure = "/filesystem.Filesystem/Remove"
+	// FilesystemEditFileProcedure is the fully-qualified name of the Filesystem's EditFile RPC.
+	FilesystemEditFileProcedure = "/filesystem.Filesystem/EditFile"
+	// FilesystemFindProcedure is the fully-qualified name of the Filesystem's Find RPC.
+	FilesystemFindProcedure = "/filesystem.Filesystem/Find"
+	// FilesystemGrepProcedure is the fully-qualified name of the Filesystem's Grep RPC.
+	FilesystemGrepProcedure = "/filesystem.Filesystem/Grep"
+	// FilesystemChecksumProcedure is the fully-qualified name of the Filesystem's Checksum RPC.
+	FilesystemChecksumProcedure = "/filesystem.Filesystem/Checksum"
+	// FilesystemDiffManifestProcedure is the fully-qualified name of the Filesystem's DiffManifest RPC.
+	FilesystemDiffManifestProcedure = "/filesystem.Filesystem/DiffManifest"
 	// FilesystemWatchDirProcedure is the fully-qualified name of the Filesystem's WatchDir RPC.
 	FilesystemWatchDirProcedure = "/filesystem.Filesystem/WatchDir"
 	// FilesystemCreateWatcherProcedure is the fully-qualified name of the Filesystem's CreateWatcher
@@ -63,6 +73,16 @@ type FilesystemClient interface {
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	// EditFile atomically applies a list of text edits to a file.
+	EditFile(context.Context, *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error)
+	// Find returns the entries under a directory matching the filters.
+	Find(context.Context, *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error)
+	// Grep streams the lines of the files under a path matching a regular expression.
+	Grep(context.Context, *connect.Request[filesystem.GrepRequest]) (*connect.ServerStreamForClient[filesystem.GrepResponse], error)
+	// Checksum returns the SHA-256 of the regular files under a path.
+	Checksum(context.Context, *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error)
+	// DiffManifest compares a manifest of files with the files under a directory.
+	DiffManifest(context.Context, *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error)
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
@@ -111,6 +131,36 @@ func NewFilesystemClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(filesystemMethods.ByName("Remove")),
 			connect.WithClientOptions(opts...),
 		),
+		editFile: connect.NewClient[filesystem.EditFileRequest, filesystem.EditFileResponse](
+			httpClient,
+			baseURL+FilesystemEditFileProcedure,
+			connect.WithSchema(filesystemMethods.ByName("EditFile")),
+			connect.WithClientOptions(opts...),
+		),
+		find: connect.NewClient[filesystem.FindRequest, filesystem.FindResponse](
+			httpClient,
+			baseURL+FilesystemFindProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Find")),
+			connect.WithClientOptions(opts...),
+		),
+		grep: connect.NewClient[filesystem.GrepRequest, filesystem.GrepResponse](
+			httpClient,
+			baseURL+FilesystemGrepProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Grep")),
+			connect.WithClientOptions(opts...),
+		),
+		checksum: connect.NewClient[filesystem.ChecksumRequest, filesystem.ChecksumResponse](
+			httpClient,
+			baseURL+FilesystemChecksumProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Checksum")),
+			connect.WithClientOptions(opts...),
+		),
+		diffManifest: connect.NewClient[filesystem.DiffManifestRequest, filesystem.DiffManifestResponse](
+			httpClient,
+			baseURL+FilesystemDiffManifestProcedure,
+			connect.WithSchema(filesystemMethods.ByName("DiffManifest")),
+			connect.WithClientOptions(opts...),
+		),
 		watchDir: connect.NewClient[filesystem.WatchDirRequest, filesystem.WatchDirResponse](
 			httpClient,
 			baseURL+FilesystemWatchDirProcedure,
@@ -145,6 +195,11 @@ type filesystemClient struct {
 	move             *connect.Client[filesystem.MoveRequest, filesystem.MoveResponse]
 	listDir          *connect.Client[filesystem.ListDirRequest, filesystem.ListDirResponse]
 	remove           *connect.Client[filesystem.RemoveRequest, filesystem.RemoveResponse]
+	editFile         *connect.Client[filesystem.EditFileRequest, filesystem.EditFileResponse]
+	find             *connect.Client[filesystem.FindRequest, filesystem.FindResponse]
+	grep             *connect.Client[filesystem.GrepRequest, filesystem.GrepResponse]
+	checksum         *connect.Client[filesystem.ChecksumRequest, filesystem.ChecksumResponse]
+	diffManifest     *connect.Client[filesystem.DiffManifestRequest, filesystem.DiffManifestResponse]
 	watchDir         *connect.Client[filesystem.WatchDirRequest, filesystem.WatchDirResponse]
 	createWatcher    *connect.Client[filesystem.CreateWatcherRequest, filesystem.CreateWatcherResponse]
 	getWatcherEvents *connect.Client[filesystem.GetWatcherEventsRequest, filesystem.GetWatcherEventsResponse]
@@ -176,6 +231,31 @@ func (c *filesystemClient) Remove(ctx context.Context, req *connect.Request[file
 	return c.remove.CallUnary(ctx, req)
 }
 
+// EditFile calls filesystem.Filesystem.EditFile.
+func (c *filesystemClient) EditFile(ctx context.Context, req *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error) {
+	return c.editFile.CallUnary(ctx, req)
+}
+
+// Find calls filesystem.Filesystem.Find.
+func (c *filesystemClient) Find(ctx context.Context, req *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error) {
+	return c.find.CallUnary(ctx, req)
+}
+
+// Grep calls filesystem.Filesystem.Grep.
+func (c *filesystemClient) Grep(ctx context.Context, req *connect.Request[filesystem.GrepRequest]) (*connect.ServerStreamForClient[filesystem.GrepResponse], error) {
+	return c.grep.CallServerStream(ctx, req)
+}
+
+// Checksum calls filesystem.Filesystem.Checksum.
+func (c *filesystemClient) Checksum(ctx context.Context, req *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error) {
+	return c.checksum.CallUnary(ctx, req)
+}
+
+// DiffManifest calls filesystem.Filesystem.DiffManifest.
+func (c *filesystemClient) DiffManifest(ctx context.Context, req *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error) {
+	return c.diffManifest.CallUnary(ctx, req)
+}
+
 // WatchDir calls filesystem.Filesystem.WatchDir.
 func (c *filesystemClient) WatchDir(ctx context.Context, req *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error) {
 	return c.watchDir.CallServerStream(ctx, req)
@@ -203,6 +283,16 @@ type FilesystemHandler interface {
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	// EditFile atomically applies a list of text edits to a file.
+	EditFile(context.Context, *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error)
+	// Find returns the entries under a directory matching the filters.
+	Find(context.Context, *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error)
+	// Grep streams the lines of the files under a path matching a regular expression.
+	Grep(context.Context, *connect.Request[filesystem.GrepRequest], *connect.ServerStream[filesystem.GrepResponse]) error
+	// Checksum returns the SHA-256 of the regular files under a path.
+	Checksum(context.Context, *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error)
+	// DiffManifest compares a manifest of files with the files under a directory.
+	DiffManifest(context.Context, *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
@@ -247,6 +337,36 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(filesystemMethods.ByName("Remove")),
 		connect.WithHandlerOptions(opts...),
 	)
+	filesystemEditFileHandler := connect.NewUnaryHandler(
+		FilesystemEditFileProcedure,
+		svc.EditFile,
+		connect.WithSchema(filesystemMethods.ByName("EditFile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemFindHandler := connect.NewUnaryHandler(
+		FilesystemFindProcedure,
+		svc.Find,
+		connect.WithSchema(filesystemMethods.ByName("Find")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemGrepHandler := connect.NewServerStreamHandler(
+		FilesystemGrepProcedure,
+		svc.Grep,
+		connect.WithSchema(filesystemMethods.ByName("Grep")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemChecksumHandler := connect.NewUnaryHandler(
+		FilesystemChecksumProcedure,
+		svc.Checksum,
+		connect.WithSchema(filesystemMethods.ByName("Checksum")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemDiffManifestHandler := connect.NewUnaryHandler(
+		FilesystemDiffManifestProcedure,
+		svc.DiffManifest,
+		connect.WithSchema(filesystemMethods.ByName("DiffManifest")),
+		connect.WithHandlerOptions(opts...),
+	)
 	filesystemWatchDirHandler := connect.NewServerStreamHandler(
 		FilesystemWatchDirProcedure,
 		svc.WatchDir,
@@ -283,6 +403,16 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 			filesystemListDirHandler.ServeHTTP(w, r)
 		case FilesystemRemoveProcedure:
 			filesystemRemoveHandler.ServeHTTP(w, r)
+		case FilesystemEditFileProcedure:
+			filesystemEditFileHandler.ServeHTTP(w, r)
+		case FilesystemFindProcedure:
+			filesystemFindHandler.ServeHTTP(w, r)
+		case FilesystemGrepProcedure:
+			filesystemGrepHandler.ServeHTTP(w, r)
+		case FilesystemChecksumProcedure:
+			filesystemChecksumHandler.ServeHTTP(w, r)
+		case FilesystemDiffManifestProcedure:
+			filesystemDiffManifestHandler.ServeHTTP(w, r)
 		case FilesystemWatchDirProcedure:
 			filesystemWatchDirHandler.ServeHTTP(w, r)
 		case FilesystemCreateWatcherProcedure:
@@ -320,6 +450,26 @@ func (UnimplementedFilesystemHandler) Remove(context.Context, *connect.Request[f
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Remove is not implemented"))
 }
 
+func (UnimplementedFilesystemHandler) EditFile(context.Context, *connect.Request[filesystem.EditFileRequest]) (*connect.Response[filesystem.EditFileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.EditFile is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Find(context.Context, *connect.Request[filesystem.FindRequest]) (*connect.Response[filesystem.FindResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Find is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Grep(context.Context, *connect.Request[filesystem.GrepRequest], *connect.ServerStream[filesystem.GrepResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Grep is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Checksum(context.Context, *connect.Request[filesystem.ChecksumRequest]) (*connect.Response[filesystem.ChecksumResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Checksum is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) DiffManifest(context.Context, *connect.Request[filesystem.DiffManifestRequest]) (*connect.Response[filesystem.DiffManifestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.DiffManifest is not implemented"))
+}
+
 func (UnimplementedFilesystemHandler) WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WatchDir is not implemented"))
 }
@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ports/ports.proto
+
+package portsconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	ports "github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/ports"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PortsName is the fully-qualified name of the Ports service.
+	PortsName = "ports.Ports"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PortsListPortsProcedure is the fully-qualified name of the Ports's ListPorts RPC.
+	PortsListPortsProcedure = "/ports.Ports/ListPorts"
+	// PortsWatchPortsProcedure is the fully-qualified name of the Ports's WatchPorts RPC.
+	PortsWatchPortsProcedure = "/ports.Ports/WatchPorts"
+)
+
+// PortsClient is a client for the ports.Ports service.
+type PortsClient interface {
+	// ListPorts returns the TCP ports the processes in the sandbox are listening on.
+	ListPorts(context.Context, *connect.Request[ports.ListPortsRequest]) (*connect.Response[ports.ListPortsResponse], error)
+	// WatchPorts streams the ports as they are opened and closed.
+	WatchPorts(context.Context, *connect.Request[ports.WatchPortsRequest]) (*connect.ServerStreamForClient[ports.WatchPortsResponse], error)
+}
+
+// NewPortsClient constructs a client for the ports.Ports service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPortsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PortsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	portsMethods := ports.File_ports_ports_proto.Services().ByName("Ports").Methods()
+	return &portsClient{
+		listPorts: connect.NewClient[ports.ListPortsRequest, ports.ListPortsResponse](
+			httpClient,
+			baseURL+PortsListPortsProcedure,
+			connect.WithSchema(portsMethods.ByName("ListPorts")),
+			connect.WithClientOptions(opts...),
+		),
+		watchPorts: connect.NewClient[ports.WatchPortsRequest, ports.WatchPortsResponse](
+			httpClient,
+			baseURL+PortsWatchPortsProcedure,
+			connect.WithSchema(portsMethods.ByName("WatchPorts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// portsClient implements PortsClient.
+type portsClient struct {
+	listPorts  *connect.Client[ports.ListPortsRequest, ports.ListPortsResponse]
+	watchPorts *connect.Client[ports.WatchPortsRequest, ports.WatchPortsResponse]
+}
+
+// ListPorts calls ports.Ports.ListPorts.
+func (c *portsClient) ListPorts(ctx context.Context, req *connect.Request[ports.ListPortsRequest]) (*connect.Response[ports.ListPortsResponse], error) {
+	return c.listPorts.CallUnary(ctx, req)
+}
+
+// WatchPorts calls ports.Ports.WatchPorts.
+func (c *portsClient) WatchPorts(ctx context.Context, req *connect.Request[ports.WatchPortsRequest]) (*connect.ServerStreamForClient[ports.WatchPortsResponse], error) {
+	return c.watchPorts.CallServerStream(ctx, req)
+}
+
+// PortsHandler is an implementation of the ports.Ports service.
+type PortsHandler interface {
+	// ListPorts returns the TCP ports the processes in the sandbox are listening on.
+	ListPorts(context.Context, *connect.Request[ports.ListPortsRequest]) (*connect.Response[ports.ListPortsResponse], error)
+	// WatchPorts streams the ports as they are opened and closed.
+	WatchPorts(context.Context, *connect.Request[ports.WatchPortsRequest], *connect.ServerStream[ports.WatchPortsResponse]) error
+}
+
+// NewPortsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPortsHandler(svc PortsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	portsMethods := ports.File_ports_ports_proto.Services().ByName("Ports").Methods()
+	portsListPortsHandler := connect.NewUnaryHandler(
+		PortsListPortsProcedure,
+		svc.ListPorts,
+		connect.WithSchema(portsMethods.ByName("ListPorts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portsWatchPortsHandler := connect.NewServerStreamHandler(
+		PortsWatchPortsProcedure,
+		svc.WatchPorts,
+		connect.WithSchema(portsMethods.ByName("WatchPorts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ports.Ports/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PortsListPortsProcedure:
+			portsListPortsHandler.ServeHTTP(w, r)
+		case PortsWatchPortsProcedure:
+			portsWatchPortsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPortsHandler returns CodeUnimplemented from all methods.
+type UnimplementedPortsHandler struct{}
+
+func (UnimplementedPortsHandler) ListPorts(context.Context, *connect.Request[ports.ListPortsRequest]) (*connect.Response[ports.ListPortsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ports.Ports.ListPorts is not implemented"))
+}
+
+func (UnimplementedPortsHandler) WatchPorts(context.Context, *connect.Request[ports.WatchPortsRequest], *connect.ServerStream[ports.WatchPortsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ports.Ports.WatchPorts is not implemented"))
+}
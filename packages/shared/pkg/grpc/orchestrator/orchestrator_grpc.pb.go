@@ -26,6 +26,7 @@ const (
 	SandboxService_Delete_FullMethodName           = "/SandboxService/Delete"
 	SandboxService_Pause_FullMethodName            = "/SandboxService/Pause"
 	SandboxService_Checkpoint_FullMethodName       = "/SandboxService/Checkpoint"
+	SandboxService_Migrate_FullMethodName          = "/SandboxService/Migrate"
 	SandboxService_ListCachedBuilds_FullMethodName = "/SandboxService/ListCachedBuilds"
 )
 
@@ -39,6 +40,9 @@ type SandboxServiceClient interface {
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*SandboxPauseResponse, error)
 	Checkpoint(ctx context.Context, in *SandboxCheckpointRequest, opts ...grpc.CallOption) (*SandboxCheckpointResponse, error)
+	// Migrate snapshots the sandbox into the local cache and stops it, serving the
+	// snapshot to the target node over the peer chunk service while it's uploaded.
+	Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
 }
 
@@ -110,6 +114,16 @@ func (c *sandboxServiceClient) Checkpoint(ctx context.Context, in *SandboxCheckp
 	return out, nil
 }
 
+func (c *sandboxServiceClient) Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SandboxMigrateResponse)
+	err := c.cc.Invoke(ctx, SandboxService_Migrate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SandboxListCachedBuildsResponse)
@@ -130,6 +144,9 @@ type SandboxServiceServer interface {
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
 	Pause(context.Context, *SandboxPauseRequest) (*SandboxPauseResponse, error)
 	Checkpoint(context.Context, *SandboxCheckpointRequest) (*SandboxCheckpointResponse, error)
+	// Migrate snapshots the sandbox into the local cache and stops it, serving the
+	// snapshot to the target node over the peer chunk service while it's uploaded.
+	Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
@@ -159,6 +176,9 @@ func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseReq
 func (UnimplementedSandboxServiceServer) Checkpoint(context.Context, *SandboxCheckpointRequest) (*SandboxCheckpointResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Checkpoint not implemented")
 }
+func (UnimplementedSandboxServiceServer) Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Migrate not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
@@ -291,6 +311,24 @@ func _SandboxService_Checkpoint_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_Migrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Migrate(ctx, req.(*SandboxMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -340,6 +378,10 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Checkpoint",
 			Handler:    _SandboxService_Checkpoint_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _SandboxService_Migrate_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
@@ -20,10 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TemplateService_TemplateCreate_FullMethodName      = "/TemplateService/TemplateCreate"
-	TemplateService_TemplateBuildStatus_FullMethodName = "/TemplateService/TemplateBuildStatus"
-	TemplateService_TemplateBuildDelete_FullMethodName = "/TemplateService/TemplateBuildDelete"
-	TemplateService_InitLayerFileUpload_FullMethodName = "/TemplateService/InitLayerFileUpload"
+	TemplateService_TemplateCreate_FullMethodName          = "/TemplateService/TemplateCreate"
+	TemplateService_TemplateBuildStatus_FullMethodName     = "/TemplateService/TemplateBuildStatus"
+	TemplateService_TemplateBuildDelete_FullMethodName     = "/TemplateService/TemplateBuildDelete"
+	TemplateService_TemplateBuildReferences_FullMethodName = "/TemplateService/TemplateBuildReferences"
+	TemplateService_InitLayerFileUpload_FullMethodName     = "/TemplateService/InitLayerFileUpload"
 )
 
 // TemplateServiceClient is the client API for TemplateService service.
@@ -38,6 +39,8 @@ type TemplateServiceClient interface {
 	TemplateBuildStatus(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildReferences lists the builds that still depend on the given builds' layers
+	TemplateBuildReferences(ctx context.Context, in *TemplateBuildReferencesRequest, opts ...grpc.CallOption) (*TemplateBuildReferencesResponse, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(ctx context.Context, in *InitLayerFileUploadRequest, opts ...grpc.CallOption) (*InitLayerFileUploadResponse, error)
 }
@@ -80,6 +83,16 @@ func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildReferences(ctx context.Context, in *TemplateBuildReferencesRequest, opts ...grpc.CallOption) (*TemplateBuildReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateBuildReferencesResponse)
+	err := c.cc.Invoke(ctx, TemplateService_TemplateBuildReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *templateServiceClient) InitLayerFileUpload(ctx context.Context, in *InitLayerFileUploadRequest, opts ...grpc.CallOption) (*InitLayerFileUploadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InitLayerFileUploadResponse)
@@ -102,6 +115,8 @@ type TemplateServiceServer interface {
 	TemplateBuildStatus(context.Context, *TemplateStatusRequest) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
+	// TemplateBuildReferences lists the builds that still depend on the given builds' layers
+	TemplateBuildReferences(context.Context, *TemplateBuildReferencesRequest) (*TemplateBuildReferencesResponse, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
@@ -123,6 +138,9 @@ func (UnimplementedTemplateServiceServer) TemplateBuildStatus(context.Context, *
 func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method TemplateBuildDelete not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildReferences(context.Context, *TemplateBuildReferencesRequest) (*TemplateBuildReferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TemplateBuildReferences not implemented")
+}
 func (UnimplementedTemplateServiceServer) InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InitLayerFileUpload not implemented")
 }
@@ -201,6 +219,24 @@ func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_TemplateBuildReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildReferences(ctx, req.(*TemplateBuildReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TemplateService_InitLayerFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitLayerFileUploadRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +274,10 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateBuildDelete",
 			Handler:    _TemplateService_TemplateBuildDelete_Handler,
 		},
+		{
+			MethodName: "TemplateBuildReferences",
+			Handler:    _TemplateService_TemplateBuildReferences_Handler,
+		},
 		{
 			MethodName: "InitLayerFileUpload",
 			Handler:    _TemplateService_InitLayerFileUpload_Handler,
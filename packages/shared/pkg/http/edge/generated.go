@@ -104,6 +104,27 @@ func (e V1SandboxLogsParamsDirection) Valid() bool {
 	}
 }
 
+// Defines values for V1SandboxLogsParamsStream.
+const (
+	Pty    V1SandboxLogsParamsStream = "pty"
+	Stderr V1SandboxLogsParamsStream = "stderr"
+	Stdout V1SandboxLogsParamsStream = "stdout"
+)
+
+// Valid indicates whether the value is a known member of the V1SandboxLogsParamsStream enum.
+func (e V1SandboxLogsParamsStream) Valid() bool {
+	switch e {
+	case Pty:
+		return true
+	case Stderr:
+		return true
+	case Stdout:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for V1TemplateBuildLogsParamsDirection.
 const (
 	V1TemplateBuildLogsParamsDirectionBackward V1TemplateBuildLogsParamsDirection = "backward"
@@ -321,11 +342,20 @@ type V1SandboxLogsParams struct {
 
 	// Search Case-sensitive substring match on log message content
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// ProcessTag Only return the output of processes started with this tag
+	ProcessTag *string `form:"processTag,omitempty" json:"processTag,omitempty"`
+
+	// Stream Only return the process output from this stream
+	Stream *V1SandboxLogsParamsStream `form:"stream,omitempty" json:"stream,omitempty"`
 }
 
 // V1SandboxLogsParamsDirection defines parameters for V1SandboxLogs.
 type V1SandboxLogsParamsDirection string
 
+// V1SandboxLogsParamsStream defines parameters for V1SandboxLogs.
+type V1SandboxLogsParamsStream string
+
 // V1SandboxMetricsParams defines parameters for V1SandboxMetrics.
 type V1SandboxMetricsParams struct {
 	// TeamID Team ID that owns the sandbox
@@ -828,6 +858,30 @@ func NewV1SandboxLogsRequest(server string, sandboxID string, params *V1SandboxL
 
 		}
 
+		if params.ProcessTag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "processTag", *params.ProcessTag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Stream != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "stream", *params.Stream, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -77,8 +77,10 @@ func (l *LokiQueryProvider) QuerySandboxLogs(
 	direction logproto.Direction,
 	level *logs.LogLevel,
 	search *string,
+	processTag *string,
+	stream *string,
 ) ([]logs.LogEntry, error) {
-	query := buildSandboxLogsQuery(teamID, sandboxID, level, search)
+	query := buildSandboxLogsQuery(teamID, sandboxID, level, search, processTag, stream)
 
 	res, err := l.client.QueryRange(query, limit, start, end, direction, time.Duration(0), time.Duration(0), true)
 	if err != nil {
@@ -142,13 +144,13 @@ func buildBuildLogsQuery(templateID string, buildID string, level *logs.LogLevel
 	return query + fmt.Sprintf(" | json | level =~ `%s`", minLevelRegexFilter(*level))
 }
 
-func buildSandboxLogsQuery(teamID string, sandboxID string, level *logs.LogLevel, search *string) string {
+func buildSandboxLogsQuery(teamID string, sandboxID string, level *logs.LogLevel, search *string, processTag *string, stream *string) string {
 	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
 	sandboxIDSanitized := sanitizeLokiLabel(sandboxID)
 	teamIDSanitized := sanitizeLokiLabel(teamID)
 
 	query := fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, category!=\"metrics\"}", teamIDSanitized, sandboxIDSanitized)
-	if level == nil && utils.DerefOrDefault(search, "") == "" {
+	if level == nil && utils.DerefOrDefault(search, "") == "" && processTag == nil && stream == nil {
 		return query
 	}
 
@@ -159,6 +161,12 @@ func buildSandboxLogsQuery(teamID string, sandboxID string, level *logs.LogLevel
 	if utils.DerefOrDefault(search, "") != "" {
 		query += fmt.Sprintf(" | message =~ `%s`", sanitizeLogMessageRegexFilter(*search))
 	}
+	if processTag != nil {
+		query += fmt.Sprintf(" | process_tag = `%s`", sanitizeLokiLabel(*processTag))
+	}
+	if stream != nil {
+		query += fmt.Sprintf(" | stream = `%s`", sanitizeLokiLabel(*stream))
+	}
 
 	return query
 }
@@ -54,7 +54,7 @@ func TestSanitizeLogMessageRegexFilter(t *testing.T) {
 func TestBuildSandboxLogsQueryWithoutSearch(t *testing.T) {
 	t.Parallel()
 
-	query := buildSandboxLogsQuery("team`id", "sandbox`id", nil, nil)
+	query := buildSandboxLogsQuery("team`id", "sandbox`id", nil, nil, nil, nil)
 
 	assert.Equal(t, "{teamID=`teamid`, sandboxID=`sandboxid`, category!=\"metrics\"}", query)
 }
@@ -63,7 +63,7 @@ func TestBuildSandboxLogsQueryWithMessageSearch(t *testing.T) {
 	t.Parallel()
 
 	search := "hello` (world)+"
-	query := buildSandboxLogsQuery("team-id", "sandbox-id", nil, &search)
+	query := buildSandboxLogsQuery("team-id", "sandbox-id", nil, &search, nil, nil)
 
 	assert.Equal(
 		t,
@@ -72,6 +72,19 @@ func TestBuildSandboxLogsQueryWithMessageSearch(t *testing.T) {
 	)
 }
 
+func TestBuildSandboxLogsQueryWithProcessFilters(t *testing.T) {
+	t.Parallel()
+
+	processTag, stream := "web`server", "stderr"
+	query := buildSandboxLogsQuery("team-id", "sandbox-id", nil, nil, &processTag, &stream)
+
+	assert.Equal(
+		t,
+		"{teamID=`team-id`, sandboxID=`sandbox-id`, category!=\"metrics\"} | json | process_tag = `webserver` | stream = `stderr`",
+		query,
+	)
+}
+
 func TestBuildBuildLogsQuerySanitizesBackticks(t *testing.T) {
 	t.Parallel()
 
@@ -89,7 +102,7 @@ func TestBuildSandboxLogsQueryEscapesInjectionLikeSearchInput(t *testing.T) {
 	t.Parallel()
 
 	search := "`foo.*(bar)+?|baz\\qux` | json | level =~ `error`"
-	query := buildSandboxLogsQuery("team-id", "sandbox-id", nil, &search)
+	query := buildSandboxLogsQuery("team-id", "sandbox-id", nil, &search, nil, nil)
 
 	assert.Equal(
 		t,
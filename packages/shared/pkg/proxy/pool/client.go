@@ -55,8 +55,8 @@ func newProxyClient(
 			var err error
 
 			// Retry connection attempts to handle port forwarding delays in sandbox envd.
-			// When a process binds to localhost inside the sandbox, it can take up to 1s (delay is 1s + socat startup delay)
-			// for the port scanner to detect it and start socat forwarding to the host IP.
+			// When a process binds to localhost inside the sandbox, it can take up to 1s (the port scanner interval)
+			// for the port scanner to detect it and start forwarding it to the host IP.
 			maxAttempts := max(maxConnectionAttempts, 1)
 			for attempt := range maxAttempts {
 				conn, err = (&net.Dialer{
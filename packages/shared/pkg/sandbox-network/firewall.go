@@ -120,3 +120,24 @@ func ParseAddressesAndDomains(entries []string) (addresses []string, domains []s
 
 	return addresses, domains
 }
+
+// MatchDomain checks if a hostname matches a domain pattern.
+// Patterns can be exact matches, wildcards (*), or suffix wildcards (*.example.com).
+func MatchDomain(hostname, pattern string) bool {
+	switch {
+	case pattern == "":
+		// Empty pattern should never match
+		return false
+	case strings.EqualFold(pattern, hostname):
+		return true
+	case strings.EqualFold(pattern, "*"):
+		return true
+	case strings.HasPrefix(pattern, "*."):
+		suffix := pattern[1:]
+		if strings.HasSuffix(strings.ToLower(hostname), strings.ToLower(suffix)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -71,3 +71,121 @@ func TestAddressStringToCIDR(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchDomain(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		hostname string
+		pattern  string
+		want     bool
+	}{
+		// Exact matches
+		{
+			name:     "exact match",
+			hostname: "example.com",
+			pattern:  "example.com",
+			want:     true,
+		},
+		{
+			name:     "exact match case insensitive",
+			hostname: "Example.COM",
+			pattern:  "example.com",
+			want:     true,
+		},
+		{
+			name:     "exact match pattern uppercase",
+			hostname: "example.com",
+			pattern:  "EXAMPLE.COM",
+			want:     true,
+		},
+		{
+			name:     "no match different domain",
+			hostname: "example.com",
+			pattern:  "other.com",
+			want:     false,
+		},
+
+		// Wildcard *
+		{
+			name:     "wildcard matches any hostname",
+			hostname: "anything.example.com",
+			pattern:  "*",
+			want:     true,
+		},
+		{
+			name:     "wildcard matches simple hostname",
+			hostname: "localhost",
+			pattern:  "*",
+			want:     true,
+		},
+
+		// Suffix wildcards *.domain
+		{
+			name:     "suffix wildcard matches subdomain",
+			hostname: "api.example.com",
+			pattern:  "*.example.com",
+			want:     true,
+		},
+		{
+			name:     "suffix wildcard matches nested subdomain",
+			hostname: "deep.nested.example.com",
+			pattern:  "*.example.com",
+			want:     true,
+		},
+		{
+			name:     "suffix wildcard case insensitive",
+			hostname: "API.EXAMPLE.COM",
+			pattern:  "*.example.com",
+			want:     true,
+		},
+		{
+			name:     "suffix wildcard does not match exact domain",
+			hostname: "example.com",
+			pattern:  "*.example.com",
+			want:     false,
+		},
+		{
+			name:     "suffix wildcard does not match different domain",
+			hostname: "api.other.com",
+			pattern:  "*.example.com",
+			want:     false,
+		},
+		{
+			name:     "suffix wildcard does not match partial suffix",
+			hostname: "notexample.com",
+			pattern:  "*.example.com",
+			want:     false,
+		},
+
+		// Edge cases
+		{
+			name:     "empty hostname",
+			hostname: "",
+			pattern:  "example.com",
+			want:     false,
+		},
+		{
+			name:     "empty pattern",
+			hostname: "example.com",
+			pattern:  "",
+			want:     false,
+		},
+		{
+			name:     "both empty - empty pattern never matches",
+			hostname: "",
+			pattern:  "",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := MatchDomain(tt.hostname, tt.pattern)
+			if got != tt.want {
+				t.Errorf("MatchDomain(%q, %q) = %v, want %v", tt.hostname, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
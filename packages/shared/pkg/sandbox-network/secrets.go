@@ -0,0 +1,51 @@
+package sandbox_network
+
+import (
+	"regexp"
+	"slices"
+)
+
+// MaxSecretNameLength is the maximum length of a team secret name.
+const MaxSecretNameLength = 64
+
+var (
+	secretNameRegex        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	secretPlaceholderRegex = regexp.MustCompile(`\{\{\s*secret:([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+)
+
+// IsValidSecretName reports whether name can be used as a team secret name
+// and referenced from a {{secret:NAME}} placeholder.
+func IsValidSecretName(name string) bool {
+	return len(name) <= MaxSecretNameLength && secretNameRegex.MatchString(name)
+}
+
+// SecretPlaceholderNames returns the unique secret names referenced by
+// {{secret:NAME}} placeholders in value, in order of appearance.
+func SecretPlaceholderNames(value string) []string {
+	var names []string
+	for _, m := range secretPlaceholderRegex.FindAllStringSubmatch(value, -1) {
+		if !slices.Contains(names, m[1]) {
+			names = append(names, m[1])
+		}
+	}
+
+	return names
+}
+
+// ReplaceSecretPlaceholders substitutes {{secret:NAME}} placeholders in value.
+// It returns false when a referenced secret is missing from secrets.
+func ReplaceSecretPlaceholders(value string, secrets map[string]string) (string, bool) {
+	ok := true
+	replaced := secretPlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+		name := secretPlaceholderRegex.FindStringSubmatch(placeholder)[1]
+
+		secret, found := secrets[name]
+		if !found {
+			ok = false
+		}
+
+		return secret
+	})
+
+	return replaced, ok
+}
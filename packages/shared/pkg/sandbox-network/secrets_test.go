@@ -0,0 +1,49 @@
+package sandbox_network
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidSecretName(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsValidSecretName("OPENAI_KEY"))
+	assert.True(t, IsValidSecretName("_token2"))
+	assert.True(t, IsValidSecretName(strings.Repeat("A", MaxSecretNameLength)))
+	assert.False(t, IsValidSecretName(""))
+	assert.False(t, IsValidSecretName("2FA"))
+	assert.False(t, IsValidSecretName("API-KEY"))
+	assert.False(t, IsValidSecretName("KEY}}"))
+	assert.False(t, IsValidSecretName(strings.Repeat("A", MaxSecretNameLength+1)))
+}
+
+func TestSecretPlaceholderNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, SecretPlaceholderNames("Bearer token"))
+	assert.Equal(t, []string{"OPENAI_KEY"}, SecretPlaceholderNames("Bearer {{secret:OPENAI_KEY}}"))
+	assert.Equal(t, []string{"USER", "PASS"}, SecretPlaceholderNames("{{ secret:USER }}:{{secret:PASS}} {{secret:USER}}"))
+	// Malformed placeholders are left as plain text.
+	assert.Empty(t, SecretPlaceholderNames("{{secret:}} {{secret:A-B}} {secret:KEY}"))
+}
+
+func TestReplaceSecretPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[string]string{"USER": "alice", "PASS": "s3cr3t"}
+
+	value, ok := ReplaceSecretPlaceholders("Basic {{secret:USER}}:{{ secret:PASS }}", secrets)
+	require.True(t, ok)
+	assert.Equal(t, "Basic alice:s3cr3t", value)
+
+	value, ok = ReplaceSecretPlaceholders("plain", nil)
+	require.True(t, ok)
+	assert.Equal(t, "plain", value)
+
+	_, ok = ReplaceSecretPlaceholders("Bearer {{secret:MISSING}}", secrets)
+	assert.False(t, ok)
+}
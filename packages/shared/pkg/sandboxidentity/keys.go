@@ -0,0 +1,137 @@
+package sandboxidentity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	jose "github.com/go-jose/go-jose/v4"
+)
+
+// parseKeys decodes the base64-encoded PEM bundle and parses all keys in it.
+func parseKeys(keysBase64 string, parse func(block *pem.Block) (any, error)) ([]any, error) {
+	data, err := base64.StdEncoding.DecodeString(keysBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode keys: %w", err)
+	}
+
+	var keys []any
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		key, err := parse(block)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded keys found")
+	}
+
+	return keys, nil
+}
+
+func parsePrivateKey(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+
+		return key, nil
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse EC private key: %w", err)
+		}
+
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+		}
+
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key PEM block %q", block.Type)
+	}
+}
+
+func parsePublicKey(block *pem.Block) (any, error) {
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unsupported public key PEM block %q", block.Type)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	return key, nil
+}
+
+// signatureAlgorithm returns the JWS algorithm for the key, only P-256 and RSA keys are supported.
+func signatureAlgorithm(key any) (jose.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return signatureAlgorithm(&k.PublicKey)
+	case *rsa.PrivateKey:
+		return signatureAlgorithm(&k.PublicKey)
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return "", fmt.Errorf("unsupported elliptic curve %s, use P-256", k.Curve.Params().Name)
+		}
+
+		return jose.ES256, nil
+	case *rsa.PublicKey:
+		if k.Size() < 256 {
+			return "", errors.New("RSA keys must be at least 2048 bits")
+		}
+
+		return jose.RS256, nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// publicJWK returns the public JWK of the key, the key ID is the RFC 7638 thumbprint
+// so the signer and the published key set agree on it without configuration.
+func publicJWK(key any) (jose.JSONWebKey, error) {
+	alg, err := signatureAlgorithm(key)
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		key = &k.PublicKey
+	case *rsa.PrivateKey:
+		key = &k.PublicKey
+	}
+
+	jwk := jose.JSONWebKey{Key: key, Algorithm: string(alg), Use: "sig"}
+
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("failed to compute key thumbprint: %w", err)
+	}
+
+	jwk.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	return jwk, nil
+}
@@ -0,0 +1,76 @@
+package sandboxidentity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	jose "github.com/go-jose/go-jose/v4"
+)
+
+const jwksPath = "/.well-known/jwks.json"
+
+// KeySet publishes the public keys the identity tokens are verified with. It holds
+// several keys so the signing key can be rotated without invalidating issued tokens.
+type KeySet struct {
+	issuer string
+	keys   jose.JSONWebKeySet
+}
+
+// NewKeySet creates the key set from a base64-encoded PEM bundle of public keys.
+func NewKeySet(issuer, publicKeysBase64 string) (*KeySet, error) {
+	if issuer == "" {
+		return nil, errors.New("issuer is not set")
+	}
+
+	keys, err := parseKeys(publicKeysBase64, parsePublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keySet := &KeySet{issuer: strings.TrimSuffix(issuer, "/")}
+	for _, key := range keys {
+		jwk, err := publicJWK(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keySet.keys.Keys = append(keySet.keys.Keys, jwk)
+	}
+
+	return keySet, nil
+}
+
+// JWKS returns the published keys.
+func (k *KeySet) JWKS() jose.JSONWebKeySet {
+	return k.keys
+}
+
+// DiscoveryDocument is the OpenID Provider metadata of the issuer.
+type DiscoveryDocument struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
+}
+
+// Discovery returns the OpenID Provider metadata, served at {issuer}/.well-known/openid-configuration.
+func (k *KeySet) Discovery() DiscoveryDocument {
+	var algorithms []string
+	for _, key := range k.keys.Keys {
+		if !slices.Contains(algorithms, key.Algorithm) {
+			algorithms = append(algorithms, key.Algorithm)
+		}
+	}
+
+	return DiscoveryDocument{
+		Issuer:                           k.issuer,
+		JWKSURI:                          k.issuer + jwksPath,
+		ResponseTypesSupported:           []string{"id_token"},
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: algorithms,
+		ClaimsSupported:                  []string{"iss", "sub", "aud", "exp", "iat", "nbf", "sandbox_id", "template_id", "team_id", "metadata"},
+	}
+}
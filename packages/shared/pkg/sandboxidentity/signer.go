@@ -0,0 +1,123 @@
+// Package sandboxidentity mints and publishes the OIDC identity tokens of sandboxes,
+// so services outside E2B can trust the identity of a sandbox through federation.
+package sandboxidentity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	// DefaultTokenTTL is the lifetime of the minted tokens.
+	DefaultTokenTTL = 10 * time.Minute
+)
+
+// Identity is the identity of a sandbox embedded in its tokens.
+type Identity struct {
+	SandboxID  string
+	TemplateID string
+	TeamID     string
+	Metadata   map[string]string
+}
+
+// Claims are the claims of a sandbox identity token.
+type Claims struct {
+	jwt.Claims
+
+	SandboxID  string            `json:"sandbox_id"`
+	TemplateID string            `json:"template_id"`
+	TeamID     string            `json:"team_id"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// Signer mints the identity tokens, it holds the private key and runs next to the sandboxes.
+type Signer struct {
+	issuer string
+	ttl    time.Duration
+	signer jose.Signer
+}
+
+// NewSigner creates a signer from a base64-encoded PEM private key, P-256 and RSA keys are supported.
+func NewSigner(issuer, privateKeyBase64 string, ttl time.Duration) (*Signer, error) {
+	if issuer == "" {
+		return nil, errors.New("issuer is not set")
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+
+	keys, err := parseKeys(privateKeyBase64, parsePrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("expected exactly one private key, got %d", len(keys))
+	}
+
+	jwk, err := publicJWK(keys[0])
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(jwk.Algorithm),
+			Key:       jose.JSONWebKey{Key: keys[0], KeyID: jwk.KeyID, Algorithm: jwk.Algorithm},
+		},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+
+	return &Signer{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		ttl:    ttl,
+		signer: signer,
+	}, nil
+}
+
+// Subject returns the subject of the sandbox's tokens. The team comes first, so
+// relying parties can trust all sandboxes of a team by matching the
+// team:<teamID>:sandbox:* prefix, without checking custom claims.
+func Subject(teamID, sandboxID string) string {
+	return fmt.Sprintf("team:%s:sandbox:%s", teamID, sandboxID)
+}
+
+// Sign mints a token for the sandbox identity, valid for the audience only.
+func (s *Signer) Sign(identity Identity, audience string, now time.Time) (string, time.Time, error) {
+	if audience == "" {
+		return "", time.Time{}, errors.New("audience is not set")
+	}
+
+	expiresAt := now.Add(s.ttl)
+
+	claims := Claims{
+		Claims: jwt.Claims{
+			Issuer:    s.issuer,
+			Subject:   Subject(identity.TeamID, identity.SandboxID),
+			Audience:  jwt.Audience{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(expiresAt),
+		},
+		SandboxID:  identity.SandboxID,
+		TemplateID: identity.TemplateID,
+		TeamID:     identity.TeamID,
+		Metadata:   identity.Metadata,
+	}
+
+	token, err := jwt.Signed(s.signer).Claims(claims).Serialize()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign identity token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
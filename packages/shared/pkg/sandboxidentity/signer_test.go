@@ -0,0 +1,131 @@
+package sandboxidentity
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://api.example.com"
+
+func generateKey(t *testing.T) (privateKeyBase64 string, publicKeyBase64 string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	return base64.StdEncoding.EncodeToString(privatePEM), base64.StdEncoding.EncodeToString(publicPEM)
+}
+
+func TestSignVerifiesWithKeySet(t *testing.T) {
+	t.Parallel()
+
+	privateKey, publicKey := generateKey(t)
+	_, rotatedPublicKey := generateKey(t)
+
+	signer, err := NewSigner(testIssuer+"/", privateKey, time.Minute)
+	require.NoError(t, err)
+
+	publicKeys, err := base64.StdEncoding.DecodeString(publicKey)
+	require.NoError(t, err)
+	rotatedKeys, err := base64.StdEncoding.DecodeString(rotatedPublicKey)
+	require.NoError(t, err)
+
+	keySet, err := NewKeySet(testIssuer, base64.StdEncoding.EncodeToString(append(rotatedKeys, publicKeys...)))
+	require.NoError(t, err)
+	require.Len(t, keySet.JWKS().Keys, 2)
+
+	now := time.Now()
+	identity := Identity{
+		SandboxID:  "sbx-1",
+		TemplateID: "base",
+		TeamID:     "team-1",
+		Metadata:   map[string]string{"env": "prod"},
+	}
+
+	token, expiresAt, err := signer.Sign(identity, "https://service.example.com", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Minute), expiresAt)
+
+	parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.ES256})
+	require.NoError(t, err)
+	require.Len(t, parsed.Headers, 1)
+
+	jwks := keySet.JWKS()
+	keys := jwks.Key(parsed.Headers[0].KeyID)
+	require.Len(t, keys, 1)
+
+	var claims Claims
+	require.NoError(t, parsed.Claims(keys[0].Key, &claims))
+
+	require.NoError(t, claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      keySet.Discovery().Issuer,
+		AnyAudience: jwt.Audience{"https://service.example.com"},
+		Time:        now,
+	}, 0))
+	assert.Equal(t, "team:team-1:sandbox:sbx-1", claims.Subject)
+	assert.Equal(t, "sbx-1", claims.SandboxID)
+	assert.Equal(t, "base", claims.TemplateID)
+	assert.Equal(t, "team-1", claims.TeamID)
+	assert.Equal(t, map[string]string{"env": "prod"}, claims.Metadata)
+}
+
+func TestSignRequiresAudience(t *testing.T) {
+	t.Parallel()
+
+	privateKey, _ := generateKey(t)
+
+	signer, err := NewSigner(testIssuer, privateKey, 0)
+	require.NoError(t, err)
+
+	_, _, err = signer.Sign(Identity{SandboxID: "sbx-1"}, "", time.Now())
+	require.Error(t, err)
+}
+
+func TestNewKeySetRejectsUnsupportedCurve(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	publicKeys := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	_, err = NewKeySet(testIssuer, publicKeys)
+	require.Error(t, err)
+}
+
+func TestDiscovery(t *testing.T) {
+	t.Parallel()
+
+	_, publicKey := generateKey(t)
+
+	keySet, err := NewKeySet(testIssuer+"/", publicKey)
+	require.NoError(t, err)
+
+	discovery := keySet.Discovery()
+	assert.Equal(t, testIssuer, discovery.Issuer)
+	assert.Equal(t, testIssuer+"/.well-known/jwks.json", discovery.JWKSURI)
+	assert.Equal(t, []string{"ES256"}, discovery.IDTokenSigningAlgValuesSupported)
+}
@@ -0,0 +1,273 @@
+package header
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// The reference index records, next to the builds in template storage, which
+// builds map blocks into which. Every uploaded header leaves one empty marker
+// object per ancestor build it references:
+//
+//	refs/{ancestorBuildID}/{childBuildID}
+//
+// so the builds depending on an ancestor can be listed without reading every
+// header in storage. Markers are written before the header they describe and
+// are never updated. Deleting a child removes its markers from the ancestors'
+// directories; a marker whose child lost its header some other way is stale
+// and is removed by the first lookup that finds it.
+const referencesDir = "refs"
+
+// referencePendingGrace is how long a marker counts as live although its
+// child has no header yet: markers are written first, the header only once the
+// whole layer has been uploaded.
+const referencePendingGrace = 24 * time.Hour
+
+// referenceConcurrency bounds the concurrent storage requests of one index
+// write or lookup.
+const referenceConcurrency = 16
+
+// ErrBuildReferenced means a live header still maps into the build, so
+// deleting it would corrupt the builds depending on it.
+var ErrBuildReferenced = errors.New("build is referenced by other builds")
+
+// Referrer is a build whose header maps into another build.
+type Referrer struct {
+	BuildID  uuid.UUID
+	Recorded time.Time
+	// Live is false once the referrer's headers are gone, unless it was
+	// recorded within referencePendingGrace and may still be uploading.
+	Live bool
+}
+
+// ReferencesDir returns the index directory listing the referrers of buildID.
+func ReferencesDir(buildID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/", referencesDir, buildID)
+}
+
+func referencePath(ancestor, child uuid.UUID) string {
+	return ReferencesDir(ancestor) + child.String()
+}
+
+// ReferencedBuilds returns the builds, other than the header's own, that the
+// header maps into or depends on.
+func ReferencedBuilds(h *Header) []uuid.UUID {
+	if h == nil {
+		return nil
+	}
+
+	var self uuid.UUID
+	var refs []uuid.UUID
+	if h.Metadata != nil {
+		self = h.Metadata.BuildId
+		refs = append(refs, h.Metadata.BaseBuildId)
+	}
+
+	refs = append(refs, h.Mapping.Builds()...)
+	for id := range h.Builds {
+		refs = append(refs, id)
+	}
+
+	refs = slices.DeleteFunc(refs, func(id uuid.UUID) bool { return id == uuid.Nil || id == self })
+	slices.SortFunc(refs, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+
+	return slices.Compact(refs)
+}
+
+// RecordReferences writes the index markers for every build the header maps
+// into. It must complete before the header itself is stored, so no stored
+// header is ever missing from the index.
+func RecordReferences(ctx context.Context, s storage.StorageProvider, h *Header) error {
+	refs := ReferencedBuilds(h)
+	if len(refs) == 0 || h.Metadata == nil {
+		return nil
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(referenceConcurrency)
+	for _, ancestor := range refs {
+		eg.Go(func() error {
+			path := referencePath(ancestor, h.Metadata.BuildId)
+
+			blob, err := s.OpenBlob(ctx, path)
+			if err != nil {
+				return fmt.Errorf("open reference %s: %w", path, err)
+			}
+
+			if err := blob.Put(ctx, nil); err != nil {
+				return fmt.Errorf("store reference %s: %w", path, err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// Referrers lists the builds recorded as mapping into buildID and whether
+// they are still live.
+func Referrers(ctx context.Context, s storage.StorageProvider, buildID uuid.UUID, now time.Time) ([]Referrer, error) {
+	dir := ReferencesDir(buildID)
+
+	var referrers []Referrer
+	var markers []string
+	err := storage.ListObjects(ctx, s, dir, func(info storage.ObjectInfo) error {
+		child, err := uuid.Parse(strings.TrimPrefix(info.Path, dir))
+		if err != nil {
+			return fmt.Errorf("invalid reference %s: %w", info.Path, err)
+		}
+
+		referrers = append(referrers, Referrer{
+			BuildID:  child,
+			Recorded: info.Modified,
+			Live:     now.Sub(info.Modified) < referencePendingGrace,
+		})
+		markers = append(markers, info.Path)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list referrers of %s: %w", buildID, err)
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(referenceConcurrency)
+	for i := range referrers {
+		if referrers[i].Live {
+			continue
+		}
+
+		eg.Go(func() error {
+			live, err := hasHeader(egCtx, s, referrers[i].BuildID)
+			if err != nil {
+				return err
+			}
+
+			referrers[i].Live = live
+			if live {
+				return nil
+			}
+
+			// The marker is stale, drop it so it isn't checked again by every lookup.
+			if err := s.DeleteObjectsWithPrefix(egCtx, markers[i]); err != nil {
+				return fmt.Errorf("delete stale reference %s: %w", markers[i], err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return referrers, nil
+}
+
+// ForgetReferences removes the markers the headers of buildID left in the
+// index directories of the builds it maps into. Deletion paths must call it
+// before removing the build's objects, while its headers can still be read.
+func ForgetReferences(ctx context.Context, s storage.StorageProvider, buildID uuid.UUID) error {
+	paths := storage.Paths{BuildID: buildID.String()}
+
+	var refs []uuid.UUID
+	for _, name := range []string{storage.MemfileName, storage.RootfsName} {
+		h, _, err := LoadHeader(ctx, s, paths.HeaderFile(name))
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("load %s header of %s: %w", name, buildID, err)
+		}
+
+		refs = append(refs, ReferencedBuilds(h)...)
+	}
+
+	slices.SortFunc(refs, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(referenceConcurrency)
+	for _, ancestor := range slices.Compact(refs) {
+		eg.Go(func() error {
+			path := referencePath(ancestor, buildID)
+			if err := s.DeleteObjectsWithPrefix(ctx, path); err != nil {
+				return fmt.Errorf("delete reference %s: %w", path, err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// LiveReferrers returns the referrers of buildID that still depend on it.
+func LiveReferrers(ctx context.Context, s storage.StorageProvider, buildID uuid.UUID) ([]uuid.UUID, error) {
+	referrers, err := Referrers(ctx, s, buildID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var live []uuid.UUID
+	for _, r := range referrers {
+		if r.Live {
+			live = append(live, r.BuildID)
+		}
+	}
+
+	return live, nil
+}
+
+// CheckUnreferenced returns an error wrapping ErrBuildReferenced when any
+// live build still maps into buildID. Deletion paths must call it before
+// removing a build's objects.
+func CheckUnreferenced(ctx context.Context, s storage.StorageProvider, buildID uuid.UUID) error {
+	live, err := LiveReferrers(ctx, s, buildID)
+	if err != nil {
+		return err
+	}
+
+	if len(live) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(live))
+	for i, id := range live {
+		ids[i] = id.String()
+	}
+
+	return fmt.Errorf("%w: %s is mapped by %s", ErrBuildReferenced, buildID, strings.Join(ids, ", "))
+}
+
+func hasHeader(ctx context.Context, s storage.StorageProvider, buildID uuid.UUID) (bool, error) {
+	paths := storage.Paths{BuildID: buildID.String()}
+
+	for _, path := range []string{paths.MemfileHeader(), paths.RootfsHeader()} {
+		blob, err := s.OpenBlob(ctx, path)
+		if err != nil {
+			return false, fmt.Errorf("open header %s: %w", path, err)
+		}
+
+		exists, err := blob.Exists(ctx)
+		if err != nil {
+			return false, fmt.Errorf("check header %s: %w", path, err)
+		}
+
+		if exists {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
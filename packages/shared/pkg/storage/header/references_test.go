@@ -0,0 +1,92 @@
+package header
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func childHeader(t *testing.T, child uuid.UUID, parents ...uuid.UUID) *Header {
+	t.Helper()
+
+	mappings := []BuildMap{{Offset: 0, Length: PageSize, BuildId: child}}
+	for i, parent := range parents {
+		mappings = append(mappings, BuildMap{Offset: uint64(i+1) * PageSize, Length: PageSize, BuildId: parent})
+	}
+
+	h, err := NewHeader(NewTemplateMetadata(child, PageSize, uint64(len(mappings))*PageSize), mappings)
+	require.NoError(t, err)
+
+	return h
+}
+
+func TestReferencedBuilds(t *testing.T) {
+	t.Parallel()
+
+	child, parent, base := uuid.New(), uuid.New(), uuid.New()
+	h := childHeader(t, child, parent, parent)
+	h.Metadata.BaseBuildId = base
+	h.Builds = map[uuid.UUID]BuildData{child: {}, parent: {}}
+
+	assert.ElementsMatch(t, []uuid.UUID{parent, base}, ReferencedBuilds(h))
+	assert.Empty(t, ReferencedBuilds(childHeader(t, child)))
+}
+
+func TestReferrers(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s, err := storage.NewProvider(ctx, storage.Spec{Provider: storage.LocalStorageProvider, BasePath: t.TempDir()})
+	require.NoError(t, err)
+
+	parent, child, deleted := uuid.New(), uuid.New(), uuid.New()
+
+	h := childHeader(t, child, parent)
+	require.NoError(t, RecordReferences(ctx, s, h))
+	_, _, _, err = StoreHeader(ctx, s, storage.Paths{BuildID: child.String()}.MemfileHeader(), h) //nolint:dogsled // only err matters
+	require.NoError(t, err)
+
+	// Recorded, but its header is gone.
+	require.NoError(t, RecordReferences(ctx, s, childHeader(t, deleted, parent)))
+
+	err = CheckUnreferenced(ctx, s, parent)
+	require.ErrorIs(t, err, ErrBuildReferenced)
+	require.ErrorContains(t, err, child.String())
+
+	// Past the pending grace only referrers with a header stay live.
+	referrers, err := Referrers(ctx, s, parent, time.Now().Add(2*referencePendingGrace))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Referrer{
+		{BuildID: child, Live: true},
+		{BuildID: deleted, Live: false},
+	}, withoutRecorded(referrers))
+
+	// The stale marker was pruned by the lookup.
+	referrers, err = Referrers(ctx, s, parent, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []Referrer{{BuildID: child, Live: true}}, withoutRecorded(referrers))
+
+	require.NoError(t, CheckUnreferenced(ctx, s, child))
+
+	require.NoError(t, ForgetReferences(ctx, s, child))
+	require.NoError(t, CheckUnreferenced(ctx, s, parent))
+
+	referrers, err = Referrers(ctx, s, parent, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, referrers)
+}
+
+func withoutRecorded(referrers []Referrer) []Referrer {
+	out := make([]Referrer, len(referrers))
+	for i, r := range referrers {
+		r.Recorded = time.Time{}
+		out[i] = r
+	}
+
+	return out
+}
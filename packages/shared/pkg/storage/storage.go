@@ -35,6 +35,9 @@ var ErrObjectSoftDeleted = errors.New("object soft-deleted by storage index")
 // backend instead of assuming there is no tombstone.
 var ErrMetadataUnsupported = errors.New("blob does not support reading custom metadata")
 
+// ErrListUnsupported means the provider cannot enumerate its objects (no ObjectLister).
+var ErrListUnsupported = errors.New("storage provider does not support listing objects")
+
 // ObjectMetadataSoftDeleted is the storage-index soft-delete tombstone key.
 const ObjectMetadataSoftDeleted = storageopts.ObjectMetadataSoftDeleted
 
@@ -81,6 +84,33 @@ type StorageProvider interface {
 	GetDetails() string
 }
 
+// ObjectInfo describes a stored object as returned by listing.
+type ObjectInfo struct {
+	// Path is relative to the provider root, "/"-separated (e.g. "{buildID}/memfile").
+	Path     string
+	Size     int64
+	Modified time.Time
+}
+
+// ObjectLister is an optional StorageProvider capability: enumerate objects
+// under a path prefix. It is meant for offline tooling (garbage collection,
+// audits), not for the sandbox read path.
+type ObjectLister interface {
+	ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error
+}
+
+// ListObjects calls fn for every object whose path starts with prefix, or
+// returns ErrListUnsupported when the provider can't list. An error returned
+// by fn stops the listing and is returned as-is.
+func ListObjects(ctx context.Context, p StorageProvider, prefix string, fn func(ObjectInfo) error) error {
+	l, ok := p.(ObjectLister)
+	if !ok {
+		return ErrListUnsupported
+	}
+
+	return l.ListObjects(ctx, prefix, fn)
+}
+
 type (
 	ObjectMetadata = storageopts.ObjectMetadata
 	ObjectOrigin   = storageopts.ObjectOrigin
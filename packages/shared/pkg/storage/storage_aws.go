@@ -29,6 +29,11 @@ const (
 	awsWriteTimeout            = 30 * time.Second
 	awsReadTimeout             = 15 * time.Second
 	awsMultipartUploadPartSize = 10 * 1024 * 1024
+
+	// awsCompatibleDefaultRegion is used for custom endpoints when no region is
+	// configured. S3-compatible backends (MinIO, Ceph RGW, …) accept it by
+	// default, while the SDK refuses to sign requests without any region.
+	awsCompatibleDefaultRegion = "us-east-1"
 )
 
 type awsStorage struct {
@@ -38,7 +43,10 @@ type awsStorage struct {
 	limiter       *limit.Limiter
 }
 
-var _ StorageProvider = (*awsStorage)(nil)
+var (
+	_ StorageProvider = (*awsStorage)(nil)
+	_ ObjectLister    = (*awsStorage)(nil)
+)
 
 type awsObject struct {
 	client     *s3.Client
@@ -68,11 +76,21 @@ func newAWSStorage(ctx context.Context, spec Spec, limiter *limit.Limiter) (*aws
 		if spec.Region != "" {
 			o.Region = spec.Region
 		}
+		if spec.Endpoint != "" && o.Region == "" {
+			o.Region = awsCompatibleDefaultRegion
+		}
 
 		// Path-style addressing (https://host/bucket/key instead of the SDK's
 		// default virtual-host style https://bucket.host/key) is required by
 		// most S3-compatible backends (MinIO, Ceph, …).
 		o.UsePathStyle = spec.UsePathStyle
+
+		// Flexible checksums (CRC32 trailers on uploads, validation on
+		// downloads) are not implemented by every S3-compatible backend.
+		if spec.ChecksumWhenRequired {
+			o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+			o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+		}
 	})
 	presignClient := s3.NewPresignClient(client)
 
@@ -85,12 +103,34 @@ func newAWSStorage(ctx context.Context, spec Spec, limiter *limit.Limiter) (*aws
 }
 
 func (s *awsStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	// ListObjectsV2 returns at most 1000 keys per page, which is also the
+	// DeleteObjects limit, so every page is deleted with a single request.
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{Bucket: &s.bucketName, Prefix: &prefix})
+
+	deleted := 0
+	for paginator.HasMorePages() {
+		n, err := s.deleteObjectsPage(ctx, paginator)
+		if err != nil {
+			return err
+		}
+
+		deleted += n
+	}
+
+	if deleted == 0 {
+		logger.L().Warn(ctx, "No objects found to delete with the given prefix", zap.String("prefix", prefix), zap.String("bucket", s.bucketName))
+	}
+
+	return nil
+}
+
+func (s *awsStorage) deleteObjectsPage(ctx context.Context, paginator *s3.ListObjectsV2Paginator) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, awsOperationTimeout)
 	defer cancel()
 
-	list, err := s.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: &s.bucketName, Prefix: &prefix})
+	list, err := paginator.NextPage(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	objects := make([]types.ObjectIdentifier, 0, len(list.Contents))
@@ -100,9 +140,7 @@ func (s *awsStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string)
 
 	// AWS S3 delete operation requires at least one object to delete.
 	if len(objects) == 0 {
-		logger.L().Warn(ctx, "No objects found to delete with the given prefix", zap.String("prefix", prefix), zap.String("bucket", s.bucketName))
-
-		return nil
+		return 0, nil
 	}
 
 	output, err := s.client.DeleteObjects(
@@ -112,7 +150,7 @@ func (s *awsStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string)
 		},
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if len(output.Errors) > 0 {
@@ -121,16 +159,50 @@ func (s *awsStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string)
 			fmt.Fprintf(&errStr, "Key: %s, Code: %s, Message: %s; ", aws.ToString(delErr.Key), aws.ToString(delErr.Code), aws.ToString(delErr.Message))
 		}
 
-		return errors.New("errors occurred during deletion: " + errStr.String())
+		return 0, errors.New("errors occurred during deletion: " + errStr.String())
 	}
 
 	if len(output.Deleted) != len(objects) {
-		return errors.New("not all objects listed were deleted")
+		return 0, errors.New("not all objects listed were deleted")
+	}
+
+	return len(objects), nil
+}
+
+func (s *awsStorage) ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{Bucket: &s.bucketName, Prefix: &prefix})
+
+	for paginator.HasMorePages() {
+		list, err := s.listObjectsPage(ctx, paginator)
+		if err != nil {
+			return fmt.Errorf("error when listing objects: %w", err)
+		}
+
+		for _, obj := range list.Contents {
+			info := ObjectInfo{
+				Path:     aws.ToString(obj.Key),
+				Size:     aws.ToInt64(obj.Size),
+				Modified: aws.ToTime(obj.LastModified),
+			}
+
+			if err := fn(info); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// listObjectsPage bounds each page request, not the whole listing, which
+// can take arbitrarily long on a large bucket.
+func (s *awsStorage) listObjectsPage(ctx context.Context, paginator *s3.ListObjectsV2Paginator) (*s3.ListObjectsV2Output, error) {
+	ctx, cancel := context.WithTimeout(ctx, awsOperationTimeout)
+	defer cancel()
+
+	return paginator.NextPage(ctx)
+}
+
 func (s *awsStorage) GetDetails() string {
 	return fmt.Sprintf("[AWS Storage, bucket set to %s]", s.bucketName)
 }
@@ -227,6 +299,8 @@ func (o *awsObject) StoreFile(ctx context.Context, path string, opts ...PutOptio
 		func(u *manager.Uploader) {
 			u.PartSize = awsMultipartUploadPartSize
 			u.Concurrency = o.limiter.MaxUploadTasks(ctx)
+			// The uploader does not inherit the client's checksum setting.
+			u.RequestChecksumCalculation = o.client.Options().RequestChecksumCalculation
 		},
 	)
 
@@ -412,7 +486,7 @@ func (m *awsPartUploader) Start(ctx context.Context) error {
 		// initiation and echoed per part in Complete. Declare it explicitly on
 		// every call so the flow is consistent regardless of SDK/env config
 		// (manager.Uploader does the same for the uncompressed path).
-		ChecksumAlgorithm: types.ChecksumAlgorithmCrc32,
+		ChecksumAlgorithm: m.checksumAlgorithm(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to initiate multipart upload: %w", err)
@@ -435,7 +509,7 @@ func (m *awsPartUploader) UploadPart(ctx context.Context, partIndex int, data ..
 		PartNumber:        aws.Int32(int32(partIndex)),
 		Body:              body,
 		ContentLength:     aws.Int64(body.Size()),
-		ChecksumAlgorithm: types.ChecksumAlgorithmCrc32,
+		ChecksumAlgorithm: m.checksumAlgorithm(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to upload part %d: %w", partIndex, err)
@@ -452,6 +526,17 @@ func (m *awsPartUploader) UploadPart(ctx context.Context, partIndex int, data ..
 	return nil
 }
 
+// checksumAlgorithm is CRC32 unless the client only computes checksums when
+// required, in which case no algorithm is declared at all (matching
+// manager.Uploader).
+func (m *awsPartUploader) checksumAlgorithm() types.ChecksumAlgorithm {
+	if m.client.Options().RequestChecksumCalculation == aws.RequestChecksumCalculationWhenRequired {
+		return ""
+	}
+
+	return types.ChecksumAlgorithmCrc32
+}
+
 func (m *awsPartUploader) Complete(ctx context.Context) error {
 	m.mu.Lock()
 	parts := make([]types.CompletedPart, len(m.parts))
@@ -240,6 +240,189 @@ func TestAWSCompressedStoreFileEmptyFile(t *testing.T) {
 	require.Equal(t, 0, ft.Table().NumFrames())
 }
 
+func TestAWSStorageDeleteObjectsWithPrefixPaginates(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"":       `<ListBucketResult><IsTruncated>true</IsTruncated><NextContinuationToken>page-2</NextContinuationToken><Contents><Key>prefix/a</Key></Contents><Contents><Key>prefix/b</Key></Contents></ListBucketResult>`,
+		"page-2": `<ListBucketResult><IsTruncated>false</IsTruncated><Contents><Key>prefix/c</Key></Contents></ListBucketResult>`,
+	}
+
+	var mu sync.Mutex
+	var deleted []string
+
+	client := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+			assert.Equal(t, "prefix/", r.URL.Query().Get("prefix"))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(pages[r.URL.Query().Get("continuation-token")]))
+		case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+			var req struct {
+				Objects []struct {
+					Key string `xml:"Key"`
+				} `xml:"Object"`
+			}
+			if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decode delete request: %v", err)
+			}
+
+			var resp strings.Builder
+			resp.WriteString(`<DeleteResult>`)
+			mu.Lock()
+			for _, obj := range req.Objects {
+				deleted = append(deleted, obj.Key)
+				fmt.Fprintf(&resp, `<Deleted><Key>%s</Key></Deleted>`, obj.Key)
+			}
+			mu.Unlock()
+			resp.WriteString(`</DeleteResult>`)
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(resp.String()))
+		default:
+			t.Fatalf("unexpected AWS request: %s %s", r.Method, r.URL.String())
+		}
+	})
+
+	s := &awsStorage{client: client, bucketName: testBucketName}
+	require.NoError(t, s.DeleteObjectsWithPrefix(t.Context(), "prefix/"))
+	require.Equal(t, []string{"prefix/a", "prefix/b", "prefix/c"}, deleted)
+}
+
+func TestAWSStorageListObjects(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"":       `<ListBucketResult><IsTruncated>true</IsTruncated><NextContinuationToken>page-2</NextContinuationToken><Contents><Key>build/memfile</Key><Size>10</Size><LastModified>2026-01-02T03:04:05.000Z</LastModified></Contents></ListBucketResult>`,
+		"page-2": `<ListBucketResult><IsTruncated>false</IsTruncated><Contents><Key>build/snapfile</Key><Size>20</Size><LastModified>2026-01-03T03:04:05.000Z</LastModified></Contents></ListBucketResult>`,
+	}
+
+	client := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Query().Get("list-type") != "2" {
+			t.Fatalf("unexpected AWS request: %s %s", r.Method, r.URL.String())
+		}
+
+		assert.Equal(t, "build/", r.URL.Query().Get("prefix"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(pages[r.URL.Query().Get("continuation-token")]))
+	})
+
+	s := &awsStorage{client: client, bucketName: testBucketName}
+
+	var listed []ObjectInfo
+	err := s.ListObjects(t.Context(), "build/", func(info ObjectInfo) error {
+		listed = append(listed, info)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, listed, 2)
+	assert.Equal(t, "build/memfile", listed[0].Path)
+	assert.Equal(t, int64(10), listed[0].Size)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), listed[0].Modified.UTC())
+	assert.Equal(t, "build/snapfile", listed[1].Path)
+}
+
+// TestAWSStorageS3Compatible builds the provider from a Spec the way the
+// orchestrator does for a self-hosted S3-compatible backend and checks the
+// requests it sends to a stand-in server: path-style addressing, a default
+// region and no flexible checksums.
+func TestAWSStorageS3Compatible(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AWS_CONFIG_FILE", dir+"/config")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", dir+"/credentials")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "minioadmin")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "minioadmin")
+
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	var parts [][]byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key := range r.Header {
+			if strings.HasPrefix(strings.ToLower(key), "x-amz-checksum-") || strings.EqualFold(key, "x-amz-trailer") {
+				t.Errorf("unexpected checksum header %s on %s %s", key, r.Method, r.URL.String())
+			}
+		}
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/s3/", "request should be signed for the default region")
+
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+testBucketName+"/")
+		if !ok {
+			t.Errorf("request is not path-style: %s", r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Query().Has("uploads"):
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<InitiateMultipartUploadResult><UploadId>compat-upload-id</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodPut && r.URL.Query().Get("partNumber") != "":
+			parts = append(parts, []byte(readAllString(t, r.Body)))
+			w.Header().Set("ETag", `"etag"`)
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Query().Get("uploadId") == "compat-upload-id":
+			objects[key] = bytes.Join(parts, nil)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<CompleteMultipartUploadResult><ETag>"complete-etag"</ETag></CompleteMultipartUploadResult>`))
+		case r.Method == http.MethodPut:
+			objects[key] = []byte(readAllString(t, r.Body))
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet:
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(data)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := NewProvider(t.Context(), Spec{
+		Provider:             AWSStorageProvider,
+		Bucket:               testBucketName,
+		Endpoint:             server.URL,
+		UsePathStyle:         true,
+		ChecksumWhenRequired: true,
+	})
+	require.NoError(t, err)
+
+	blob, err := provider.OpenBlob(t.Context(), "small")
+	require.NoError(t, err)
+	require.NoError(t, blob.Put(t.Context(), []byte("hello")))
+
+	var got bytes.Buffer
+	_, err = blob.WriteTo(t.Context(), &got)
+	require.NoError(t, err)
+	require.Equal(t, "hello", got.String())
+
+	input := []byte(strings.Repeat("compressible-data", 1024))
+	seekable, err := provider.OpenSeekable(t.Context(), testObjectName)
+	require.NoError(t, err)
+	_, _, err = seekable.StoreFile(t.Context(), writeTempFile(t, input), WithCompressConfig(testCompressConfig()))
+	require.NoError(t, err)
+	require.NotEmpty(t, parts)
+
+	signedURL, err := provider.UploadSignedURL(t.Context(), "upload", time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(signedURL, server.URL+"/"+testBucketName+"/upload?"), signedURL)
+}
+
 type completeMultipartUploadRequest struct {
 	Parts []struct {
 		PartNumber    int    `xml:"PartNumber"`
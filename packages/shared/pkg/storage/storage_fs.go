@@ -27,7 +27,10 @@ type fsStorage struct {
 	hmacKey   []byte // HMAC key for signing upload tokens
 }
 
-var _ StorageProvider = (*fsStorage)(nil)
+var (
+	_ StorageProvider = (*fsStorage)(nil)
+	_ ObjectLister    = (*fsStorage)(nil)
+)
 
 type fsObject struct {
 	path    string
@@ -54,6 +57,51 @@ func (s *fsStorage) DeleteObjectsWithPrefix(_ context.Context, prefix string) er
 	return os.RemoveAll(filePath)
 }
 
+func (s *fsStorage) ListObjects(_ context.Context, prefix string, fn func(ObjectInfo) error) error {
+	// Walk only the deepest directory the prefix fully names; the rest of the
+	// prefix is matched against the relative paths.
+	root := s.basePath
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		root = s.getPath(prefix[:i])
+	}
+
+	return filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		// Objects (or the whole prefix) removed while walking are simply not listed.
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.basePath, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if !strings.HasPrefix(rel, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fn(ObjectInfo{Path: rel, Size: info.Size(), Modified: info.ModTime()})
+	})
+}
+
 func (s *fsStorage) GetDetails() string {
 	return fmt.Sprintf("[Local file storage, base path set to %s]", s.basePath)
 }
@@ -114,6 +114,37 @@ func TestDeleteObjectsWithPrefix(t *testing.T) {
 	}
 }
 
+func TestFSListObjects(t *testing.T) {
+	t.Parallel()
+	p := newTempProvider(t)
+	ctx := t.Context()
+
+	for _, pth := range []string{"build-a/memfile", "build-a/sub/rootfs.ext4", "build-b/memfile"} {
+		obj, err := p.OpenBlob(ctx, pth)
+		require.NoError(t, err)
+		require.NoError(t, obj.Put(ctx, []byte("xy")))
+	}
+
+	list := func(prefix string) []string {
+		var paths []string
+		err := ListObjects(ctx, p, prefix, func(info ObjectInfo) error {
+			assert.Equal(t, int64(2), info.Size)
+			assert.False(t, info.Modified.IsZero())
+			paths = append(paths, info.Path)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		return paths
+	}
+
+	assert.ElementsMatch(t, []string{"build-a/memfile", "build-a/sub/rootfs.ext4", "build-b/memfile"}, list(""))
+	assert.ElementsMatch(t, []string{"build-a/memfile", "build-a/sub/rootfs.ext4"}, list("build-a/"))
+	assert.ElementsMatch(t, []string{"build-a/sub/rootfs.ext4"}, list("build-a/sub/root"))
+	assert.Empty(t, list("missing/"))
+}
+
 func TestWriteToNonExistentObject(t *testing.T) {
 	t.Parallel()
 	p := newTempProvider(t)
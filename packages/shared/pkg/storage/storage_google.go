@@ -54,7 +54,10 @@ type gcpStorage struct {
 	limiter *limit.Limiter
 }
 
-var _ StorageProvider = (*gcpStorage)(nil)
+var (
+	_ StorageProvider = (*gcpStorage)(nil)
+	_ ObjectLister    = (*gcpStorage)(nil)
+)
 
 type gcpObject struct {
 	storage *gcpStorage
@@ -118,6 +121,29 @@ func (s *gcpStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string)
 	return nil
 }
 
+func (s *gcpStorage) ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	query := &storage.Query{Prefix: prefix}
+	if err := query.SetAttrSelection([]string{"Name", "Size", "Updated"}); err != nil {
+		return fmt.Errorf("failed to set list attributes: %w", err)
+	}
+
+	objects := s.bucket.Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error when listing objects: %w", err)
+		}
+
+		if err := fn(ObjectInfo{Path: attrs.Name, Size: attrs.Size, Modified: attrs.Updated}); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *gcpStorage) GetDetails() string {
 	return fmt.Sprintf("[GCP Storage, bucket set to %s]", s.bucket.BucketName())
 }
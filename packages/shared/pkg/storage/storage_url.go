@@ -40,6 +40,10 @@ type Spec struct {
 	UsePathStyle bool
 	// Region overrides the S3 region; empty defers to the AWS SDK (AWS_REGION et al.).
 	Region string
+	// ChecksumWhenRequired limits S3 request checksums and response checksum
+	// validation to operations that require them. Needed by S3-compatible
+	// backends without flexible checksum support (older Ceph RGW, …).
+	ChecksumWhenRequired bool
 }
 
 // ParseStorageURL parses a storage URL into a Spec, following the
@@ -48,6 +52,7 @@ type Spec struct {
 //	gs://bucket                                                    Google Cloud Storage
 //	s3://bucket?endpoint=http://host:port/s3&s3ForcePathStyle=true S3 / S3-compatible
 //	s3://bucket?region=us-east-1                                   plain AWS S3
+//	s3://bucket?endpoint=…&checksum=when_required                  S3-compatible without flexible checksums
 //	file:///var/lib/storage                                        local filesystem
 //	file:relative/path                                             local filesystem (relative)
 //
@@ -140,8 +145,17 @@ func parseBucketURL(u *url.URL, provider Provider) (Spec, error) {
 			spec.UsePathStyle = pathStyle
 		case "region":
 			spec.Region = value
+		case "checksum":
+			switch value {
+			case "when_supported":
+				spec.ChecksumWhenRequired = false
+			case "when_required":
+				spec.ChecksumWhenRequired = true
+			default:
+				return Spec{}, fmt.Errorf("storage URL %q: invalid checksum %q (want when_supported or when_required)", redactedURL(u), value)
+			}
 		default:
-			return Spec{}, fmt.Errorf("storage URL %q: unsupported query parameter %q (want endpoint, s3ForcePathStyle, or region)", redactedURL(u), key)
+			return Spec{}, fmt.Errorf("storage URL %q: unsupported query parameter %q (want endpoint, s3ForcePathStyle, region, or checksum)", redactedURL(u), key)
 		}
 	}
 
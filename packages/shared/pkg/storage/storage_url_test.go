@@ -46,6 +46,22 @@ func TestParseStorageURL(t *testing.T) {
 				UsePathStyle: true,
 			},
 		},
+		{
+			name: "s3-compatible endpoint with checksums when required",
+			url:  "s3://templates?endpoint=http://rgw:7480&s3ForcePathStyle=true&checksum=when_required",
+			want: Spec{
+				Provider:             AWSStorageProvider,
+				Bucket:               "templates",
+				Endpoint:             "http://rgw:7480",
+				UsePathStyle:         true,
+				ChecksumWhenRequired: true,
+			},
+		},
+		{
+			name: "s3 checksums when supported",
+			url:  "s3://my-bucket?checksum=when_supported",
+			want: Spec{Provider: AWSStorageProvider, Bucket: "my-bucket"},
+		},
 		{
 			name: "s3 path style false",
 			url:  "s3://my-bucket?s3ForcePathStyle=false",
@@ -106,6 +122,11 @@ func TestParseStorageURL(t *testing.T) {
 			url:     "s3://my-bucket?s3ForcePathStyle=yep",
 			wantErr: "invalid s3ForcePathStyle",
 		},
+		{
+			name:    "invalid checksum value",
+			url:     "s3://my-bucket?checksum=never",
+			wantErr: "invalid checksum",
+		},
 		{
 			name:    "endpoint without scheme",
 			url:     "s3://my-bucket?endpoint=minio:9000",
@@ -24,12 +24,15 @@ const (
 
 // Defines values for EntryInfoType.
 const (
-	File EntryInfoType = "file"
+	Directory EntryInfoType = "directory"
+	File      EntryInfoType = "file"
 )
 
 // Valid indicates whether the value is a known member of the EntryInfoType enum.
 func (e EntryInfoType) Valid() bool {
 	switch e {
+	case Directory:
+		return true
 	case File:
 		return true
 	default:
@@ -37,6 +40,81 @@ func (e EntryInfoType) Valid() bool {
 	}
 }
 
+// Defines values for ArchiveFormat.
+const (
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+	ArchiveFormatZip   ArchiveFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the ArchiveFormat enum.
+func (e ArchiveFormat) Valid() bool {
+	switch e {
+	case ArchiveFormatTarGz:
+		return true
+	case ArchiveFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesParamsMode.
+const (
+	Append    PostFilesParamsMode = "append"
+	Overwrite PostFilesParamsMode = "overwrite"
+	Write     PostFilesParamsMode = "write"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesParamsMode enum.
+func (e PostFilesParamsMode) Valid() bool {
+	switch e {
+	case Append:
+		return true
+	case Overwrite:
+		return true
+	case Write:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetFilesArchiveParamsFormat.
+const (
+	GetFilesArchiveParamsFormatTarGz GetFilesArchiveParamsFormat = "tar.gz"
+	GetFilesArchiveParamsFormatZip   GetFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the GetFilesArchiveParamsFormat enum.
+func (e GetFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case GetFilesArchiveParamsFormatTarGz:
+		return true
+	case GetFilesArchiveParamsFormatZip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostFilesArchiveParamsFormat.
+const (
+	TarGz PostFilesArchiveParamsFormat = "tar.gz"
+	Zip   PostFilesArchiveParamsFormat = "zip"
+)
+
+// Valid indicates whether the value is a known member of the PostFilesArchiveParamsFormat enum.
+func (e PostFilesArchiveParamsFormat) Valid() bool {
+	switch e {
+	case TarGz:
+		return true
+	case Zip:
+		return true
+	default:
+		return false
+	}
+}
+
 // CollapseResult Per-call statistics from a heap collapse
 type CollapseResult struct {
 	// AlreadyHuge Chunks MADV_COLLAPSE accepted but were already hugepages (no work)
@@ -91,6 +169,15 @@ type EntryInfoType string
 // EnvVars Environment variables to set
 type EnvVars map[string]string
 
+// EnvVarsUpdate Environment variables to set and unset, other environment variables are kept
+type EnvVarsUpdate struct {
+	// Set Environment variables to set
+	Set *EnvVars `json:"set,omitempty"`
+
+	// Unset Names of the environment variables to unset
+	Unset *[]string `json:"unset,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -142,6 +229,9 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// ArchiveFormat defines model for ArchiveFormat.
+type ArchiveFormat string
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -211,8 +301,61 @@ type PostFilesParams struct {
 
 	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
 	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Mode How the uploaded content is written: `overwrite` replaces the file,
+	// `append` adds the content to its end, and `write` writes it at
+	// `offset`, keeping the rest of the file.
+	Mode *PostFilesParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// Offset Byte offset to write the content at. Required with the `write` mode and not allowed with the other modes.
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// PostFilesParamsMode defines parameters for PostFiles.
+type PostFilesParamsMode string
+
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username *User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format *GetFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// GetFilesArchiveParamsFormat defines parameters for GetFilesArchive.
+type GetFilesArchiveParamsFormat string
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to the user's home directory (e.g. "file.txt" resolves to ~/file.txt).
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User for setting file ownership and resolving relative paths. Defaults to the sandbox's default user.
+	Username *User `form:"username,omitempty" json:"username,omitempty"`
+
+	// Format Format of the archive.
+	Format *PostFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Unix timestamp (seconds) after which the signature expires. Only used with the signature parameter.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PostFilesArchiveParamsFormat defines parameters for PostFilesArchive.
+type PostFilesArchiveParamsFormat string
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// AccessToken Access token for secure access to envd service
@@ -236,11 +379,17 @@ type PostInitJSONBody struct {
 	// LifecycleID Lifecycle ID of the sandbox
 	LifecycleID *string `json:"lifecycleID,omitempty"`
 
+	// LogProcessOutput Ship the output of started processes to the sandbox logs unless a process sets it explicitly
+	LogProcessOutput *bool `json:"logProcessOutput,omitempty"`
+
 	// Timestamp The current timestamp in RFC3339 format
 	Timestamp    *time.Time     `json:"timestamp,omitempty"`
 	VolumeMounts *[]VolumeMount `json:"volumeMounts,omitempty"`
 }
 
+// PatchEnvsJSONRequestBody defines body for PatchEnvs for application/json ContentType.
+type PatchEnvsJSONRequestBody = EnvVarsUpdate
+
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
@@ -329,12 +478,23 @@ type ClientInterface interface {
 	// GetEnvs request
 	GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchEnvsWithBody request with any body
+	PatchEnvsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEnvs(ctx context.Context, body PatchEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFiles request
 	GetFiles(ctx context.Context, params *GetFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostFilesWithBody request with any body
 	PostFilesWithBody(ctx context.Context, params *PostFilesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetFilesArchive request
+	GetFilesArchive(ctx context.Context, params *GetFilesArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFilesArchiveWithBody request with any body
+	PostFilesArchiveWithBody(ctx context.Context, params *PostFilesArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostFilesComposeWithBody request with any body
 	PostFilesComposeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -388,6 +548,30 @@ func (c *Client) GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
+func (c *Client) PatchEnvsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEnvs(ctx context.Context, body PatchEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetFiles(ctx context.Context, params *GetFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetFilesRequest(c.Server, params)
 	if err != nil {
@@ -412,6 +596,30 @@ func (c *Client) PostFilesWithBody(ctx context.Context, params *PostFilesParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetFilesArchive(ctx context.Context, params *GetFilesArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFilesArchiveRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFilesArchiveWithBody(ctx context.Context, params *PostFilesArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFilesArchiveRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostFilesComposeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostFilesComposeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -578,16 +786,262 @@ func NewGetEnvsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchEnvsRequest calls the generic PatchEnvs builder with application/json body
+func NewPatchEnvsRequest(server string, body PatchEnvsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEnvsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchEnvsRequestWithBody generates requests for PatchEnvs with any type of body
+func NewPatchEnvsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/envs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFilesRequest generates requests for GetFiles
+func NewGetFilesRequest(server string, params *GetFilesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "username", *params.Username, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Signature != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature", *params.Signature, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SignatureExpiration != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature_expiration", *params.SignatureExpiration, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFilesRequestWithBody generates requests for PostFiles with any type of body
+func NewPostFilesRequestWithBody(server string, params *PostFilesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "username", *params.Username, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Signature != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature", *params.Signature, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SignatureExpiration != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature_expiration", *params.SignatureExpiration, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "mode", *params.Mode, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetFilesRequest generates requests for GetFiles
-func NewGetFilesRequest(server string, params *GetFilesParams) (*http.Request, error) {
+// NewGetFilesArchiveRequest generates requests for GetFilesArchive
+func NewGetFilesArchiveRequest(server string, params *GetFilesArchiveParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -595,7 +1049,7 @@ func NewGetFilesRequest(server string, params *GetFilesParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/files")
+	operationPath := fmt.Sprintf("/files/archive")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -638,6 +1092,18 @@ func NewGetFilesRequest(server string, params *GetFilesParams) (*http.Request, e
 
 		}
 
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if params.Signature != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature", *params.Signature, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
@@ -676,8 +1142,8 @@ func NewGetFilesRequest(server string, params *GetFilesParams) (*http.Request, e
 	return req, nil
 }
 
-// NewPostFilesRequestWithBody generates requests for PostFiles with any type of body
-func NewPostFilesRequestWithBody(server string, params *PostFilesParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostFilesArchiveRequestWithBody generates requests for PostFilesArchive with any type of body
+func NewPostFilesArchiveRequestWithBody(server string, params *PostFilesArchiveParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -685,7 +1151,7 @@ func NewPostFilesRequestWithBody(server string, params *PostFilesParams, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/files")
+	operationPath := fmt.Sprintf("/files/archive")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -728,6 +1194,18 @@ func NewPostFilesRequestWithBody(server string, params *PostFilesParams, content
 
 		}
 
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if params.Signature != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "signature", *params.Signature, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
@@ -1059,12 +1537,23 @@ type ClientWithResponsesInterface interface {
 	// GetEnvsWithResponse request
 	GetEnvsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnvsResponse, error)
 
+	// PatchEnvsWithBodyWithResponse request with any body
+	PatchEnvsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvsResponse, error)
+
+	PatchEnvsWithResponse(ctx context.Context, body PatchEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvsResponse, error)
+
 	// GetFilesWithResponse request
 	GetFilesWithResponse(ctx context.Context, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error)
 
 	// PostFilesWithBodyWithResponse request with any body
 	PostFilesWithBodyWithResponse(ctx context.Context, params *PostFilesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFilesResponse, error)
 
+	// GetFilesArchiveWithResponse request
+	GetFilesArchiveWithResponse(ctx context.Context, params *GetFilesArchiveParams, reqEditors ...RequestEditorFn) (*GetFilesArchiveResponse, error)
+
+	// PostFilesArchiveWithBodyWithResponse request with any body
+	PostFilesArchiveWithBodyWithResponse(ctx context.Context, params *PostFilesArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFilesArchiveResponse, error)
+
 	// PostFilesComposeWithBodyWithResponse request with any body
 	PostFilesComposeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFilesComposeResponse, error)
 
@@ -1155,6 +1644,36 @@ func (r GetEnvsResponse) ContentType() string {
 	return ""
 }
 
+type PatchEnvsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchEnvsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchEnvsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchEnvsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type GetFilesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1223,6 +1742,73 @@ func (r PostFilesResponse) ContentType() string {
 	return ""
 }
 
+type GetFilesArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *InvalidPath
+	JSON401      *InvalidUser
+	JSON404      *FileNotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFilesArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFilesArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetFilesArchiveResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostFilesArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EntryInfo
+	JSON400      *InvalidPath
+	JSON401      *InvalidUser
+	JSON500      *InternalServerError
+	JSON507      *NotEnoughDiskSpace
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFilesArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFilesArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostFilesArchiveResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type PostFilesComposeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1484,6 +2070,23 @@ func (c *ClientWithResponses) GetEnvsWithResponse(ctx context.Context, reqEditor
 	return ParseGetEnvsResponse(rsp)
 }
 
+// PatchEnvsWithBodyWithResponse request with arbitrary body returning *PatchEnvsResponse
+func (c *ClientWithResponses) PatchEnvsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvsResponse, error) {
+	rsp, err := c.PatchEnvsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchEnvsWithResponse(ctx context.Context, body PatchEnvsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvsResponse, error) {
+	rsp, err := c.PatchEnvs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvsResponse(rsp)
+}
+
 // GetFilesWithResponse request returning *GetFilesResponse
 func (c *ClientWithResponses) GetFilesWithResponse(ctx context.Context, params *GetFilesParams, reqEditors ...RequestEditorFn) (*GetFilesResponse, error) {
 	rsp, err := c.GetFiles(ctx, params, reqEditors...)
@@ -1502,6 +2105,24 @@ func (c *ClientWithResponses) PostFilesWithBodyWithResponse(ctx context.Context,
 	return ParsePostFilesResponse(rsp)
 }
 
+// GetFilesArchiveWithResponse request returning *GetFilesArchiveResponse
+func (c *ClientWithResponses) GetFilesArchiveWithResponse(ctx context.Context, params *GetFilesArchiveParams, reqEditors ...RequestEditorFn) (*GetFilesArchiveResponse, error) {
+	rsp, err := c.GetFilesArchive(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFilesArchiveResponse(rsp)
+}
+
+// PostFilesArchiveWithBodyWithResponse request with arbitrary body returning *PostFilesArchiveResponse
+func (c *ClientWithResponses) PostFilesArchiveWithBodyWithResponse(ctx context.Context, params *PostFilesArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFilesArchiveResponse, error) {
+	rsp, err := c.PostFilesArchiveWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFilesArchiveResponse(rsp)
+}
+
 // PostFilesComposeWithBodyWithResponse request with arbitrary body returning *PostFilesComposeResponse
 func (c *ClientWithResponses) PostFilesComposeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFilesComposeResponse, error) {
 	rsp, err := c.PostFilesComposeWithBody(ctx, contentType, body, reqEditors...)
@@ -1649,6 +2270,32 @@ func ParseGetEnvsResponse(rsp *http.Response) (*GetEnvsResponse, error) {
 	return response, nil
 }
 
+// ParsePatchEnvsResponse parses an HTTP response from a PatchEnvsWithResponse call
+func ParsePatchEnvsResponse(rsp *http.Response) (*PatchEnvsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchEnvsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetFilesResponse parses an HTTP response from a GetFilesWithResponse call
 func ParseGetFilesResponse(rsp *http.Response) (*GetFilesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1757,6 +2404,107 @@ func ParsePostFilesResponse(rsp *http.Response) (*PostFilesResponse, error) {
 	return response, nil
 }
 
+// ParseGetFilesArchiveResponse parses an HTTP response from a GetFilesArchiveWithResponse call
+func ParseGetFilesArchiveResponse(rsp *http.Response) (*GetFilesArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFilesArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidPath
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest InvalidUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest FileNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFilesArchiveResponse parses an HTTP response from a PostFilesArchiveWithResponse call
+func ParsePostFilesArchiveResponse(rsp *http.Response) (*PostFilesArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFilesArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntryInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidPath
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest InvalidUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 507:
+		var dest NotEnoughDiskSpace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON507 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostFilesComposeResponse parses an HTTP response from a PostFilesComposeWithResponse call
 func ParsePostFilesComposeResponse(rsp *http.Response) (*PostFilesComposeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -0,0 +1,109 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/envd"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxCheckpointRestore(t *testing.T) {
+	t.Parallel()
+	c := setup.GetAPIClient()
+
+	// Checkpoints and the restore can outlast the default 30s sandbox timeout
+	// on a loaded runner.
+	sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false), utils.WithTimeout(300))
+	sbxID := sbx.SandboxID
+
+	envdClient := setup.GetEnvdClient(t, t.Context())
+	path := "/checkpoint.txt"
+	utils.UploadFile(t, t.Context(), sbx, envdClient, path, "before")
+
+	name := "before-change"
+	createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxID, api.PostSandboxesSandboxIDCheckpointsJSONRequestBody{Name: &name}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createResp.StatusCode())
+	require.NotNil(t, createResp.JSON201)
+
+	checkpoint := *createResp.JSON201
+	require.NotNil(t, checkpoint.Name)
+	assert.Equal(t, name, *checkpoint.Name)
+
+	// Names are unique within the sandbox.
+	dupResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxID, api.PostSandboxesSandboxIDCheckpointsJSONRequestBody{Name: &name}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, dupResp.StatusCode())
+
+	// The sandbox keeps running after the checkpoint.
+	utils.UploadFile(t, t.Context(), sbx, envdClient, path, "after")
+
+	listResp, err := c.GetSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	require.Len(t, *listResp.JSON200, 1)
+	assert.Equal(t, checkpoint.CheckpointID, (*listResp.JSON200)[0].CheckpointID)
+
+	timeout := int32(300)
+	restoreResp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreWithResponse(t.Context(), sbxID, checkpoint.CheckpointID, api.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody{Timeout: &timeout}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, restoreResp.StatusCode())
+	require.NotNil(t, restoreResp.JSON201)
+	assert.Equal(t, sbxID, restoreResp.JSON201.SandboxID)
+
+	// The change made after the checkpoint is rolled back.
+	fileResponse, err := envdClient.HTTPClient.GetFilesWithResponse(
+		t.Context(),
+		&envd.GetFilesParams{
+			Path:     &path,
+			Username: new("user"),
+		},
+		setup.WithSandbox(t, sbxID),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, fileResponse.StatusCode())
+	assert.Equal(t, "before", string(fileResponse.Body))
+
+	deleteResp, err := c.DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse(t.Context(), sbxID, checkpoint.CheckpointID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	deleteResp, err = c.DeleteSandboxesSandboxIDCheckpointsCheckpointIDWithResponse(t.Context(), sbxID, checkpoint.CheckpointID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode())
+}
+
+func TestSandboxCheckpoint_AlreadyPaused(t *testing.T) {
+	t.Parallel()
+	c := setup.GetAPIClient()
+
+	sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+	pauseSandbox(t, c, sbx.SandboxID)
+
+	createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbx.SandboxID, api.PostSandboxesSandboxIDCheckpointsJSONRequestBody{}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, createResp.StatusCode())
+}
+
+func TestSandboxCheckpoint_CrossTeamAccess(t *testing.T) {
+	t.Parallel()
+	c := setup.GetAPIClient()
+	db := setup.GetTestDBClient(t)
+
+	sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+
+	foreignUserID := utils.CreateUser(t, db)
+	foreignTeamID := utils.CreateTeamWithUser(t, db, "foreign-team-checkpoint", foreignUserID.String())
+	foreignAPIKey := utils.CreateAPIKey(t, t.Context(), c, foreignUserID.String(), foreignTeamID)
+
+	createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbx.SandboxID, api.PostSandboxesSandboxIDCheckpointsJSONRequestBody{}, setup.WithAPIKey(foreignAPIKey))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, createResp.StatusCode())
+}
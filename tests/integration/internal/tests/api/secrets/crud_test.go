@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestSecretRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	client := setup.GetAPIClient()
+
+	name := "INTEGRATION_TEST_KEY"
+
+	// set secret
+	setSecret, err := client.PutSecretsSecretNameWithResponse(
+		t.Context(),
+		name,
+		api.PutSecretsSecretNameJSONRequestBody{Value: "sk-test"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, setSecret.StatusCode(), string(setSecret.Body))
+	require.NotNil(t, setSecret.JSON200)
+	assert.Equal(t, name, setSecret.JSON200.Name)
+	// The value is never returned.
+	assert.NotContains(t, string(setSecret.Body), "sk-test")
+
+	// list secrets
+	listSecrets, err := client.GetSecretsWithResponse(t.Context(), setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listSecrets.StatusCode(), string(listSecrets.Body))
+	require.NotNil(t, listSecrets.JSON200)
+	assert.NotContains(t, string(listSecrets.Body), "sk-test")
+
+	found := false
+	for _, s := range *listSecrets.JSON200 {
+		if s.Name == name {
+			found = true
+		}
+	}
+	assert.True(t, found, "set secret is not listed")
+
+	// delete secret
+	deleteSecret, err := client.DeleteSecretsSecretNameWithResponse(t.Context(), name, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, deleteSecret.StatusCode(), string(deleteSecret.Body))
+
+	// delete secret again
+	deleteSecret, err = client.DeleteSecretsSecretNameWithResponse(t.Context(), name, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, deleteSecret.StatusCode(), string(deleteSecret.Body))
+}
+
+func TestSecretSetRejectsInvalidName(t *testing.T) {
+	t.Parallel()
+
+	client := setup.GetAPIClient()
+
+	setSecret, err := client.PutSecretsSecretNameWithResponse(
+		t.Context(),
+		"not-valid",
+		api.PutSecretsSecretNameJSONRequestBody{Value: "value"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, setSecret.StatusCode(), string(setSecret.Body))
+}
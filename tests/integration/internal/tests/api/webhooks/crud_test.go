@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestWebhookRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	client := setup.GetAPIClient()
+
+	events := []api.SandboxEventType{api.SandboxLifecycleCreated, api.SandboxLifecycleKilled}
+
+	// create webhook
+	createWebhook, err := client.PostWebhooksWithResponse(
+		t.Context(),
+		api.PostWebhooksJSONRequestBody{Url: "https://example.com/e2b-webhook", Events: &events},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createWebhook.StatusCode(), string(createWebhook.Body))
+	require.NotNil(t, createWebhook.JSON201)
+	assert.NotEmpty(t, createWebhook.JSON201.SigningSecret)
+	assert.Equal(t, events, createWebhook.JSON201.Events)
+	webhook := createWebhook.JSON201
+
+	// list webhooks
+	listWebhooks, err := client.GetWebhooksWithResponse(t.Context(), setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listWebhooks.StatusCode(), string(listWebhooks.Body))
+	require.NotNil(t, listWebhooks.JSON200)
+
+	found := false
+	for _, w := range *listWebhooks.JSON200 {
+		if w.WebhookID == webhook.WebhookID {
+			found = true
+
+			assert.Equal(t, webhook.Url, w.Url)
+		}
+	}
+	assert.True(t, found, "created webhook is not listed")
+
+	// delete webhook
+	deleteWebhook, err := client.DeleteWebhooksWebhookIDWithResponse(t.Context(), webhook.WebhookID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, deleteWebhook.StatusCode(), string(deleteWebhook.Body))
+
+	// delete webhook again
+	deleteWebhook, err = client.DeleteWebhooksWebhookIDWithResponse(t.Context(), webhook.WebhookID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, deleteWebhook.StatusCode(), string(deleteWebhook.Body))
+}
+
+func TestWebhookCreateRejectsInsecureURL(t *testing.T) {
+	t.Parallel()
+
+	client := setup.GetAPIClient()
+
+	createWebhook, err := client.PostWebhooksWithResponse(
+		t.Context(),
+		api.PostWebhooksJSONRequestBody{Url: "http://example.com/e2b-webhook"},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, createWebhook.StatusCode(), string(createWebhook.Body))
+}